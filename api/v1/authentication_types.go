@@ -32,7 +32,9 @@ const (
 // AuthenticationSpec defines the desired state of Authentication
 type AuthenticationSpec struct {
 	// ManagerDomain is the domain name of the Manager
-	// +required
+	// It may be omitted when the Manager is exposed through Manager.Spec.Exposure, in which case it defaults
+	// to the exposure hostname. When both are set, they must refer to the same host.
+	// +optional
 	ManagerDomain string `json:"managerDomain,omitempty"`
 
 	// If specified, UsernamePrefix is prepended to each user obtained from the identity provider. Note that
@@ -59,9 +61,327 @@ type AuthenticationSpec struct {
 	// +optional
 	LDAP *AuthenticationLDAP `json:"ldap,omitempty"`
 
+	// Connectors configures identity providers in addition to the one configured through OIDC, Openshift or LDAP.
+	// Users can log in through any of the configured identity providers, which allows an identity provider for
+	// break-glass access, such as LDAP, to stay configured alongside the primary one.
+	// Connectors require Dex, so they cannot be combined with OIDC of type Tigera.
+	// +optional
+	// +listType=map
+	// +listMapKey=id
+	Connectors []AuthenticationConnector `json:"connectors,omitempty"`
+
+	// RoleMappings grants ClusterRoles to groups obtained from the identity providers. The operator renders a
+	// ClusterRoleBinding or RoleBindings for each mapping, and removes them when the mapping is removed.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	RoleMappings []AuthenticationRoleMapping `json:"roleMappings,omitempty"`
+
 	// DexDeployment configures the Dex Deployment.
 	// +optional
 	DexDeployment *DexDeployment `json:"dexDeployment,omitempty"`
+
+	// DexStorage configures where Dex stores its signing keys, refresh tokens and pending logins.
+	// Default: Kubernetes
+	// +optional
+	DexStorage *DexStorage `json:"dexStorage,omitempty"`
+
+	// DexReplicas is the number of Dex replicas. When more than one replica runs, a PodDisruptionBudget keeps
+	// at least one of them available during node drains.
+	// Default: Installation.Spec.ControlPlaneReplicas
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	DexReplicas *int32 `json:"dexReplicas,omitempty"`
+
+	// DexExpiry configures the lifetime of the tokens and keys issued by Dex.
+	// +optional
+	DexExpiry *DexExpiry `json:"dexExpiry,omitempty"`
+
+	// DexSession configures how long users stay logged in. Dex does not keep a login cookie; sessions of the
+	// manager are kept alive through refresh tokens, so these settings bound the refresh tokens Dex hands out.
+	// +optional
+	DexSession *DexSession `json:"dexSession,omitempty"`
+}
+
+// ConnectorType is the type of identity provider of a connector.
+// One of: OIDC, LDAP, GitHub, GitLab, SAML
+// +kubebuilder:validation:Enum=OIDC;LDAP;GitHub;GitLab;SAML
+type ConnectorType string
+
+const (
+	ConnectorTypeOIDC   ConnectorType = "OIDC"
+	ConnectorTypeLDAP   ConnectorType = "LDAP"
+	ConnectorTypeGitHub ConnectorType = "GitHub"
+	ConnectorTypeGitLab ConnectorType = "GitLab"
+	ConnectorTypeSAML   ConnectorType = "SAML"
+)
+
+// AuthenticationConnector configures an identity provider for Dex. Exactly the section matching Type must be set.
+type AuthenticationConnector struct {
+	// ID uniquely identifies the connector.
+	// +kubebuilder:validation:MaxLength=53
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	ID string `json:"id"`
+
+	// Name is shown on the login page.
+	// Default: the ID of the connector
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Type is the type of identity provider.
+	// +required
+	Type ConnectorType `json:"type"`
+
+	// SecretName is the name of a Secret in the tigera-operator namespace that contains the credentials for the
+	// identity provider. OIDC, GitHub and GitLab connectors require the fields clientID and clientSecret, LDAP
+	// connectors require bindDN and bindPW, and SAML connectors require rootCA, the certificate the identity provider
+	// signs its responses with. Other connectors may set rootCA to the CA of the identity provider.
+	// +required
+	SecretName string `json:"secretName"`
+
+	// OIDC configures an OpenID Connect identity provider.
+	// +optional
+	OIDC *AuthenticationOIDC `json:"oidc,omitempty"`
+
+	// LDAP configures an LDAP identity provider.
+	// +optional
+	LDAP *AuthenticationLDAP `json:"ldap,omitempty"`
+
+	// GitHub configures GitHub or GitHub Enterprise as identity provider.
+	// +optional
+	GitHub *AuthenticationGitHub `json:"github,omitempty"`
+
+	// GitLab configures GitLab as identity provider.
+	// +optional
+	GitLab *AuthenticationGitLab `json:"gitlab,omitempty"`
+
+	// SAML configures a SAML 2.0 identity provider.
+	// +optional
+	SAML *AuthenticationSAML `json:"saml,omitempty"`
+}
+
+// AuthenticationGitHub is the configuration needed to setup GitHub.
+type AuthenticationGitHub struct {
+	// HostName of the GitHub Enterprise instance. Leave empty to use github.com.
+	// +optional
+	HostName string `json:"hostName,omitempty"`
+
+	// Orgs restricts logins to members of these organizations. The teams of the user in these organizations
+	// are used as groups, formatted as "org:team".
+	// +optional
+	Orgs []GitHubOrg `json:"orgs,omitempty"`
+
+	// TeamNameField specifies which field of a team is used as group name.
+	// Default: name
+	// +kubebuilder:validation:Enum=name;slug;both
+	// +optional
+	TeamNameField string `json:"teamNameField,omitempty"`
+}
+
+// GitHubOrg is an organization users must be a member of.
+type GitHubOrg struct {
+	// Name of the organization.
+	// +required
+	Name string `json:"name"`
+
+	// Teams restricts logins to members of these teams within the organization.
+	// +optional
+	Teams []string `json:"teams,omitempty"`
+}
+
+// AuthenticationGitLab is the configuration needed to setup GitLab.
+type AuthenticationGitLab struct {
+	// BaseURL of the GitLab instance.
+	// Default: https://gitlab.com
+	// +optional
+	BaseURL string `json:"baseURL,omitempty"`
+
+	// Groups restricts logins to members of these groups.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// AuthenticationSAML is the configuration needed to setup SAML.
+type AuthenticationSAML struct {
+	// SSOURL is the URL of the identity provider to which users are redirected to log in.
+	// +required
+	SSOURL string `json:"ssoURL"`
+
+	// EntityIssuer is the issuer included in authentication requests.
+	// +optional
+	EntityIssuer string `json:"entityIssuer,omitempty"`
+
+	// SSOIssuer is the issuer the identity provider uses in its responses. If set, responses from other issuers
+	// are rejected.
+	// +optional
+	SSOIssuer string `json:"ssoIssuer,omitempty"`
+
+	// UsernameAttr is the attribute of the response that holds the username.
+	// +required
+	UsernameAttr string `json:"usernameAttr"`
+
+	// EmailAttr is the attribute of the response that holds the email address of the user.
+	// +required
+	EmailAttr string `json:"emailAttr"`
+
+	// GroupsAttr is the attribute of the response that holds the groups of the user.
+	// +optional
+	GroupsAttr string `json:"groupsAttr,omitempty"`
+
+	// NameIDPolicyFormat is the format of the NameID requested from the identity provider.
+	// Default: urn:oasis:names:tc:SAML:2.0:nameid-format:persistent
+	// +optional
+	NameIDPolicyFormat string `json:"nameIDPolicyFormat,omitempty"`
+}
+
+// AuthenticationRoleMapping grants a ClusterRole to groups from the identity providers.
+type AuthenticationRoleMapping struct {
+	// Name identifies the mapping. The bindings rendered for the mapping are named tigera-idp-<name>.
+	// +kubebuilder:validation:MaxLength=52
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	Name string `json:"name"`
+
+	// Groups are the names of the groups as reported by the identity provider, without the GroupsPrefix.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Groups []string `json:"groups"`
+
+	// ClusterRole is the name of the ClusterRole granted to the groups. Only the roles of the manager UI may be
+	// granted.
+	// One of: tigera-network-admin, tigera-ui-user
+	// +kubebuilder:validation:Enum=tigera-network-admin;tigera-ui-user
+	// +required
+	ClusterRole string `json:"clusterRole"`
+
+	// Namespaces restricts the ClusterRole to these namespaces by rendering a RoleBinding in each of them.
+	// Namespaces that do not exist are skipped and reported in the status of the mapping.
+	// If empty, the ClusterRole is granted cluster wide through a ClusterRoleBinding.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// AuthenticationRoleMappingStatus reports the role mappings that could not be fully applied.
+type AuthenticationRoleMappingStatus struct {
+	// Name is the name of the role mapping.
+	Name string `json:"name"`
+
+	// MissingNamespaces are the namespaces of the mapping that do not exist, so no RoleBinding was rendered in them.
+	// +optional
+	MissingNamespaces []string `json:"missingNamespaces,omitempty"`
+}
+
+// DexStorageType is the storage backend of Dex.
+// One of: Kubernetes, Postgres, MySQL
+// +kubebuilder:validation:Enum=Kubernetes;Postgres;MySQL
+type DexStorageType string
+
+const (
+	DexStorageTypeKubernetes DexStorageType = "Kubernetes"
+	DexStorageTypePostgres   DexStorageType = "Postgres"
+	DexStorageTypeMySQL      DexStorageType = "MySQL"
+)
+
+// DexStorage configures the storage backend of Dex.
+type DexStorage struct {
+	// Type is the storage backend. Kubernetes stores the state of Dex in custom resources in the cluster.
+	// Postgres and MySQL store it in an external database, configured through SQL.
+	// Default: Kubernetes
+	// +optional
+	Type DexStorageType `json:"type,omitempty"`
+
+	// SQL configures the database. It is required for the Postgres and MySQL storage types.
+	// +optional
+	SQL *DexSQLStorage `json:"sql,omitempty"`
+}
+
+// DexSQLSSLMode controls TLS between Dex and its database.
+// One of: Disable, Require, VerifyCA, VerifyFull
+// +kubebuilder:validation:Enum=Disable;Require;VerifyCA;VerifyFull
+type DexSQLSSLMode string
+
+const (
+	DexSQLSSLModeDisable    DexSQLSSLMode = "Disable"
+	DexSQLSSLModeRequire    DexSQLSSLMode = "Require"
+	DexSQLSSLModeVerifyCA   DexSQLSSLMode = "VerifyCA"
+	DexSQLSSLModeVerifyFull DexSQLSSLMode = "VerifyFull"
+)
+
+// DexSQLStorage configures a SQL database as storage backend of Dex.
+type DexSQLStorage struct {
+	// Host is the hostname or IP address of the database server.
+	// +required
+	Host string `json:"host"`
+
+	// Port of the database server.
+	// Default: 5432 for Postgres, 3306 for MySQL
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+
+	// Database is the name of the database.
+	// +required
+	Database string `json:"database"`
+
+	// SecretName is the name of a Secret in the tigera-operator namespace with the fields username and password,
+	// the credentials Dex uses to connect to the database.
+	// +required
+	SecretName string `json:"secretName"`
+
+	// SSLMode controls TLS between Dex and the database. With VerifyCA and VerifyFull the certificate of the
+	// database is verified against the system root certificates and the tigera-ca-bundle.
+	// Default: VerifyFull
+	// +optional
+	SSLMode DexSQLSSLMode `json:"sslMode,omitempty"`
+}
+
+// DexExpiry configures the lifetime of the tokens and keys issued by Dex.
+type DexExpiry struct {
+	// IDTokens is the lifetime of ID tokens.
+	// Default: 15m
+	// +optional
+	IDTokens *metav1.Duration `json:"idTokens,omitempty"`
+
+	// SigningKeys is the interval at which Dex rotates the keys it signs tokens with.
+	// Default: 6h
+	// +optional
+	SigningKeys *metav1.Duration `json:"signingKeys,omitempty"`
+
+	// AuthRequests is the time a user has to complete a login.
+	// Default: 24h
+	// +optional
+	AuthRequests *metav1.Duration `json:"authRequests,omitempty"`
+}
+
+// DexSession configures the refresh tokens that keep users logged in.
+type DexSession struct {
+	// IdleTimeout logs out users that have not used their session for this long.
+	// Default: no idle timeout
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+
+	// AbsoluteLifetime logs out users this long after they logged in, regardless of activity.
+	// Default: no absolute lifetime
+	// +optional
+	AbsoluteLifetime *metav1.Duration `json:"absoluteLifetime,omitempty"`
+}
+
+// GetType returns the storage type, defaulting to Kubernetes.
+func (s *DexStorage) GetType() DexStorageType {
+	if s == nil || s.Type == "" {
+		return DexStorageTypeKubernetes
+	}
+	return s.Type
+}
+
+// GetSSLMode returns the SSL mode, defaulting to VerifyFull.
+func (s *DexSQLStorage) GetSSLMode() DexSQLSSLMode {
+	if s.SSLMode == "" {
+		return DexSQLSSLModeVerifyFull
+	}
+	return s.SSLMode
 }
 
 // AuthenticationStatus defines the observed state of Authentication
@@ -73,6 +393,10 @@ type AuthenticationStatus struct {
 	// Ready, Progressing, Degraded or other customer types.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// RoleMappings reports the role mappings that could not be fully applied.
+	// +optional
+	RoleMappings []AuthenticationRoleMappingStatus `json:"roleMappings,omitempty"`
 }
 
 // AuthenticationOIDC is the configuration needed to setup OIDC.
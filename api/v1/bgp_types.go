@@ -0,0 +1,188 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BGPTopology describes the BGP configuration the operator renders into the default BGPConfiguration and into
+// BGPPeer and BGPFilter resources.
+type BGPTopology struct {
+	// NodeToNodeMesh configures whether every node peers with every other node. This is usually disabled when
+	// route reflectors are configured. If not specified, the setting of the default BGPConfiguration is left as is,
+	// which is Enabled unless changed.
+	// +optional
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	NodeToNodeMesh *BGPOption `json:"nodeToNodeMesh,omitempty"`
+
+	// ASNumber is the default AS number used by the nodes. If not specified, the setting of the default
+	// BGPConfiguration is left as is, which is 64512 unless changed.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	ASNumber *uint32 `json:"asNumber,omitempty"`
+
+	// RouteReflectors selects the nodes that act as route reflectors. Every other node peers with all the route
+	// reflectors, and the route reflectors peer with each other.
+	// +optional
+	RouteReflectors []BGPRouteReflector `json:"routeReflectors,omitempty"`
+
+	// Peers is the list of BGP peers outside of the cluster.
+	// +optional
+	Peers []BGPExternalPeer `json:"peers,omitempty"`
+
+	// Communities is a list of named BGP community values that can be referenced by PrefixAdvertisements. If set,
+	// replaces the communities of the default BGPConfiguration. Otherwise, those are left as is.
+	// +optional
+	Communities []BGPCommunity `json:"communities,omitempty"`
+
+	// PrefixAdvertisements tags the routes of the given prefixes with communities. If set, replaces the prefix
+	// advertisements of the default BGPConfiguration. Otherwise, those are left as is.
+	// +optional
+	PrefixAdvertisements []BGPPrefixAdvertisement `json:"prefixAdvertisements,omitempty"`
+
+	// Filters is a list of route filters that can be applied to Peers.
+	// +optional
+	Filters []BGPRouteFilter `json:"filters,omitempty"`
+}
+
+// BGPRouteReflector selects the nodes that act as route reflectors of one route reflector cluster.
+type BGPRouteReflector struct {
+	// ClusterID is the route reflector cluster ID, in IPv4 address format, set on the selected nodes.
+	// +kubebuilder:validation:Pattern=`^(\d{1,3}\.){3}\d{1,3}$`
+	ClusterID string `json:"clusterID"`
+
+	// NodeSelector selects the Kubernetes nodes that act as route reflectors of this cluster.
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+}
+
+// BGPExternalPeer describes a BGP peer outside of the cluster.
+type BGPExternalPeer struct {
+	// Name is the name of the BGPPeer resource created for this peer.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// PeerIP is the IP address of the peer, optionally followed by a port: `<IPv4>:<port>` or `[<IPv6>]:<port>`.
+	PeerIP string `json:"peerIP"`
+
+	// ASNumber is the AS number of the peer.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	ASNumber uint32 `json:"asNumber"`
+
+	// NodeSelector is a Calico selector for the nodes that peer with this peer. If not specified, every node peers
+	// with it.
+	// +optional
+	NodeSelector string `json:"nodeSelector,omitempty"`
+
+	// PasswordSecretRef selects a key of a Secret in the tigera-operator namespace holding the BGP password of the
+	// peering. The operator copies the Secret to the calico-system namespace and allows calico-node to read it.
+	// +optional
+	PasswordSecretRef *corev1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// Filters is the ordered list of names of Filters applied to the routes exchanged with this peer.
+	// +optional
+	Filters []string `json:"filters,omitempty"`
+
+	// KeepOriginalNextHop keeps the original next hop of the routes sent to this peer, instead of setting the
+	// node as the next hop.
+	// +optional
+	KeepOriginalNextHop bool `json:"keepOriginalNextHop,omitempty"`
+}
+
+// BGPCommunity is a named BGP community value.
+type BGPCommunity struct {
+	// Name is the name of the community, which PrefixAdvertisements can refer to.
+	Name string `json:"name"`
+
+	// Value is a standard community in `aa:nn` format or a large community in `aa:nn:mm` format.
+	// +kubebuilder:validation:Pattern=`^(\d+):(\d+)$|^(\d+):(\d+):(\d+)$`
+	Value string `json:"value"`
+}
+
+// BGPPrefixAdvertisement configures the communities of the routes of a prefix.
+type BGPPrefixAdvertisement struct {
+	// CIDR is the prefix whose routes are tagged.
+	CIDR string `json:"cidr"`
+
+	// Communities is a list of community names from Communities, or community values in `aa:nn` or `aa:nn:mm`
+	// format.
+	Communities []string `json:"communities"`
+}
+
+// BGPRouteFilter describes a BGPFilter resource.
+type BGPRouteFilter struct {
+	// Name is the name of the BGPFilter resource created for this filter.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// ExportV4 is the ordered list of rules applied to the IPv4 routes exported to a peer.
+	// +optional
+	ExportV4 []BGPFilterRule `json:"exportV4,omitempty"`
+
+	// ImportV4 is the ordered list of rules applied to the IPv4 routes imported from a peer.
+	// +optional
+	ImportV4 []BGPFilterRule `json:"importV4,omitempty"`
+
+	// ExportV6 is the ordered list of rules applied to the IPv6 routes exported to a peer.
+	// +optional
+	ExportV6 []BGPFilterRule `json:"exportV6,omitempty"`
+
+	// ImportV6 is the ordered list of rules applied to the IPv6 routes imported from a peer.
+	// +optional
+	ImportV6 []BGPFilterRule `json:"importV6,omitempty"`
+}
+
+// BGPFilterMatchOperator describes how a route is matched against the CIDR of a filter rule.
+//
+// One of: Equal, NotEqual, In, NotIn
+// +kubebuilder:validation:Enum=Equal;NotEqual;In;NotIn
+type BGPFilterMatchOperator string
+
+const (
+	BGPFilterMatchOperatorEqual    BGPFilterMatchOperator = "Equal"
+	BGPFilterMatchOperatorNotEqual BGPFilterMatchOperator = "NotEqual"
+	BGPFilterMatchOperatorIn       BGPFilterMatchOperator = "In"
+	BGPFilterMatchOperatorNotIn    BGPFilterMatchOperator = "NotIn"
+)
+
+// BGPFilterAction is the action taken on a route matching a filter rule.
+//
+// One of: Accept, Reject
+// +kubebuilder:validation:Enum=Accept;Reject
+type BGPFilterAction string
+
+const (
+	BGPFilterActionAccept BGPFilterAction = "Accept"
+	BGPFilterActionReject BGPFilterAction = "Reject"
+)
+
+// BGPFilterRule accepts or rejects the routes matching a CIDR.
+type BGPFilterRule struct {
+	// CIDR is matched against the routes, according to MatchOperator.
+	CIDR string `json:"cidr"`
+
+	// MatchOperator describes how the routes are matched against CIDR.
+	MatchOperator BGPFilterMatchOperator `json:"matchOperator"`
+
+	// Action is the action taken on the matching routes.
+	Action BGPFilterAction `json:"action"`
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConnectivityTestSpec defines the pod network connectivity self-test.
+type ConnectivityTestSpec struct {
+	// Interval is the time between test runs.
+	// Default: 10m
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// ProbeTimeout bounds each individual probe.
+	// Default: 5s
+	// +optional
+	ProbeTimeout *metav1.Duration `json:"probeTimeout,omitempty"`
+
+	// NodeSelector selects the Linux nodes to run probe pods on. If omitted, every Linux node is tested.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// DNSName is the name that each probe pod resolves to test DNS.
+	// Default: kubernetes.default.svc.<cluster domain>
+	// +optional
+	DNSName string `json:"dnsName,omitempty"`
+
+	// EgressTargets are addresses outside the cluster, in the form <IP>:<port>, that pods are allowed to connect to.
+	// Each probe pod opens a TCP connection to each of them. If omitted, egress is not tested.
+	// +optional
+	EgressTargets []string `json:"egressTargets,omitempty"`
+
+	// RunRequest triggers a test run as soon as it is changed, for example by setting it to the current time after
+	// an upgrade.
+	// +optional
+	RunRequest string `json:"runRequest,omitempty"`
+}
+
+// ConnectivityTestResult is the overall outcome of a test run.
+// +kubebuilder:validation:Enum=Passed;Failed
+type ConnectivityTestResult string
+
+const (
+	ConnectivityTestPassed ConnectivityTestResult = "Passed"
+	ConnectivityTestFailed ConnectivityTestResult = "Failed"
+)
+
+// ConnectivityCheckType identifies what a connectivity check tests.
+// +kubebuilder:validation:Enum=Service;DNS;Egress
+type ConnectivityCheckType string
+
+const (
+	ConnectivityCheckService ConnectivityCheckType = "Service"
+	ConnectivityCheckDNS     ConnectivityCheckType = "DNS"
+	ConnectivityCheckEgress  ConnectivityCheckType = "Egress"
+)
+
+// ConnectivityTestStatus defines the observed state of the connectivity test.
+type ConnectivityTestStatus struct {
+	// Result is the outcome of the last test run.
+	// +optional
+	Result ConnectivityTestResult `json:"result,omitempty"`
+
+	// LastRunTime is when the last test run started.
+	// +optional
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+
+	// ObservedRunRequest is the value of spec.runRequest that the last test run was started for.
+	// +optional
+	ObservedRunRequest string `json:"observedRunRequest,omitempty"`
+
+	// TestedNodes is the number of nodes with a probe pod that took part in the last test run.
+	// +optional
+	TestedNodes int32 `json:"testedNodes,omitempty"`
+
+	// TestedNodePairs is the number of pairs of nodes that the pod to pod connectivity was probed between in the last
+	// test run.
+	// +optional
+	TestedNodePairs int32 `json:"testedNodePairs,omitempty"`
+
+	// TestedChecks is the number of service, DNS and egress checks run in the last test run.
+	// +optional
+	TestedChecks int32 `json:"testedChecks,omitempty"`
+
+	// FailedChecks is the number of node pairs and checks that failed in the last test run.
+	// +optional
+	FailedChecks int32 `json:"failedChecks,omitempty"`
+
+	// AverageNodePairLatencyMilliseconds is the mean latency of the successful pod to pod probes in the last test run.
+	// +optional
+	AverageNodePairLatencyMilliseconds int64 `json:"averageNodePairLatencyMilliseconds,omitempty"`
+
+	// MaxNodePairLatencyMilliseconds is the highest latency of the successful pod to pod probes in the last test run.
+	// +optional
+	MaxNodePairLatencyMilliseconds int64 `json:"maxNodePairLatencyMilliseconds,omitempty"`
+
+	// NodePairs lists the pairs of nodes between which pod to pod connectivity failed in the last test run. At most
+	// 100 are listed.
+	// +optional
+	NodePairs []ConnectivityNodePairStatus `json:"nodePairs,omitempty"`
+
+	// Checks lists the service, DNS and egress checks that failed in the last test run. At most 100 are listed.
+	// +optional
+	Checks []ConnectivityCheckStatus `json:"checks,omitempty"`
+
+	// Conditions represents the latest observed set of conditions for the component. A component may be one or more of
+	// Ready, Progressing, Degraded or other customer types.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ConnectivityNodePairStatus is the result of probing the pod on one node from the pod on another.
+type ConnectivityNodePairStatus struct {
+	// Source is the node the probe was sent from.
+	Source string `json:"source"`
+
+	// Destination is the node the probe was sent to.
+	Destination string `json:"destination"`
+
+	// Success is whether the probe succeeded.
+	Success bool `json:"success"`
+
+	// LatencyMilliseconds is how long the probe took.
+	// +optional
+	LatencyMilliseconds int64 `json:"latencyMilliseconds,omitempty"`
+
+	// Error describes why the probe failed.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// ConnectivityCheckStatus is the result of a service, DNS or egress check from a node.
+type ConnectivityCheckStatus struct {
+	// Node is the node the check ran on.
+	Node string `json:"node"`
+
+	// Type is what the check tests.
+	Type ConnectivityCheckType `json:"type"`
+
+	// Target is the address or name the check tested.
+	Target string `json:"target"`
+
+	// Success is whether the check succeeded.
+	Success bool `json:"success"`
+
+	// LatencyMilliseconds is how long the check took.
+	// +optional
+	LatencyMilliseconds int64 `json:"latencyMilliseconds,omitempty"`
+
+	// Error describes why the check failed.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Result",type="string",JSONPath=".status.result",description="The outcome of the last test run."
+// +kubebuilder:printcolumn:name="Nodes",type="integer",JSONPath=".status.testedNodes",description="The number of nodes tested."
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failedChecks",description="The number of failed checks."
+// +kubebuilder:printcolumn:name="Last Run",type="date",JSONPath=".status.lastRunTime",description="When the last test run started."
+
+// ConnectivityTest periodically checks pod network connectivity: between pods on every pair of nodes, from pods
+// to services, DNS, and to addresses outside the cluster. At most one instance of this resource is supported. It
+// must be named "default".
+type ConnectivityTest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired state for the connectivity test.
+	Spec ConnectivityTestSpec `json:"spec,omitempty"`
+	// Most recently observed state for the connectivity test.
+	Status ConnectivityTestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConnectivityTestList contains a list of ConnectivityTest
+type ConnectivityTestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConnectivityTest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ConnectivityTest{}, &ConnectivityTestList{})
+}
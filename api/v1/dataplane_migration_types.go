@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DataplaneMigration configures how the operator moves nodes from one Linux dataplane to another when
+// CalicoNetwork.LinuxDataplane is changed between Iptables, Nftables and BPF. Nodes are moved over in batches
+// using per-node FelixConfiguration overrides, and the default FelixConfiguration is only switched once every node
+// has been migrated. If a batch does not become healthy in time, the migrated nodes are moved back to the original
+// dataplane, again in batches.
+type DataplaneMigration struct {
+	// CanaryNodeSelector selects the nodes that are migrated first, as a batch of their own, before any other node.
+	// If not specified, or if it matches no nodes, migration starts with a regular batch.
+	// +optional
+	CanaryNodeSelector *metav1.LabelSelector `json:"canaryNodeSelector,omitempty"`
+
+	// BatchSize is the maximum number of nodes migrated at the same time.
+	// Default: 10
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	BatchSize *int32 `json:"batchSize,omitempty"`
+
+	// PauseBetweenBatches is how long the operator waits after a batch has become healthy before migrating the next one.
+	// Default: 5m
+	// +optional
+	PauseBetweenBatches *metav1.Duration `json:"pauseBetweenBatches,omitempty"`
+
+	// BatchTimeout is how long the nodes of a batch have to become healthy after being migrated. A node is healthy
+	// when it is Ready, its calico-node pod is Ready, every pod on it with a readiness probe is Ready, and the operator
+	// can connect to the pods on it that expose a TCP port.
+	// When the timeout expires the migration is rolled back. It is attempted again once the Installation is changed.
+	// Default: 10m
+	// +optional
+	BatchTimeout *metav1.Duration `json:"batchTimeout,omitempty"`
+
+	// KubeProxyManagement configures whether the operator keeps the kube-proxy DaemonSet in kube-system off nodes
+	// running the eBPF dataplane, which replaces it. When enabled, kube-proxy is given a node affinity excluding the
+	// nodes the operator has labelled with operator.tigera.io/dataplane=BPF.
+	// Default: Disabled
+	// +optional
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	KubeProxyManagement *KubeProxyManagementOption `json:"kubeProxyManagement,omitempty"`
+}
+
+type KubeProxyManagementOption string
+
+const (
+	KubeProxyManagementEnabled  KubeProxyManagementOption = "Enabled"
+	KubeProxyManagementDisabled KubeProxyManagementOption = "Disabled"
+)
+
+// DataplaneMigrationPhase is the state of a staged dataplane migration.
+// +kubebuilder:validation:Enum=Progressing;Completed;RollingBack;RolledBack
+type DataplaneMigrationPhase string
+
+const (
+	DataplaneMigrationProgressing DataplaneMigrationPhase = "Progressing"
+	DataplaneMigrationCompleted   DataplaneMigrationPhase = "Completed"
+	DataplaneMigrationRollingBack DataplaneMigrationPhase = "RollingBack"
+	DataplaneMigrationRolledBack  DataplaneMigrationPhase = "RolledBack"
+)
+
+// DataplaneMigrationStatus reports the progress of the most recent staged dataplane migration.
+type DataplaneMigrationStatus struct {
+	// Phase is the state of the migration.
+	Phase DataplaneMigrationPhase `json:"phase"`
+
+	// From is the dataplane the cluster was running when the migration started.
+	From LinuxDataplaneOption `json:"from"`
+
+	// To is the dataplane the nodes are being migrated to.
+	To LinuxDataplaneOption `json:"to"`
+
+	// MigratedNodes is the number of nodes that have been migrated and found healthy.
+	MigratedNodes int32 `json:"migratedNodes"`
+
+	// TotalNodes is the number of Linux nodes in the cluster.
+	TotalNodes int32 `json:"totalNodes"`
+
+	// CurrentBatch lists the nodes currently being migrated, or moved back while rolling back.
+	// +optional
+	CurrentBatch []string `json:"currentBatch,omitempty"`
+
+	// BatchStartTime is when the current batch was started.
+	// +optional
+	BatchStartTime *metav1.Time `json:"batchStartTime,omitempty"`
+
+	// LastBatchCompletionTime is when the previous batch was found healthy.
+	// +optional
+	LastBatchCompletionTime *metav1.Time `json:"lastBatchCompletionTime,omitempty"`
+
+	// StartTime is when the migration started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the migration completed or was rolled back.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message is a human readable description of the state of the migration, including the reason for a rollback.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// RollbackReason is why the migration is being, or was, rolled back.
+	// +optional
+	RollbackReason string `json:"rollbackReason,omitempty"`
+
+	// ObservedGeneration is the generation of the Installation the migration was started or rolled back for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
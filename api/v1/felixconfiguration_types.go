@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FelixLogSeverity is a Felix log level.
+// +kubebuilder:validation:Enum=Debug;Info;Warning;Error;Fatal
+type FelixLogSeverity string
+
+const (
+	FelixLogSeverityDebug   FelixLogSeverity = "Debug"
+	FelixLogSeverityInfo    FelixLogSeverity = "Info"
+	FelixLogSeverityWarning FelixLogSeverity = "Warning"
+	FelixLogSeverityError   FelixLogSeverity = "Error"
+	FelixLogSeverityFatal   FelixLogSeverity = "Fatal"
+)
+
+// IptablesBackend selects the iptables backend Felix uses.
+// +kubebuilder:validation:Enum=Auto;Legacy;NFT
+type IptablesBackend string
+
+const (
+	IptablesBackendAuto   IptablesBackend = "Auto"
+	IptablesBackendLegacy IptablesBackend = "Legacy"
+	IptablesBackendNFT    IptablesBackend = "NFT"
+)
+
+// BPFLogLevel is the log level of the eBPF dataplane programs.
+// +kubebuilder:validation:Enum=Off;Info;Debug
+type BPFLogLevel string
+
+const (
+	BPFLogLevelOff   BPFLogLevel = "Off"
+	BPFLogLevelInfo  BPFLogLevel = "Info"
+	BPFLogLevelDebug BPFLogLevel = "Debug"
+)
+
+// FelixSettings are commonly tuned Felix settings. Each setting that is specified is owned by the operator: it is
+// written to the FelixConfiguration and kept at the value given here. Settings that are not specified are left to be
+// managed directly on the FelixConfiguration.
+type FelixSettings struct {
+	// LogSeverityScreen is the log severity above which Felix logs are sent to stdout.
+	// +optional
+	LogSeverityScreen *FelixLogSeverity `json:"logSeverityScreen,omitempty"`
+
+	// LogSeverityFile is the log severity above which Felix logs are sent to the log file.
+	// +optional
+	LogSeverityFile *FelixLogSeverity `json:"logSeverityFile,omitempty"`
+
+	// FlowLogsFlushInterval is the period between flushes of flow logs.
+	// Default: 15s while Goldmane is running
+	// +optional
+	FlowLogsFlushInterval *metav1.Duration `json:"flowLogsFlushInterval,omitempty"`
+
+	// RouteRefreshInterval is the period at which Felix re-checks the routes in the dataplane to ensure that no
+	// other process has accidentally broken Calico's rules.
+	// +optional
+	RouteRefreshInterval *metav1.Duration `json:"routeRefreshInterval,omitempty"`
+
+	// IptablesBackend is the iptables backend Felix uses.
+	// +optional
+	IptablesBackend *IptablesBackend `json:"iptablesBackend,omitempty"`
+
+	// BPFLogLevel is the log level of the eBPF dataplane programs. Debug logs are written to the kernel trace pipe and
+	// have a significant performance impact.
+	// +optional
+	BPFLogLevel *BPFLogLevel `json:"bpfLogLevel,omitempty"`
+
+	// HealthPort is the port Felix serves its health endpoints on.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	HealthPort *int32 `json:"healthPort,omitempty"`
+}
+
+// FelixConfigurationSpec configures Felix through the FelixConfiguration resources.
+type FelixConfigurationSpec struct {
+	// FelixSettings are written to the default FelixConfiguration, and apply to every node.
+	// +optional
+	FelixSettings `json:",inline"`
+
+	// NodeOverrides are settings that apply only to the nodes selected by each override. They are written to the
+	// per-node FelixConfiguration of each selected node. If a node is selected by more than one override, later
+	// overrides take precedence. Health ports can only be set for all nodes.
+	// +optional
+	NodeOverrides []FelixNodeOverride `json:"nodeOverrides,omitempty"`
+}
+
+// FelixNodeOverride holds Felix settings for the nodes matching a selector.
+type FelixNodeOverride struct {
+	// NodeSelector selects the nodes that the settings apply to.
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+
+	// Settings are the Felix settings for the selected nodes.
+	Settings FelixSettings `json:"settings"`
+}
+
+// FelixConfigurationConflict is a Felix setting that the operator could not apply because the FelixConfiguration
+// holds a different value that the operator did not set.
+type FelixConfigurationConflict struct {
+	// Resource is the name of the FelixConfiguration.
+	Resource string `json:"resource"`
+
+	// Field is the name of the FelixConfiguration field.
+	Field string `json:"field"`
+
+	// Value is the value on the FelixConfiguration.
+	Value string `json:"value"`
+
+	// DesiredValue is the value configured on the Installation.
+	DesiredValue string `json:"desiredValue"`
+}
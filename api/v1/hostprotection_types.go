@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// AutoHostEndpointsMode configures whether host endpoints are created automatically.
+// +kubebuilder:validation:Enum=Enabled;Disabled
+type AutoHostEndpointsMode string
+
+const (
+	AutoHostEndpointsEnabled  AutoHostEndpointsMode = "Enabled"
+	AutoHostEndpointsDisabled AutoHostEndpointsMode = "Disabled"
+)
+
+// HostBaselinePolicyMode configures the baseline policy for host endpoints.
+// +kubebuilder:validation:Enum=Disabled;Audit;Enforce
+type HostBaselinePolicyMode string
+
+const (
+	HostBaselinePolicyDisabled HostBaselinePolicyMode = "Disabled"
+	HostBaselinePolicyAudit    HostBaselinePolicyMode = "Audit"
+
+	// HostBaselinePolicyEnforce denies ingress to the nodes that no policy allows. Besides the ports that Calico and
+	// the Kubernetes control plane need, only SSH (22), HTTP (80), HTTPS (443), the kube-proxy health check (10256)
+	// and the default NodePort range (30000-32767) stay reachable from outside the cluster. Anything else listening
+	// on the nodes, such as services on a custom NodePort range or host networked pods on other ports, becomes
+	// unreachable from outside the cluster unless it is listed in AllowedIngressPorts or allowed by another policy.
+	HostBaselinePolicyEnforce HostBaselinePolicyMode = "Enforce"
+)
+
+// HostProtectionProtocol is the protocol of a port allowed by the baseline policy.
+// +kubebuilder:validation:Enum=TCP;UDP
+type HostProtectionProtocol string
+
+const (
+	HostProtectionProtocolTCP HostProtectionProtocol = "TCP"
+	HostProtectionProtocolUDP HostProtectionProtocol = "UDP"
+)
+
+// HostProtectionPort is a port, or range of ports, on the cluster's nodes.
+type HostProtectionPort struct {
+	// Protocol is the protocol of the port.
+	// Default: TCP
+	// +optional
+	Protocol *HostProtectionProtocol `json:"protocol,omitempty"`
+
+	// Port is the port number, or the first port of the range if EndPort is set.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// EndPort is the last port of the range, inclusive. It must not be less than Port.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	EndPort *int32 `json:"endPort,omitempty"`
+}
+
+// HostProtection configures policy enforcement on the network interfaces of the cluster's nodes.
+type HostProtection struct {
+	// AutoHostEndpoints configures whether calico-kube-controllers creates a host endpoint for each node, covering
+	// all of the node's interfaces. The host endpoints allow all traffic until policy selects them.
+	// If not specified, the setting is left to the KubeControllersConfiguration.
+	// +optional
+	AutoHostEndpoints *AutoHostEndpointsMode `json:"autoHostEndpoints,omitempty"`
+
+	// BaselinePolicy configures an operator managed baseline policy for the automatic host endpoints. The policy
+	// is placed in its own tier, applied ahead of the tiers with a higher order, and always allows ingress to the
+	// ports that Calico and the Kubernetes control plane need: the Kubernetes API server, kubelet and etcd, BGP,
+	// Typha, the overlay and WireGuard ports, and the calico-node and Typha metrics ports if they are configured.
+	// Other ingress is passed on to the following tiers. Ingress that no other policy allows or denies is allowed
+	// if it comes from another node or from a pod, or if it is to SSH, HTTP, HTTPS, the kube-proxy health check, the
+	// default NodePort range or one of the AllowedIngressPorts. Ingress from anywhere else is denied in Enforce mode,
+	// and is logged and allowed in Audit mode.
+	// Requires AutoHostEndpoints to be Enabled and is only supported for Variant=TigeraSecureEnterprise.
+	// Default: Disabled
+	// +optional
+	BaselinePolicy *HostBaselinePolicyMode `json:"baselinePolicy,omitempty"`
+
+	// AllowedIngressPorts are additional ports that the baseline policy allows ingress to from anywhere, for
+	// example the ports of host networked ingress controllers or of a custom NodePort range.
+	// +optional
+	AllowedIngressPorts []HostProtectionPort `json:"allowedIngressPorts,omitempty"`
+}
+
+// ProtocolOrDefault returns the protocol of the port, or TCP if none is configured.
+func (p HostProtectionPort) ProtocolOrDefault() HostProtectionProtocol {
+	if p.Protocol == nil {
+		return HostProtectionProtocolTCP
+	}
+	return *p.Protocol
+}
+
+// AutoHostEndpointsEnabled returns true if automatic host endpoints are enabled.
+func (h *HostProtection) AutoHostEndpointsEnabled() bool {
+	return h != nil && h.AutoHostEndpoints != nil && *h.AutoHostEndpoints == AutoHostEndpointsEnabled
+}
+
+// BaselinePolicyMode returns the configured baseline policy mode, or Disabled if none is configured.
+func (h *HostProtection) BaselinePolicyMode() HostBaselinePolicyMode {
+	if h == nil || h.BaselinePolicy == nil {
+		return HostBaselinePolicyDisabled
+	}
+	return *h.BaselinePolicy
+}
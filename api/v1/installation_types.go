@@ -208,6 +208,17 @@ type InstallationSpec struct {
 	// the cluster (including the API server) are exempt from proxying.
 	// +optional
 	Proxy *Proxy `json:"proxy,omitempty"`
+
+	// FelixConfiguration holds commonly tuned Felix settings, for all nodes and for selected nodes. The operator
+	// records the settings it manages in an annotation on each FelixConfiguration it writes to, so that other fields
+	// can still be edited directly. Settings that the FelixConfiguration already holds a different value for are
+	// not overwritten, and are reported in the Installation status instead.
+	// +optional
+	FelixConfiguration *FelixConfigurationSpec `json:"felixConfiguration,omitempty"`
+
+	// HostProtection configures automatic host endpoints for the cluster's nodes and a baseline policy for them.
+	// +optional
+	HostProtection *HostProtection `json:"hostProtection,omitempty"`
 }
 
 type Azure struct {
@@ -495,6 +506,19 @@ type CalicoNetworkSpec struct {
 	// +kubebuilder:validation:Enum=Enabled;Disabled
 	BGP *BGPOption `json:"bgp,omitempty"`
 
+	// BGPTopology declares the BGP topology of the cluster: the node-to-node mesh, the default AS number,
+	// route reflectors, external peers, communities and route filters. When set, the operator manages the default
+	// BGPConfiguration and the BGPPeer and BGPFilter resources it describes, and removes the ones it created that are
+	// no longer declared. Requires BGP to be enabled.
+	// +optional
+	BGPTopology *BGPTopology `json:"bgpTopology,omitempty"`
+
+	// DataplaneMigration enables staged migration between the Iptables, Nftables and BPF Linux dataplanes. When set,
+	// changing LinuxDataplane moves nodes over in batches, checking their health in between, instead of switching
+	// every node at once. Progress is reported in the Installation status.
+	// +optional
+	DataplaneMigration *DataplaneMigration `json:"dataplaneMigration,omitempty"`
+
 	// IPPools contains a list of IP pools to manage. If nil, a single IPv4 IP pool
 	// will be created by the operator. If an empty list is provided, the operator will not create any IP pools and will instead
 	// wait for IP pools to be created out-of-band.
@@ -503,6 +527,10 @@ type CalicoNetworkSpec struct {
 	// +kubebuilder:validation:MaxItems=25
 	IPPools []IPPool `json:"ipPools,omitempty"`
 
+	// IPPoolDraining configures how IP pools with new allocations disabled, or removed from IPPools, are drained.
+	// +optional
+	IPPoolDraining *IPPoolDraining `json:"ipPoolDraining,omitempty"`
+
 	// MTU specifies the maximum transmission unit to use on the pod network.
 	// If not specified, Calico will perform MTU auto-detection based on the cluster network.
 	// +optional
@@ -531,6 +559,14 @@ type CalicoNetworkSpec struct {
 	// +kubebuilder:validation:Enum=None;Multus
 	MultiInterfaceMode *MultiInterfaceMode `json:"multiInterfaceMode,omitempty"`
 
+	// SecondaryNetworks configures additional Calico networks that pods can attach extra interfaces to through
+	// Multus. For each network, the operator creates a dedicated IP pool and a NetworkAttachmentDefinition in each
+	// of the network's namespaces. Requires MultiInterfaceMode to be Multus.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	SecondaryNetworks []SecondaryNetwork `json:"secondaryNetworks,omitempty"`
+
 	// ContainerIPForwarding configures whether ip forwarding will be enabled for containers in the CNI configuration.
 	// Default: Disabled
 	// +optional
@@ -708,6 +744,27 @@ type IPPool struct {
 	AssignmentMode pcv1.AssignmentMode `json:"assignmentMode,omitempty" validate:"omitempty,assignmentMode"`
 }
 
+// SecondaryNetwork describes a Calico network that pods can attach an additional interface to.
+type SecondaryNetwork struct {
+	// Name is the name of the network, and of the NetworkAttachmentDefinitions that pods reference to attach to it.
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// IPPool is the IP pool that addresses on this network are assigned from. The pool is only used for this
+	// network, so its assignment mode is always Manual, and it may only be used for workloads.
+	IPPool IPPool `json:"ipPool"`
+
+	// InterfacePrefix is the prefix of the host-side interface names of this network's pod interfaces. It is added
+	// to the interface prefixes Felix treats as workload interfaces, and must be distinct for each network.
+	// +kubebuilder:validation:MaxLength=4
+	InterfacePrefix string `json:"interfacePrefix"`
+
+	// Namespaces are the namespaces whose pods may attach to the network. A NetworkAttachmentDefinition for the
+	// network is created in each of them once it exists.
+	// +kubebuilder:validation:MinItems=1
+	Namespaces []string `json:"namespaces"`
+}
+
 type IPPoolAllowedUse string
 
 const (
@@ -716,6 +773,71 @@ const (
 	IPPoolAllowedUseLoadBalancer IPPoolAllowedUse = "LoadBalancer"
 )
 
+// IPPoolDraining configures how the operator drains IP pools. A pool is drained when it has DisableNewAllocations
+// set, or when it has been removed from IPPools. A removed pool is kept, with new allocations disabled, until no
+// addresses are allocated from it any more, and is then deleted.
+type IPPoolDraining struct {
+	// PodEviction configures whether the operator evicts pods holding addresses from draining pools, so that they
+	// are recreated with addresses from the remaining pools. Pods are evicted one node at a time, and only pods
+	// managed by a controller are evicted. Evictions respect PodDisruptionBudgets.
+	// Default: Disabled
+	// +optional
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	PodEviction *PodEvictionOption `json:"podEviction,omitempty"`
+}
+
+type PodEvictionOption string
+
+const (
+	PodEvictionEnabled  PodEvictionOption = "Enabled"
+	PodEvictionDisabled PodEvictionOption = "Disabled"
+)
+
+// IPPoolState describes whether an IP pool is handing out addresses.
+// +kubebuilder:validation:Enum=Active;Draining;PendingDeletion
+type IPPoolState string
+
+const (
+	// IPPoolStateActive pools hand out new addresses.
+	IPPoolStateActive IPPoolState = "Active"
+	// IPPoolStateDraining pools have new allocations disabled.
+	IPPoolStateDraining IPPoolState = "Draining"
+	// IPPoolStatePendingDeletion pools have been removed from the Installation and will be deleted once empty.
+	IPPoolStatePendingDeletion IPPoolState = "PendingDeletion"
+)
+
+// IPPoolStatus reports the state of an IP pool managed by the operator.
+type IPPoolStatus struct {
+	// Name is the name of the IP pool.
+	Name string `json:"name"`
+
+	// CIDR is the address range of the IP pool.
+	CIDR string `json:"cidr"`
+
+	// State is whether the pool is active, draining, or waiting to be deleted.
+	State IPPoolState `json:"state"`
+
+	// AllocatedAddresses is the number of addresses currently allocated from the pool, counted from its IPAM blocks.
+	AllocatedAddresses int32 `json:"allocatedAddresses"`
+
+	// Capacity is the number of addresses in the pool. It is capped at 2^53 for large IPv6 pools.
+	Capacity int64 `json:"capacity"`
+
+	// Utilization is the percentage of the pool's addresses that are allocated.
+	Utilization int32 `json:"utilization"`
+
+	// AllocatedBlocks is the number of IPAM blocks claimed by nodes from the pool.
+	AllocatedBlocks int32 `json:"allocatedBlocks"`
+
+	// BlockCapacity is the number of IPAM blocks the pool can be split into. It is capped at 2^53 for large IPv6 pools.
+	BlockCapacity int64 `json:"blockCapacity"`
+
+	// ExhaustedNodes lists the nodes that have no free addresses left in their blocks from this pool, when the pool
+	// has no free blocks left to give them.
+	// +optional
+	ExhaustedNodes []string `json:"exhaustedNodes,omitempty"`
+}
+
 // ToProjectCalicoV1 converts an IPPool to a crd.projectcalico.org/v1 IPPool resource.
 func (p *IPPool) ToProjectCalicoV1() (*pcv1.IPPool, error) {
 	pool := pcv1.IPPool{
@@ -903,6 +1025,15 @@ type IPAMSpec struct {
 	// Default: Calico
 	// +kubebuilder:validation:Enum=Calico;HostLocal;AmazonVPC;AzureVNET
 	Type IPAMPluginType `json:"type"`
+
+	// UtilizationThreshold is the percentage of an IP pool's addresses that may be allocated before the
+	// IPPoolExhaustion condition on the Installation status reports the pool as close to exhaustion. Only applies to
+	// Calico IPAM.
+	// Default: 90
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	UtilizationThreshold *int32 `json:"utilizationThreshold,omitempty"`
 }
 
 // CNISpec contains configuration for the CNI plugin.
@@ -926,6 +1057,51 @@ type CNISpec struct {
 	// Calico Enterprise installation.
 	// +optional
 	IPAM *IPAMSpec `json:"ipam"`
+
+	// ChainedPlugins customizes the plugins chained after the Calico plugin in the CNI network configuration.
+	// Only valid when Type is Calico.
+	// +optional
+	ChainedPlugins *CNIChainedPlugins `json:"chainedPlugins,omitempty"`
+}
+
+// CNIChainedPlugins configures the plugins chained after the Calico plugin in the CNI network configuration. The
+// portmap plugin is chained when spec.calicoNetwork.hostPorts is Enabled, and the tuning plugin when
+// spec.calicoNetwork.sysctl is set.
+type CNIChainedPlugins struct {
+	// Bandwidth configures whether the bandwidth plugin is chained, which applies the pod bandwidth annotations.
+	// Default: Enabled
+	// +optional
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	Bandwidth *BandwidthPluginOption `json:"bandwidth,omitempty"`
+
+	// Additional are further plugins to chain. The plugin binaries must be installed in the CNI binary directory
+	// of each node.
+	// +optional
+	Additional []CNIChainedPlugin `json:"additional,omitempty"`
+}
+
+type BandwidthPluginOption string
+
+const (
+	BandwidthPluginEnabled  BandwidthPluginOption = "Enabled"
+	BandwidthPluginDisabled BandwidthPluginOption = "Disabled"
+)
+
+// CNIChainedPlugin is an additional plugin in the CNI network configuration.
+type CNIChainedPlugin struct {
+	// Type is the type of the plugin, which is the name of its binary.
+	// +kubebuilder:validation:MinLength=1
+	Type string `json:"type"`
+
+	// After is the type of the plugin this one is chained directly after. It may be one of the plugins the operator
+	// configures (calico, bandwidth, portmap or tuning), or an additional plugin listed before this one. If not set,
+	// the plugin is chained last.
+	// +optional
+	After string `json:"after,omitempty"`
+
+	// Config is the rest of the plugin's configuration, as a JSON object.
+	// +optional
+	Config string `json:"config,omitempty"`
 }
 
 // InstallationStatus defines the observed state of the Calico or Calico Enterprise installation.
@@ -952,6 +1128,21 @@ type InstallationStatus struct {
 	// version deployed.
 	CalicoVersion string `json:"calicoVersion,omitempty"`
 
+	// IPPools reports the state of the IP pools managed by the operator, including the number of addresses still
+	// allocated from pools that are being drained.
+	// +optional
+	IPPools []IPPoolStatus `json:"ipPools,omitempty"`
+
+	// DataplaneMigration reports the progress of the most recent staged Linux dataplane migration.
+	// +optional
+	DataplaneMigration *DataplaneMigrationStatus `json:"dataplaneMigration,omitempty"`
+
+	// FelixConfigurationConflicts lists the Felix settings configured on the Installation that were not applied
+	// because the FelixConfiguration holds a value the operator did not set. Remove the field from the
+	// FelixConfiguration to let the operator manage it.
+	// +optional
+	FelixConfigurationConflicts []FelixConfigurationConflict `json:"felixConfigurationConflicts,omitempty"`
+
 	// Conditions represents the latest observed set of conditions for the component. A component may be one or more of
 	// Ready, Progressing, Degraded or other customer types.
 	// +optional
@@ -1060,8 +1251,36 @@ type WindowsNodeSpec struct {
 	// VXLANAdapter is the Network Adapter used for VXLAN, leave blank for primary NIC
 	// +optional
 	VXLANAdapter string `json:"vxlanAdapter,omitempty"`
+
+	// HostProcessUserName is the Windows user that the calico-node-windows host process containers run as. The user
+	// must have administrative privileges on the node.
+	// Default: NT AUTHORITY\system
+	// +optional
+	HostProcessUserName string `json:"hostProcessUserName,omitempty"`
+
+	// BGP configures whether or not to run BGP on Windows nodes. Windows nodes without BGP route pod traffic
+	// using VXLAN, so this may only be disabled when the IP pools use VXLAN encapsulation.
+	// Default: the value of spec.calicoNetwork.bgp
+	// +optional
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	BGP *BGPOption `json:"bgp,omitempty"`
+
+	// FirewallRules configures whether or not Felix programs Windows Firewall rules to allow inbound access to its
+	// own metrics ports.
+	// Default: Disabled
+	// +optional
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	FirewallRules *WindowsFirewallRulesOption `json:"firewallRules,omitempty"`
 }
 
+// WindowsFirewallRulesOption specifies whether Felix manages Windows Firewall rules.
+type WindowsFirewallRulesOption string
+
+const (
+	WindowsFirewallRulesEnabled  WindowsFirewallRulesOption = "Enabled"
+	WindowsFirewallRulesDisabled WindowsFirewallRulesOption = "Disabled"
+)
+
 type Proxy struct {
 	// HTTPProxy defines the value of the HTTP_PROXY environment variable that will be set on Tigera containers that connect to
 	// destinations outside the cluster.
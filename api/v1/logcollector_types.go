@@ -42,9 +42,15 @@ type LogCollectorSpec struct {
 	// +optional
 	MultiTenantManagementClusterNamespace string `json:"multiTenantManagementClusterNamespace,omitempty"`
 
-	// FluentdDaemonSet configures the Fluentd DaemonSet.
+	// FluentdDaemonSet configures the Fluentd DaemonSet. It also configures the Fluentd DaemonSet for Windows nodes,
+	// unless FluentdWindowsDaemonSet is set.
 	FluentdDaemonSet *FluentdDaemonSet `json:"fluentdDaemonSet,omitempty"`
 
+	// FluentdWindowsDaemonSet configures the Fluentd DaemonSet for Windows nodes. If not set, the FluentdDaemonSet
+	// configuration is used for Windows nodes as well.
+	// +optional
+	FluentdWindowsDaemonSet *FluentdDaemonSet `json:"fluentdWindowsDaemonSet,omitempty"`
+
 	// EKSLogForwarderDeployment configures the EKSLogForwarderDeployment Deployment.
 	// +optional
 	EKSLogForwarderDeployment *EKSLogForwarderDeployment `json:"eksLogForwarderDeployment,omitempty"`
@@ -77,6 +83,18 @@ type AdditionalLogStoreSpec struct {
 	// If specified, enables exporting of flow, audit, and DNS logs to splunk.
 	// +optional
 	Splunk *SplunkStoreSpec `json:"splunk,omitempty"`
+	// If specified, enables exporting of flow, audit, and DNS logs to Kafka.
+	// +optional
+	Kafka *KafkaStoreSpec `json:"kafka,omitempty"`
+	// If specified, enables exporting of flow, audit, and DNS logs as JSON to a generic HTTP endpoint.
+	// +optional
+	HTTP *HTTPStoreSpec `json:"http,omitempty"`
+	// If specified, enables exporting of flow, audit, and DNS logs to an OpenTelemetry (OTLP) log receiver.
+	// +optional
+	OpenTelemetry *OpenTelemetryStoreSpec `json:"openTelemetry,omitempty"`
+	// If specified, enables exporting of flow, audit, and DNS logs to Grafana Loki.
+	// +optional
+	Loki *LokiStoreSpec `json:"loki,omitempty"`
 }
 
 type AdditionalLogSourceSpec struct {
@@ -97,6 +115,148 @@ type S3StoreSpec struct {
 
 	// Path in the S3 bucket where to send logs
 	BucketPath string `json:"bucketPath"`
+
+	// Endpoint is the URL of an S3 compatible object store, such as MinIO or Ceph. If not specified, the AWS S3
+	// endpoint for the region is used. example: `https://minio.example.com:9000`
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// AddressingStyle configures how the bucket is addressed in request URLs. Most S3 compatible object stores
+	// require Path.
+	// Default: VirtualHosted
+	// +optional
+	// +kubebuilder:validation:Enum=VirtualHosted;Path
+	AddressingStyle S3AddressingStyle `json:"addressingStyle,omitempty"`
+
+	// Credentials configures how fluentd obtains credentials for the bucket.
+	// AccessKey reads a static access key from the log-collector-s3-credentials Secret in the tigera-operator namespace.
+	// ServiceAccountRole annotates the fluentd service account with RoleARN so that the credentials are injected
+	// by the EKS pod identity webhook (IAM roles for service accounts).
+	// WebIdentity mounts a projected service account token that fluentd exchanges for the credentials of RoleARN.
+	// Default: AccessKey
+	// +optional
+	// +kubebuilder:validation:Enum=AccessKey;ServiceAccountRole;WebIdentity
+	Credentials S3CredentialsType `json:"credentials,omitempty"`
+
+	// RoleARN is the IAM role assumed by fluentd. Required when Credentials is ServiceAccountRole or WebIdentity.
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+
+	// WebIdentityAudience is the audience of the projected service account token when Credentials is WebIdentity.
+	// Default: sts.amazonaws.com
+	// +optional
+	WebIdentityAudience string `json:"webIdentityAudience,omitempty"`
+
+	// ServerSideEncryption configures the server-side encryption of the objects written to the bucket.
+	// Default: None
+	// +optional
+	// +kubebuilder:validation:Enum=None;AES256;KMS
+	ServerSideEncryption S3ServerSideEncryption `json:"serverSideEncryption,omitempty"`
+
+	// KMSKeyID is the ID or ARN of the KMS key used to encrypt objects. Required when ServerSideEncryption is KMS.
+	// +optional
+	KMSKeyID string `json:"kmsKeyID,omitempty"`
+
+	// ObjectKeyFormat is the format of the keys of the objects written to the bucket, using the placeholders of
+	// the fluentd S3 output plugin. example: `%{path}%{time_slice}_%{index}.%{file_extension}`
+	// +optional
+	ObjectKeyFormat string `json:"objectKeyFormat,omitempty"`
+
+	// Compression configures the compression of the objects written to the bucket.
+	// Default: Gzip
+	// +optional
+	// +kubebuilder:validation:Enum=None;Gzip
+	Compression S3Compression `json:"compression,omitempty"`
+
+	// If no values are provided, the list will be updated to include log types Audit, DNS and Flows.
+	// Default: Audit, DNS, Flows
+	// +optional
+	LogTypes []SyslogLogType `json:"logTypes,omitempty"`
+
+	// Filters restricts which flow logs are exported to this store.
+	// +optional
+	Filters *AdditionalStoreFilters `json:"filters,omitempty"`
+}
+
+// S3AddressingStyle specifies how an S3 bucket is addressed in request URLs.
+//
+// One of: VirtualHosted, Path
+type S3AddressingStyle string
+
+const (
+	S3AddressingStyleVirtualHosted S3AddressingStyle = "VirtualHosted"
+	S3AddressingStylePath          S3AddressingStyle = "Path"
+)
+
+// S3CredentialsType specifies how fluentd obtains credentials for S3.
+//
+// One of: AccessKey, ServiceAccountRole, WebIdentity
+type S3CredentialsType string
+
+const (
+	S3CredentialsAccessKey          S3CredentialsType = "AccessKey"
+	S3CredentialsServiceAccountRole S3CredentialsType = "ServiceAccountRole"
+	S3CredentialsWebIdentity        S3CredentialsType = "WebIdentity"
+)
+
+// S3ServerSideEncryption specifies the server-side encryption of objects written to S3.
+//
+// One of: None, AES256, KMS
+type S3ServerSideEncryption string
+
+const (
+	S3ServerSideEncryptionNone   S3ServerSideEncryption = "None"
+	S3ServerSideEncryptionAES256 S3ServerSideEncryption = "AES256"
+	S3ServerSideEncryptionKMS    S3ServerSideEncryption = "KMS"
+)
+
+// S3Compression specifies the compression of objects written to S3.
+//
+// One of: None, Gzip
+type S3Compression string
+
+const (
+	S3CompressionNone S3Compression = "None"
+	S3CompressionGzip S3Compression = "Gzip"
+)
+
+// FlowLogAction is the action recorded on a flow log.
+//
+// One of: Allow, Deny
+// +kubebuilder:validation:Enum=Allow;Deny
+type FlowLogAction string
+
+const (
+	FlowLogActionAllow FlowLogAction = "Allow"
+	FlowLogActionDeny  FlowLogAction = "Deny"
+)
+
+// AdditionalStoreFilters restricts the flow logs that are exported to an additional store. Filters only apply to
+// flow logs; other log types selected for the store are exported unfiltered.
+type AdditionalStoreFilters struct {
+	// Include, if specified, exports only the flow logs that match every criterion it sets.
+	// +optional
+	Include *FlowLogFilter `json:"include,omitempty"`
+
+	// Exclude, if specified, drops the flow logs that match any criterion it sets. Exclude is applied after Include.
+	// +optional
+	Exclude *FlowLogFilter `json:"exclude,omitempty"`
+}
+
+// FlowLogFilter describes flow log match criteria. Within a criterion a flow log matches if it matches any of the
+// listed values.
+type FlowLogFilter struct {
+	// Namespaces matches flow logs whose source or destination namespace is one of the listed namespaces.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Actions matches flow logs with one of the listed actions.
+	// +optional
+	Actions []FlowLogAction `json:"actions,omitempty"`
+
+	// PolicyTiers matches flow logs that were evaluated by a policy in one of the listed tiers.
+	// +optional
+	PolicyTiers []string `json:"policyTiers,omitempty"`
 }
 
 // SyslogLogType represents the allowable log types for syslog.
@@ -156,12 +316,161 @@ type SyslogStoreSpec struct {
 	// +optional
 	// +kubebuilder:validation:Enum=None;TLS
 	Encryption EncryptionOption `json:"encryption,omitempty"`
+
+	// Filters restricts which flow logs are exported to this store.
+	// +optional
+	Filters *AdditionalStoreFilters `json:"filters,omitempty"`
 }
 
 // SplunkStoreSpec defines configuration for exporting logs to splunk.
 type SplunkStoreSpec struct {
 	// Location for splunk's http event collector end point. example `https://1.2.3.4:8088`
 	Endpoint string `json:"endpoint"`
+
+	// If no values are provided, the list will be updated to include log types Audit, DNS and Flows.
+	// Default: Audit, DNS, Flows
+	// +optional
+	LogTypes []SyslogLogType `json:"logTypes,omitempty"`
+
+	// Filters restricts which flow logs are exported to this store.
+	// +optional
+	Filters *AdditionalStoreFilters `json:"filters,omitempty"`
+}
+
+// KafkaSASLMechanism specifies the SASL mechanism used to authenticate with Kafka brokers.
+//
+// One of: None, Plain, SCRAM-SHA-256, SCRAM-SHA-512
+type KafkaSASLMechanism string
+
+const (
+	KafkaSASLMechanismNone        KafkaSASLMechanism = "None"
+	KafkaSASLMechanismPlain       KafkaSASLMechanism = "Plain"
+	KafkaSASLMechanismSCRAMSHA256 KafkaSASLMechanism = "SCRAM-SHA-256"
+	KafkaSASLMechanismSCRAMSHA512 KafkaSASLMechanism = "SCRAM-SHA-512"
+)
+
+// KafkaStoreSpec defines configuration for exporting logs to Kafka.
+type KafkaStoreSpec struct {
+	// Brokers is the list of Kafka bootstrap brokers. example: 1.2.3.4:9092
+	// +kubebuilder:validation:MinItems=1
+	Brokers []string `json:"brokers"`
+
+	// Topics configures the Kafka topic that each log type is written to.
+	// +optional
+	Topics *KafkaTopics `json:"topics,omitempty"`
+
+	// If no values are provided, the list will be updated to include log types Audit, DNS and Flows.
+	// Default: Audit, DNS, Flows
+	// +optional
+	LogTypes []SyslogLogType `json:"logTypes,omitempty"`
+
+	// Filters restricts which flow logs are exported to this store.
+	// +optional
+	Filters *AdditionalStoreFilters `json:"filters,omitempty"`
+
+	// SASLMechanism configures SASL authentication with the Kafka brokers. For any value other than None,
+	// the username and password are read from the logcollector-kafka-credentials Secret in the tigera-operator namespace.
+	// Default: None
+	// +optional
+	// +kubebuilder:validation:Enum=None;Plain;SCRAM-SHA-256;SCRAM-SHA-512
+	SASLMechanism KafkaSASLMechanism `json:"saslMechanism,omitempty"`
+
+	// Encryption configures traffic encryption to the Kafka brokers. A CA certificate for the brokers may be
+	// provided in the kafka-ca ConfigMap in the tigera-operator namespace.
+	// Default: None
+	// +optional
+	// +kubebuilder:validation:Enum=None;TLS
+	Encryption EncryptionOption `json:"encryption,omitempty"`
+}
+
+// KafkaTopics defines the Kafka topic that each log type is written to.
+type KafkaTopics struct {
+	// Default: tigera_secure_ee_flows
+	// +optional
+	Flows string `json:"flows,omitempty"`
+
+	// Default: tigera_secure_ee_dns
+	// +optional
+	DNS string `json:"dns,omitempty"`
+
+	// Default: tigera_secure_ee_audit
+	// +optional
+	Audit string `json:"audit,omitempty"`
+
+	// Default: tigera_secure_ee_events
+	// +optional
+	IDSEvents string `json:"idsEvents,omitempty"`
+}
+
+// HTTPStoreSpec defines configuration for exporting logs as JSON to a generic HTTP endpoint.
+type HTTPStoreSpec struct {
+	// Location of the HTTP endpoint that logs are posted to. example: `https://1.2.3.4:8080/ingest`
+	// If the logcollector-http-credentials Secret exists in the tigera-operator namespace, the value of its
+	// authorization field is sent in the Authorization header of each request.
+	Endpoint string `json:"endpoint"`
+
+	// If no values are provided, the list will be updated to include log types Audit, DNS and Flows.
+	// Default: Audit, DNS, Flows
+	// +optional
+	LogTypes []SyslogLogType `json:"logTypes,omitempty"`
+
+	// Filters restricts which flow logs are exported to this store.
+	// +optional
+	Filters *AdditionalStoreFilters `json:"filters,omitempty"`
+}
+
+// OTLPProtocol specifies the transport protocol used to export logs to an OpenTelemetry receiver.
+//
+// One of: GRPC, HTTP
+type OTLPProtocol string
+
+const (
+	OTLPProtocolGRPC OTLPProtocol = "GRPC"
+	OTLPProtocolHTTP OTLPProtocol = "HTTP"
+)
+
+// OpenTelemetryStoreSpec defines configuration for exporting logs to an OpenTelemetry (OTLP) log receiver.
+type OpenTelemetryStoreSpec struct {
+	// Location of the OTLP receiver. example: `https://otel-collector.observability:4318`
+	// If the logcollector-otlp-credentials Secret exists in the tigera-operator namespace, the value of its
+	// authorization field is sent in the Authorization header of each export request.
+	Endpoint string `json:"endpoint"`
+
+	// Protocol configures the OTLP transport.
+	// Default: HTTP
+	// +optional
+	// +kubebuilder:validation:Enum=GRPC;HTTP
+	Protocol OTLPProtocol `json:"protocol,omitempty"`
+
+	// If no values are provided, the list will be updated to include log types Audit, DNS and Flows.
+	// Default: Audit, DNS, Flows
+	// +optional
+	LogTypes []SyslogLogType `json:"logTypes,omitempty"`
+
+	// Filters restricts which flow logs are exported to this store.
+	// +optional
+	Filters *AdditionalStoreFilters `json:"filters,omitempty"`
+}
+
+// LokiStoreSpec defines configuration for exporting logs to Grafana Loki.
+type LokiStoreSpec struct {
+	// Location of the Loki server. example: `https://loki.example.com:3100`
+	// If the logcollector-loki-credentials Secret exists in the tigera-operator namespace, its username
+	// and password fields are used for basic authentication.
+	Endpoint string `json:"endpoint"`
+
+	// TenantID is sent as the X-Scope-OrgID header when Loki runs in multi-tenant mode.
+	// +optional
+	TenantID string `json:"tenantID,omitempty"`
+
+	// If no values are provided, the list will be updated to include log types Audit, DNS and Flows.
+	// Default: Audit, DNS, Flows
+	// +optional
+	LogTypes []SyslogLogType `json:"logTypes,omitempty"`
+
+	// Filters restricts which flow logs are exported to this store.
+	// +optional
+	Filters *AdditionalStoreFilters `json:"filters,omitempty"`
 }
 
 // EksConfigSpec defines configuration for fetching EKS audit logs.
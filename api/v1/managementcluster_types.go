@@ -58,6 +58,7 @@ type TLS struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Connected",type="integer",JSONPath=".status.connectedClusters",description="The number of connected managed clusters."
 
 // The presence of ManagementCluster in your cluster, will configure it to be the management plane to which managed
 // clusters can connect. At most one instance of this resource is supported. It must be named "tigera-secure".
@@ -65,7 +66,41 @@ type ManagementCluster struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec ManagementClusterSpec `json:"spec,omitempty"`
+	Spec   ManagementClusterSpec   `json:"spec,omitempty"`
+	Status ManagementClusterStatus `json:"status,omitempty"`
+}
+
+// ManagementClusterStatus defines the observed state of a ManagementCluster.
+type ManagementClusterStatus struct {
+	// ManagedClusters reports the tunnel state of every managed cluster registered with this management cluster.
+	// +optional
+	ManagedClusters []ManagedClusterConnectionStatus `json:"managedClusters,omitempty"`
+
+	// ConnectedClusters is the number of managed clusters with an established tunnel.
+	// +optional
+	ConnectedClusters int32 `json:"connectedClusters,omitempty"`
+}
+
+// ManagedClusterConnectionStatus is the tunnel state of a managed cluster, as reported by Voltron on the
+// ManagedCluster resource.
+type ManagedClusterConnectionStatus struct {
+	// Name of the ManagedCluster resource.
+	Name string `json:"name"`
+
+	// Namespace of the ManagedCluster resource. Only set in multi-tenant management clusters.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// State of the tunnel to the managed cluster.
+	State TunnelState `json:"state"`
+
+	// LastTransitionTime is the time the state last changed.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Message explains the state, if Voltron reported a reason.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -62,6 +62,7 @@ const (
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Tunnel",type="string",JSONPath=".status.tunnel.state",description="The state of the tunnel to the management cluster."
 
 // ManagementClusterConnection represents a link between a managed cluster and a management cluster. At most one
 // instance of this resource is supported. It must be named "tigera-secure".
@@ -88,6 +89,72 @@ type ManagementClusterConnectionStatus struct {
 	// Ready, Progressing, Degraded or other customer types.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Tunnel reports the state of the tunnel from Guardian to the management cluster, as observed by the operator
+	// through the health endpoint of Guardian.
+	// +optional
+	Tunnel *GuardianTunnelStatus `json:"tunnel,omitempty"`
+}
+
+// TunnelState is the state of the tunnel between a managed and a management cluster.
+// One of: Connected, Disconnected, Unknown
+type TunnelState string
+
+const (
+	TunnelStateConnected    TunnelState = "Connected"
+	TunnelStateDisconnected TunnelState = "Disconnected"
+	TunnelStateUnknown      TunnelState = "Unknown"
+)
+
+// TunnelErrorType classifies the last error Guardian encountered while establishing the tunnel.
+// One of: TLS, Proxy, Dial, Other
+type TunnelErrorType string
+
+const (
+	TunnelErrorTypeTLS   TunnelErrorType = "TLS"
+	TunnelErrorTypeProxy TunnelErrorType = "Proxy"
+	TunnelErrorTypeDial  TunnelErrorType = "Dial"
+	TunnelErrorTypeOther TunnelErrorType = "Other"
+)
+
+// GuardianTunnelStatus is the state of the tunnel from Guardian to the management cluster.
+type GuardianTunnelStatus struct {
+	// State is Connected if any Guardian replica has an established tunnel, Disconnected if none has, and Unknown
+	// if the operator could not reach the health endpoint of Guardian.
+	State TunnelState `json:"state"`
+
+	// LastCheckTime is the time the operator last polled Guardian and found the tunnel status changed.
+	// +optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+
+	// LastHandshakeTime is the time Guardian last completed the TLS handshake with the management cluster.
+	// +optional
+	LastHandshakeTime *metav1.Time `json:"lastHandshakeTime,omitempty"`
+
+	// Reconnects is the number of times Guardian re-established the tunnel since it started.
+	// +optional
+	Reconnects int32 `json:"reconnects,omitempty"`
+
+	// LastError is the last error Guardian encountered while establishing the tunnel.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// LastErrorType classifies LastError.
+	// +optional
+	LastErrorType TunnelErrorType `json:"lastErrorType,omitempty"`
+
+	// LastErrorTime is the time Guardian encountered LastError.
+	// +optional
+	LastErrorTime *metav1.Time `json:"lastErrorTime,omitempty"`
+
+	// Destinations are the host:port addresses Guardian dials to establish the tunnel, which are either the
+	// management cluster address or the proxies configured for the Guardian pods.
+	// +optional
+	Destinations []string `json:"destinations,omitempty"`
+
+	// ProxyError is set when the proxy configuration of the Guardian pods cannot be resolved.
+	// +optional
+	ProxyError string `json:"proxyError,omitempty"`
 }
 
 func init() {
@@ -26,6 +26,150 @@ type ManagerSpec struct {
 	// ManagerDeployment configures the Manager Deployment.
 	// +optional
 	ManagerDeployment *ManagerDeployment `json:"managerDeployment,omitempty"`
+
+	// Exposure configures how the manager is exposed outside of the cluster. When set, the operator renders the
+	// Ingress, Gateway API route or LoadBalancer Service for the manager, adds the hostname to the manager's
+	// TLS certificate and uses it to default Authentication.Spec.ManagerDomain.
+	// If omitted, the manager is only reachable through its ClusterIP Service.
+	// +optional
+	Exposure *ManagerExposure `json:"exposure,omitempty"`
+
+	// Branding configures how the manager UI identifies this cluster, so that the UIs of different clusters can be
+	// told apart.
+	// +optional
+	Branding *ManagerBranding `json:"branding,omitempty"`
+
+	// Features enables or disables optional parts of the manager UI.
+	// +optional
+	Features *ManagerFeatures `json:"features,omitempty"`
+}
+
+// ManagerBranding configures how the manager UI identifies this cluster.
+type ManagerBranding struct {
+	// ClusterName is the name the manager UI displays for this cluster.
+	// Default: cluster
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9]([A-Za-z0-9 ._-]*[A-Za-z0-9])?$`
+	ClusterName string `json:"clusterName,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Enabled;Disabled
+type ManagerFeatureState string
+
+const (
+	ManagerFeatureEnabled  ManagerFeatureState = "Enabled"
+	ManagerFeatureDisabled ManagerFeatureState = "Disabled"
+)
+
+// ManagerFeatures enables or disables optional parts of the manager UI.
+type ManagerFeatures struct {
+	// PolicyRecommendation controls whether the manager UI shows policy recommendations.
+	// Default: Enabled
+	// +optional
+	PolicyRecommendation *ManagerFeatureState `json:"policyRecommendation,omitempty"`
+
+	// ApplicationLayerPolicy controls whether the manager UI offers application layer policy configuration.
+	// Default: Enabled
+	// +optional
+	ApplicationLayerPolicy *ManagerFeatureState `json:"applicationLayerPolicy,omitempty"`
+
+	// Kibana controls whether the manager UI links to Kibana. Kibana is not available in multi-tenant
+	// management clusters, where this may not be Enabled.
+	// Default: Enabled, or Disabled in multi-tenant management clusters.
+	// +optional
+	Kibana *ManagerFeatureState `json:"kibana,omitempty"`
+}
+
+// ManagerExposureType is the mechanism used to expose the manager outside of the cluster.
+// +kubebuilder:validation:Enum=Ingress;GatewayAPI;LoadBalancer
+type ManagerExposureType string
+
+const (
+	ManagerExposureTypeIngress      ManagerExposureType = "Ingress"
+	ManagerExposureTypeGatewayAPI   ManagerExposureType = "GatewayAPI"
+	ManagerExposureTypeLoadBalancer ManagerExposureType = "LoadBalancer"
+)
+
+// ManagerExposure configures how the manager is exposed outside of the cluster.
+type ManagerExposure struct {
+	// Type is the mechanism used to expose the manager. Ingress renders a networking.k8s.io/v1 Ingress,
+	// GatewayAPI renders a Gateway using the class provisioned by the GatewayAPI resource together with a
+	// route, and LoadBalancer changes the manager Service to type LoadBalancer.
+	Type ManagerExposureType `json:"type"`
+
+	// Hostname is the fully qualified domain name users browse to in order to reach the manager, for example
+	// "manager.example.com". It must not contain a scheme, port or path.
+	// +kubebuilder:validation:MinLength=1
+	Hostname string `json:"hostname"`
+
+	// Ingress configures the rendered Ingress. Only valid when Type is Ingress.
+	// +optional
+	Ingress *ManagerIngress `json:"ingress,omitempty"`
+
+	// GatewayAPI configures the rendered Gateway and route. Only valid when Type is GatewayAPI.
+	// +optional
+	GatewayAPI *ManagerGatewayAPI `json:"gatewayAPI,omitempty"`
+
+	// LoadBalancer configures the manager LoadBalancer Service. Only valid when Type is LoadBalancer.
+	// +optional
+	LoadBalancer *ManagerLoadBalancer `json:"loadBalancer,omitempty"`
+}
+
+// ManagerIngress configures the Ingress rendered for the manager.
+type ManagerIngress struct {
+	// IngressClassName is the name of the IngressClass that should serve the manager Ingress.
+	// If omitted, the cluster's default IngressClass is used.
+	// +optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+
+	// Annotations are added to the Ingress. The manager only serves HTTPS, so the operator sets
+	// nginx.ingress.kubernetes.io/backend-protocol to HTTPS unless overridden here; other ingress
+	// controllers need their equivalent annotation set here.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ManagerGatewayRouteType is the kind of Gateway API route used to reach the manager.
+// +kubebuilder:validation:Enum=TLSRoute;HTTPRoute
+type ManagerGatewayRouteType string
+
+const (
+	ManagerGatewayRouteTypeTLSRoute  ManagerGatewayRouteType = "TLSRoute"
+	ManagerGatewayRouteTypeHTTPRoute ManagerGatewayRouteType = "HTTPRoute"
+)
+
+// ManagerGatewayAPI configures the Gateway and route rendered for the manager.
+type ManagerGatewayAPI struct {
+	// RouteType selects the route kind. TLSRoute passes TLS through to the manager, which terminates it with
+	// its own certificate. HTTPRoute terminates TLS at the gateway with the manager certificate and
+	// re-encrypts to the manager.
+	// Default: TLSRoute
+	// +optional
+	RouteType *ManagerGatewayRouteType `json:"routeType,omitempty"`
+}
+
+// GetRouteType returns the configured route type, defaulting to TLSRoute.
+func (g *ManagerGatewayAPI) GetRouteType() ManagerGatewayRouteType {
+	if g == nil || g.RouteType == nil {
+		return ManagerGatewayRouteTypeTLSRoute
+	}
+	return *g.RouteType
+}
+
+// ManagerLoadBalancer configures the manager Service when it is exposed as a LoadBalancer.
+type ManagerLoadBalancer struct {
+	// Annotations are added to the manager Service, for example to select a cloud provider's load balancer type.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// LoadBalancerClass is the class of the load balancer implementation that should provision the Service.
+	// +optional
+	LoadBalancerClass *string `json:"loadBalancerClass,omitempty"`
+
+	// LoadBalancerSourceRanges restricts the client CIDRs that may reach the load balancer.
+	// +optional
+	LoadBalancerSourceRanges []string `json:"loadBalancerSourceRanges,omitempty"`
 }
 
 // ManagerDeployment is the configuration for the Manager Deployment.
@@ -19,6 +19,7 @@ package v1
 import (
 	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -36,6 +37,196 @@ type MonitorSpec struct {
 	// AlertManager is the configuration for the AlertManager.
 	// +optional
 	AlertManager *AlertManager `json:"alertManager,omitempty"`
+
+	// Alerts configures the curated set of alerting rules that the operator renders for Calico components. All alerts
+	// are enabled by default.
+	// +optional
+	Alerts *MonitorAlerts `json:"alerts,omitempty"`
+
+	// Grafana enables the bundled Grafana dashboards for Calico metrics. When specified, the operator either deploys
+	// Grafana with the dashboards, or exports the dashboards for an existing Grafana instance.
+	// +optional
+	Grafana *Grafana `json:"grafana,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Deployment;DashboardsOnly
+type GrafanaMode string
+
+const (
+	// GrafanaModeDeployment deploys Grafana in the tigera-prometheus namespace, with the Calico Prometheus as its data
+	// source and the bundled dashboards provisioned.
+	GrafanaModeDeployment GrafanaMode = "Deployment"
+
+	// GrafanaModeDashboardsOnly only renders the bundled dashboards as ConfigMaps, for an existing Grafana instance
+	// that loads them with its dashboard sidecar.
+	GrafanaModeDashboardsOnly GrafanaMode = "DashboardsOnly"
+)
+
+// Grafana configures the bundled Grafana dashboards. The dashboards cover the Felix dataplane, Typha fan-out, BGP, IPAM
+// usage and the log pipeline throughput.
+type Grafana struct {
+	// Mode determines whether the operator deploys Grafana, or only exports the dashboards.
+	// Default: Deployment
+	// +optional
+	Mode *GrafanaMode `json:"mode,omitempty"`
+
+	// Dashboards configures the ConfigMaps that the dashboards are exported to in DashboardsOnly mode.
+	// +optional
+	Dashboards *GrafanaDashboards `json:"dashboards,omitempty"`
+
+	// URL is the address that users open the deployed Grafana on, such as https://grafana.example.com, for example
+	// through an Ingress to the tigera-grafana service. When set and the Authentication uses Dex, users sign in to
+	// Grafana with the identity providers of the Authentication: the operator registers Grafana as a Dex client, with
+	// <URL>/login/generic_oauth as its redirect URI. Otherwise, only the admin user can sign in.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^https://.+$`
+	URL string `json:"url,omitempty"`
+
+	// RoleMappings grant Grafana roles to the groups that users obtain from the identity providers. The first mapping
+	// that matches a group of the user applies, so list the most privileged roles first. Users that match no mapping
+	// are Viewers.
+	// +optional
+	RoleMappings []GrafanaRoleMapping `json:"roleMappings,omitempty"`
+}
+
+// GrafanaRole is an organization role of a Grafana user.
+// +kubebuilder:validation:Enum=Admin;Editor;Viewer
+type GrafanaRole string
+
+const (
+	GrafanaRoleAdmin  GrafanaRole = "Admin"
+	GrafanaRoleEditor GrafanaRole = "Editor"
+	GrafanaRoleViewer GrafanaRole = "Viewer"
+)
+
+// GrafanaRoleMapping grants a Grafana role to groups from the identity providers.
+type GrafanaRoleMapping struct {
+	// Groups are the names of the groups, as reported in the groups claim of the identity token.
+	// +kubebuilder:validation:MinItems=1
+	Groups []string `json:"groups"`
+
+	// Role is the Grafana role granted to the members of the groups.
+	Role GrafanaRole `json:"role"`
+}
+
+// GrafanaDashboards configures the ConfigMaps that the dashboards are exported to, following the label conventions of
+// the Grafana dashboard sidecar.
+type GrafanaDashboards struct {
+	// Namespace is the namespace of the ConfigMaps. The namespace must be created before the operator will create the
+	// ConfigMaps.
+	// Default: tigera-prometheus
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Labels are the labels of the ConfigMaps, which the dashboard sidecar of your Grafana instance is configured to
+	// select.
+	// Default: grafana_dashboard=1
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Folder is the Grafana folder the dashboards are placed in. It is set as the grafana_folder annotation of the
+	// ConfigMaps, which the dashboard sidecar uses when its folderAnnotation is set to grafana_folder.
+	// +optional
+	Folder string `json:"folder,omitempty"`
+}
+
+// GetMode returns the mode of the Grafana configuration, or Deployment if it is not set.
+func (g *Grafana) GetMode() GrafanaMode {
+	if g.Mode == nil {
+		return GrafanaModeDeployment
+	}
+	return *g.Mode
+}
+
+// MonitorAlerts configures each of the curated alerting rules.
+type MonitorAlerts struct {
+	// DeniedPacketsRate fires when a calico-node instance denies packets by policy at a high rate. Threshold is the
+	// number of denied packets per second.
+	// Default threshold: 50
+	// +optional
+	DeniedPacketsRate *AlertRule `json:"deniedPacketsRate,omitempty"`
+
+	// FelixDown fires when Prometheus cannot scrape the Felix metrics of a calico-node instance.
+	// +optional
+	FelixDown *AlertRule `json:"felixDown,omitempty"`
+
+	// TyphaDown fires when Prometheus cannot scrape the metrics of a Typha instance. It requires Typha metrics to be
+	// enabled on the Installation.
+	// +optional
+	TyphaDown *AlertRule `json:"typhaDown,omitempty"`
+
+	// BGPSessionDown fires when a calico-node instance has BGP peers that are not established.
+	// +optional
+	BGPSessionDown *AlertRule `json:"bgpSessionDown,omitempty"`
+
+	// IPAMExhaustion fires when the utilization of an IP pool is high. Threshold is the percentage of the pool that
+	// is allocated.
+	// Default threshold: 90
+	// +optional
+	IPAMExhaustion *AlertRule `json:"ipamExhaustion,omitempty"`
+
+	// ElasticsearchClusterHealth fires when the health of the Elasticsearch cluster is red.
+	// +optional
+	ElasticsearchClusterHealth *AlertRule `json:"elasticsearchClusterHealth,omitempty"`
+
+	// GuardianTunnelDown fires when Guardian, which maintains the tunnel of a managed cluster to its management
+	// cluster, does not have an established tunnel. Like CertificateExpiry, it relies on the metrics of the operator
+	// and is only deployed when the operator serves metrics.
+	// +optional
+	GuardianTunnelDown *AlertRule `json:"guardianTunnelDown,omitempty"`
+
+	// CertificateExpiry fires when a certificate read by the operator expires soon. Certificates signed by the
+	// operator are renewed 30 days before they expire, so this mostly applies to user-provided certificates.
+	// The alert relies on the metrics of the operator, so it is only deployed when the operator serves metrics,
+	// which requires the METRICS_HOST or METRICS_PORT environment variable to be set on the operator.
+	// Threshold is the number of days before expiry.
+	// Default threshold: 21
+	// +optional
+	CertificateExpiry *AlertRule `json:"certificateExpiry,omitempty"`
+}
+
+type AlertRuleState string
+
+const (
+	AlertRuleEnabled  AlertRuleState = "Enabled"
+	AlertRuleDisabled AlertRuleState = "Disabled"
+)
+
+// +kubebuilder:validation:Enum=critical;warning;info
+type AlertSeverity string
+
+const (
+	AlertSeverityCritical AlertSeverity = "critical"
+	AlertSeverityWarning  AlertSeverity = "warning"
+	AlertSeverityInfo     AlertSeverity = "info"
+)
+
+// AlertRule configures a curated alerting rule.
+type AlertRule struct {
+	// State enables or disables the alert.
+	// Default: Enabled
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	State *AlertRuleState `json:"state,omitempty"`
+
+	// Threshold overrides the threshold of the alert. Its unit depends on the alert, and it is ignored by alerts that
+	// do not have a threshold.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Threshold *int32 `json:"threshold,omitempty"`
+
+	// For is how long the condition must hold before the alert fires.
+	// +optional
+	For *v1.Duration `json:"for,omitempty"`
+
+	// Severity is the value of the severity label of the alert.
+	// +optional
+	Severity *AlertSeverity `json:"severity,omitempty"`
+}
+
+// IsEnabled returns true unless the alert rule is explicitly disabled.
+func (a *AlertRule) IsEnabled() bool {
+	return a == nil || a.State == nil || *a.State != AlertRuleDisabled
 }
 
 type ExternalPrometheus struct {
@@ -141,7 +332,107 @@ type Prometheus struct {
 type PrometheusSpec struct {
 	// CommonPrometheusFields are the options available to both the Prometheus server and agent.
 	CommonPrometheusFields *CommonPrometheusFields `json:"commonPrometheusFields,omitempty"`
+
+	// Retention is how long Prometheus retains samples, for example "24h" or "15d".
+	// Default: 24h
+	// +kubebuilder:validation:Pattern:="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	// +optional
+	Retention string `json:"retention,omitempty"`
+
+	// RetentionSize is the maximum number of bytes Prometheus uses to retain samples, for example "10GB". If both
+	// Retention and RetentionSize are set, samples are removed once either limit is reached.
+	// +kubebuilder:validation:Pattern:="(^0|([0-9]*[.])?[0-9]+((K|M|G|T|E|P)i?)?B)$"
+	// +optional
+	RetentionSize string `json:"retentionSize,omitempty"`
+
+	// Storage configures a persistent volume for the Prometheus data. If not specified, Prometheus stores its data in
+	// an emptyDir volume, which is lost when the pod is rescheduled.
+	// +optional
+	Storage *PrometheusStorage `json:"storage,omitempty"`
+
+	// Replicas is the number of Prometheus replicas. Each replica scrapes all targets, and replicas are spread across
+	// nodes.
+	// Default: 1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// RemoteWrite is a list of endpoints Prometheus sends the samples it scrapes to.
+	// +optional
+	RemoteWrite []PrometheusRemoteWrite `json:"remoteWrite,omitempty"`
+}
+
+// PrometheusStorage configures a persistent volume claim for each Prometheus replica.
+type PrometheusStorage struct {
+	// StorageClassName is the name of the StorageClass of the volume. If not specified, the default StorageClass of
+	// the cluster is used.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// Size is the requested size of the volume.
+	Size resource.Quantity `json:"size"`
 }
+
+// PrometheusRemoteWrite configures an endpoint Prometheus sends samples to. The Secrets referenced by the endpoint must
+// exist in the tigera-operator namespace. The operator copies them to the tigera-prometheus namespace.
+type PrometheusRemoteWrite struct {
+	// Name of the remote write queue. It must be unique if specified.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// URL of the endpoint to send samples to.
+	URL string `json:"url"`
+
+	// BasicAuth configures basic authentication with the endpoint. It cannot be combined with BearerTokenSecret.
+	// +optional
+	BasicAuth *PrometheusRemoteWriteBasicAuth `json:"basicAuth,omitempty"`
+
+	// BearerTokenSecret selects the Secret key containing the bearer token used to authenticate with the endpoint.
+	// +optional
+	BearerTokenSecret *corev1.SecretKeySelector `json:"bearerTokenSecret,omitempty"`
+
+	// TLS configures the TLS connection with the endpoint.
+	// +optional
+	TLS *PrometheusRemoteWriteTLS `json:"tls,omitempty"`
+
+	// WriteRelabelConfigs are relabeling rules applied to samples before they are sent to the endpoint.
+	// +optional
+	WriteRelabelConfigs []v1.RelabelConfig `json:"writeRelabelConfigs,omitempty"`
+}
+
+// PrometheusRemoteWriteBasicAuth selects the Secret keys containing the basic authentication credentials.
+type PrometheusRemoteWriteBasicAuth struct {
+	// Username selects the Secret key containing the username.
+	Username corev1.SecretKeySelector `json:"username"`
+
+	// Password selects the Secret key containing the password.
+	Password corev1.SecretKeySelector `json:"password"`
+}
+
+// PrometheusRemoteWriteTLS configures the TLS connection with a remote write endpoint.
+type PrometheusRemoteWriteTLS struct {
+	// CA selects the Secret key containing the CA certificate used to verify the endpoint. If not specified, the
+	// system root certificates are used.
+	// +optional
+	CA *corev1.SecretKeySelector `json:"ca,omitempty"`
+
+	// Cert selects the Secret key containing the client certificate presented to the endpoint. Key must be set as well.
+	// +optional
+	Cert *corev1.SecretKeySelector `json:"cert,omitempty"`
+
+	// Key selects the Secret key containing the private key of the client certificate.
+	// +optional
+	Key *corev1.SecretKeySelector `json:"key,omitempty"`
+
+	// ServerName is used to verify the hostname of the endpoint certificate.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+
+	// InsecureSkipVerify disables the verification of the endpoint certificate.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
 type CommonPrometheusFields struct {
 
 	// Containers is a list of Prometheus containers.
@@ -176,6 +467,140 @@ type AlertManager struct {
 type AlertManagerSpec struct {
 	// Define resources requests and limits for single Pods.
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Config is the structured configuration of Alertmanager receivers and routes. When specified, the operator renders
+	// it into the alertmanager-calico-node-alertmanager Secret in the tigera-operator namespace, replacing its contents.
+	// When not specified, the Secret is left for the user to manage.
+	// +optional
+	Config *AlertmanagerConfig `json:"config,omitempty"`
+}
+
+// AlertmanagerConfig configures how Alertmanager groups and delivers alerts.
+type AlertmanagerConfig struct {
+	// Route is the root of the routing tree. Its receiver receives all alerts that do not match a child route.
+	Route AlertmanagerRoute `json:"route"`
+
+	// Receivers are the notification integrations that routes refer to by name.
+	// +kubebuilder:validation:MinItems=1
+	Receivers []AlertmanagerReceiver `json:"receivers"`
+}
+
+// AlertmanagerRoute is a node of the Alertmanager routing tree.
+type AlertmanagerRoute struct {
+	// Receiver is the name of the receiver that alerts matching this route are sent to.
+	Receiver string `json:"receiver"`
+
+	// Matchers are the conditions an alert must satisfy to match this route, for example `severity="critical"`. They
+	// are ignored on the root route.
+	// +optional
+	Matchers []string `json:"matchers,omitempty"`
+
+	// GroupBy lists the labels alerts are grouped by.
+	// +optional
+	GroupBy []string `json:"groupBy,omitempty"`
+
+	// GroupWait is how long to wait before sending the first notification of a new group.
+	// +optional
+	GroupWait *v1.Duration `json:"groupWait,omitempty"`
+
+	// GroupInterval is how long to wait before notifying about new alerts added to a group.
+	// +optional
+	GroupInterval *v1.Duration `json:"groupInterval,omitempty"`
+
+	// RepeatInterval is how long to wait before sending a notification again for a firing alert.
+	// +optional
+	RepeatInterval *v1.Duration `json:"repeatInterval,omitempty"`
+
+	// Continue makes alerts that match this route continue to be matched against its siblings.
+	// +optional
+	Continue bool `json:"continue,omitempty"`
+
+	// Routes are the child routes.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +optional
+	Routes []AlertmanagerRoute `json:"routes,omitempty"`
+}
+
+// AlertmanagerReceiver configures the notification integrations of a receiver. The Secrets referenced by a receiver
+// must exist in the tigera-operator namespace.
+type AlertmanagerReceiver struct {
+	// Name of the receiver, referred to by routes.
+	Name string `json:"name"`
+
+	// SlackConfigs send notifications to Slack.
+	// +optional
+	SlackConfigs []SlackConfig `json:"slackConfigs,omitempty"`
+
+	// PagerDutyConfigs send notifications to PagerDuty.
+	// +optional
+	PagerDutyConfigs []PagerDutyConfig `json:"pagerDutyConfigs,omitempty"`
+
+	// WebhookConfigs send notifications to a generic webhook.
+	// +optional
+	WebhookConfigs []WebhookConfig `json:"webhookConfigs,omitempty"`
+}
+
+// SlackConfig sends notifications to a Slack channel.
+type SlackConfig struct {
+	// APIURLSecret selects the Secret key containing the Slack webhook URL.
+	APIURLSecret corev1.SecretKeySelector `json:"apiURLSecret"`
+
+	// Channel overrides the channel of the webhook.
+	// +optional
+	Channel string `json:"channel,omitempty"`
+
+	// SendResolved controls whether notifications are sent for resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+}
+
+// PagerDutyConfig sends notifications to PagerDuty using the Events API v2.
+type PagerDutyConfig struct {
+	// RoutingKeySecret selects the Secret key containing the PagerDuty integration key.
+	RoutingKeySecret corev1.SecretKeySelector `json:"routingKeySecret"`
+
+	// Severity of the PagerDuty incident. Defaults to the severity label of the alert.
+	// +optional
+	Severity string `json:"severity,omitempty"`
+
+	// SendResolved controls whether notifications are sent for resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+}
+
+// WebhookConfig sends notifications to a generic webhook.
+type WebhookConfig struct {
+	// URL of the webhook. Exactly one of URL and URLSecret must be specified.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// URLSecret selects the Secret key containing the URL of the webhook, for URLs that embed credentials.
+	// +optional
+	URLSecret *corev1.SecretKeySelector `json:"urlSecret,omitempty"`
+
+	// SendResolved controls whether notifications are sent for resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+}
+
+// SecretKeySelectors returns the selectors of the Secret keys referenced by the receivers.
+func (c *AlertmanagerConfig) SecretKeySelectors() []corev1.SecretKeySelector {
+	var selectors []corev1.SecretKeySelector
+	for _, r := range c.Receivers {
+		for _, s := range r.SlackConfigs {
+			selectors = append(selectors, s.APIURLSecret)
+		}
+		for _, p := range r.PagerDutyConfigs {
+			selectors = append(selectors, p.RoutingKeySecret)
+		}
+		for _, w := range r.WebhookConfigs {
+			if w.URLSecret != nil {
+				selectors = append(selectors, *w.URLSecret)
+			}
+		}
+	}
+	return selectors
 }
 
 func (c *Prometheus) GetContainers() []corev1.Container {
@@ -209,6 +634,26 @@ func (c *Prometheus) GetPrometheusResource() *corev1.ResourceRequirements {
 	return nil
 }
 
+// SecretNames returns the names of the Secrets referenced by the remote write endpoint.
+func (rw *PrometheusRemoteWrite) SecretNames() []string {
+	var selectors []*corev1.SecretKeySelector
+	if rw.BasicAuth != nil {
+		selectors = append(selectors, &rw.BasicAuth.Username, &rw.BasicAuth.Password)
+	}
+	selectors = append(selectors, rw.BearerTokenSecret)
+	if rw.TLS != nil {
+		selectors = append(selectors, rw.TLS.CA, rw.TLS.Cert, rw.TLS.Key)
+	}
+
+	var names []string
+	for _, s := range selectors {
+		if s != nil && s.Name != "" {
+			names = append(names, s.Name)
+		}
+	}
+	return names
+}
+
 func init() {
 	SchemeBuilder.Register(&Monitor{}, &MonitorList{})
 }
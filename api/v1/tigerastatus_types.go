@@ -137,6 +137,9 @@ const (
 	UpgradeError              TigeraStatusReason = "UpgradeError"
 	Unknown                   TigeraStatusReason = "Unknown"
 	ImageSetError             TigeraStatusReason = "ImageSetError"
+	ConnectivityTestFailure   TigeraStatusReason = "ConnectivityTestFailure"
+	FelixSettingsConflict     TigeraStatusReason = "FelixSettingsConflict"
+	DataplaneMigrationFailure TigeraStatusReason = "DataplaneMigrationFailure"
 )
 
 func init() {
@@ -26,14 +26,42 @@ const (
 	TargetTypeUI             TargetType = "UI"
 )
 
+// Condition types and reasons reported on TLSTerminatedRoutes and TLSPassThroughRoutes.
+const (
+	// TLSRouteConditionAccepted is True when the route has been validated and added to Voltron's routes, and False
+	// when it has been rejected.
+	TLSRouteConditionAccepted = "Accepted"
+
+	// TLSRouteReasonAccepted is used when the route has been added to Voltron's routes.
+	TLSRouteReasonAccepted = "Accepted"
+	// TLSRouteReasonInvalidSpec is used when the route spec itself is invalid.
+	TLSRouteReasonInvalidSpec = "InvalidSpec"
+	// TLSRouteReasonRefNotFound is used when a referenced ConfigMap, Secret or key does not exist.
+	TLSRouteReasonRefNotFound = "RefNotFound"
+	// TLSRouteReasonInvalidRef is used when a referenced ConfigMap or Secret key does not contain valid PEM data.
+	TLSRouteReasonInvalidRef = "InvalidRef"
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Accepted",type="string",JSONPath=".status.conditions[?(@.type=='Accepted')].status",description="Whether the route has been accepted."
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type=='Accepted')].reason",description="Why the route was accepted or rejected."
 
 type TLSTerminatedRoute struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	Spec              TLSTerminatedRouteSpec `json:"spec,omitempty"`
+	Status            TLSRouteStatus         `json:"status,omitempty"`
+}
+
+// TLSRouteStatus is the observed state of a TLSTerminatedRoute or TLSPassThroughRoute.
+type TLSRouteStatus struct {
+	// Conditions reports whether the route has been accepted by the operator. A route that is rejected is left out
+	// of Voltron's routes without affecting other routes.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -47,12 +75,16 @@ type TLSTerminatedRouteList struct {
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Accepted",type="string",JSONPath=".status.conditions[?(@.type=='Accepted')].status",description="Whether the route has been accepted."
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type=='Accepted')].reason",description="Why the route was accepted or rejected."
 
 type TLSPassThroughRoute struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Dest is the destination URL
-	Spec TLSPassThroughRouteSpec `json:"spec"`
+	Spec   TLSPassThroughRouteSpec `json:"spec"`
+	Status TLSRouteStatus          `json:"status,omitempty"`
 }
 
 type TLSPassThroughRouteSpec struct {
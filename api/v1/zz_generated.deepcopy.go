@@ -400,7 +400,7 @@ func (in *AdditionalLogStoreSpec) DeepCopyInto(out *AdditionalLogStoreSpec) {
 	if in.S3 != nil {
 		in, out := &in.S3, &out.S3
 		*out = new(S3StoreSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Syslog != nil {
 		in, out := &in.Syslog, &out.Syslog
@@ -410,7 +410,27 @@ func (in *AdditionalLogStoreSpec) DeepCopyInto(out *AdditionalLogStoreSpec) {
 	if in.Splunk != nil {
 		in, out := &in.Splunk, &out.Splunk
 		*out = new(SplunkStoreSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaStoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPStoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenTelemetry != nil {
+		in, out := &in.OpenTelemetry, &out.OpenTelemetry
+		*out = new(OpenTelemetryStoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Loki != nil {
+		in, out := &in.Loki, &out.Loki
+		*out = new(LokiStoreSpec)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -424,6 +444,31 @@ func (in *AdditionalLogStoreSpec) DeepCopy() *AdditionalLogStoreSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalStoreFilters) DeepCopyInto(out *AdditionalStoreFilters) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = new(FlowLogFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = new(FlowLogFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalStoreFilters.
+func (in *AdditionalStoreFilters) DeepCopy() *AdditionalStoreFilters {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalStoreFilters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertManager) DeepCopyInto(out *AlertManager) {
 	*out = *in
@@ -448,6 +493,11 @@ func (in *AlertManager) DeepCopy() *AlertManager {
 func (in *AlertManagerSpec) DeepCopyInto(out *AlertManagerSpec) {
 	*out = *in
 	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(AlertmanagerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertManagerSpec.
@@ -460,6 +510,147 @@ func (in *AlertManagerSpec) DeepCopy() *AlertManagerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRule) DeepCopyInto(out *AlertRule) {
+	*out = *in
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(AlertRuleState)
+		**out = **in
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.For != nil {
+		in, out := &in.For, &out.For
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
+	if in.Severity != nil {
+		in, out := &in.Severity, &out.Severity
+		*out = new(AlertSeverity)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRule.
+func (in *AlertRule) DeepCopy() *AlertRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerConfig) DeepCopyInto(out *AlertmanagerConfig) {
+	*out = *in
+	in.Route.DeepCopyInto(&out.Route)
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]AlertmanagerReceiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfig.
+func (in *AlertmanagerConfig) DeepCopy() *AlertmanagerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerReceiver) DeepCopyInto(out *AlertmanagerReceiver) {
+	*out = *in
+	if in.SlackConfigs != nil {
+		in, out := &in.SlackConfigs, &out.SlackConfigs
+		*out = make([]SlackConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PagerDutyConfigs != nil {
+		in, out := &in.PagerDutyConfigs, &out.PagerDutyConfigs
+		*out = make([]PagerDutyConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WebhookConfigs != nil {
+		in, out := &in.WebhookConfigs, &out.WebhookConfigs
+		*out = make([]WebhookConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerReceiver.
+func (in *AlertmanagerReceiver) DeepCopy() *AlertmanagerReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerRoute) DeepCopyInto(out *AlertmanagerRoute) {
+	*out = *in
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GroupBy != nil {
+		in, out := &in.GroupBy, &out.GroupBy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GroupWait != nil {
+		in, out := &in.GroupWait, &out.GroupWait
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
+	if in.GroupInterval != nil {
+		in, out := &in.GroupInterval, &out.GroupInterval
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
+	if in.RepeatInterval != nil {
+		in, out := &in.RepeatInterval, &out.RepeatInterval
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]AlertmanagerRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerRoute.
+func (in *AlertmanagerRoute) DeepCopy() *AlertmanagerRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AnomalyDetectionSpec) DeepCopyInto(out *AnomalyDetectionSpec) {
 	*out = *in
@@ -633,6 +824,88 @@ func (in *Authentication) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationConnector) DeepCopyInto(out *AuthenticationConnector) {
+	*out = *in
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(AuthenticationOIDC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LDAP != nil {
+		in, out := &in.LDAP, &out.LDAP
+		*out = new(AuthenticationLDAP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GitHub != nil {
+		in, out := &in.GitHub, &out.GitHub
+		*out = new(AuthenticationGitHub)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GitLab != nil {
+		in, out := &in.GitLab, &out.GitLab
+		*out = new(AuthenticationGitLab)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SAML != nil {
+		in, out := &in.SAML, &out.SAML
+		*out = new(AuthenticationSAML)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationConnector.
+func (in *AuthenticationConnector) DeepCopy() *AuthenticationConnector {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationConnector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationGitHub) DeepCopyInto(out *AuthenticationGitHub) {
+	*out = *in
+	if in.Orgs != nil {
+		in, out := &in.Orgs, &out.Orgs
+		*out = make([]GitHubOrg, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationGitHub.
+func (in *AuthenticationGitHub) DeepCopy() *AuthenticationGitHub {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationGitHub)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationGitLab) DeepCopyInto(out *AuthenticationGitLab) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationGitLab.
+func (in *AuthenticationGitLab) DeepCopy() *AuthenticationGitLab {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationGitLab)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthenticationLDAP) DeepCopyInto(out *AuthenticationLDAP) {
 	*out = *in
@@ -740,6 +1013,66 @@ func (in *AuthenticationOpenshift) DeepCopy() *AuthenticationOpenshift {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationRoleMapping) DeepCopyInto(out *AuthenticationRoleMapping) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationRoleMapping.
+func (in *AuthenticationRoleMapping) DeepCopy() *AuthenticationRoleMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationRoleMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationRoleMappingStatus) DeepCopyInto(out *AuthenticationRoleMappingStatus) {
+	*out = *in
+	if in.MissingNamespaces != nil {
+		in, out := &in.MissingNamespaces, &out.MissingNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationRoleMappingStatus.
+func (in *AuthenticationRoleMappingStatus) DeepCopy() *AuthenticationRoleMappingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationRoleMappingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationSAML) DeepCopyInto(out *AuthenticationSAML) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationSAML.
+func (in *AuthenticationSAML) DeepCopy() *AuthenticationSAML {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationSAML)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
 	*out = *in
@@ -758,11 +1091,45 @@ func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
 		*out = new(AuthenticationLDAP)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Connectors != nil {
+		in, out := &in.Connectors, &out.Connectors
+		*out = make([]AuthenticationConnector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RoleMappings != nil {
+		in, out := &in.RoleMappings, &out.RoleMappings
+		*out = make([]AuthenticationRoleMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DexDeployment != nil {
 		in, out := &in.DexDeployment, &out.DexDeployment
 		*out = new(DexDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DexStorage != nil {
+		in, out := &in.DexStorage, &out.DexStorage
+		*out = new(DexStorage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DexReplicas != nil {
+		in, out := &in.DexReplicas, &out.DexReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DexExpiry != nil {
+		in, out := &in.DexExpiry, &out.DexExpiry
+		*out = new(DexExpiry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DexSession != nil {
+		in, out := &in.DexSession, &out.DexSession
+		*out = new(DexSession)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationSpec.
@@ -785,34 +1152,265 @@ func (in *AuthenticationStatus) DeepCopyInto(out *AuthenticationStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RoleMappings != nil {
+		in, out := &in.RoleMappings, &out.RoleMappings
+		*out = make([]AuthenticationRoleMappingStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationStatus.
+func (in *AuthenticationStatus) DeepCopy() *AuthenticationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Azure) DeepCopyInto(out *Azure) {
+	*out = *in
+	if in.PolicyMode != nil {
+		in, out := &in.PolicyMode, &out.PolicyMode
+		*out = new(PolicyMode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Azure.
+func (in *Azure) DeepCopy() *Azure {
+	if in == nil {
+		return nil
+	}
+	out := new(Azure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPCommunity) DeepCopyInto(out *BGPCommunity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPCommunity.
+func (in *BGPCommunity) DeepCopy() *BGPCommunity {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPCommunity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPExternalPeer) DeepCopyInto(out *BGPExternalPeer) {
+	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPExternalPeer.
+func (in *BGPExternalPeer) DeepCopy() *BGPExternalPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPExternalPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterRule) DeepCopyInto(out *BGPFilterRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterRule.
+func (in *BGPFilterRule) DeepCopy() *BGPFilterRule {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPrefixAdvertisement) DeepCopyInto(out *BGPPrefixAdvertisement) {
+	*out = *in
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPrefixAdvertisement.
+func (in *BGPPrefixAdvertisement) DeepCopy() *BGPPrefixAdvertisement {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPrefixAdvertisement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPRouteFilter) DeepCopyInto(out *BGPRouteFilter) {
+	*out = *in
+	if in.ExportV4 != nil {
+		in, out := &in.ExportV4, &out.ExportV4
+		*out = make([]BGPFilterRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImportV4 != nil {
+		in, out := &in.ImportV4, &out.ImportV4
+		*out = make([]BGPFilterRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExportV6 != nil {
+		in, out := &in.ExportV6, &out.ExportV6
+		*out = make([]BGPFilterRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImportV6 != nil {
+		in, out := &in.ImportV6, &out.ImportV6
+		*out = make([]BGPFilterRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPRouteFilter.
+func (in *BGPRouteFilter) DeepCopy() *BGPRouteFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPRouteFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPRouteReflector) DeepCopyInto(out *BGPRouteReflector) {
+	*out = *in
+	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPRouteReflector.
+func (in *BGPRouteReflector) DeepCopy() *BGPRouteReflector {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPRouteReflector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPTopology) DeepCopyInto(out *BGPTopology) {
+	*out = *in
+	if in.NodeToNodeMesh != nil {
+		in, out := &in.NodeToNodeMesh, &out.NodeToNodeMesh
+		*out = new(BGPOption)
+		**out = **in
+	}
+	if in.ASNumber != nil {
+		in, out := &in.ASNumber, &out.ASNumber
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RouteReflectors != nil {
+		in, out := &in.RouteReflectors, &out.RouteReflectors
+		*out = make([]BGPRouteReflector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]BGPExternalPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = make([]BGPCommunity, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrefixAdvertisements != nil {
+		in, out := &in.PrefixAdvertisements, &out.PrefixAdvertisements
+		*out = make([]BGPPrefixAdvertisement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]BGPRouteFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPTopology.
+func (in *BGPTopology) DeepCopy() *BGPTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CNIChainedPlugin) DeepCopyInto(out *CNIChainedPlugin) {
+	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationStatus.
-func (in *AuthenticationStatus) DeepCopy() *AuthenticationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIChainedPlugin.
+func (in *CNIChainedPlugin) DeepCopy() *CNIChainedPlugin {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthenticationStatus)
+	out := new(CNIChainedPlugin)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Azure) DeepCopyInto(out *Azure) {
+func (in *CNIChainedPlugins) DeepCopyInto(out *CNIChainedPlugins) {
 	*out = *in
-	if in.PolicyMode != nil {
-		in, out := &in.PolicyMode, &out.PolicyMode
-		*out = new(PolicyMode)
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(BandwidthPluginOption)
 		**out = **in
 	}
+	if in.Additional != nil {
+		in, out := &in.Additional, &out.Additional
+		*out = make([]CNIChainedPlugin, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Azure.
-func (in *Azure) DeepCopy() *Azure {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNIChainedPlugins.
+func (in *CNIChainedPlugins) DeepCopy() *CNIChainedPlugins {
 	if in == nil {
 		return nil
 	}
-	out := new(Azure)
+	out := new(CNIChainedPlugins)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -858,7 +1456,12 @@ func (in *CNISpec) DeepCopyInto(out *CNISpec) {
 	if in.IPAM != nil {
 		in, out := &in.IPAM, &out.IPAM
 		*out = new(IPAMSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChainedPlugins != nil {
+		in, out := &in.ChainedPlugins, &out.ChainedPlugins
+		*out = new(CNIChainedPlugins)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -1162,6 +1765,16 @@ func (in *CalicoNetworkSpec) DeepCopyInto(out *CalicoNetworkSpec) {
 		*out = new(BGPOption)
 		**out = **in
 	}
+	if in.BGPTopology != nil {
+		in, out := &in.BGPTopology, &out.BGPTopology
+		*out = new(BGPTopology)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataplaneMigration != nil {
+		in, out := &in.DataplaneMigration, &out.DataplaneMigration
+		*out = new(DataplaneMigration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IPPools != nil {
 		in, out := &in.IPPools, &out.IPPools
 		*out = make([]IPPool, len(*in))
@@ -1169,6 +1782,11 @@ func (in *CalicoNetworkSpec) DeepCopyInto(out *CalicoNetworkSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.IPPoolDraining != nil {
+		in, out := &in.IPPoolDraining, &out.IPPoolDraining
+		*out = new(IPPoolDraining)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MTU != nil {
 		in, out := &in.MTU, &out.MTU
 		*out = new(int32)
@@ -1194,6 +1812,13 @@ func (in *CalicoNetworkSpec) DeepCopyInto(out *CalicoNetworkSpec) {
 		*out = new(MultiInterfaceMode)
 		**out = **in
 	}
+	if in.SecondaryNetworks != nil {
+		in, out := &in.SecondaryNetworks, &out.SecondaryNetworks
+		*out = make([]SecondaryNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ContainerIPForwarding != nil {
 		in, out := &in.ContainerIPForwarding, &out.ContainerIPForwarding
 		*out = new(ContainerIPForwardingType)
@@ -2492,6 +3117,168 @@ func (in *ComponentResource) DeepCopy() *ComponentResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityCheckStatus) DeepCopyInto(out *ConnectivityCheckStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityCheckStatus.
+func (in *ConnectivityCheckStatus) DeepCopy() *ConnectivityCheckStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityCheckStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityNodePairStatus) DeepCopyInto(out *ConnectivityNodePairStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityNodePairStatus.
+func (in *ConnectivityNodePairStatus) DeepCopy() *ConnectivityNodePairStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityNodePairStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityTest) DeepCopyInto(out *ConnectivityTest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityTest.
+func (in *ConnectivityTest) DeepCopy() *ConnectivityTest {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectivityTest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityTestList) DeepCopyInto(out *ConnectivityTestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ConnectivityTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityTestList.
+func (in *ConnectivityTestList) DeepCopy() *ConnectivityTestList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityTestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectivityTestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityTestSpec) DeepCopyInto(out *ConnectivityTestSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ProbeTimeout != nil {
+		in, out := &in.ProbeTimeout, &out.ProbeTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EgressTargets != nil {
+		in, out := &in.EgressTargets, &out.EgressTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityTestSpec.
+func (in *ConnectivityTestSpec) DeepCopy() *ConnectivityTestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityTestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityTestStatus) DeepCopyInto(out *ConnectivityTestStatus) {
+	*out = *in
+	if in.LastRunTime != nil {
+		in, out := &in.LastRunTime, &out.LastRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NodePairs != nil {
+		in, out := &in.NodePairs, &out.NodePairs
+		*out = make([]ConnectivityNodePairStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]ConnectivityCheckStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityTestStatus.
+func (in *ConnectivityTestStatus) DeepCopy() *ConnectivityTestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityTestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DPIDaemonsetInitContainer) DeepCopyInto(out *DPIDaemonsetInitContainer) {
 	*out = *in
@@ -2676,6 +3463,82 @@ func (in *DashboardsJobSpec) DeepCopy() *DashboardsJobSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataplaneMigration) DeepCopyInto(out *DataplaneMigration) {
+	*out = *in
+	if in.CanaryNodeSelector != nil {
+		in, out := &in.CanaryNodeSelector, &out.CanaryNodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PauseBetweenBatches != nil {
+		in, out := &in.PauseBetweenBatches, &out.PauseBetweenBatches
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BatchTimeout != nil {
+		in, out := &in.BatchTimeout, &out.BatchTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.KubeProxyManagement != nil {
+		in, out := &in.KubeProxyManagement, &out.KubeProxyManagement
+		*out = new(KubeProxyManagementOption)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataplaneMigration.
+func (in *DataplaneMigration) DeepCopy() *DataplaneMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(DataplaneMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataplaneMigrationStatus) DeepCopyInto(out *DataplaneMigrationStatus) {
+	*out = *in
+	if in.CurrentBatch != nil {
+		in, out := &in.CurrentBatch, &out.CurrentBatch
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BatchStartTime != nil {
+		in, out := &in.BatchStartTime, &out.BatchStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastBatchCompletionTime != nil {
+		in, out := &in.LastBatchCompletionTime, &out.LastBatchCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataplaneMigrationStatus.
+func (in *DataplaneMigrationStatus) DeepCopy() *DataplaneMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataplaneMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeepPacketInspectionDaemonset) DeepCopyInto(out *DeepPacketInspectionDaemonset) {
 	*out = *in
@@ -2775,52 +3638,147 @@ func (in *DexDeploymentPodSpec) DeepCopyInto(out *DexDeploymentPodSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DexDeploymentPodSpec.
-func (in *DexDeploymentPodSpec) DeepCopy() *DexDeploymentPodSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DexDeploymentPodSpec.
+func (in *DexDeploymentPodSpec) DeepCopy() *DexDeploymentPodSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DexDeploymentPodSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DexDeploymentPodTemplateSpec) DeepCopyInto(out *DexDeploymentPodTemplateSpec) {
+	*out = *in
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(DexDeploymentPodSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DexDeploymentPodTemplateSpec.
+func (in *DexDeploymentPodTemplateSpec) DeepCopy() *DexDeploymentPodTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DexDeploymentPodTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DexDeploymentSpec) DeepCopyInto(out *DexDeploymentSpec) {
+	*out = *in
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(DexDeploymentPodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DexDeploymentSpec.
+func (in *DexDeploymentSpec) DeepCopy() *DexDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DexDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DexExpiry) DeepCopyInto(out *DexExpiry) {
+	*out = *in
+	if in.IDTokens != nil {
+		in, out := &in.IDTokens, &out.IDTokens
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SigningKeys != nil {
+		in, out := &in.SigningKeys, &out.SigningKeys
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AuthRequests != nil {
+		in, out := &in.AuthRequests, &out.AuthRequests
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DexExpiry.
+func (in *DexExpiry) DeepCopy() *DexExpiry {
+	if in == nil {
+		return nil
+	}
+	out := new(DexExpiry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DexSQLStorage) DeepCopyInto(out *DexSQLStorage) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DexSQLStorage.
+func (in *DexSQLStorage) DeepCopy() *DexSQLStorage {
 	if in == nil {
 		return nil
 	}
-	out := new(DexDeploymentPodSpec)
+	out := new(DexSQLStorage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DexDeploymentPodTemplateSpec) DeepCopyInto(out *DexDeploymentPodTemplateSpec) {
+func (in *DexSession) DeepCopyInto(out *DexSession) {
 	*out = *in
-	if in.Spec != nil {
-		in, out := &in.Spec, &out.Spec
-		*out = new(DexDeploymentPodSpec)
-		(*in).DeepCopyInto(*out)
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AbsoluteLifetime != nil {
+		in, out := &in.AbsoluteLifetime, &out.AbsoluteLifetime
+		*out = new(metav1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DexDeploymentPodTemplateSpec.
-func (in *DexDeploymentPodTemplateSpec) DeepCopy() *DexDeploymentPodTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DexSession.
+func (in *DexSession) DeepCopy() *DexSession {
 	if in == nil {
 		return nil
 	}
-	out := new(DexDeploymentPodTemplateSpec)
+	out := new(DexSession)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DexDeploymentSpec) DeepCopyInto(out *DexDeploymentSpec) {
+func (in *DexStorage) DeepCopyInto(out *DexStorage) {
 	*out = *in
-	if in.Template != nil {
-		in, out := &in.Template, &out.Template
-		*out = new(DexDeploymentPodTemplateSpec)
+	if in.SQL != nil {
+		in, out := &in.SQL, &out.SQL
+		*out = new(DexSQLStorage)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DexDeploymentSpec.
-func (in *DexDeploymentSpec) DeepCopy() *DexDeploymentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DexStorage.
+func (in *DexStorage) DeepCopy() *DexStorage {
 	if in == nil {
 		return nil
 	}
-	out := new(DexDeploymentSpec)
+	out := new(DexStorage)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3772,6 +4730,141 @@ func (in *ExternalPrometheus) DeepCopy() *ExternalPrometheus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FelixConfigurationConflict) DeepCopyInto(out *FelixConfigurationConflict) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FelixConfigurationConflict.
+func (in *FelixConfigurationConflict) DeepCopy() *FelixConfigurationConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(FelixConfigurationConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
+	*out = *in
+	in.FelixSettings.DeepCopyInto(&out.FelixSettings)
+	if in.NodeOverrides != nil {
+		in, out := &in.NodeOverrides, &out.NodeOverrides
+		*out = make([]FelixNodeOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FelixConfigurationSpec.
+func (in *FelixConfigurationSpec) DeepCopy() *FelixConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FelixConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FelixNodeOverride) DeepCopyInto(out *FelixNodeOverride) {
+	*out = *in
+	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
+	in.Settings.DeepCopyInto(&out.Settings)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FelixNodeOverride.
+func (in *FelixNodeOverride) DeepCopy() *FelixNodeOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(FelixNodeOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FelixSettings) DeepCopyInto(out *FelixSettings) {
+	*out = *in
+	if in.LogSeverityScreen != nil {
+		in, out := &in.LogSeverityScreen, &out.LogSeverityScreen
+		*out = new(FelixLogSeverity)
+		**out = **in
+	}
+	if in.LogSeverityFile != nil {
+		in, out := &in.LogSeverityFile, &out.LogSeverityFile
+		*out = new(FelixLogSeverity)
+		**out = **in
+	}
+	if in.FlowLogsFlushInterval != nil {
+		in, out := &in.FlowLogsFlushInterval, &out.FlowLogsFlushInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RouteRefreshInterval != nil {
+		in, out := &in.RouteRefreshInterval, &out.RouteRefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.IptablesBackend != nil {
+		in, out := &in.IptablesBackend, &out.IptablesBackend
+		*out = new(IptablesBackend)
+		**out = **in
+	}
+	if in.BPFLogLevel != nil {
+		in, out := &in.BPFLogLevel, &out.BPFLogLevel
+		*out = new(BPFLogLevel)
+		**out = **in
+	}
+	if in.HealthPort != nil {
+		in, out := &in.HealthPort, &out.HealthPort
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FelixSettings.
+func (in *FelixSettings) DeepCopy() *FelixSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(FelixSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowLogFilter) DeepCopyInto(out *FlowLogFilter) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]FlowLogAction, len(*in))
+		copy(*out, *in)
+	}
+	if in.PolicyTiers != nil {
+		in, out := &in.PolicyTiers, &out.PolicyTiers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogFilter.
+func (in *FlowLogFilter) DeepCopy() *FlowLogFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowLogFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FluentdDaemonSet) DeepCopyInto(out *FluentdDaemonSet) {
 	*out = *in
@@ -4419,6 +5512,100 @@ func (in *GatewayDeploymentStrategy) DeepCopy() *GatewayDeploymentStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubOrg) DeepCopyInto(out *GitHubOrg) {
+	*out = *in
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubOrg.
+func (in *GitHubOrg) DeepCopy() *GitHubOrg {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubOrg)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Grafana) DeepCopyInto(out *Grafana) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(GrafanaMode)
+		**out = **in
+	}
+	if in.Dashboards != nil {
+		in, out := &in.Dashboards, &out.Dashboards
+		*out = new(GrafanaDashboards)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleMappings != nil {
+		in, out := &in.RoleMappings, &out.RoleMappings
+		*out = make([]GrafanaRoleMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grafana.
+func (in *Grafana) DeepCopy() *Grafana {
+	if in == nil {
+		return nil
+	}
+	out := new(Grafana)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaDashboards) DeepCopyInto(out *GrafanaDashboards) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDashboards.
+func (in *GrafanaDashboards) DeepCopy() *GrafanaDashboards {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaDashboards)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaRoleMapping) DeepCopyInto(out *GrafanaRoleMapping) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaRoleMapping.
+func (in *GrafanaRoleMapping) DeepCopy() *GrafanaRoleMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaRoleMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupSearch) DeepCopyInto(out *GroupSearch) {
 	*out = *in
@@ -4569,31 +5756,145 @@ func (in *GuardianDeploymentSpec) DeepCopy() *GuardianDeploymentSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPProbe) DeepCopyInto(out *HTTPProbe) {
+func (in *GuardianTunnelStatus) DeepCopyInto(out *GuardianTunnelStatus) {
+	*out = *in
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastHandshakeTime != nil {
+		in, out := &in.LastHandshakeTime, &out.LastHandshakeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastErrorTime != nil {
+		in, out := &in.LastErrorTime, &out.LastErrorTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianTunnelStatus.
+func (in *GuardianTunnelStatus) DeepCopy() *GuardianTunnelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardianTunnelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPProbe) DeepCopyInto(out *HTTPProbe) {
+	*out = *in
+	if in.URLs != nil {
+		in, out := &in.URLs, &out.URLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPProbe.
+func (in *HTTPProbe) DeepCopy() *HTTPProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPStoreSpec) DeepCopyInto(out *HTTPStoreSpec) {
+	*out = *in
+	if in.LogTypes != nil {
+		in, out := &in.LogTypes, &out.LogTypes
+		*out = make([]SyslogLogType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = new(AdditionalStoreFilters)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPStoreSpec.
+func (in *HTTPStoreSpec) DeepCopy() *HTTPStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostProtection) DeepCopyInto(out *HostProtection) {
+	*out = *in
+	if in.AutoHostEndpoints != nil {
+		in, out := &in.AutoHostEndpoints, &out.AutoHostEndpoints
+		*out = new(AutoHostEndpointsMode)
+		**out = **in
+	}
+	if in.BaselinePolicy != nil {
+		in, out := &in.BaselinePolicy, &out.BaselinePolicy
+		*out = new(HostBaselinePolicyMode)
+		**out = **in
+	}
+	if in.AllowedIngressPorts != nil {
+		in, out := &in.AllowedIngressPorts, &out.AllowedIngressPorts
+		*out = make([]HostProtectionPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProtection.
+func (in *HostProtection) DeepCopy() *HostProtection {
+	if in == nil {
+		return nil
+	}
+	out := new(HostProtection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostProtectionPort) DeepCopyInto(out *HostProtectionPort) {
 	*out = *in
-	if in.URLs != nil {
-		in, out := &in.URLs, &out.URLs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.IntervalSeconds != nil {
-		in, out := &in.IntervalSeconds, &out.IntervalSeconds
-		*out = new(int32)
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(HostProtectionProtocol)
 		**out = **in
 	}
-	if in.TimeoutSeconds != nil {
-		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+	if in.EndPort != nil {
+		in, out := &in.EndPort, &out.EndPort
 		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPProbe.
-func (in *HTTPProbe) DeepCopy() *HTTPProbe {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostProtectionPort.
+func (in *HostProtectionPort) DeepCopy() *HostProtectionPort {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPProbe)
+	out := new(HostProtectionPort)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4631,6 +5932,11 @@ func (in *ICMPProbe) DeepCopy() *ICMPProbe {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMSpec) DeepCopyInto(out *IPAMSpec) {
 	*out = *in
+	if in.UtilizationThreshold != nil {
+		in, out := &in.UtilizationThreshold, &out.UtilizationThreshold
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMSpec.
@@ -4678,6 +5984,46 @@ func (in *IPPool) DeepCopy() *IPPool {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolDraining) DeepCopyInto(out *IPPoolDraining) {
+	*out = *in
+	if in.PodEviction != nil {
+		in, out := &in.PodEviction, &out.PodEviction
+		*out = new(PodEvictionOption)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolDraining.
+func (in *IPPoolDraining) DeepCopy() *IPPoolDraining {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolDraining)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolStatus) DeepCopyInto(out *IPPoolStatus) {
+	*out = *in
+	if in.ExhaustedNodes != nil {
+		in, out := &in.ExhaustedNodes, &out.ExhaustedNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolStatus.
+func (in *IPPoolStatus) DeepCopy() *IPPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
@@ -4978,7 +6324,7 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 	if in.WindowsNodes != nil {
 		in, out := &in.WindowsNodes, &out.WindowsNodes
 		*out = new(WindowsNodeSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ServiceCIDRs != nil {
 		in, out := &in.ServiceCIDRs, &out.ServiceCIDRs
@@ -4995,6 +6341,16 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(Proxy)
 		**out = **in
 	}
+	if in.FelixConfiguration != nil {
+		in, out := &in.FelixConfiguration, &out.FelixConfiguration
+		*out = new(FelixConfigurationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostProtection != nil {
+		in, out := &in.HostProtection, &out.HostProtection
+		*out = new(HostProtection)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSpec.
@@ -5015,6 +6371,23 @@ func (in *InstallationStatus) DeepCopyInto(out *InstallationStatus) {
 		*out = new(InstallationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IPPools != nil {
+		in, out := &in.IPPools, &out.IPPools
+		*out = make([]IPPoolStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DataplaneMigration != nil {
+		in, out := &in.DataplaneMigration, &out.DataplaneMigration
+		*out = new(DataplaneMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FelixConfigurationConflicts != nil {
+		in, out := &in.FelixConfigurationConflicts, &out.FelixConfigurationConflicts
+		*out = make([]FelixConfigurationConflict, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -5297,6 +6670,56 @@ func (in *IntrusionDetectionStatus) DeepCopy() *IntrusionDetectionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaStoreSpec) DeepCopyInto(out *KafkaStoreSpec) {
+	*out = *in
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = new(KafkaTopics)
+		**out = **in
+	}
+	if in.LogTypes != nil {
+		in, out := &in.LogTypes, &out.LogTypes
+		*out = make([]SyslogLogType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = new(AdditionalStoreFilters)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaStoreSpec.
+func (in *KafkaStoreSpec) DeepCopy() *KafkaStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopics) DeepCopyInto(out *KafkaTopics) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopics.
+func (in *KafkaTopics) DeepCopy() *KafkaTopics {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kibana) DeepCopyInto(out *Kibana) {
 	*out = *in
@@ -5796,6 +7219,11 @@ func (in *LogCollectorSpec) DeepCopyInto(out *LogCollectorSpec) {
 		*out = new(FluentdDaemonSet)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FluentdWindowsDaemonSet != nil {
+		in, out := &in.FluentdWindowsDaemonSet, &out.FluentdWindowsDaemonSet
+		*out = new(FluentdDaemonSet)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EKSLogForwarderDeployment != nil {
 		in, out := &in.EKSLogForwarderDeployment, &out.EKSLogForwarderDeployment
 		*out = new(EKSLogForwarderDeployment)
@@ -6025,12 +7453,57 @@ func (in *Logging) DeepCopy() *Logging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiStoreSpec) DeepCopyInto(out *LokiStoreSpec) {
+	*out = *in
+	if in.LogTypes != nil {
+		in, out := &in.LogTypes, &out.LogTypes
+		*out = make([]SyslogLogType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = new(AdditionalStoreFilters)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStoreSpec.
+func (in *LokiStoreSpec) DeepCopy() *LokiStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterConnectionStatus) DeepCopyInto(out *ManagedClusterConnectionStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterConnectionStatus.
+func (in *ManagedClusterConnectionStatus) DeepCopy() *ManagedClusterConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagementCluster) DeepCopyInto(out *ManagementCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementCluster.
@@ -6145,6 +7618,11 @@ func (in *ManagementClusterConnectionStatus) DeepCopyInto(out *ManagementCluster
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Tunnel != nil {
+		in, out := &in.Tunnel, &out.Tunnel
+		*out = new(GuardianTunnelStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterConnectionStatus.
@@ -6209,6 +7687,28 @@ func (in *ManagementClusterSpec) DeepCopy() *ManagementClusterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagementClusterStatus) DeepCopyInto(out *ManagementClusterStatus) {
+	*out = *in
+	if in.ManagedClusters != nil {
+		in, out := &in.ManagedClusters, &out.ManagedClusters
+		*out = make([]ManagedClusterConnectionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterStatus.
+func (in *ManagementClusterStatus) DeepCopy() *ManagementClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagementClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagementClusterTLS) DeepCopyInto(out *ManagementClusterTLS) {
 	*out = *in
@@ -6251,6 +7751,21 @@ func (in *Manager) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagerBranding) DeepCopyInto(out *ManagerBranding) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerBranding.
+func (in *ManagerBranding) DeepCopy() *ManagerBranding {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagerBranding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagerDeployment) DeepCopyInto(out *ManagerDeployment) {
 	*out = *in
@@ -6380,6 +7895,113 @@ func (in *ManagerDeploymentSpec) DeepCopy() *ManagerDeploymentSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagerExposure) DeepCopyInto(out *ManagerExposure) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(ManagerIngress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GatewayAPI != nil {
+		in, out := &in.GatewayAPI, &out.GatewayAPI
+		*out = new(ManagerGatewayAPI)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(ManagerLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerExposure.
+func (in *ManagerExposure) DeepCopy() *ManagerExposure {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagerExposure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagerFeatures) DeepCopyInto(out *ManagerFeatures) {
+	*out = *in
+	if in.PolicyRecommendation != nil {
+		in, out := &in.PolicyRecommendation, &out.PolicyRecommendation
+		*out = new(ManagerFeatureState)
+		**out = **in
+	}
+	if in.ApplicationLayerPolicy != nil {
+		in, out := &in.ApplicationLayerPolicy, &out.ApplicationLayerPolicy
+		*out = new(ManagerFeatureState)
+		**out = **in
+	}
+	if in.Kibana != nil {
+		in, out := &in.Kibana, &out.Kibana
+		*out = new(ManagerFeatureState)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerFeatures.
+func (in *ManagerFeatures) DeepCopy() *ManagerFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagerFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagerGatewayAPI) DeepCopyInto(out *ManagerGatewayAPI) {
+	*out = *in
+	if in.RouteType != nil {
+		in, out := &in.RouteType, &out.RouteType
+		*out = new(ManagerGatewayRouteType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerGatewayAPI.
+func (in *ManagerGatewayAPI) DeepCopy() *ManagerGatewayAPI {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagerGatewayAPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagerIngress) DeepCopyInto(out *ManagerIngress) {
+	*out = *in
+	if in.IngressClassName != nil {
+		in, out := &in.IngressClassName, &out.IngressClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerIngress.
+func (in *ManagerIngress) DeepCopy() *ManagerIngress {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagerIngress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagerList) DeepCopyInto(out *ManagerList) {
 	*out = *in
@@ -6394,24 +8016,56 @@ func (in *ManagerList) DeepCopyInto(out *ManagerList) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerList.
-func (in *ManagerList) DeepCopy() *ManagerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerList.
+func (in *ManagerList) DeepCopy() *ManagerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagerLoadBalancer) DeepCopyInto(out *ManagerLoadBalancer) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LoadBalancerClass != nil {
+		in, out := &in.LoadBalancerClass, &out.LoadBalancerClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.LoadBalancerSourceRanges != nil {
+		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerLoadBalancer.
+func (in *ManagerLoadBalancer) DeepCopy() *ManagerLoadBalancer {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagerList)
+	out := new(ManagerLoadBalancer)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ManagerList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagerSpec) DeepCopyInto(out *ManagerSpec) {
 	*out = *in
@@ -6420,6 +8074,21 @@ func (in *ManagerSpec) DeepCopyInto(out *ManagerSpec) {
 		*out = new(ManagerDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Exposure != nil {
+		in, out := &in.Exposure, &out.Exposure
+		*out = new(ManagerExposure)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Branding != nil {
+		in, out := &in.Branding, &out.Branding
+		*out = new(ManagerBranding)
+		**out = **in
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = new(ManagerFeatures)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerSpec.
@@ -6510,6 +8179,61 @@ func (in *Monitor) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorAlerts) DeepCopyInto(out *MonitorAlerts) {
+	*out = *in
+	if in.DeniedPacketsRate != nil {
+		in, out := &in.DeniedPacketsRate, &out.DeniedPacketsRate
+		*out = new(AlertRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FelixDown != nil {
+		in, out := &in.FelixDown, &out.FelixDown
+		*out = new(AlertRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TyphaDown != nil {
+		in, out := &in.TyphaDown, &out.TyphaDown
+		*out = new(AlertRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BGPSessionDown != nil {
+		in, out := &in.BGPSessionDown, &out.BGPSessionDown
+		*out = new(AlertRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPAMExhaustion != nil {
+		in, out := &in.IPAMExhaustion, &out.IPAMExhaustion
+		*out = new(AlertRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ElasticsearchClusterHealth != nil {
+		in, out := &in.ElasticsearchClusterHealth, &out.ElasticsearchClusterHealth
+		*out = new(AlertRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuardianTunnelDown != nil {
+		in, out := &in.GuardianTunnelDown, &out.GuardianTunnelDown
+		*out = new(AlertRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificateExpiry != nil {
+		in, out := &in.CertificateExpiry, &out.CertificateExpiry
+		*out = new(AlertRule)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorAlerts.
+func (in *MonitorAlerts) DeepCopy() *MonitorAlerts {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorAlerts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MonitorList) DeepCopyInto(out *MonitorList) {
 	*out = *in
@@ -6560,6 +8284,16 @@ func (in *MonitorSpec) DeepCopyInto(out *MonitorSpec) {
 		*out = new(AlertManager)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Alerts != nil {
+		in, out := &in.Alerts, &out.Alerts
+		*out = new(MonitorAlerts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Grafana != nil {
+		in, out := &in.Grafana, &out.Grafana
+		*out = new(Grafana)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorSpec.
@@ -6786,6 +8520,31 @@ func (in *NonClusterHostSpec) DeepCopy() *NonClusterHostSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenTelemetryStoreSpec) DeepCopyInto(out *OpenTelemetryStoreSpec) {
+	*out = *in
+	if in.LogTypes != nil {
+		in, out := &in.LogTypes, &out.LogTypes
+		*out = make([]SyslogLogType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = new(AdditionalStoreFilters)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenTelemetryStoreSpec.
+func (in *OpenTelemetryStoreSpec) DeepCopy() *OpenTelemetryStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenTelemetryStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PacketCaptureAPI) DeepCopyInto(out *PacketCaptureAPI) {
 	*out = *in
@@ -7016,6 +8775,27 @@ func (in *PacketCaptureAPIStatus) DeepCopy() *PacketCaptureAPIStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagerDutyConfig) DeepCopyInto(out *PagerDutyConfig) {
+	*out = *in
+	in.RoutingKeySecret.DeepCopyInto(&out.RoutingKeySecret)
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagerDutyConfig.
+func (in *PagerDutyConfig) DeepCopy() *PagerDutyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PagerDutyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PathMatch) DeepCopyInto(out *PathMatch) {
 	*out = *in
@@ -7304,6 +9084,90 @@ func (in *PrometheusContainer) DeepCopy() *PrometheusContainer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRemoteWrite) DeepCopyInto(out *PrometheusRemoteWrite) {
+	*out = *in
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(PrometheusRemoteWriteBasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BearerTokenSecret != nil {
+		in, out := &in.BearerTokenSecret, &out.BearerTokenSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(PrometheusRemoteWriteTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteRelabelConfigs != nil {
+		in, out := &in.WriteRelabelConfigs, &out.WriteRelabelConfigs
+		*out = make([]monitoringv1.RelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRemoteWrite.
+func (in *PrometheusRemoteWrite) DeepCopy() *PrometheusRemoteWrite {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRemoteWrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRemoteWriteBasicAuth) DeepCopyInto(out *PrometheusRemoteWriteBasicAuth) {
+	*out = *in
+	in.Username.DeepCopyInto(&out.Username)
+	in.Password.DeepCopyInto(&out.Password)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRemoteWriteBasicAuth.
+func (in *PrometheusRemoteWriteBasicAuth) DeepCopy() *PrometheusRemoteWriteBasicAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRemoteWriteBasicAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRemoteWriteTLS) DeepCopyInto(out *PrometheusRemoteWriteTLS) {
+	*out = *in
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cert != nil {
+		in, out := &in.Cert, &out.Cert
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRemoteWriteTLS.
+func (in *PrometheusRemoteWriteTLS) DeepCopy() *PrometheusRemoteWriteTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRemoteWriteTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
 	*out = *in
@@ -7312,6 +9176,23 @@ func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
 		*out = new(CommonPrometheusFields)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(PrometheusStorage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RemoteWrite != nil {
+		in, out := &in.RemoteWrite, &out.RemoteWrite
+		*out = make([]PrometheusRemoteWrite, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusSpec.
@@ -7324,6 +9205,27 @@ func (in *PrometheusSpec) DeepCopy() *PrometheusSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusStorage) DeepCopyInto(out *PrometheusStorage) {
+	*out = *in
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	out.Size = in.Size.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusStorage.
+func (in *PrometheusStorage) DeepCopy() *PrometheusStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Proxy) DeepCopyInto(out *Proxy) {
 	*out = *in
@@ -7407,6 +9309,16 @@ func (in *Retention) DeepCopy() *Retention {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3StoreSpec) DeepCopyInto(out *S3StoreSpec) {
 	*out = *in
+	if in.LogTypes != nil {
+		in, out := &in.LogTypes, &out.LogTypes
+		*out = make([]SyslogLogType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = new(AdditionalStoreFilters)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3StoreSpec.
@@ -7434,6 +9346,27 @@ func (in *SNIMatch) DeepCopy() *SNIMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryNetwork) DeepCopyInto(out *SecondaryNetwork) {
+	*out = *in
+	in.IPPool.DeepCopyInto(&out.IPPool)
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryNetwork.
+func (in *SecondaryNetwork) DeepCopy() *SecondaryNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceMonitor) DeepCopyInto(out *ServiceMonitor) {
 	*out = *in
@@ -7463,9 +9396,40 @@ func (in *ServiceMonitor) DeepCopy() *ServiceMonitor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackConfig) DeepCopyInto(out *SlackConfig) {
+	*out = *in
+	in.APIURLSecret.DeepCopyInto(&out.APIURLSecret)
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackConfig.
+func (in *SlackConfig) DeepCopy() *SlackConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SplunkStoreSpec) DeepCopyInto(out *SplunkStoreSpec) {
 	*out = *in
+	if in.LogTypes != nil {
+		in, out := &in.LogTypes, &out.LogTypes
+		*out = make([]SyslogLogType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = new(AdditionalStoreFilters)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplunkStoreSpec.
@@ -7506,6 +9470,11 @@ func (in *SyslogStoreSpec) DeepCopyInto(out *SyslogStoreSpec) {
 		*out = make([]SyslogLogType, len(*in))
 		copy(*out, *in)
 	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = new(AdditionalStoreFilters)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogStoreSpec.
@@ -7539,6 +9508,7 @@ func (in *TLSPassThroughRoute) DeepCopyInto(out *TLSPassThroughRoute) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPassThroughRoute.
@@ -7611,12 +9581,35 @@ func (in *TLSPassThroughRouteSpec) DeepCopy() *TLSPassThroughRouteSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSRouteStatus) DeepCopyInto(out *TLSRouteStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSRouteStatus.
+func (in *TLSRouteStatus) DeepCopy() *TLSRouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSRouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSTerminatedRoute) DeepCopyInto(out *TLSTerminatedRoute) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSTerminatedRoute.
@@ -8205,6 +10198,31 @@ func (in *UserSearch) DeepCopy() *UserSearch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
+	*out = *in
+	if in.URLSecret != nil {
+		in, out := &in.URLSecret, &out.URLSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookConfig.
+func (in *WebhookConfig) DeepCopy() *WebhookConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Whisker) DeepCopyInto(out *Whisker) {
 	*out = *in
@@ -8288,6 +10306,16 @@ func (in *WhiskerStatus) DeepCopy() *WhiskerStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WindowsNodeSpec) DeepCopyInto(out *WindowsNodeSpec) {
 	*out = *in
+	if in.BGP != nil {
+		in, out := &in.BGP, &out.BGP
+		*out = new(BGPOption)
+		**out = **in
+	}
+	if in.FirewallRules != nil {
+		in, out := &in.FirewallRules, &out.FirewallRules
+		*out = new(WindowsFirewallRulesOption)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WindowsNodeSpec.
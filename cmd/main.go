@@ -35,6 +35,7 @@ import (
 	"github.com/tigera/operator/pkg/awssgsetup"
 	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/components"
+	"github.com/tigera/operator/pkg/connectivityprobe"
 	"github.com/tigera/operator/pkg/controller/options"
 	"github.com/tigera/operator/pkg/controller/utils"
 	"github.com/tigera/operator/pkg/crds"
@@ -67,9 +68,8 @@ import (
 )
 
 var (
-	defaultMetricsPort int32 = 8484
-	scheme                   = runtime.NewScheme()
-	setupLog                 = ctrl.Log.WithName("setup")
+	scheme   = runtime.NewScheme()
+	setupLog = ctrl.Log.WithName("setup")
 )
 
 // bootstrapConfigMapName is the name of the ConfigMap that contains cluster-wide
@@ -102,6 +102,7 @@ func main() {
 	var printCalicoCRDs string
 	var printEnterpriseCRDs string
 	var sgSetup bool
+	var connectivityProbe bool
 	var manageCRDs bool
 	var preDelete bool
 	var variant string
@@ -128,6 +129,7 @@ If a value other than 'all' is specified, the first CRD with a prefix of the spe
 	flag.BoolVar(&showVersion, "version", false, "Show version information")
 	flag.StringVar(&printImages, "print-images", "", "Print the default images the operator could deploy and exit. Possible values: list")
 	flag.BoolVar(&sgSetup, "aws-sg-setup", false, "Setup Security Groups in AWS (should only be used on OpenShift).")
+	flag.BoolVar(&connectivityProbe, "connectivity-probe", false, "Run the connectivity test probe agent (should only be used in the probe pods).")
 	flag.BoolVar(&manageCRDs, "manage-crds", false, "Operator should manage the projectcalico.org and operator.tigera.io CRDs.")
 	flag.BoolVar(&preDelete, "pre-delete", false, "Run helm pre-deletion hook logic, then exit.")
 	flag.BoolVar(&bootstrapCRDs, "bootstrap-crds", false, "Install CRDs and exit")
@@ -193,6 +195,16 @@ If a value other than 'all' is specified, the first CRD with a prefix of the spe
 
 	ctx, cancel := context.WithCancel(context.Background())
 
+	// The probe pods run in the pod network without access to the Kubernetes API, so this must run before building
+	// a client.
+	if connectivityProbe {
+		if err := connectivityprobe.Serve(ctrl.SetupSignalHandler(), fmt.Sprintf(":%d", connectivityprobe.Port), os.Getenv(connectivityprobe.TokenEnvVar)); err != nil {
+			log.Error(err, "")
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	cfg, err := config.GetConfig()
 	if err != nil {
 		log.Error(err, "")
@@ -508,8 +520,8 @@ func metricsAddr() string {
 	// if just a host is specified, listen on port 8484 of that host.
 	if metricsHost != "" && metricsPort == "" {
 		// the controller-runtime will choose a random port if none is specified.
-		// so use the defaultMetricsPort in that case.
-		return fmt.Sprintf("%s:%d", metricsHost, defaultMetricsPort)
+		// so use the default operator metrics port in that case.
+		return fmt.Sprintf("%s:%d", metricsHost, common.DefaultOperatorMetricsPort)
 	}
 
 	// finally, handle cases where just a port is specified or both are specified in the same case
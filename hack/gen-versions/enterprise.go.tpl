@@ -280,6 +280,13 @@ var (
 		Registry: "{{ .Registry }}",
 	}
 {{- end }}
+{{ with index .Components "grafana" }}
+	ComponentGrafana = Component{
+		Version:  "{{ .Version }}",
+		Image:    "{{ .Image }}",
+		Registry: "{{ .Registry }}",
+	}
+{{- end }}
 {{ with index .Components "cnx-queryserver" }}
 	ComponentQueryServer = Component{
 		Version:  "{{ .Version }}",
@@ -410,6 +417,7 @@ var (
 		ComponentPrometheus,
 		ComponentTigeraPrometheusService,
 		ComponentPrometheusAlertmanager,
+		ComponentGrafana,
 		ComponentQueryServer,
 		ComponentTigeraKubeControllers,
 		ComponentTigeraNode,
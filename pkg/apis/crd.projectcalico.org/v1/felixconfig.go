@@ -201,6 +201,9 @@ type FelixConfigurationSpec struct {
 	// ReportingTTL is the time-to-live setting for process-wide status reports. [Default: 90s]
 	ReportingTTL *metav1.Duration `json:"reportingTTL,omitempty" configv1timescale:"seconds" confignamev1:"ReportingTTLSecs"`
 
+	// FlowLogsFlushInterval configures the interval at which Felix exports flow logs. [Default: 300s]
+	FlowLogsFlushInterval *metav1.Duration `json:"flowLogsFlushInterval,omitempty" configv1timescale:"seconds" confignamev1:"FlowLogsFlushIntervalSecs"`
+
 	EndpointReportingEnabled *bool            `json:"endpointReportingEnabled,omitempty"`
 	EndpointReportingDelay   *metav1.Duration `json:"endpointReportingDelay,omitempty" configv1timescale:"seconds" confignamev1:"EndpointReportingDelaySecs"`
 
@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindIPAMBlock     = "IPAMBlock"
+	KindIPAMBlockList = "IPAMBlockList"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IPAMBlock contains information about a block of addresses handed out by Calico IPAM. Blocks are
+// written by Calico IPAM; the operator only reads them.
+type IPAMBlock struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the IPAMBlock.
+	Spec IPAMBlockSpec `json:"spec,omitempty"`
+}
+
+// IPAMBlockSpec contains the specification for an IPAMBlock resource. Only the fields the operator
+// uses are included.
+type IPAMBlockSpec struct {
+	// The block's CIDR.
+	CIDR string `json:"cidr"`
+
+	// Affinity of the block, if this block has one. If set, it will be of the form
+	// "host:<hostname>".
+	Affinity *string `json:"affinity,omitempty"`
+
+	// Array of allocations in-use within this block. nil entries mean the allocation is free.
+	// For non-nil entries at index i, the index is the ordinal of the allocation within this block
+	// and the value is the index of the associated attributes in the Attributes array.
+	Allocations []*int `json:"allocations"`
+
+	// Unallocated is an ordered list of allocations which are free in the block.
+	Unallocated []int `json:"unallocated"`
+
+	// Attributes is an array of arbitrary metadata associated with allocations in the block.
+	Attributes []AllocationAttribute `json:"attributes"`
+
+	// Deleted is an internal boolean used to workaround a limitation in the Kubernetes API whereby
+	// deletion will not return a conflict error if the block has been updated.
+	Deleted bool `json:"deleted"`
+}
+
+// AllocationAttribute holds the metadata of an allocation in an IPAMBlock.
+type AllocationAttribute struct {
+	AttrPrimary   *string           `json:"handle_id,omitempty"`
+	AttrSecondary map[string]string `json:"secondary,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IPAMBlockList contains a list of IPAMBlock resources.
+type IPAMBlockList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []IPAMBlock `json:"items"`
+}
@@ -0,0 +1,21 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v1 contains the NetworkAttachmentDefinition type of the Kubernetes Network Plumbing Working Group
+// multi-network specification, as used by Multus.
+//
+// +k8s:deepcopy-gen=package,register
+// +groupName=k8s.cni.cncf.io
+
+package v1
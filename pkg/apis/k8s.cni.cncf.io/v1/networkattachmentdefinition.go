@@ -0,0 +1,50 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindNetworkAttachmentDefinition     = "NetworkAttachmentDefinition"
+	KindNetworkAttachmentDefinitionList = "NetworkAttachmentDefinitionList"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NetworkAttachmentDefinition describes a network that pods can attach additional interfaces to.
+type NetworkAttachmentDefinition struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the NetworkAttachmentDefinition.
+	Spec NetworkAttachmentDefinitionSpec `json:"spec"`
+}
+
+// NetworkAttachmentDefinitionSpec contains the specification for a NetworkAttachmentDefinition resource.
+type NetworkAttachmentDefinitionSpec struct {
+	// Config is the CNI network configuration, as JSON.
+	Config string `json:"config"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NetworkAttachmentDefinitionList contains a list of NetworkAttachmentDefinition resources.
+type NetworkAttachmentDefinitionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []NetworkAttachmentDefinition `json:"items"`
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"os"
+	"strconv"
+)
+
+// DefaultOperatorMetricsPort is the port the operator serves metrics on when METRICS_HOST is set without METRICS_PORT.
+const DefaultOperatorMetricsPort int32 = 8484
+
+// OperatorMetricsPort returns the port the operator serves metrics on, based on the METRICS_HOST and METRICS_PORT
+// environment variables. It returns 0 when metrics are disabled.
+func OperatorMetricsPort() int32 {
+	metricsHost := os.Getenv("METRICS_HOST")
+	metricsPort := os.Getenv("METRICS_PORT")
+	if metricsPort == "" {
+		if metricsHost == "" {
+			return 0
+		}
+		return DefaultOperatorMetricsPort
+	}
+	port, err := strconv.ParseInt(metricsPort, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(port)
+}
@@ -243,6 +243,12 @@ var (
 		Registry: "",
 	}
 
+	ComponentGrafana = Component{
+		Version:  "11.6.1",
+		Image:    "grafana/grafana",
+		Registry: "docker.io/",
+	}
+
 	ComponentQueryServer = Component{
 		Version:  "master",
 		Image:    "tigera/cnx-queryserver",
@@ -358,6 +364,7 @@ var (
 		ComponentPrometheus,
 		ComponentTigeraPrometheusService,
 		ComponentPrometheusAlertmanager,
+		ComponentGrafana,
 		ComponentQueryServer,
 		ComponentTigeraKubeControllers,
 		ComponentTigeraNode,
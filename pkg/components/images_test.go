@@ -36,6 +36,7 @@ var _ = Describe("test GetReference", func() {
 			Entry("an operator init image correctly", ComponentOperatorInit, InitRegistry, "tigera/operator"),
 			Entry("a CSR init image correctly", ComponentCalicoCSRInitContainer, CalicoRegistry, "calico/key-cert-provisioner"),
 			Entry("a CSR init image correctly", ComponentTigeraCSRInitContainer, TigeraRegistry, "tigera/key-cert-provisioner"),
+			Entry("an upstream Grafana image correctly", ComponentGrafana, "docker.io/", "grafana/grafana"),
 		)
 	})
 
@@ -0,0 +1,216 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package connectivityprobe implements the agent that runs in the connectivity test probe pods. Each agent serves
+// a health endpoint that the other agents probe, and runs the probes that the operator asks for from within the pod
+// network.
+package connectivityprobe
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("connectivity_probe")
+
+const (
+	// Port is the port the agent listens on.
+	Port = 9098
+
+	// HealthPath is served by every agent, and is the target of the pod to pod probes.
+	HealthPath = "/healthz"
+
+	// ProbePath runs the probes in the posted Request and returns a Response. Requests must carry the agent's token
+	// as a bearer token.
+	ProbePath = "/probe"
+
+	// TokenEnvVar is the environment variable the agent reads its token from.
+	TokenEnvVar = "CONNECTIVITY_PROBE_TOKEN"
+)
+
+// ProbeType identifies what a probe checks.
+type ProbeType string
+
+const (
+	// ProbeTypePod checks connectivity to another probe pod.
+	ProbeTypePod ProbeType = "Pod"
+	// ProbeTypeService checks connectivity to a service cluster IP.
+	ProbeTypeService ProbeType = "Service"
+	// ProbeTypeDNS checks that a name can be resolved.
+	ProbeTypeDNS ProbeType = "DNS"
+	// ProbeTypeEgress checks connectivity to an address outside the cluster.
+	ProbeTypeEgress ProbeType = "Egress"
+)
+
+// Request lists the probes for an agent to run.
+type Request struct {
+	// Timeout bounds each individual probe.
+	Timeout time.Duration `json:"timeout"`
+	// Pods are the addresses (host:port) of the other agents.
+	Pods []string `json:"pods,omitempty"`
+	// Services are the addresses (host:port) of services to connect to.
+	Services []string `json:"services,omitempty"`
+	// DNSNames are the names to resolve.
+	DNSNames []string `json:"dnsNames,omitempty"`
+	// Egress are the addresses (host:port) outside the cluster to connect to.
+	Egress []string `json:"egress,omitempty"`
+}
+
+// Result is the outcome of a single probe.
+type Result struct {
+	Type    ProbeType     `json:"type"`
+	Target  string        `json:"target"`
+	Success bool          `json:"success"`
+	Latency time.Duration `json:"latency"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// Response holds the results of the probes in a Request, in the order they were requested.
+type Response struct {
+	Results []Result `json:"results"`
+}
+
+// Serve runs the agent until the context is cancelled. Only requests with the given token may run probes.
+func Serve(ctx context.Context, addr, token string) error {
+	if token == "" {
+		return fmt.Errorf("no connectivity probe token set in %s", TokenEnvVar)
+	}
+	srv := &http.Server{Addr: addr, Handler: Handler(token), ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	log.Info("Serving connectivity probes", "address", addr)
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// Handler returns the agent's HTTP handler. Probe requests are rejected unless they carry the given token, so that
+// the agent can't be used to make connections on behalf of anyone else.
+func Handler(token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(HealthPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc(ProbePath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if !authorized(r, token) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		req := Request{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(Run(r.Context(), req))
+	})
+	return mux
+}
+
+func authorized(r *http.Request, token string) bool {
+	got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && token != "" && subtle.ConstantTimeCompare([]byte(got), []byte(token)) == 1
+}
+
+// Run runs the probes in the request concurrently.
+func Run(ctx context.Context, req Request) Response {
+	type probe struct {
+		t      ProbeType
+		target string
+		run    func(ctx context.Context, target string) error
+	}
+	var probes []probe
+	for _, p := range req.Pods {
+		probes = append(probes, probe{ProbeTypePod, p, probeHealth})
+	}
+	for _, s := range req.Services {
+		probes = append(probes, probe{ProbeTypeService, s, probeDial})
+	}
+	for _, n := range req.DNSNames {
+		probes = append(probes, probe{ProbeTypeDNS, n, probeDNS})
+	}
+	for _, e := range req.Egress {
+		probes = append(probes, probe{ProbeTypeEgress, e, probeDial})
+	}
+
+	resp := Response{Results: make([]Result, len(probes))}
+	var wg sync.WaitGroup
+	for i, p := range probes {
+		wg.Add(1)
+		go func(i int, p probe) {
+			defer wg.Done()
+			pctx, cancel := context.WithTimeout(ctx, req.Timeout)
+			defer cancel()
+			start := time.Now()
+			err := p.run(pctx, p.target)
+			resp.Results[i] = Result{Type: p.t, Target: p.target, Success: err == nil, Latency: time.Since(start)}
+			if err != nil {
+				resp.Results[i].Error = err.Error()
+			}
+		}(i, p)
+	}
+	wg.Wait()
+	return resp
+}
+
+func probeHealth(ctx context.Context, target string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s%s", target, HealthPath), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func probeDial(ctx context.Context, target string) error {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", target)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func probeDNS(ctx context.Context, target string) error {
+	addrs, err := net.DefaultResolver.LookupHost(ctx, target)
+	if err != nil {
+		return err
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("no addresses found for %s", target)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectivityprobe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	peer := httptest.NewServer(Handler("token"))
+	defer peer.Close()
+	peerAddr := strings.TrimPrefix(peer.URL, "http://")
+
+	// A listener that has been closed gives an address that refuses connections.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closedAddr := l.Addr().String()
+	_ = l.Close()
+
+	resp := Run(context.Background(), Request{
+		Timeout:  time.Second,
+		Pods:     []string{peerAddr, closedAddr},
+		Services: []string{peerAddr},
+		DNSNames: []string{"localhost"},
+	})
+
+	if len(resp.Results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(resp.Results))
+	}
+	expected := []struct {
+		t       ProbeType
+		target  string
+		success bool
+	}{
+		{ProbeTypePod, peerAddr, true},
+		{ProbeTypePod, closedAddr, false},
+		{ProbeTypeService, peerAddr, true},
+		{ProbeTypeDNS, "localhost", true},
+	}
+	for i, e := range expected {
+		r := resp.Results[i]
+		if r.Type != e.t || r.Target != e.target || r.Success != e.success {
+			t.Errorf("result %d: expected %s %s success=%v, got %+v", i, e.t, e.target, e.success, r)
+		}
+		if !r.Success && r.Error == "" {
+			t.Errorf("result %d: expected an error message", i)
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	srv := httptest.NewServer(Handler("token"))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + ProbePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("expected GET to be rejected, got %d", resp.StatusCode)
+	}
+
+	body, _ := json.Marshal(Request{Timeout: time.Second, Services: []string{strings.TrimPrefix(srv.URL, "http://")}})
+	post := func(token string) *http.Response {
+		req, err := http.NewRequest(http.MethodPost, srv.URL+ProbePath, bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+	for _, token := range []string{"", "wrong"} {
+		resp = post(token)
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("expected a request with token %q to be rejected, got %d", token, resp.StatusCode)
+		}
+	}
+
+	resp = post("token")
+	defer resp.Body.Close()
+	out := Response{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Results) != 1 || !out.Results[0].Success {
+		t.Errorf("expected a successful service probe, got %+v", out.Results)
+	}
+}
@@ -157,6 +157,12 @@ func add(c ctrlruntime.Controller, r *ReconcileAPIServer) error {
 			return fmt.Errorf("apiserver-controller failed to watch resource: %w", err)
 		}
 
+		// The manager exposure may determine the issuer of the tokens that are validated.
+		err = c.WatchObject(&operatorv1.Manager{}, &handler.EnqueueRequestForObject{})
+		if err != nil {
+			return fmt.Errorf("apiserver-controller failed to watch resource: %w", err)
+		}
+
 	}
 
 	// Watch for the namespace(s) managed by this controller.
@@ -17,9 +17,13 @@ package authentication
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 
 	"golang.org/x/net/http/httpproxy"
 	v1 "k8s.io/api/apps/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 
@@ -38,6 +42,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	oprv1 "github.com/tigera/operator/api/v1"
@@ -51,6 +56,7 @@ import (
 	"github.com/tigera/operator/pkg/dns"
 	"github.com/tigera/operator/pkg/render"
 	rcertificatemanagement "github.com/tigera/operator/pkg/render/certificatemanagement"
+	"github.com/tigera/operator/pkg/render/monitor"
 	"github.com/tigera/operator/pkg/tls/certificatemanagement"
 )
 
@@ -132,6 +138,32 @@ func add(mgr manager.Manager, c ctrlruntime.Controller) error {
 		return fmt.Errorf("%s failed to watch resource: %w", controllerName, err)
 	}
 
+	err = c.WatchObject(&oprv1.Manager{}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return fmt.Errorf("%s failed to watch resource: %w", controllerName, err)
+	}
+
+	// Grafana is registered as a Dex client when the Monitor declares its address.
+	err = c.WatchObject(&oprv1.Monitor{}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return fmt.Errorf("%s failed to watch resource: %w", controllerName, err)
+	}
+
+	// Connector secrets may have any name, so watch all secrets in the operator namespace.
+	if err = utils.AddSecretsWatch(c, "", common.OperatorNamespace()); err != nil {
+		return fmt.Errorf("%s failed to watch secrets in '%s' namespace: %w", controllerName, common.OperatorNamespace(), err)
+	}
+
+	// Watch the bindings rendered for role mappings, so that they are restored when modified.
+	for _, obj := range []client.Object{&rbacv1.ClusterRoleBinding{}, &rbacv1.RoleBinding{}} {
+		if err = c.WatchObject(obj, &handler.EnqueueRequestForObject{}, predicate.NewPredicateFuncs(func(o client.Object) bool {
+			_, ok := o.GetLabels()[render.RoleMappingLabel]
+			return ok
+		})); err != nil {
+			return fmt.Errorf("%s failed to watch role mapping bindings: %w", controllerName, err)
+		}
+	}
+
 	for _, namespace := range []string{common.OperatorNamespace(), render.DexNamespace} {
 		for _, secretName := range []string{
 			render.DexTLSSecretName, render.OIDCSecretName, render.OpenshiftSecretName,
@@ -208,6 +240,14 @@ func (r *ReconcileAuthentication) Reconcile(ctx context.Context, request reconci
 	}
 
 	reqLogger.V(2).Info("Loaded config", "config", authentication)
+
+	// Fetch the Manager, whose exposure hostname the manager domain is derived from and must agree with.
+	managerCR, err := utils.GetIfExists[oprv1.Manager](ctx, utils.DefaultTSEEInstanceKey, r.client)
+	if err != nil {
+		r.status.SetDegraded(oprv1.ResourceReadError, "Error querying Manager", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
 	preDefaultPatchFrom := client.MergeFrom(authentication.DeepCopy())
 
 	// Set defaults for backwards compatibility.
@@ -225,6 +265,16 @@ func (r *ReconcileAuthentication) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, err
 	}
 
+	// Derive the manager domain from the manager exposure, so that dex redirect URIs follow the exposed hostname. This
+	// is done on a copy, so that the derived domain is never written back and keeps following the exposure.
+	managerDomain, err := utils.ManagerDomain(authentication, managerCR)
+	if err != nil {
+		r.status.SetDegraded(oprv1.ResourceValidationError, "Invalid Authentication provided", err, reqLogger)
+		return reconcile.Result{}, nil
+	}
+	dexAuthentication := authentication.DeepCopy()
+	dexAuthentication.Spec.ManagerDomain = managerDomain
+
 	// Query for the installation object.
 	variant, install, err := utils.GetInstallation(context.Background(), r.client)
 	if err != nil {
@@ -295,6 +345,30 @@ func (r *ReconcileAuthentication) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, err
 	}
 
+	connectorSecrets, err := utils.GetConnectorSecrets(ctx, r.client, authentication)
+	if err != nil {
+		r.status.SetDegraded(oprv1.ResourceValidationError, "Invalid or missing connector secret", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	storageSecret, err := utils.GetDexStorageSecret(ctx, r.client, authentication)
+	if err != nil {
+		r.status.SetDegraded(oprv1.ResourceValidationError, "Invalid or missing Dex storage secret", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	existingBindings, err := r.roleMappingBindings(ctx)
+	if err != nil {
+		r.status.SetDegraded(oprv1.ResourceReadError, "Failed to list role mapping bindings", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	missingNamespaces, err := r.missingRoleMappingNamespaces(ctx, authentication.Spec.RoleMappings)
+	if err != nil {
+		r.status.SetDegraded(oprv1.ResourceReadError, "Failed to get role mapping namespaces", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
 	dexSecret := &corev1.Secret{}
 	if err := r.client.Get(ctx, types.NamespacedName{Name: render.DexObjectName, Namespace: common.OperatorNamespace()}, dexSecret); err != nil {
 		if errors.IsNotFound(err) {
@@ -306,6 +380,15 @@ func (r *ReconcileAuthentication) Reconcile(ctx context.Context, request reconci
 		}
 	}
 
+	var grafanaURL string
+	monitorCR := &oprv1.Monitor{}
+	if err := r.client.Get(ctx, utils.DefaultTSEEInstanceKey, monitorCR); err == nil {
+		grafanaURL = monitor.GrafanaURL(monitorCR.Spec)
+	} else if !errors.IsNotFound(err) {
+		r.status.SetDegraded(oprv1.ResourceReadError, "Failed to read the Monitor", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
 	pullSecrets, err := utils.GetNetworkingPullSecrets(install, r.client)
 	if err != nil {
 		r.status.SetDegraded(oprv1.ResourceReadError, "Error retrieving pull secrets", err, reqLogger)
@@ -387,7 +470,7 @@ func (r *ReconcileAuthentication) Reconcile(ctx context.Context, request reconci
 	enableDex := utils.DexEnabled(authentication)
 
 	// DexConfig adds convenience methods around dex related objects in k8s and can be used to configure Dex.
-	dexCfg := render.NewDexConfig(install.CertificateManagement, authentication, dexSecret, idpSecret, r.clusterDomain)
+	dexCfg := render.NewDexConfig(install.CertificateManagement, dexAuthentication, dexSecret, idpSecret, connectorSecrets, r.clusterDomain)
 
 	// Create a component handler to manage the rendered component.
 	hlr := utils.NewComponentHandler(log, r.client, r.scheme, authentication)
@@ -401,7 +484,9 @@ func (r *ReconcileAuthentication) Reconcile(ctx context.Context, request reconci
 		DeleteDex:      !enableDex,
 		TLSKeyPair:     tlsKeyPair,
 		TrustedBundle:  trustedBundle,
-		Authentication: authentication,
+		Authentication: dexAuthentication,
+		StorageSecret:  storageSecret,
+		GrafanaURL:     grafanaURL,
 		PodProxies:     r.resolvedPodProxies,
 	}
 
@@ -414,7 +499,14 @@ func (r *ReconcileAuthentication) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, err
 	}
 
-	components := []render.Component{component}
+	components := []render.Component{
+		component,
+		render.AuthenticationRoleMappings(&render.AuthenticationRoleMappingConfiguration{
+			Authentication:    authentication,
+			ExistingBindings:  existingBindings,
+			MissingNamespaces: missingNamespaces,
+		}),
+	}
 
 	if enableDex {
 		components = append(components,
@@ -446,12 +538,82 @@ func (r *ReconcileAuthentication) Reconcile(ctx context.Context, request reconci
 
 	// Everything is available - update the CRD status.
 	authentication.Status.State = oprv1.TigeraStatusReady
+	authentication.Status.RoleMappings = roleMappingStatuses(authentication.Spec.RoleMappings, missingNamespaces)
 	if err = r.client.Status().Update(ctx, authentication); err != nil {
 		return reconcile.Result{}, err
 	}
+
+	if len(missingNamespaces) > 0 {
+		// Check again later, so that the RoleBindings are rendered once the namespaces are created.
+		return reconcile.Result{RequeueAfter: utils.StandardRetry}, nil
+	}
 	return reconcile.Result{}, nil
 }
 
+// missingRoleMappingNamespaces returns the namespaces referenced by the role mappings that do not exist.
+func (r *ReconcileAuthentication) missingRoleMappingNamespaces(ctx context.Context, mappings []oprv1.AuthenticationRoleMapping) (map[string]bool, error) {
+	missing := map[string]bool{}
+	for _, mapping := range mappings {
+		for _, namespace := range mapping.Namespaces {
+			if _, checked := missing[namespace]; checked {
+				continue
+			}
+			err := r.client.Get(ctx, client.ObjectKey{Name: namespace}, &corev1.Namespace{})
+			if err != nil && !errors.IsNotFound(err) {
+				return nil, err
+			}
+			missing[namespace] = errors.IsNotFound(err)
+		}
+	}
+
+	for namespace, isMissing := range missing {
+		if !isMissing {
+			delete(missing, namespace)
+		}
+	}
+	return missing, nil
+}
+
+// roleMappingStatuses reports the missing namespaces of each role mapping.
+func roleMappingStatuses(mappings []oprv1.AuthenticationRoleMapping, missingNamespaces map[string]bool) []oprv1.AuthenticationRoleMappingStatus {
+	var statuses []oprv1.AuthenticationRoleMappingStatus
+	for _, mapping := range mappings {
+		var missing []string
+		for _, namespace := range mapping.Namespaces {
+			if missingNamespaces[namespace] {
+				missing = append(missing, namespace)
+			}
+		}
+		if len(missing) > 0 {
+			statuses = append(statuses, oprv1.AuthenticationRoleMappingStatus{Name: mapping.Name, MissingNamespaces: missing})
+		}
+	}
+	return statuses
+}
+
+// roleMappingBindings returns the ClusterRoleBindings and RoleBindings rendered for role mappings.
+func (r *ReconcileAuthentication) roleMappingBindings(ctx context.Context) ([]client.Object, error) {
+	var bindings []client.Object
+	hasLabel := client.HasLabels{render.RoleMappingLabel}
+
+	clusterRoleBindings := &rbacv1.ClusterRoleBindingList{}
+	if err := r.client.List(ctx, clusterRoleBindings, hasLabel); err != nil {
+		return nil, err
+	}
+	for i := range clusterRoleBindings.Items {
+		bindings = append(bindings, &clusterRoleBindings.Items[i])
+	}
+
+	roleBindings := &rbacv1.RoleBindingList{}
+	if err := r.client.List(ctx, roleBindings, hasLabel); err != nil {
+		return nil, err
+	}
+	for i := range roleBindings.Items {
+		bindings = append(bindings, &roleBindings.Items[i])
+	}
+	return bindings, nil
+}
+
 // updateAuthenticationWithDefaults sets values for backwards compatibility.
 func updateAuthenticationWithDefaults(authentication *oprv1.Authentication) {
 	if authentication.Spec.OIDC != nil {
@@ -490,10 +652,20 @@ func validateAuthentication(authentication *oprv1.Authentication, multiTenant bo
 		numConnectors++
 	}
 
-	if numConnectors == 0 {
+	if numConnectors == 0 && len(authentication.Spec.Connectors) == 0 {
 		return fmt.Errorf("no identity provider connector was specified, please add a connector to the Authentication spec")
 	} else if numConnectors > 1 {
-		return fmt.Errorf("multiple identity provider connectors were specified, but only 1 is allowed in the Authentication spec")
+		return fmt.Errorf("only one of Authentication.Spec.OIDC, Openshift and LDAP may be specified, please move additional identity providers to Authentication.Spec.Connectors")
+	}
+
+	if err := validateConnectors(authentication); err != nil {
+		return err
+	}
+	if err := validateRoleMappings(authentication.Spec.RoleMappings); err != nil {
+		return err
+	}
+	if err := validateDexSettings(authentication); err != nil {
+		return err
 	}
 
 	// If the user has specified the deprecated and the new prefix field, but with different values, we cannot proceed.
@@ -509,37 +681,190 @@ func validateAuthentication(authentication *oprv1.Authentication, multiTenant bo
 			return fmt.Errorf("you set groups prefix twice, but with different values, please remove Authentication.Spec.OIDC.GroupsPrefix")
 		}
 
-		promptTypes := authentication.Spec.OIDC.PromptTypes
-		if promptTypes != nil && len(authentication.Spec.OIDC.PromptTypes) > 1 {
-			for _, pt := range promptTypes {
-				if pt == oprv1.PromptTypeNone {
-					return fmt.Errorf("you cannot combine PromptType None with other prompt types, please modify Authentication.Spec.OIDC.PromptType")
-				}
-			}
+		if err := validatePromptTypes(authentication.Spec.OIDC.PromptTypes); err != nil {
+			return fmt.Errorf("%w, please modify Authentication.Spec.OIDC.PromptType", err)
 		}
 
 	}
 
 	if ldp != nil {
-		if _, err := ldap.ParseDN(ldp.UserSearch.BaseDN); err != nil {
-			return fmt.Errorf("invalid dn for LDAP user search: %w", err)
+		if err := validateLDAP(ldp); err != nil {
+			return err
 		}
-		if ldp.GroupSearch != nil {
-			if _, err := ldap.ParseDN(ldp.GroupSearch.BaseDN); err != nil {
-				return fmt.Errorf("invalid dn for LDAP group search: %w", err)
+	}
+
+	return nil
+}
+
+func validatePromptTypes(promptTypes []oprv1.PromptType) error {
+	if len(promptTypes) > 1 {
+		for _, pt := range promptTypes {
+			if pt == oprv1.PromptTypeNone {
+				return fmt.Errorf("you cannot combine PromptType None with other prompt types")
 			}
-			if ldp.GroupSearch.Filter != "" {
-				if _, err := ldap.CompileFilter(ldp.GroupSearch.Filter); err != nil {
-					return fmt.Errorf("invalid filter for LDAP group search: %w", err)
-				}
+		}
+	}
+	return nil
+}
+
+func validateLDAP(ldp *oprv1.AuthenticationLDAP) error {
+	if ldp.UserSearch == nil {
+		return fmt.Errorf("LDAP user search is required")
+	}
+	if _, err := ldap.ParseDN(ldp.UserSearch.BaseDN); err != nil {
+		return fmt.Errorf("invalid dn for LDAP user search: %w", err)
+	}
+	if ldp.GroupSearch != nil {
+		if _, err := ldap.ParseDN(ldp.GroupSearch.BaseDN); err != nil {
+			return fmt.Errorf("invalid dn for LDAP group search: %w", err)
+		}
+		if ldp.GroupSearch.Filter != "" {
+			if _, err := ldap.CompileFilter(ldp.GroupSearch.Filter); err != nil {
+				return fmt.Errorf("invalid filter for LDAP group search: %w", err)
 			}
 		}
-		if ldp.UserSearch.Filter != "" {
-			if _, err := ldap.CompileFilter(ldp.UserSearch.Filter); err != nil {
-				return fmt.Errorf("invalid filter for LDAP user search: %w", err)
+	}
+	if ldp.UserSearch.Filter != "" {
+		if _, err := ldap.CompileFilter(ldp.UserSearch.Filter); err != nil {
+			return fmt.Errorf("invalid filter for LDAP user search: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateConnectors makes sure that each of the additional connectors is configured for its type and has an ID
+// that is unique across all connectors Dex is configured with.
+func validateConnectors(authentication *oprv1.Authentication) error {
+	if len(authentication.Spec.Connectors) == 0 {
+		return nil
+	}
+	if !utils.DexEnabled(authentication) {
+		return fmt.Errorf("connectors cannot be combined with OIDC of type Tigera, please remove Authentication.Spec.Connectors")
+	}
+
+	// The connector configured through OIDC, Openshift or LDAP uses its type as ID.
+	ids := map[string]bool{}
+	if authentication.Spec.OIDC != nil {
+		ids["oidc"], ids["google"] = true, true
+	} else if authentication.Spec.Openshift != nil {
+		ids["openshift"] = true
+	} else if authentication.Spec.LDAP != nil {
+		ids["ldap"] = true
+	}
+
+	for _, connector := range authentication.Spec.Connectors {
+		if ids[connector.ID] {
+			return fmt.Errorf("connector ID %q is used more than once", connector.ID)
+		}
+		ids[connector.ID] = true
+
+		if connector.SecretName == "" {
+			return fmt.Errorf("connector %s: secretName is required", connector.ID)
+		}
+
+		for _, section := range []struct {
+			connectorType oprv1.ConnectorType
+			set           bool
+		}{
+			{oprv1.ConnectorTypeOIDC, connector.OIDC != nil},
+			{oprv1.ConnectorTypeLDAP, connector.LDAP != nil},
+			{oprv1.ConnectorTypeGitHub, connector.GitHub != nil},
+			{oprv1.ConnectorTypeGitLab, connector.GitLab != nil},
+			{oprv1.ConnectorTypeSAML, connector.SAML != nil},
+		} {
+			name := strings.ToLower(string(section.connectorType))
+			if section.connectorType == connector.Type && !section.set {
+				return fmt.Errorf("connector %s: the %s section is required for connectors of type %s", connector.ID, name, connector.Type)
+			} else if section.connectorType != connector.Type && section.set {
+				return fmt.Errorf("connector %s: the %s section may only be set for connectors of type %s", connector.ID, name, section.connectorType)
+			}
+		}
+
+		switch connector.Type {
+		case oprv1.ConnectorTypeOIDC:
+			if connector.OIDC.Type == oprv1.OIDCTypeTigera {
+				return fmt.Errorf("connector %s: OIDC of type Tigera is not supported for connectors", connector.ID)
+			}
+			if connector.OIDC.UsernamePrefix != "" || connector.OIDC.GroupsPrefix != "" {
+				return fmt.Errorf("connector %s: use Authentication.Spec.UsernamePrefix and GroupsPrefix instead of the OIDC prefixes", connector.ID)
+			}
+			if err := validatePromptTypes(connector.OIDC.PromptTypes); err != nil {
+				return fmt.Errorf("connector %s: %w", connector.ID, err)
+			}
+		case oprv1.ConnectorTypeLDAP:
+			if err := validateLDAP(connector.LDAP); err != nil {
+				return fmt.Errorf("connector %s: %w", connector.ID, err)
+			}
+		case oprv1.ConnectorTypeSAML:
+			if _, err := url.ParseRequestURI(connector.SAML.SSOURL); err != nil {
+				return fmt.Errorf("connector %s: invalid SAML ssoURL: %w", connector.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateRoleMappings(mappings []oprv1.AuthenticationRoleMapping) error {
+	names := map[string]bool{}
+	for _, mapping := range mappings {
+		if names[mapping.Name] {
+			return fmt.Errorf("role mapping name %q is used more than once", mapping.Name)
+		}
+		names[mapping.Name] = true
+
+		if len(mapping.Groups) == 0 {
+			return fmt.Errorf("role mapping %s: at least one group is required", mapping.Name)
+		}
+		if mapping.ClusterRole == "" {
+			return fmt.Errorf("role mapping %s: clusterRole is required", mapping.Name)
+		}
+		if !slices.Contains(render.RoleMappingClusterRoles, mapping.ClusterRole) {
+			return fmt.Errorf("role mapping %s: clusterRole %q is not allowed, it must be one of %s", mapping.Name, mapping.ClusterRole, strings.Join(render.RoleMappingClusterRoles, ", "))
+		}
+	}
+	return nil
+}
+
+// validateDexSettings validates the storage, expiry and session settings of Dex.
+func validateDexSettings(authentication *oprv1.Authentication) error {
+	if storage := authentication.Spec.DexStorage; storage != nil {
+		switch storage.GetType() {
+		case oprv1.DexStorageTypeKubernetes:
+			if storage.SQL != nil {
+				return fmt.Errorf("Authentication.Spec.DexStorage.SQL may only be set for the storage types Postgres and MySQL")
+			}
+		default:
+			if storage.SQL == nil {
+				return fmt.Errorf("Authentication.Spec.DexStorage.SQL is required for storage type %s", storage.GetType())
+			}
+			if storage.SQL.Host == "" || storage.SQL.Database == "" || storage.SQL.SecretName == "" {
+				return fmt.Errorf("Authentication.Spec.DexStorage.SQL requires host, database and secretName")
 			}
 		}
 	}
 
+	type duration struct {
+		field string
+		value *metav1.Duration
+	}
+	var durations []duration
+	if expiry := authentication.Spec.DexExpiry; expiry != nil {
+		durations = append(durations,
+			duration{"DexExpiry.IDTokens", expiry.IDTokens},
+			duration{"DexExpiry.SigningKeys", expiry.SigningKeys},
+			duration{"DexExpiry.AuthRequests", expiry.AuthRequests},
+		)
+	}
+	if session := authentication.Spec.DexSession; session != nil {
+		durations = append(durations,
+			duration{"DexSession.IdleTimeout", session.IdleTimeout},
+			duration{"DexSession.AbsoluteLifetime", session.AbsoluteLifetime},
+		)
+	}
+	for _, d := range durations {
+		if d.value != nil && d.value.Duration <= 0 {
+			return fmt.Errorf("Authentication.Spec.%s must be a positive duration", d.field)
+		}
+	}
 	return nil
 }
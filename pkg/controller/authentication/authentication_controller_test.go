@@ -46,6 +46,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -364,6 +365,82 @@ var _ = Describe("authentication controller tests", func() {
 		})
 	})
 
+	Context("additional connectors and role mappings", func() {
+		It("should configure dex with all connectors and render the role mappings", func() {
+			Expect(cli.Create(ctx, idpSecret)).ToNot(HaveOccurred())
+			Expect(cli.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "break-glass", Namespace: common.OperatorNamespace()},
+				Data: map[string][]byte{
+					"bindDN": []byte("cn=admin,dc=example,dc=com"),
+					"bindPW": []byte("my-secret"),
+				},
+			})).ToNot(HaveOccurred())
+			auth.Spec.OIDC = &operatorv1.AuthenticationOIDC{IssuerURL: "https://example.com", UsernameClaim: "email"}
+			auth.Spec.GroupsPrefix = "idp:"
+			auth.Spec.Connectors = []operatorv1.AuthenticationConnector{
+				{
+					ID:         "break-glass",
+					Type:       operatorv1.ConnectorTypeLDAP,
+					SecretName: "break-glass",
+					LDAP: &operatorv1.AuthenticationLDAP{
+						Host:       "ldap.example.com:636",
+						UserSearch: &operatorv1.UserSearch{BaseDN: "dc=example,dc=com"},
+					},
+				},
+			}
+			auth.Spec.RoleMappings = []operatorv1.AuthenticationRoleMapping{
+				{Name: "admins", Groups: []string{"admins"}, ClusterRole: "tigera-network-admin"},
+				{Name: "viewers", Groups: []string{"viewers"}, ClusterRole: "tigera-ui-user", Namespaces: []string{"team-a", "team-b"}},
+			}
+			Expect(cli.Create(ctx, auth)).ToNot(HaveOccurred())
+			Expect(cli.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}})).ToNot(HaveOccurred())
+
+			r := &ReconcileAuthentication{client: cli, scheme: scheme, provider: operatorv1.ProviderNone, status: mockStatus, tierWatchReady: readyFlag}
+			result, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// The missing namespace is reported for its mapping, without failing the others.
+			Expect(result.RequeueAfter).To(Equal(utils.StandardRetry))
+			Expect(cli.Get(ctx, client.ObjectKey{Name: auth.Name}, auth)).ToNot(HaveOccurred())
+			Expect(auth.Status.RoleMappings).To(ConsistOf(operatorv1.AuthenticationRoleMappingStatus{Name: "viewers", MissingNamespaces: []string{"team-b"}}))
+
+			cm := &corev1.ConfigMap{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: render.DexObjectName, Namespace: render.DexNamespace}, cm)).ToNot(HaveOccurred())
+			Expect(cm.Data["config.yaml"]).To(ContainSubstring("id: oidc"))
+			Expect(cm.Data["config.yaml"]).To(ContainSubstring("id: break-glass"))
+
+			crb := &rbacv1.ClusterRoleBinding{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-idp-admins"}, crb)).ToNot(HaveOccurred())
+			Expect(crb.RoleRef.Name).To(Equal("tigera-network-admin"))
+			Expect(crb.Subjects).To(ConsistOf(rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: "rbac.authorization.k8s.io", Name: "idp:admins"}))
+
+			rb := &rbacv1.RoleBinding{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-idp-viewers", Namespace: "team-a"}, rb)).ToNot(HaveOccurred())
+			Expect(rb.RoleRef.Name).To(Equal("tigera-ui-user"))
+			err = cli.Get(ctx, client.ObjectKey{Name: "tigera-idp-viewers", Namespace: "team-b"}, rb)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			// The RoleBinding is rendered once the namespace exists.
+			Expect(cli.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}})).ToNot(HaveOccurred())
+			result, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-idp-viewers", Namespace: "team-b"}, rb)).ToNot(HaveOccurred())
+			Expect(cli.Get(ctx, client.ObjectKey{Name: auth.Name}, auth)).ToNot(HaveOccurred())
+			Expect(auth.Status.RoleMappings).To(BeEmpty())
+
+			// Removing a mapping removes its bindings.
+			Expect(cli.Get(ctx, client.ObjectKey{Name: auth.Name}, auth)).ToNot(HaveOccurred())
+			auth.Spec.RoleMappings = auth.Spec.RoleMappings[:1]
+			Expect(cli.Update(ctx, auth)).ToNot(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-idp-admins"}, crb)).ToNot(HaveOccurred())
+			err = cli.Get(ctx, client.ObjectKey{Name: "tigera-idp-viewers", Namespace: "team-a"}, rb)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
 	Context("multi-tenant OIDC connector config options", func() {
 		It("should reject non-Tigera OIDC setup", func() {
 			Expect(cli.Create(ctx, idpSecret)).ToNot(HaveOccurred())
@@ -384,6 +461,47 @@ var _ = Describe("authentication controller tests", func() {
 		})
 	})
 
+	Context("manager domain", func() {
+		It("should derive the manager domain from the manager exposure without persisting it", func() {
+			Expect(cli.Create(ctx, idpSecret)).ToNot(HaveOccurred())
+			manager := &operatorv1.Manager{
+				ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				Spec: operatorv1.ManagerSpec{Exposure: &operatorv1.ManagerExposure{
+					Type:     operatorv1.ManagerExposureTypeIngress,
+					Hostname: "manager.example.com",
+				}},
+			}
+			Expect(cli.Create(ctx, manager)).ToNot(HaveOccurred())
+			Expect(cli.Create(ctx, &operatorv1.Authentication{
+				ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				Spec: operatorv1.AuthenticationSpec{
+					OIDC: &operatorv1.AuthenticationOIDC{IssuerURL: "https://example.com", UsernameClaim: "email"},
+				},
+			})).ToNot(HaveOccurred())
+
+			r := ReconcileAuthentication{client: cli, scheme: scheme, provider: operatorv1.ProviderNone, status: mockStatus, tierWatchReady: readyFlag}
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			instance, err := utils.GetAuthentication(ctx, cli)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(instance.Spec.ManagerDomain).To(BeEmpty())
+			cm := &corev1.ConfigMap{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: render.DexObjectName, Namespace: render.DexNamespace}, cm)).ToNot(HaveOccurred())
+			Expect(cm.Data["config.yaml"]).To(ContainSubstring("https://manager.example.com/login/oidc/callback"))
+
+			// Changing the exposure hostname moves the redirect URIs along.
+			manager.Spec.Exposure.Hostname = "calico.example.com"
+			Expect(cli.Update(ctx, manager)).ToNot(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			mockStatus.AssertNotCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceValidationError, "Invalid Authentication provided", mock.Anything, mock.Anything)
+			Expect(cli.Get(ctx, client.ObjectKey{Name: render.DexObjectName, Namespace: render.DexNamespace}, cm)).ToNot(HaveOccurred())
+			Expect(cm.Data["config.yaml"]).To(ContainSubstring("https://calico.example.com/login/oidc/callback"))
+		})
+	})
+
 	Context("image reconciliation", func() {
 		BeforeEach(func() {
 			Expect(cli.Create(ctx, idpSecret)).ToNot(HaveOccurred())
@@ -857,6 +975,9 @@ var _ = Describe("authentication controller tests", func() {
 		ocp  = &operatorv1.AuthenticationOpenshift{IssuerURL: iss}
 		ldap = &operatorv1.AuthenticationLDAP{UserSearch: &operatorv1.UserSearch{BaseDN: validDN}}
 		oidc = &operatorv1.AuthenticationOIDC{IssuerURL: iss, UsernameClaim: "email"}
+
+		ldapConnector   = operatorv1.AuthenticationConnector{ID: "break-glass", Type: operatorv1.ConnectorTypeLDAP, SecretName: "break-glass", LDAP: ldap}
+		githubConnector = operatorv1.AuthenticationConnector{ID: "github", Type: operatorv1.ConnectorTypeGitHub, SecretName: "github", GitHub: &operatorv1.AuthenticationGitHub{}}
 	)
 	DescribeTable("should validate the authentication spec", func(auth *operatorv1.Authentication, multiTenant, expectPass bool) {
 		if expectPass {
@@ -875,9 +996,29 @@ var _ = Describe("authentication controller tests", func() {
 		Entry("Expect prompt type to be used without other values", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: copyAndAddPromptTypes(oidc, []operatorv1.PromptType{operatorv1.PromptTypeNone})}}, false, true),
 		Entry("Expect prompt type to fail when none is combined", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: copyAndAddPromptTypes(oidc, []operatorv1.PromptType{operatorv1.PromptTypeNone, operatorv1.PromptTypeLogin})}}, false, false),
 		Entry("Expect prompt type to be able to be combined", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: copyAndAddPromptTypes(oidc, []operatorv1.PromptType{operatorv1.PromptTypeSelectAccount, operatorv1.PromptTypeLogin})}}, false, true),
+		Entry("Expect OIDC with an additional LDAP connector to pass validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, Connectors: []operatorv1.AuthenticationConnector{ldapConnector}}}, false, true),
+		Entry("Expect only additional connectors to pass validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{Connectors: []operatorv1.AuthenticationConnector{ldapConnector, githubConnector}}}, false, true),
+		Entry("Expect duplicate connector IDs to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{Connectors: []operatorv1.AuthenticationConnector{ldapConnector, ldapConnector}}}, false, false),
+		Entry("Expect a connector ID used by the OIDC connector to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, Connectors: []operatorv1.AuthenticationConnector{withID(githubConnector, "oidc")}}}, false, false),
+		Entry("Expect a connector without the section of its type to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{Connectors: []operatorv1.AuthenticationConnector{{ID: "github", Type: operatorv1.ConnectorTypeGitHub, SecretName: "github"}}}}, false, false),
+		Entry("Expect a connector with the section of another type to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{Connectors: []operatorv1.AuthenticationConnector{{ID: "github", Type: operatorv1.ConnectorTypeGitHub, SecretName: "github", GitHub: &operatorv1.AuthenticationGitHub{}, LDAP: ldap}}}}, false, false),
+		Entry("Expect connectors combined with Tigera OIDC to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: &operatorv1.AuthenticationOIDC{IssuerURL: iss, Type: operatorv1.OIDCTypeTigera}, Connectors: []operatorv1.AuthenticationConnector{ldapConnector}}}, false, false),
+		Entry("Expect a SAML connector with an invalid SSO URL to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{Connectors: []operatorv1.AuthenticationConnector{{ID: "saml", Type: operatorv1.ConnectorTypeSAML, SecretName: "saml", SAML: &operatorv1.AuthenticationSAML{SSOURL: "not a url"}}}}}, false, false),
+		Entry("Expect a role mapping to pass validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, RoleMappings: []operatorv1.AuthenticationRoleMapping{{Name: "admins", Groups: []string{"admins"}, ClusterRole: "tigera-network-admin"}}}}, false, true),
+		Entry("Expect a role mapping to cluster-admin to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, RoleMappings: []operatorv1.AuthenticationRoleMapping{{Name: "admins", Groups: []string{"admins"}, ClusterRole: "cluster-admin"}}}}, false, false),
+		Entry("Expect a role mapping without groups to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, RoleMappings: []operatorv1.AuthenticationRoleMapping{{Name: "admins", ClusterRole: "tigera-network-admin"}}}}, false, false),
+		Entry("Expect Postgres storage to pass validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, DexStorage: &operatorv1.DexStorage{Type: operatorv1.DexStorageTypePostgres, SQL: &operatorv1.DexSQLStorage{Host: "db", Database: "dex", SecretName: "dex-db"}}}}, false, true),
+		Entry("Expect Postgres storage without SQL to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, DexStorage: &operatorv1.DexStorage{Type: operatorv1.DexStorageTypePostgres}}}, false, false),
+		Entry("Expect Kubernetes storage with SQL to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, DexStorage: &operatorv1.DexStorage{SQL: &operatorv1.DexSQLStorage{Host: "db", Database: "dex", SecretName: "dex-db"}}}}, false, false),
+		Entry("Expect a negative session idle timeout to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, DexSession: &operatorv1.DexSession{IdleTimeout: &metav1.Duration{Duration: -time.Hour}}}}, false, false),
 	)
 })
 
+func withID(connector operatorv1.AuthenticationConnector, id string) operatorv1.AuthenticationConnector {
+	connector.ID = id
+	return connector
+}
+
 func copyAndAddPromptTypes(auth *operatorv1.AuthenticationOIDC, promptTypes []operatorv1.PromptType) *operatorv1.AuthenticationOIDC {
 	copy := auth.DeepCopy()
 	copy.PromptTypes = promptTypes
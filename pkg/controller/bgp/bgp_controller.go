@@ -0,0 +1,391 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bgp
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operator "github.com/tigera/operator/api/v1"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/controller/options"
+	"github.com/tigera/operator/pkg/controller/status"
+	"github.com/tigera/operator/pkg/controller/utils"
+	"github.com/tigera/operator/pkg/ctrlruntime"
+	"github.com/tigera/operator/pkg/render"
+)
+
+const tigeraStatusName string = "bgp"
+
+var log = logf.Log.WithName("controller_bgp")
+
+// Add creates the BGP controller, which renders Installation.Spec.CalicoNetwork.BGPTopology into the default
+// BGPConfiguration, BGPPeers, BGPFilters and route reflector node labels.
+func Add(mgr manager.Manager, opts options.AddOptions) error {
+	r := &Reconciler{
+		client: mgr.GetClient(),
+		scheme: mgr.GetScheme(),
+		status: status.New(mgr.GetClient(), tigeraStatusName, opts.KubernetesVersion),
+	}
+	r.status.Run(opts.ShutdownContext)
+
+	c, err := ctrlruntime.NewController("tigera-bgp-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return fmt.Errorf("Failed to create tigera-bgp-controller: %w", err)
+	}
+
+	if err = utils.AddInstallationWatch(c); err != nil {
+		return fmt.Errorf("tigera-bgp-controller failed to watch primary resource: %w", err)
+	}
+
+	if err = utils.AddAPIServerWatch(c); err != nil {
+		return fmt.Errorf("tigera-bgp-controller failed to watch APIServer resource: %w", err)
+	}
+
+	if err = utils.AddTigeraStatusWatch(c, tigeraStatusName); err != nil {
+		return fmt.Errorf("tigera-bgp-controller failed to watch bgp Tigerastatus: %w", err)
+	}
+
+	if err = c.WatchObject(&crdv1.BGPConfiguration{}, &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("tigera-bgp-controller failed to watch BGPConfiguration resource: %w", err)
+	}
+
+	// Route reflectors are selected by node labels, so only label changes are relevant.
+	if err = c.WatchObject(&corev1.Node{}, &handler.EnqueueRequestForObject{}, predicate.LabelChangedPredicate{}); err != nil {
+		return fmt.Errorf("tigera-bgp-controller failed to watch Node resource: %w", err)
+	}
+
+	// Password secrets may have any name, so watch all secrets in the operator namespace.
+	if err = utils.AddSecretsWatch(c, "", common.OperatorNamespace()); err != nil {
+		return fmt.Errorf("tigera-bgp-controller failed to watch secrets in '%s' namespace: %w", common.OperatorNamespace(), err)
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("tigera-bgp-controller failed to establish a connection to k8s: %w", err)
+	}
+	go utils.WaitToAddResourceWatch(c, k8sClient, log, nil, []client.Object{
+		&v3.BGPPeer{TypeMeta: metav1.TypeMeta{Kind: v3.KindBGPPeer}},
+		&v3.BGPFilter{TypeMeta: metav1.TypeMeta{Kind: v3.KindBGPFilter}},
+	})
+
+	// Perform periodic reconciliation. This acts as a backstop to catch reconcile issues,
+	// and also makes sure we spot when things change that might not trigger a reconciliation.
+	if err = utils.AddPeriodicReconcile(c, utils.PeriodicReconcileTime, &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("tigera-bgp-controller failed to create periodic reconcile watch: %w", err)
+	}
+	return nil
+}
+
+var _ reconcile.Reconciler = &Reconciler{}
+
+type Reconciler struct {
+	client client.Client
+	scheme *runtime.Scheme
+	status status.StatusManager
+}
+
+// Reconcile reconciles the BGP topology declared in the Installation.
+//
+// - The default BGPConfiguration is updated using the crd.projectcalico.org/v1 API, like the default
+// FelixConfiguration, so that the mesh and AS number are in place at start of day.
+// - Route reflector nodes are labelled and annotated with their cluster ID.
+// - BGPPeers and BGPFilters are created, updated and deleted through the projectcalico.org/v3 API once the API
+// server is available, to benefit from its validation.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.V(1).Info("Reconciling BGP topology")
+
+	installation := &operator.Installation{}
+	if err := r.client.Get(ctx, utils.DefaultInstanceKey, installation); err != nil {
+		if apierrors.IsNotFound(err) {
+			reqLogger.Info("Installation config not found")
+			r.status.OnCRNotFound()
+			return reconcile.Result{}, nil
+		}
+		reqLogger.Error(err, "An error occurred when querying the Installation resource")
+		return reconcile.Result{}, err
+	}
+
+	// If the installation is terminating, do nothing.
+	if installation.DeletionTimestamp != nil {
+		reqLogger.Info("Installation is terminating, skipping BGP reconciliation")
+		return reconcile.Result{}, nil
+	}
+
+	var topology *operator.BGPTopology
+	if installation.Spec.CalicoNetwork != nil {
+		topology = installation.Spec.CalicoNetwork.BGPTopology
+	}
+	if topology == nil {
+		// No topology is declared. Remove what was rendered for an earlier one, but leave the default
+		// BGPConfiguration as is since it may be managed out-of-band.
+		r.status.OnCRNotFound()
+		return reconcile.Result{}, r.cleanup(ctx, reqLogger)
+	}
+	r.status.OnCRFound()
+	defer r.status.SetMetaData(&installation.ObjectMeta)
+
+	// This controller relies on the core Installation controller to default the BGP setting of the Installation.
+	// The core installation controller adds a specific finalizer as part of performing defaulting,
+	// so wait for that before we continue.
+	readyToGo := false
+	for _, finalizer := range installation.GetFinalizers() {
+		if finalizer == render.OperatorCompleteFinalizer {
+			readyToGo = true
+			break
+		}
+	}
+	if !readyToGo {
+		r.status.SetDegraded(operator.ResourceNotReady, "Waiting for Installation defaulting to occur", nil, reqLogger)
+		return reconcile.Result{}, nil
+	}
+
+	if err := ValidateTopology(installation); err != nil {
+		r.status.SetDegraded(operator.InvalidConfigurationError, "error validating BGP topology", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	if err := r.patchBGPConfiguration(ctx, topology); err != nil {
+		r.status.SetDegraded(operator.ResourceUpdateError, "Error updating the default BGPConfiguration", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	if err := r.reconcileRouteReflectors(ctx, topology); err != nil {
+		r.status.SetDegraded(operator.ResourceUpdateError, "Error configuring route reflector nodes", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	secretNames := passwordSecretNames(topology)
+	var secrets []*corev1.Secret
+	for _, name := range secretNames {
+		secret := &corev1.Secret{}
+		if err := r.client.Get(ctx, types.NamespacedName{Name: name, Namespace: common.OperatorNamespace()}, secret); err != nil {
+			r.status.SetDegraded(operator.ResourceReadError, fmt.Sprintf("Unable to read BGP password secret %s/%s", common.OperatorNamespace(), name), err, reqLogger)
+			return reconcile.Result{}, err
+		}
+		secrets = append(secrets, secret)
+	}
+	for _, peer := range topology.Peers {
+		if ref := peer.PasswordSecretRef; ref != nil {
+			for _, secret := range secrets {
+				if secret.Name == ref.Name && len(secret.Data[ref.Key]) == 0 {
+					r.status.SetDegraded(operator.ResourceValidationError, fmt.Sprintf("BGP password secret %s/%s has no %s key", common.OperatorNamespace(), ref.Name, ref.Key), nil, reqLogger)
+					return reconcile.Result{}, nil
+				}
+			}
+		}
+	}
+
+	handler := utils.NewComponentHandler(log, r.client, r.scheme, installation)
+	if len(secrets) > 0 {
+		objs := append(passwordSecrets(secrets), passwordRBAC(secretNames)...)
+		if err := handler.CreateOrUpdateOrDelete(ctx, render.NewPassthroughWithLog(log, objs...), nil); err != nil {
+			r.status.SetDegraded(operator.ResourceUpdateError, "Error creating / updating BGP password secrets", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+	}
+	if err := r.deleteStalePasswords(ctx, secretNames); err != nil {
+		r.status.SetDegraded(operator.ResourceUpdateError, "Error deleting BGP password secrets", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	// BGPPeers and BGPFilters are only managed through the v3 API.
+	if !utils.IsAPIServerReady(r.client, reqLogger) {
+		r.status.SetDegraded(operator.ResourceNotReady, "Waiting for Calico API server to be ready to manage BGP peers and filters", nil, reqLogger)
+		return reconcile.Result{RequeueAfter: utils.StandardRetry}, nil
+	}
+
+	var desired []client.Object
+	for _, peer := range desiredPeers(topology) {
+		desired = append(desired, peer)
+	}
+	for _, filter := range desiredFilters(topology) {
+		desired = append(desired, filter)
+	}
+	if err := r.checkOwnership(ctx, desired); err != nil {
+		r.status.SetDegraded(operator.ResourceValidationError, "Cannot update a BGP resource not owned by the operator", err, reqLogger)
+		return reconcile.Result{}, nil
+	}
+	stale, err := r.staleResources(ctx, desired)
+	if err != nil {
+		r.status.SetDegraded(operator.ResourceReadError, "Error querying BGP peers and filters", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	// As for IP pools, BGPPeers and BGPFilters get no OwnerReference: deleting the Installation must not depend on
+	// the Calico API server being available.
+	v3Handler := utils.NewComponentHandler(log, r.client, r.scheme, nil)
+	if err := v3Handler.CreateOrUpdateOrDelete(ctx, render.NewPassthroughWithLog(log, desired...), nil); err != nil {
+		r.status.SetDegraded(operator.ResourceUpdateError, "Error creating / updating BGP peers and filters", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	if err := v3Handler.CreateOrUpdateOrDelete(ctx, render.NewDeletionPassthrough(stale...), nil); err != nil {
+		r.status.SetDegraded(operator.ResourceUpdateError, "Error deleting BGP peers and filters", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	r.status.ReadyToMonitor()
+	r.status.ClearDegraded()
+	return reconcile.Result{}, nil
+}
+
+// patchBGPConfiguration applies the topology to the default BGPConfiguration, creating it if needed.
+func (r *Reconciler) patchBGPConfiguration(ctx context.Context, topology *operator.BGPTopology) error {
+	bgpConfig := &crdv1.BGPConfiguration{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: "default"}, bgpConfig); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("unable to read BGPConfiguration: %w", err)
+	}
+
+	patchFrom := client.MergeFrom(bgpConfig.DeepCopy())
+	spec := bgpConfig.Spec.DeepCopy()
+	applyBGPConfiguration(topology, &bgpConfig.Spec)
+	if reflect.DeepEqual(spec, &bgpConfig.Spec) && bgpConfig.ResourceVersion != "" {
+		return nil
+	}
+
+	if bgpConfig.ResourceVersion == "" {
+		bgpConfig.Name = "default"
+		return r.client.Create(ctx, bgpConfig)
+	}
+	return r.client.Patch(ctx, bgpConfig, patchFrom)
+}
+
+// checkOwnership returns an error if a desired BGPPeer or BGPFilter already exists, is not managed by this controller
+// and differs from the desired one. Identical resources are adopted, which allows taking over resources created
+// before the topology was declared.
+func (r *Reconciler) checkOwnership(ctx context.Context, desired []client.Object) error {
+	for _, obj := range desired {
+		current := obj.DeepCopyObject().(client.Object)
+		if err := r.client.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if current.GetLabels()[managedByLabel] == managedByValue {
+			continue
+		}
+
+		var identical bool
+		switch c := current.(type) {
+		case *v3.BGPPeer:
+			identical = reflect.DeepEqual(c.Spec, obj.(*v3.BGPPeer).Spec)
+		case *v3.BGPFilter:
+			identical = reflect.DeepEqual(c.Spec, obj.(*v3.BGPFilter).Spec)
+		}
+		if !identical {
+			return fmt.Errorf("%s %s exists and is not managed by the operator", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName())
+		}
+	}
+	return nil
+}
+
+// staleResources returns the BGPPeers and BGPFilters managed by this controller that are not desired.
+func (r *Reconciler) staleResources(ctx context.Context, desired []client.Object) ([]client.Object, error) {
+	keep := map[string]bool{}
+	for _, obj := range desired {
+		keep[obj.GetObjectKind().GroupVersionKind().Kind+"/"+obj.GetName()] = true
+	}
+
+	managed := client.MatchingLabels{managedByLabel: managedByValue}
+	peers := &v3.BGPPeerList{}
+	if err := r.client.List(ctx, peers, managed); err != nil {
+		return nil, err
+	}
+	filters := &v3.BGPFilterList{}
+	if err := r.client.List(ctx, filters, managed); err != nil {
+		return nil, err
+	}
+
+	var stale []client.Object
+	for i := range peers.Items {
+		if !keep[v3.KindBGPPeer+"/"+peers.Items[i].Name] {
+			stale = append(stale, &peers.Items[i])
+		}
+	}
+	for i := range filters.Items {
+		if !keep[v3.KindBGPFilter+"/"+filters.Items[i].Name] {
+			stale = append(stale, &filters.Items[i])
+		}
+	}
+	return stale, nil
+}
+
+// deleteStalePasswords deletes the copied BGP password secrets that are no longer referenced, and the RBAC for
+// calico-node to read them if none are.
+func (r *Reconciler) deleteStalePasswords(ctx context.Context, secretNames []string) error {
+	keep := map[string]bool{}
+	for _, name := range secretNames {
+		keep[name] = true
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := r.client.List(ctx, secrets, client.InNamespace(common.CalicoNamespace), client.MatchingLabels{bgpPasswordLabel: "true"}); err != nil {
+		return err
+	}
+	var stale []client.Object
+	for i := range secrets.Items {
+		if !keep[secrets.Items[i].Name] {
+			stale = append(stale, &secrets.Items[i])
+		}
+	}
+	if len(secretNames) == 0 {
+		stale = append(stale,
+			&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: BGPPasswordsRoleName, Namespace: common.CalicoNamespace}},
+			&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: BGPPasswordsRoleName, Namespace: common.CalicoNamespace}},
+		)
+	}
+	return utils.NewComponentHandler(log, r.client, r.scheme, nil).CreateOrUpdateOrDelete(ctx, render.NewDeletionPassthrough(stale...), nil)
+}
+
+// cleanup removes the route reflector node labels, password secrets, BGPPeers and BGPFilters rendered for a topology
+// that is no longer declared.
+func (r *Reconciler) cleanup(ctx context.Context, reqLogger logr.Logger) error {
+	if err := r.reconcileRouteReflectors(ctx, nil); err != nil {
+		return err
+	}
+	if err := r.deleteStalePasswords(ctx, nil); err != nil {
+		return err
+	}
+	if !utils.IsAPIServerReady(r.client, reqLogger) {
+		// BGPPeers and BGPFilters are only deleted through the v3 API. They are cleaned up once it's available.
+		return nil
+	}
+	stale, err := r.staleResources(ctx, nil)
+	if err != nil {
+		return err
+	}
+	return utils.NewComponentHandler(log, r.client, r.scheme, nil).CreateOrUpdateOrDelete(ctx, render.NewDeletionPassthrough(stale...), nil)
+}
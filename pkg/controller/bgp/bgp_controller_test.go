@@ -0,0 +1,307 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bgp
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operator "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/controller/status"
+	"github.com/tigera/operator/pkg/controller/utils"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/render"
+)
+
+var _ = Describe("BGP controller tests", func() {
+	var ctx context.Context
+	var c client.Client
+	var mockStatus *status.MockStatus
+	var r Reconciler
+	var instance *operator.Installation
+
+	asNumber := uint32(65000)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme)).NotTo(HaveOccurred())
+		Expect(corev1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+		Expect(rbacv1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+
+		c = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+		ctx = context.Background()
+		mockStatus = &status.MockStatus{}
+		r = Reconciler{client: c, scheme: scheme, status: mockStatus}
+
+		instance = &operator.Installation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "default",
+				Finalizers: []string{render.OperatorCompleteFinalizer},
+			},
+			Spec: operator.InstallationSpec{
+				CalicoNetwork: &operator.CalicoNetworkSpec{
+					BGP: operator.BGPOptionPtr(operator.BGPEnabled),
+					BGPTopology: &operator.BGPTopology{
+						NodeToNodeMesh: operator.BGPOptionPtr(operator.BGPDisabled),
+						ASNumber:       &asNumber,
+						RouteReflectors: []operator.BGPRouteReflector{{
+							ClusterID:    "224.0.0.1",
+							NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"rack": "rr"}},
+						}},
+						Peers: []operator.BGPExternalPeer{{
+							Name:     "tor",
+							PeerIP:   "10.0.0.1",
+							ASNumber: 64600,
+							PasswordSecretRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "tor-password"},
+								Key:                  "password",
+							},
+							Filters: []string{"no-default"},
+						}},
+						Communities:          []operator.BGPCommunity{{Name: "edge", Value: "65000:100"}},
+						PrefixAdvertisements: []operator.BGPPrefixAdvertisement{{CIDR: "192.168.0.0/16", Communities: []string{"edge"}}},
+						Filters: []operator.BGPRouteFilter{{
+							Name:     "no-default",
+							ImportV4: []operator.BGPFilterRule{{CIDR: "0.0.0.0/0", MatchOperator: operator.BGPFilterMatchOperatorEqual, Action: operator.BGPFilterActionReject}},
+						}},
+					},
+				},
+			},
+		}
+		Expect(c.Create(ctx, instance)).To(Succeed())
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "tor-password", Namespace: common.OperatorNamespace()},
+			Data:       map[string][]byte{"password": []byte("secret")},
+		})).To(Succeed())
+		Expect(c.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "rr-node", Labels: map[string]string{"rack": "rr"}}})).To(Succeed())
+		Expect(c.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker", Labels: map[string]string{"rack": "a"}}})).To(Succeed())
+		apiserver := &operator.APIServer{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		Expect(c.Create(ctx, apiserver)).To(Succeed())
+		apiserver.Status.State = operator.TigeraStatusReady
+		Expect(c.Status().Update(ctx, apiserver)).To(Succeed())
+	})
+
+	expectReconciled := func() {
+		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("SetMetaData", mock.Anything).Return()
+		mockStatus.On("ReadyToMonitor").Return()
+		mockStatus.On("ClearDegraded").Return()
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		mockStatus.AssertExpectations(GinkgoT())
+	}
+
+	It("should render the topology", func() {
+		expectReconciled()
+
+		bgpConfig := &crdv1.BGPConfiguration{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "default"}, bgpConfig)).To(Succeed())
+		Expect(*bgpConfig.Spec.NodeToNodeMeshEnabled).To(BeFalse())
+		Expect(bgpConfig.Spec.ASNumber.String()).To(Equal("65000"))
+		Expect(bgpConfig.Spec.Communities).To(Equal([]crdv1.Community{{Name: "edge", Value: "65000:100"}}))
+		Expect(bgpConfig.Spec.PrefixAdvertisements).To(Equal([]crdv1.PrefixAdvertisement{{CIDR: "192.168.0.0/16", Communities: []string{"edge"}}}))
+
+		node := &corev1.Node{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "rr-node"}, node)).To(Succeed())
+		Expect(node.Labels).To(HaveKeyWithValue(RouteReflectorLabel, "224.0.0.1"))
+		Expect(node.Annotations).To(HaveKeyWithValue(routeReflectorClusterIDAnnotation, "224.0.0.1"))
+		Expect(c.Get(ctx, client.ObjectKey{Name: "worker"}, node)).To(Succeed())
+		Expect(node.Labels).NotTo(HaveKey(RouteReflectorLabel))
+
+		rrPeer := &v3.BGPPeer{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: routeReflectorPeerName}, rrPeer)).To(Succeed())
+		Expect(rrPeer.Spec.NodeSelector).To(Equal("all()"))
+		Expect(rrPeer.Spec.PeerSelector).To(Equal("has(operator.tigera.io/route-reflector)"))
+
+		peer := &v3.BGPPeer{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "tor"}, peer)).To(Succeed())
+		Expect(peer.Labels).To(HaveKeyWithValue(managedByLabel, managedByValue))
+		Expect(peer.Spec.PeerIP).To(Equal("10.0.0.1"))
+		Expect(peer.Spec.ASNumber.String()).To(Equal("64600"))
+		Expect(peer.Spec.Filters).To(Equal([]string{"no-default"}))
+		Expect(peer.Spec.Password.SecretKeyRef.Name).To(Equal("tor-password"))
+
+		filter := &v3.BGPFilter{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "no-default"}, filter)).To(Succeed())
+		Expect(filter.Spec.ImportV4).To(Equal([]v3.BGPFilterRuleV4{{CIDR: "0.0.0.0/0", MatchOperator: v3.Equal, Action: v3.Reject}}))
+
+		secret := &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "tor-password", Namespace: common.CalicoNamespace}, secret)).To(Succeed())
+		Expect(secret.Data).To(HaveKeyWithValue("password", []byte("secret")))
+		role := &rbacv1.Role{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: BGPPasswordsRoleName, Namespace: common.CalicoNamespace}, role)).To(Succeed())
+		Expect(role.Rules[0].ResourceNames).To(Equal([]string{"tor-password"}))
+	})
+
+	It("should keep the settings of the default BGPConfiguration the topology does not specify", func() {
+		listenPort := uint16(1790)
+		Expect(c.Create(ctx, &crdv1.BGPConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec:       crdv1.BGPConfigurationSpec{ListenPort: listenPort, BindMode: "NodeIP"},
+		})).To(Succeed())
+		instance.Spec.CalicoNetwork.BGPTopology.ASNumber = nil
+		Expect(c.Update(ctx, instance)).To(Succeed())
+
+		expectReconciled()
+
+		bgpConfig := &crdv1.BGPConfiguration{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "default"}, bgpConfig)).To(Succeed())
+		Expect(bgpConfig.Spec.ListenPort).To(Equal(listenPort))
+		Expect(bgpConfig.Spec.BindMode).To(Equal("NodeIP"))
+		Expect(bgpConfig.Spec.ASNumber).To(BeNil())
+		Expect(*bgpConfig.Spec.NodeToNodeMeshEnabled).To(BeFalse())
+	})
+
+	It("should keep the communities and prefix advertisements of the default BGPConfiguration if the topology declares none", func() {
+		communities := []crdv1.Community{{Name: "core", Value: "65000:200"}}
+		prefixAdvertisements := []crdv1.PrefixAdvertisement{{CIDR: "10.0.0.0/8", Communities: []string{"core"}}}
+		Expect(c.Create(ctx, &crdv1.BGPConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec:       crdv1.BGPConfigurationSpec{Communities: communities, PrefixAdvertisements: prefixAdvertisements},
+		})).To(Succeed())
+		instance.Spec.CalicoNetwork.BGPTopology.Communities = nil
+		instance.Spec.CalicoNetwork.BGPTopology.PrefixAdvertisements = nil
+		Expect(c.Update(ctx, instance)).To(Succeed())
+
+		expectReconciled()
+
+		bgpConfig := &crdv1.BGPConfiguration{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "default"}, bgpConfig)).To(Succeed())
+		Expect(bgpConfig.Spec.Communities).To(Equal(communities))
+		Expect(bgpConfig.Spec.PrefixAdvertisements).To(Equal(prefixAdvertisements))
+	})
+
+	It("should remove the resources that are no longer declared", func() {
+		expectReconciled()
+
+		// A peer that is not managed by the operator must be left alone.
+		Expect(c.Create(ctx, &v3.BGPPeer{ObjectMeta: metav1.ObjectMeta{Name: "hand-made"}})).To(Succeed())
+
+		instance.Spec.CalicoNetwork.BGPTopology.Peers = nil
+		instance.Spec.CalicoNetwork.BGPTopology.RouteReflectors = nil
+		Expect(c.Update(ctx, instance)).To(Succeed())
+		expectReconciled()
+
+		Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKey{Name: "tor"}, &v3.BGPPeer{}))).To(BeTrue())
+		Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKey{Name: routeReflectorPeerName}, &v3.BGPPeer{}))).To(BeTrue())
+		Expect(c.Get(ctx, client.ObjectKey{Name: "hand-made"}, &v3.BGPPeer{})).To(Succeed())
+		Expect(c.Get(ctx, client.ObjectKey{Name: "no-default"}, &v3.BGPFilter{})).To(Succeed())
+		Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKey{Name: "tor-password", Namespace: common.CalicoNamespace}, &corev1.Secret{}))).To(BeTrue())
+		Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKey{Name: BGPPasswordsRoleName, Namespace: common.CalicoNamespace}, &rbacv1.Role{}))).To(BeTrue())
+
+		node := &corev1.Node{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "rr-node"}, node)).To(Succeed())
+		Expect(node.Labels).NotTo(HaveKey(RouteReflectorLabel))
+		Expect(node.Annotations).NotTo(HaveKey(routeReflectorClusterIDAnnotation))
+		Expect(node.Labels).To(HaveKeyWithValue("rack", "rr"))
+
+		// Removing the topology removes the rest, but leaves the default BGPConfiguration.
+		instance.Spec.CalicoNetwork.BGPTopology = nil
+		Expect(c.Update(ctx, instance)).To(Succeed())
+		mockStatus.On("OnCRNotFound").Return()
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKey{Name: "no-default"}, &v3.BGPFilter{}))).To(BeTrue())
+		Expect(c.Get(ctx, client.ObjectKey{Name: "default"}, &crdv1.BGPConfiguration{})).To(Succeed())
+	})
+
+	It("should adopt an identical peer but refuse to overwrite a different one", func() {
+		Expect(c.Create(ctx, &v3.BGPPeer{
+			ObjectMeta: metav1.ObjectMeta{Name: "tor"},
+			Spec:       v3.BGPPeerSpec{PeerIP: "10.0.0.2"},
+		})).To(Succeed())
+
+		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("SetMetaData", mock.Anything).Return()
+		mockStatus.On("SetDegraded", operator.ResourceValidationError, "Cannot update a BGP resource not owned by the operator", mock.Anything, mock.Anything).Return()
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		mockStatus.AssertExpectations(GinkgoT())
+
+		peer := &v3.BGPPeer{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "tor"}, peer)).To(Succeed())
+		Expect(peer.Spec.PeerIP).To(Equal("10.0.0.2"))
+
+		// Once the peer matches the declared one, the operator takes it over.
+		peer.Spec = desiredPeers(instance.Spec.CalicoNetwork.BGPTopology)[1].Spec
+		Expect(c.Update(ctx, peer)).To(Succeed())
+		mockStatus = &status.MockStatus{}
+		r.status = mockStatus
+		expectReconciled()
+		Expect(c.Get(ctx, client.ObjectKey{Name: "tor"}, peer)).To(Succeed())
+		Expect(peer.Labels).To(HaveKeyWithValue(managedByLabel, managedByValue))
+	})
+
+	It("should wait for the API server before managing peers and filters", func() {
+		apiserver := &operator.APIServer{}
+		Expect(c.Get(ctx, utils.DefaultInstanceKey, apiserver)).To(Succeed())
+		apiserver.Status.State = operator.TigeraStatusDegraded
+		Expect(c.Status().Update(ctx, apiserver)).To(Succeed())
+
+		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("SetMetaData", mock.Anything).Return()
+		mockStatus.On("SetDegraded", operator.ResourceNotReady, "Waiting for Calico API server to be ready to manage BGP peers and filters", nil, mock.Anything).Return()
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		mockStatus.AssertExpectations(GinkgoT())
+
+		// The BGPConfiguration does not depend on the API server.
+		Expect(c.Get(ctx, client.ObjectKey{Name: "default"}, &crdv1.BGPConfiguration{})).To(Succeed())
+		Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKey{Name: "tor"}, &v3.BGPPeer{}))).To(BeTrue())
+	})
+
+	table.DescribeTable("topology validation",
+		func(mutate func(*operator.BGPTopology), expectedErr string) {
+			mutate(instance.Spec.CalicoNetwork.BGPTopology)
+			err := ValidateTopology(instance)
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			}
+		},
+		table.Entry("valid topology", func(*operator.BGPTopology) {}, ""),
+		table.Entry("peer with an IPv6 address and port", func(t *operator.BGPTopology) { t.Peers[0].PeerIP = "[fd00::1]:1790" }, ""),
+		table.Entry("invalid cluster ID", func(t *operator.BGPTopology) { t.RouteReflectors[0].ClusterID = "fd00::1" }, "is not an IPv4 address"),
+		table.Entry("empty route reflector selector", func(t *operator.BGPTopology) { t.RouteReflectors[0].NodeSelector = metav1.LabelSelector{} }, "should have a nodeSelector"),
+		table.Entry("invalid peer IP", func(t *operator.BGPTopology) { t.Peers[0].PeerIP = "tor.example.com" }, "should be an IP address"),
+		table.Entry("reserved peer name", func(t *operator.BGPTopology) { t.Peers[0].Name = routeReflectorPeerName }, "is specified more than once or is reserved"),
+		table.Entry("unknown filter", func(t *operator.BGPTopology) { t.Peers[0].Filters = []string{"missing"} }, "which is not specified"),
+		table.Entry("filter rule of the wrong family", func(t *operator.BGPTopology) { t.Filters[0].ImportV4[0].CIDR = "::/0" }, "is not of the IP family"),
+		table.Entry("unknown community", func(t *operator.BGPTopology) { t.PrefixAdvertisements[0].Communities = []string{"core"} }, "is neither specified nor a community value"),
+		table.Entry("community value", func(t *operator.BGPTopology) { t.PrefixAdvertisements[0].Communities = []string{"65000:200"} }, ""),
+	)
+
+	It("should reject a topology when BGP is disabled", func() {
+		instance.Spec.CalicoNetwork.BGP = operator.BGPOptionPtr(operator.BGPDisabled)
+		Expect(ValidateTopology(instance)).To(MatchError("bgpTopology requires BGP to be enabled"))
+	})
+})
@@ -0,0 +1,214 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bgp
+
+import (
+	"fmt"
+	"sort"
+
+	calicov1numorstring "github.com/projectcalico/api/pkg/lib/numorstring"
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	"github.com/tigera/api/pkg/lib/numorstring"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operator "github.com/tigera/operator/api/v1"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/render"
+)
+
+const (
+	// These labels are used to track which BGP resources are managed by this controller. Any BGPPeer or BGPFilter
+	// with this label key/value pair is assumed to be solely managed and reconciled by this controller.
+	managedByLabel = "app.kubernetes.io/managed-by"
+	managedByValue = "tigera-operator"
+
+	// RouteReflectorLabel is set on the route reflector nodes, with their route reflector cluster ID as value.
+	RouteReflectorLabel = "operator.tigera.io/route-reflector"
+
+	// routeReflectorClusterIDAnnotation is the node annotation Calico reads the route reflector cluster ID from.
+	routeReflectorClusterIDAnnotation = "projectcalico.org/RouteReflectorClusterID"
+
+	// routeReflectorPeerName is the BGPPeer peering every node with the route reflectors.
+	routeReflectorPeerName = "tigera-route-reflectors"
+
+	// BGPPasswordsRoleName is the name of the Role and RoleBinding allowing calico-node to read the BGP passwords.
+	BGPPasswordsRoleName = "calico-node-bgp-passwords"
+
+	// bgpPasswordLabel marks the BGP password secrets copied to the calico-system namespace.
+	bgpPasswordLabel = "operator.tigera.io/bgp-password"
+)
+
+// applyBGPConfiguration applies the topology to the spec of the default BGPConfiguration. Settings the topology
+// leaves unspecified are left as is.
+func applyBGPConfiguration(topology *operator.BGPTopology, spec *crdv1.BGPConfigurationSpec) {
+	if topology.NodeToNodeMesh != nil {
+		enabled := *topology.NodeToNodeMesh == operator.BGPEnabled
+		spec.NodeToNodeMeshEnabled = &enabled
+	}
+	if topology.ASNumber != nil {
+		asNumber := calicov1numorstring.ASNumber(*topology.ASNumber)
+		spec.ASNumber = &asNumber
+	}
+
+	// Communities and prefix advertisements configured directly on the BGPConfiguration are kept unless the topology
+	// declares its own.
+	if len(topology.Communities) > 0 {
+		spec.Communities = nil
+		for _, c := range topology.Communities {
+			spec.Communities = append(spec.Communities, crdv1.Community{Name: c.Name, Value: c.Value})
+		}
+	}
+	if len(topology.PrefixAdvertisements) > 0 {
+		spec.PrefixAdvertisements = nil
+		for _, pa := range topology.PrefixAdvertisements {
+			spec.PrefixAdvertisements = append(spec.PrefixAdvertisements, crdv1.PrefixAdvertisement{CIDR: pa.CIDR, Communities: pa.Communities})
+		}
+	}
+}
+
+// desiredPeers returns the BGPPeers for the external peers and the route reflectors of the topology.
+func desiredPeers(topology *operator.BGPTopology) []*v3.BGPPeer {
+	var peers []*v3.BGPPeer
+	if len(topology.RouteReflectors) > 0 {
+		// Every node, including the route reflectors themselves, peers with the route reflectors.
+		peer := newPeer(routeReflectorPeerName)
+		peer.Spec.NodeSelector = "all()"
+		peer.Spec.PeerSelector = fmt.Sprintf("has(%s)", RouteReflectorLabel)
+		peers = append(peers, peer)
+	}
+
+	for _, p := range topology.Peers {
+		peer := newPeer(p.Name)
+		peer.Spec.PeerIP = p.PeerIP
+		peer.Spec.ASNumber = numorstring.ASNumber(p.ASNumber)
+		peer.Spec.NodeSelector = p.NodeSelector
+		peer.Spec.Filters = p.Filters
+		peer.Spec.KeepOriginalNextHop = p.KeepOriginalNextHop
+		if p.PasswordSecretRef != nil {
+			peer.Spec.Password = &v3.BGPPassword{SecretKeyRef: p.PasswordSecretRef.DeepCopy()}
+		}
+		peers = append(peers, peer)
+	}
+	return peers
+}
+
+func newPeer(name string) *v3.BGPPeer {
+	return &v3.BGPPeer{
+		TypeMeta: metav1.TypeMeta{Kind: v3.KindBGPPeer, APIVersion: v3.GroupVersionCurrent},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{managedByLabel: managedByValue},
+		},
+	}
+}
+
+// desiredFilters returns the BGPFilters of the topology.
+func desiredFilters(topology *operator.BGPTopology) []*v3.BGPFilter {
+	var filters []*v3.BGPFilter
+	for _, f := range topology.Filters {
+		filter := &v3.BGPFilter{
+			TypeMeta: metav1.TypeMeta{Kind: v3.KindBGPFilter, APIVersion: v3.GroupVersionCurrent},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   f.Name,
+				Labels: map[string]string{managedByLabel: managedByValue},
+			},
+		}
+		for _, r := range f.ExportV4 {
+			filter.Spec.ExportV4 = append(filter.Spec.ExportV4, v3.BGPFilterRuleV4{CIDR: r.CIDR, MatchOperator: v3.BGPFilterMatchOperator(r.MatchOperator), Action: v3.BGPFilterAction(r.Action)})
+		}
+		for _, r := range f.ImportV4 {
+			filter.Spec.ImportV4 = append(filter.Spec.ImportV4, v3.BGPFilterRuleV4{CIDR: r.CIDR, MatchOperator: v3.BGPFilterMatchOperator(r.MatchOperator), Action: v3.BGPFilterAction(r.Action)})
+		}
+		for _, r := range f.ExportV6 {
+			filter.Spec.ExportV6 = append(filter.Spec.ExportV6, v3.BGPFilterRuleV6{CIDR: r.CIDR, MatchOperator: v3.BGPFilterMatchOperator(r.MatchOperator), Action: v3.BGPFilterAction(r.Action)})
+		}
+		for _, r := range f.ImportV6 {
+			filter.Spec.ImportV6 = append(filter.Spec.ImportV6, v3.BGPFilterRuleV6{CIDR: r.CIDR, MatchOperator: v3.BGPFilterMatchOperator(r.MatchOperator), Action: v3.BGPFilterAction(r.Action)})
+		}
+		filters = append(filters, filter)
+	}
+	return filters
+}
+
+// passwordSecretNames returns the names of the secrets holding the BGP passwords of the peers.
+func passwordSecretNames(topology *operator.BGPTopology) []string {
+	names := map[string]bool{}
+	for _, p := range topology.Peers {
+		if p.PasswordSecretRef != nil {
+			names[p.PasswordSecretRef.Name] = true
+		}
+	}
+	var sorted []string
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// passwordSecrets copies the BGP password secrets to the calico-system namespace, where calico-node reads them.
+func passwordSecrets(secrets []*corev1.Secret) []client.Object {
+	var objs []client.Object
+	for _, s := range secrets {
+		objs = append(objs, &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.Name,
+				Namespace: common.CalicoNamespace,
+				Labels:    map[string]string{bgpPasswordLabel: "true"},
+			},
+			Data: s.Data,
+		})
+	}
+	return objs
+}
+
+// passwordRBAC allows calico-node to read the BGP password secrets.
+func passwordRBAC(secretNames []string) []client.Object {
+	return []client.Object{
+		&rbacv1.Role{
+			TypeMeta:   metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: BGPPasswordsRoleName, Namespace: common.CalicoNamespace},
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups:     []string{""},
+					Resources:     []string{"secrets"},
+					Verbs:         []string{"watch", "list", "get"},
+					ResourceNames: secretNames,
+				},
+			},
+		},
+		&rbacv1.RoleBinding{
+			TypeMeta:   metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: BGPPasswordsRoleName, Namespace: common.CalicoNamespace},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: "rbac.authorization.k8s.io",
+				Kind:     "Role",
+				Name:     BGPPasswordsRoleName,
+			},
+			Subjects: []rbacv1.Subject{
+				{
+					Kind:      "ServiceAccount",
+					Name:      render.CalicoNodeObjectName,
+					Namespace: common.CalicoNamespace,
+				},
+			},
+		},
+	}
+}
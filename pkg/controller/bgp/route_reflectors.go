@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bgp
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operator "github.com/tigera/operator/api/v1"
+)
+
+type routeReflectorCluster struct {
+	clusterID string
+	selector  labels.Selector
+}
+
+// reconcileRouteReflectors labels and annotates the nodes selected as route reflectors by the topology, and removes
+// the label and annotation from the nodes that are no longer selected. A nil topology unlabels every node.
+func (r *Reconciler) reconcileRouteReflectors(ctx context.Context, topology *operator.BGPTopology) error {
+	var clusters []routeReflectorCluster
+	if topology != nil {
+		for _, rr := range topology.RouteReflectors {
+			selector, err := metav1.LabelSelectorAsSelector(&rr.NodeSelector)
+			if err != nil {
+				return err
+			}
+			clusters = append(clusters, routeReflectorCluster{clusterID: rr.ClusterID, selector: selector})
+		}
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := r.client.List(ctx, nodes); err != nil {
+		return err
+	}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		clusterID := routeReflectorClusterID(node, clusters)
+		current, labelled := node.Labels[RouteReflectorLabel]
+		if clusterID == "" && !labelled {
+			continue
+		}
+		if clusterID == current && node.Annotations[routeReflectorClusterIDAnnotation] == clusterID {
+			continue
+		}
+
+		patchFrom := client.MergeFrom(node.DeepCopy())
+		if clusterID == "" {
+			delete(node.Labels, RouteReflectorLabel)
+			delete(node.Annotations, routeReflectorClusterIDAnnotation)
+		} else {
+			if node.Labels == nil {
+				node.Labels = map[string]string{}
+			}
+			if node.Annotations == nil {
+				node.Annotations = map[string]string{}
+			}
+			node.Labels[RouteReflectorLabel] = clusterID
+			node.Annotations[routeReflectorClusterIDAnnotation] = clusterID
+		}
+		if err := r.client.Patch(ctx, node, patchFrom); err != nil {
+			return fmt.Errorf("failed to update route reflector configuration of node %s: %w", node.Name, err)
+		}
+	}
+	return nil
+}
+
+// routeReflectorClusterID returns the cluster ID of the first route reflector cluster selecting the node, or an empty
+// string if the node is not a route reflector.
+func routeReflectorClusterID(node *corev1.Node, clusters []routeReflectorCluster) string {
+	for _, c := range clusters {
+		if c.selector.Matches(labels.Set(node.Labels)) {
+			return c.clusterID
+		}
+	}
+	return ""
+}
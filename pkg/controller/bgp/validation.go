@@ -0,0 +1,140 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bgp
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operator "github.com/tigera/operator/api/v1"
+)
+
+var communityValueRegexp = regexp.MustCompile(`^(\d+):(\d+)$|^(\d+):(\d+):(\d+)$`)
+
+// ValidateTopology validates the BGP topology specified in the Installation object.
+func ValidateTopology(instance *operator.Installation) error {
+	cn := instance.Spec.CalicoNetwork
+	if cn == nil || cn.BGPTopology == nil {
+		return nil
+	}
+	if cn.BGP == nil || *cn.BGP != operator.BGPEnabled {
+		return fmt.Errorf("bgpTopology requires BGP to be enabled")
+	}
+	topology := cn.BGPTopology
+
+	clusterIDs := map[string]bool{}
+	for _, rr := range topology.RouteReflectors {
+		if ip := net.ParseIP(rr.ClusterID); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("route reflector cluster ID %s is not an IPv4 address", rr.ClusterID)
+		}
+		if clusterIDs[rr.ClusterID] {
+			return fmt.Errorf("route reflector cluster ID %s is specified more than once", rr.ClusterID)
+		}
+		clusterIDs[rr.ClusterID] = true
+
+		if len(rr.NodeSelector.MatchLabels) == 0 && len(rr.NodeSelector.MatchExpressions) == 0 {
+			return fmt.Errorf("route reflector cluster %s should have a nodeSelector", rr.ClusterID)
+		}
+		if _, err := metav1.LabelSelectorAsSelector(&rr.NodeSelector); err != nil {
+			return fmt.Errorf("route reflector cluster %s has an invalid nodeSelector: %w", rr.ClusterID, err)
+		}
+	}
+
+	filters := map[string]bool{}
+	for _, filter := range topology.Filters {
+		if filters[filter.Name] {
+			return fmt.Errorf("BGP filter %s is specified more than once", filter.Name)
+		}
+		filters[filter.Name] = true
+
+		for _, rules := range []struct {
+			rules []operator.BGPFilterRule
+			ipv4  bool
+		}{
+			{filter.ExportV4, true},
+			{filter.ImportV4, true},
+			{filter.ExportV6, false},
+			{filter.ImportV6, false},
+		} {
+			for _, rule := range rules.rules {
+				ip, _, err := net.ParseCIDR(rule.CIDR)
+				if err != nil {
+					return fmt.Errorf("BGP filter %s CIDR (%s) is invalid: %s", filter.Name, rule.CIDR, err)
+				}
+				if (ip.To4() != nil) != rules.ipv4 {
+					return fmt.Errorf("BGP filter %s CIDR (%s) is not of the IP family of its rule list", filter.Name, rule.CIDR)
+				}
+			}
+		}
+	}
+
+	peers := map[string]bool{routeReflectorPeerName: true}
+	for _, peer := range topology.Peers {
+		if peers[peer.Name] {
+			return fmt.Errorf("BGP peer name %s is specified more than once or is reserved", peer.Name)
+		}
+		peers[peer.Name] = true
+
+		if !validPeerIP(peer.PeerIP) {
+			return fmt.Errorf("BGP peer %s peerIP (%s) should be an IP address with an optional port", peer.Name, peer.PeerIP)
+		}
+		if ref := peer.PasswordSecretRef; ref != nil && (ref.Name == "" || ref.Key == "") {
+			return fmt.Errorf("BGP peer %s passwordSecretRef should have a name and a key", peer.Name)
+		}
+		for _, name := range peer.Filters {
+			if !filters[name] {
+				return fmt.Errorf("BGP peer %s refers to filter %s, which is not specified", peer.Name, name)
+			}
+		}
+	}
+
+	communities := map[string]bool{}
+	for _, community := range topology.Communities {
+		if community.Name == "" {
+			return fmt.Errorf("BGP community %s should have a name", community.Value)
+		}
+		if communities[community.Name] {
+			return fmt.Errorf("BGP community %s is specified more than once", community.Name)
+		}
+		communities[community.Name] = true
+		if !communityValueRegexp.MatchString(community.Value) {
+			return fmt.Errorf("BGP community %s value (%s) should be in aa:nn or aa:nn:mm format", community.Name, community.Value)
+		}
+	}
+
+	for _, pa := range topology.PrefixAdvertisements {
+		if _, _, err := net.ParseCIDR(pa.CIDR); err != nil {
+			return fmt.Errorf("BGP prefix advertisement CIDR (%s) is invalid: %s", pa.CIDR, err)
+		}
+		for _, c := range pa.Communities {
+			if !communities[c] && !communityValueRegexp.MatchString(c) {
+				return fmt.Errorf("BGP prefix advertisement %s refers to community %s, which is neither specified nor a community value", pa.CIDR, c)
+			}
+		}
+	}
+	return nil
+}
+
+// validPeerIP returns true if the address is an IP, an <IPv4>:<port> or a [<IPv6>]:<port>.
+func validPeerIP(address string) bool {
+	if net.ParseIP(address) != nil {
+		return true
+	}
+	host, _, err := net.SplitHostPort(address)
+	return err == nil && net.ParseIP(host) != nil
+}
@@ -499,6 +499,8 @@ func (cm *certificateManager) getKeyPair(cli client.Client, secretName, secretNa
 		}
 	}
 
+	recordCertificateExpiry(secretName, secretNamespace, x509Cert)
+
 	var issuer certificatemanagement.KeyPairInterface
 	if x509Cert.Issuer.CommonName == rmeta.TigeraOperatorCAIssuerPrefix {
 		if cm.keyPair.CertificateManagement != nil {
@@ -52,6 +52,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
 var _ = Describe("Test CertificateManagement suite", func() {
@@ -235,6 +236,38 @@ var _ = Describe("Test CertificateManagement suite", func() {
 			Expect(keyPair2).NotTo(BeNil())
 		})
 
+		It("should expose the expiry of the certificates it reads as a metric", func() {
+			keyPair, err := certificateManager.GetOrCreateKeyPair(cli, appSecretName, appNs, appDNSNames)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cli.Create(ctx, keyPair.Secret(appNs))).NotTo(HaveOccurred())
+
+			_, err = certificateManager.GetKeyPair(cli, appSecretName, appNs, nil)
+			Expect(err).NotTo(HaveOccurred())
+			x509Cert, err := certificatemanagement.ParseCertificate(keyPair.GetCertificatePEM())
+			Expect(err).NotTo(HaveOccurred())
+
+			families, err := metrics.Registry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+			var expiry *float64
+			for _, family := range families {
+				if family.GetName() != "tigera_operator_certificate_expiry_timestamp_seconds" {
+					continue
+				}
+				for _, m := range family.GetMetric() {
+					labels := map[string]string{}
+					for _, l := range m.GetLabel() {
+						labels[l.GetName()] = l.GetValue()
+					}
+					if labels["namespace"] == appNs && labels["name"] == appSecretName {
+						value := m.GetGauge().GetValue()
+						expiry = &value
+					}
+				}
+			}
+			Expect(expiry).NotTo(BeNil())
+			Expect(*expiry).To(Equal(float64(x509Cert.NotAfter.Unix())))
+		})
+
 		It("should be able to fetch a certificate if it exists", func() {
 			By("verifying that it returns nil if the certificate does not exist")
 			crt, err := certificateManager.GetCertificate(cli, appSecretName, appNs)
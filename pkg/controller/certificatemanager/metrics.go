@@ -0,0 +1,99 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificatemanager
+
+import (
+	"crypto/x509"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// certificateExpiryStaleness is how long the expiry of a certificate is reported after the operator last read it. It
+// exceeds the default resync period of the controllers, so certificates that are still in use are read again before
+// they are dropped, while certificates that were removed or replaced stop being reported.
+const certificateExpiryStaleness = 24 * time.Hour
+
+// certificateExpiry is exposed on the metrics endpoint of the operator, so that the CertificateExpiry alert of the
+// monitor component can fire before a certificate that is not renewed by the operator expires.
+var certificateExpiry = newCertificateExpiryCollector()
+
+func init() {
+	metrics.Registry.MustRegister(certificateExpiry)
+}
+
+func recordCertificateExpiry(secretName, secretNamespace string, cert *x509.Certificate) {
+	certificateExpiry.record(secretNamespace, secretName, cert.NotAfter, time.Now())
+}
+
+type certificateKey struct {
+	namespace string
+	name      string
+}
+
+type certificateExpiryRecord struct {
+	notAfter time.Time
+	readAt   time.Time
+}
+
+// certificateExpiryCollector rebuilds the expiry gauge on each collection from the certificates that were read
+// recently, so that the gauge does not keep reporting certificates that no longer exist.
+type certificateExpiryCollector struct {
+	lock    sync.Mutex
+	gauge   *prometheus.GaugeVec
+	records map[certificateKey]certificateExpiryRecord
+	now     func() time.Time
+}
+
+func newCertificateExpiryCollector() *certificateExpiryCollector {
+	return &certificateExpiryCollector{
+		gauge: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "tigera_operator_certificate_expiry_timestamp_seconds",
+				Help: "The time at which a certificate read by the operator expires, in seconds since the epoch.",
+			},
+			[]string{"namespace", "name"},
+		),
+		records: map[certificateKey]certificateExpiryRecord{},
+		now:     time.Now,
+	}
+}
+
+func (c *certificateExpiryCollector) record(namespace, name string, notAfter, readAt time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.records[certificateKey{namespace: namespace, name: name}] = certificateExpiryRecord{notAfter: notAfter, readAt: readAt}
+}
+
+func (c *certificateExpiryCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.gauge.Describe(ch)
+}
+
+func (c *certificateExpiryCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.gauge.Reset()
+	for key, r := range c.records {
+		if c.now().Sub(r.readAt) > certificateExpiryStaleness {
+			delete(c.records, key)
+			continue
+		}
+		c.gauge.WithLabelValues(key.namespace, key.name).Set(float64(r.notAfter.Unix()))
+	}
+	c.gauge.Collect(ch)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificatemanager
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// gatherExpiries returns the expiry of each certificate reported by the collector, keyed by the certificate name.
+func gatherExpiries(c *certificateExpiryCollector) map[string]float64 {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(c)
+	families, err := registry.Gather()
+	Expect(err).NotTo(HaveOccurred())
+
+	expiries := map[string]float64{}
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "name" {
+					expiries[l.GetValue()] = m.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	return expiries
+}
+
+var _ = Describe("certificate expiry metric", func() {
+	It("should stop reporting certificates that have not been read recently", func() {
+		now := time.Now()
+		c := newCertificateExpiryCollector()
+		c.now = func() time.Time { return now }
+
+		c.record("ns", "old-cert", now.Add(10*24*time.Hour), now.Add(-2*certificateExpiryStaleness))
+		c.record("ns", "cert", now.Add(20*24*time.Hour), now)
+		Expect(gatherExpiries(c)).To(Equal(map[string]float64{"cert": float64(now.Add(20 * 24 * time.Hour).Unix())}))
+
+		// The gauge is rebuilt on each collection.
+		now = now.Add(2 * certificateExpiryStaleness)
+		Expect(gatherExpiries(c)).To(BeEmpty())
+	})
+})
@@ -88,6 +88,9 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 	go utils.WaitToAddLicenseKeyWatch(c, k8sClient, log, nil)
 	go utils.WaitToAddTierWatch(networkpolicy.TigeraComponentTierName, c, k8sClient, log, tierWatchReady)
 
+	// Watch ManagedClusters to report their tunnel state on the ManagementCluster.
+	go utils.WaitToAddResourceWatch(c, k8sClient, log, nil, []client.Object{&v3.ManagedCluster{TypeMeta: metav1.TypeMeta{Kind: v3.KindManagedCluster}}})
+
 	go utils.WaitToAddNetworkPolicyWatches(c, k8sClient, log, []types.NamespacedName{
 		{Name: render.GuardianPolicyName, Namespace: render.GuardianNamespace},
 		{Name: networkpolicy.TigeraComponentDefaultDenyPolicyName, Namespace: render.GuardianNamespace},
@@ -117,12 +120,13 @@ func newReconciler(
 	opts options.AddOptions,
 ) *ReconcileConnection {
 	c := &ReconcileConnection{
-		Client:         cli,
-		Scheme:         schema,
-		Provider:       p,
-		status:         statusMgr,
-		clusterDomain:  opts.ClusterDomain,
-		tierWatchReady: tierWatchReady,
+		Client:           cli,
+		Scheme:           schema,
+		Provider:         p,
+		status:           statusMgr,
+		clusterDomain:    opts.ClusterDomain,
+		tierWatchReady:   tierWatchReady,
+		pollTunnelHealth: pollGuardianTunnelHealth,
 	}
 	c.status.Run(opts.ShutdownContext)
 	return c
@@ -193,6 +197,9 @@ type ReconcileConnection struct {
 	tierWatchReady             *utils.ReadyFlag
 	resolvedPodProxies         []*httpproxy.Config
 	lastAvailabilityTransition metav1.Time
+
+	// pollTunnelHealth reads the tunnel state from the Guardian pods. If nil, the tunnel state is not reported.
+	pollTunnelHealth tunnelHealthPoller
 }
 
 // Reconcile reads that state of the cluster for a ManagementClusterConnection object and makes changes based on the
@@ -214,6 +221,12 @@ func (r *ReconcileConnection) Reconcile(ctx context.Context, request reconcile.R
 		r.status.SetDegraded(operatorv1.ResourceReadError, "Error reading ManagementCluster", err, reqLogger)
 		return reconcile.Result{}, err
 	}
+	if managementCluster != nil {
+		// Voltron may not be running yet, so a failure to report the managed clusters does not block the reconcile.
+		if err := r.updateManagedClusterStatus(ctx, managementCluster); err != nil {
+			reqLogger.Error(err, "Failed to update the managed cluster status of the ManagementCluster")
+		}
+	}
 
 	// Fetch the managementClusterConnection.
 	managementClusterConnection, err := utils.GetManagementClusterConnection(ctx, r.Client)
@@ -221,6 +234,7 @@ func (r *ReconcileConnection) Reconcile(ctx context.Context, request reconcile.R
 		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying ManagementClusterConnection", err, reqLogger)
 		return result, err
 	} else if managementClusterConnection == nil {
+		clearTunnelState()
 		r.status.OnCRNotFound()
 		return result, nil
 	}
@@ -432,6 +446,12 @@ func (r *ReconcileConnection) Reconcile(ctx context.Context, request reconcile.R
 	includeEgressNetworkPolicy := tierAvailable && licenseActive
 
 	ch := utils.NewComponentHandler(log, r.Client, r.Scheme, managementClusterConnection)
+	probeSourceNets, err := utils.NodeAddressNets(ctx, r.Client)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying the node addresses", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
 	guardianCfg := &render.GuardianConfiguration{
 		URL:                         managementClusterConnection.Spec.ManagementClusterAddr,
 		PodProxies:                  r.resolvedPodProxies,
@@ -442,6 +462,7 @@ func (r *ReconcileConnection) Reconcile(ctx context.Context, request reconcile.R
 		TunnelSecret:                tunnelSecret,
 		TrustedCertBundle:           trustedCertBundle,
 		ManagementClusterConnection: managementClusterConnection,
+		HealthProbeSourceNets:       probeSourceNets,
 	}
 
 	components := []render.Component{render.Guardian(guardianCfg)}
@@ -473,8 +494,13 @@ func (r *ReconcileConnection) Reconcile(ctx context.Context, request reconcile.R
 
 	r.status.ClearDegraded()
 
-	// We should create the Guardian deployment.
-	return result, nil
+	// Report the tunnel state. Guardian may still be starting, so failures only show in the status.
+	if err := r.updateTunnelStatus(ctx, managementClusterConnection); err != nil {
+		reqLogger.Error(err, "Failed to update the tunnel status of the ManagementClusterConnection")
+	}
+
+	// Poll the tunnel state again periodically.
+	return reconcile.Result{RequeueAfter: tunnelCheckInterval}, nil
 }
 
 func fillDefaults(mcc *operatorv1.ManagementClusterConnection) {
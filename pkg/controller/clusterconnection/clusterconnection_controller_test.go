@@ -546,7 +546,8 @@ var _ = Describe("ManagementClusterConnection controller tests", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 			instance, err := utils.GetManagementClusterConnection(ctx, c)
 			Expect(err).ShouldNot(HaveOccurred())
-			Expect(instance.Status.Conditions).To(HaveLen(0))
+			// Only the tunnel state is reported.
+			Expect(instance.Status.Conditions).To(ConsistOf(HaveField("Type", clusterconnection.TunnelConnectedConditionType)))
 			Expect(c.Delete(ctx, ts)).NotTo(HaveOccurred())
 		})
 		It("should reconcile with creating new status condition with one item", func() {
@@ -574,7 +575,7 @@ var _ = Describe("ManagementClusterConnection controller tests", func() {
 			instance, err := utils.GetManagementClusterConnection(ctx, c)
 			Expect(err).ShouldNot(HaveOccurred())
 
-			Expect(instance.Status.Conditions).To(HaveLen(1))
+			Expect(instance.Status.Conditions).To(HaveLen(2))
 
 			Expect(instance.Status.Conditions[0].Type).To(Equal("Ready"))
 			Expect(string(instance.Status.Conditions[0].Status)).To(Equal(string(operatorv1.ConditionTrue)))
@@ -622,7 +623,7 @@ var _ = Describe("ManagementClusterConnection controller tests", func() {
 			instance, err := utils.GetManagementClusterConnection(ctx, c)
 			Expect(err).ShouldNot(HaveOccurred())
 
-			Expect(instance.Status.Conditions).To(HaveLen(3))
+			Expect(instance.Status.Conditions).To(HaveLen(4))
 			Expect(instance.Status.Conditions[0].Type).To(Equal("Ready"))
 			Expect(string(instance.Status.Conditions[0].Status)).To(Equal(string(operatorv1.ConditionTrue)))
 			Expect(instance.Status.Conditions[0].Reason).To(Equal(string(operatorv1.AllObjectsAvailable)))
@@ -681,7 +682,7 @@ var _ = Describe("ManagementClusterConnection controller tests", func() {
 			instance, err := utils.GetManagementClusterConnection(ctx, c)
 			Expect(err).ShouldNot(HaveOccurred())
 
-			Expect(instance.Status.Conditions).To(HaveLen(3))
+			Expect(instance.Status.Conditions).To(HaveLen(4))
 			Expect(instance.Status.Conditions[0].Type).To(Equal("Ready"))
 			Expect(string(instance.Status.Conditions[0].Status)).To(Equal(string(operatorv1.ConditionTrue)))
 			Expect(instance.Status.Conditions[0].Reason).To(Equal(string(operatorv1.AllObjectsAvailable)))
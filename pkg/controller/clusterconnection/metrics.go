@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusterconnection
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+)
+
+// tunnelConnected is exposed on the metrics endpoint of the operator, so that the GuardianTunnelDown alert of the
+// monitor component can fire when the tunnel of a managed cluster is down. It is only reported while a
+// ManagementClusterConnection exists.
+var tunnelConnected = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "tigera_operator_guardian_tunnel_connected",
+		Help: "Whether Guardian has an established tunnel to the management cluster (1) or not (0).",
+	},
+	nil,
+)
+
+func init() {
+	metrics.Registry.MustRegister(tunnelConnected)
+}
+
+func recordTunnelState(state operatorv1.TunnelState) {
+	if state == operatorv1.TunnelStateConnected {
+		tunnelConnected.WithLabelValues().Set(1)
+	} else {
+		tunnelConnected.WithLabelValues().Set(0)
+	}
+}
+
+func clearTunnelState() {
+	tunnelConnected.Reset()
+}
@@ -41,5 +41,10 @@ func NewReconcilerWithShims(
 		ShutdownContext: context.Background(),
 	}
 
-	return newReconciler(cli, schema, status, provider, tierWatchReady, opts)
+	r := newReconciler(cli, schema, status, provider, tierWatchReady, opts)
+	// There are no Guardian pods to poll in the tests, so report a connected tunnel.
+	r.pollTunnelHealth = func(context.Context, client.Client) ([]guardianTunnelHealth, error) {
+		return []guardianTunnelHealth{{Connected: true}}, nil
+	}
+	return r
 }
@@ -0,0 +1,276 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusterconnection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"reflect"
+	"sort"
+	"time"
+
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/render"
+)
+
+const (
+	// TunnelConnectedConditionType is the ManagementClusterConnection status condition reporting whether Guardian
+	// has an established tunnel to the management cluster.
+	TunnelConnectedConditionType = "TunnelConnected"
+
+	tunnelCheckTimeout  = 5 * time.Second
+	tunnelCheckInterval = time.Minute
+)
+
+// guardianTunnelHealth is the tunnel state a Guardian pod reports on render.GuardianTunnelStatusPath.
+type guardianTunnelHealth struct {
+	Connected     bool       `json:"connected"`
+	LastHandshake *time.Time `json:"lastHandshake,omitempty"`
+	Reconnects    int32      `json:"reconnects,omitempty"`
+	LastError     string     `json:"lastError,omitempty"`
+	LastErrorType string     `json:"lastErrorType,omitempty"`
+	LastErrorTime *time.Time `json:"lastErrorTime,omitempty"`
+}
+
+// tunnelHealthPoller reads the tunnel state of every running Guardian pod.
+type tunnelHealthPoller func(ctx context.Context, cli client.Client) ([]guardianTunnelHealth, error)
+
+// pollGuardianTunnelHealth queries the health endpoint of every running Guardian pod.
+func pollGuardianTunnelHealth(ctx context.Context, cli client.Client) ([]guardianTunnelHealth, error) {
+	pods := &corev1.PodList{}
+	if err := cli.List(ctx, pods, client.InNamespace(render.GuardianNamespace), client.MatchingLabels{"app.kubernetes.io/name": render.GuardianDeploymentName}); err != nil {
+		return nil, err
+	}
+
+	httpClient := &http.Client{Timeout: tunnelCheckTimeout}
+	var healths []guardianTunnelHealth
+	var lastErr error
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" {
+			continue
+		}
+		health, err := pollGuardianPod(ctx, httpClient, pod.Status.PodIP)
+		if err != nil {
+			lastErr = fmt.Errorf("failed to query the tunnel status of pod %s: %w", pod.Name, err)
+			continue
+		}
+		healths = append(healths, *health)
+	}
+	if len(healths) == 0 {
+		if lastErr == nil {
+			lastErr = fmt.Errorf("no running Guardian pods found")
+		}
+		return nil, lastErr
+	}
+	return healths, nil
+}
+
+func pollGuardianPod(ctx context.Context, httpClient *http.Client, podIP string) (*guardianTunnelHealth, error) {
+	endpoint := fmt.Sprintf("http://%s%s", net.JoinHostPort(podIP, fmt.Sprint(render.GuardianHealthPort)), render.GuardianTunnelStatusPath)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response %s", resp.Status)
+	}
+	health := &guardianTunnelHealth{}
+	if err := json.NewDecoder(resp.Body).Decode(health); err != nil {
+		return nil, err
+	}
+	return health, nil
+}
+
+// tunnelStatus aggregates the tunnel state reported by the Guardian pods. The tunnel is connected if any pod has
+// an established tunnel.
+func tunnelStatus(healths []guardianTunnelHealth, pollErr error, now metav1.Time) *operatorv1.GuardianTunnelStatus {
+	status := &operatorv1.GuardianTunnelStatus{
+		State:         operatorv1.TunnelStateUnknown,
+		LastCheckTime: &now,
+	}
+	if pollErr != nil {
+		return status
+	}
+
+	status.State = operatorv1.TunnelStateDisconnected
+	for _, health := range healths {
+		if health.Connected {
+			status.State = operatorv1.TunnelStateConnected
+		}
+		status.Reconnects += health.Reconnects
+		// The times are truncated to the precision they are stored with, so that unchanged times compare equal.
+		if health.LastHandshake != nil && (status.LastHandshakeTime == nil || health.LastHandshake.After(status.LastHandshakeTime.Time)) {
+			status.LastHandshakeTime = &metav1.Time{Time: health.LastHandshake.Truncate(time.Second)}
+		}
+		if health.LastError != "" && (status.LastErrorTime == nil || (health.LastErrorTime != nil && health.LastErrorTime.After(status.LastErrorTime.Time))) {
+			status.LastError = health.LastError
+			status.LastErrorType = tunnelErrorType(health.LastErrorType)
+			if health.LastErrorTime != nil {
+				status.LastErrorTime = &metav1.Time{Time: health.LastErrorTime.Truncate(time.Second)}
+			}
+		}
+	}
+	return status
+}
+
+func tunnelErrorType(errorType string) operatorv1.TunnelErrorType {
+	switch t := operatorv1.TunnelErrorType(errorType); t {
+	case operatorv1.TunnelErrorTypeTLS, operatorv1.TunnelErrorTypeProxy, operatorv1.TunnelErrorTypeDial:
+		return t
+	default:
+		return operatorv1.TunnelErrorTypeOther
+	}
+}
+
+// tunnelCondition translates the tunnel status into the TunnelConnected condition.
+func tunnelCondition(status *operatorv1.GuardianTunnelStatus, pollErr error, generation int64) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               TunnelConnectedConditionType,
+		ObservedGeneration: generation,
+	}
+	switch {
+	case status.ProxyError != "":
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "ProxyConfigurationError"
+		condition.Message = fmt.Sprintf("The proxy configuration of Guardian cannot be resolved: %s", status.ProxyError)
+	case pollErr != nil:
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = "HealthCheckFailed"
+		condition.Message = pollErr.Error()
+	case status.State == operatorv1.TunnelStateConnected:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Connected"
+		condition.Message = "Guardian is connected to the management cluster"
+	default:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "Disconnected"
+		condition.Message = "Guardian is not connected to the management cluster"
+		if status.LastError != "" {
+			condition.Reason = string(status.LastErrorType) + "Error"
+			condition.Message = fmt.Sprintf("Guardian is not connected to the management cluster: %s", status.LastError)
+		}
+	}
+	return condition
+}
+
+// updateTunnelStatus polls Guardian and reports the tunnel state on the ManagementClusterConnection.
+func (r *ReconcileConnection) updateTunnelStatus(ctx context.Context, mcc *operatorv1.ManagementClusterConnection) error {
+	if r.pollTunnelHealth == nil {
+		return nil
+	}
+
+	healths, pollErr := r.pollTunnelHealth(ctx, r.Client)
+	status := tunnelStatus(healths, pollErr, metav1.Now())
+	destinations, err := render.GuardianTunnelDestinations(mcc.Spec.ManagementClusterAddr, r.resolvedPodProxies)
+	if err != nil {
+		status.ProxyError = err.Error()
+	}
+	status.Destinations = destinations
+
+	recordTunnelState(status.State)
+
+	// Every write triggers another reconcile through the ManagementClusterConnection watch, so only write when
+	// something other than the check time changed.
+	conditionChanged := meta.SetStatusCondition(&mcc.Status.Conditions, tunnelCondition(status, pollErr, mcc.Generation))
+	if !conditionChanged && tunnelStatusEqual(mcc.Status.Tunnel, status) {
+		return nil
+	}
+	mcc.Status.Tunnel = status
+	return r.Client.Status().Update(ctx, mcc)
+}
+
+// tunnelStatusEqual returns whether the tunnel statuses are equal, ignoring the time they were checked at.
+func tunnelStatusEqual(a, b *operatorv1.GuardianTunnelStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	a, b = a.DeepCopy(), b.DeepCopy()
+	a.LastCheckTime, b.LastCheckTime = nil, nil
+	return equality.Semantic.DeepEqual(a, b)
+}
+
+// updateManagedClusterStatus reports the tunnel state of every managed cluster on the ManagementCluster, based on
+// the conditions Voltron sets on the ManagedCluster resources.
+func (r *ReconcileConnection) updateManagedClusterStatus(ctx context.Context, mc *operatorv1.ManagementCluster) error {
+	managedClusters := &v3.ManagedClusterList{}
+	if err := r.Client.List(ctx, managedClusters); err != nil {
+		return err
+	}
+
+	previous := map[string]operatorv1.ManagedClusterConnectionStatus{}
+	for _, s := range mc.Status.ManagedClusters {
+		previous[s.Namespace+"/"+s.Name] = s
+	}
+
+	now := metav1.Now()
+	status := operatorv1.ManagementClusterStatus{}
+	for _, managedCluster := range managedClusters.Items {
+		s := operatorv1.ManagedClusterConnectionStatus{
+			Name:      managedCluster.Name,
+			Namespace: managedCluster.Namespace,
+			State:     operatorv1.TunnelStateUnknown,
+		}
+		for _, condition := range managedCluster.Status.Conditions {
+			if condition.Type != v3.ManagedClusterStatusTypeConnected {
+				continue
+			}
+			switch condition.Status {
+			case v3.ManagedClusterStatusValueTrue:
+				s.State = operatorv1.TunnelStateConnected
+			case v3.ManagedClusterStatusValueFalse:
+				s.State = operatorv1.TunnelStateDisconnected
+			}
+			s.Message = condition.Message
+		}
+
+		// ManagedCluster conditions carry no timestamps, so the transition time is tracked here.
+		s.LastTransitionTime = &now
+		if p, ok := previous[s.Namespace+"/"+s.Name]; ok && p.State == s.State {
+			s.LastTransitionTime = p.LastTransitionTime
+		}
+		if s.State == operatorv1.TunnelStateConnected {
+			status.ConnectedClusters++
+		}
+		status.ManagedClusters = append(status.ManagedClusters, s)
+	}
+	sort.Slice(status.ManagedClusters, func(i, j int) bool {
+		a, b := status.ManagedClusters[i], status.ManagedClusters[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+
+	if reflect.DeepEqual(mc.Status, status) {
+		return nil
+	}
+	mc.Status = status
+	return r.Client.Status().Update(ctx, mc)
+}
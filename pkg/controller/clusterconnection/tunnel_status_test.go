@@ -0,0 +1,185 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusterconnection
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	"golang.org/x/net/http/httpproxy"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+)
+
+var _ = Describe("Tunnel status", func() {
+	var ctx context.Context
+	var cli client.Client
+	var r *ReconcileConnection
+
+	handshake := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	failure := handshake.Add(time.Minute)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme)).To(Succeed())
+		cli = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+		r = &ReconcileConnection{Client: cli}
+	})
+
+	It("should report the tunnel as connected if any Guardian pod is connected", func() {
+		status := tunnelStatus([]guardianTunnelHealth{
+			{Connected: true, LastHandshake: &handshake, Reconnects: 2},
+			{Connected: false, Reconnects: 1, LastError: "x509: certificate signed by unknown authority", LastErrorType: "TLS", LastErrorTime: &failure},
+		}, nil, metav1.Now())
+
+		Expect(status.State).To(Equal(operatorv1.TunnelStateConnected))
+		Expect(status.Reconnects).To(BeEquivalentTo(3))
+		Expect(status.LastHandshakeTime.Time).To(Equal(handshake))
+		Expect(status.LastErrorType).To(Equal(operatorv1.TunnelErrorTypeTLS))
+
+		condition := tunnelCondition(status, nil, 1)
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("Connected"))
+	})
+
+	It("should explain why the tunnel is down", func() {
+		status := tunnelStatus([]guardianTunnelHealth{
+			{LastError: "proxyconnect tcp: dial tcp 10.0.0.1:3128: connect: connection refused", LastErrorType: "Proxy", LastErrorTime: &failure},
+		}, nil, metav1.Now())
+
+		Expect(status.State).To(Equal(operatorv1.TunnelStateDisconnected))
+		condition := tunnelCondition(status, nil, 1)
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("ProxyError"))
+		Expect(condition.Message).To(ContainSubstring("connection refused"))
+	})
+
+	It("should report an unknown state when Guardian cannot be polled", func() {
+		pollErr := fmt.Errorf("no running Guardian pods found")
+		status := tunnelStatus(nil, pollErr, metav1.Now())
+
+		Expect(status.State).To(Equal(operatorv1.TunnelStateUnknown))
+		condition := tunnelCondition(status, pollErr, 1)
+		Expect(condition.Status).To(Equal(metav1.ConditionUnknown))
+		Expect(condition.Reason).To(Equal("HealthCheckFailed"))
+	})
+
+	It("should write the tunnel status and destinations to the ManagementClusterConnection", func() {
+		mcc := &operatorv1.ManagementClusterConnection{
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec:       operatorv1.ManagementClusterConnectionSpec{ManagementClusterAddr: "voltron.example.com:9449"},
+		}
+		Expect(cli.Create(ctx, mcc)).To(Succeed())
+
+		r.pollTunnelHealth = func(context.Context, client.Client) ([]guardianTunnelHealth, error) {
+			return []guardianTunnelHealth{{Connected: true, LastHandshake: &handshake}}, nil
+		}
+		r.resolvedPodProxies = []*httpproxy.Config{{HTTPSProxy: "http://proxy.example.com:3128"}}
+		Expect(r.updateTunnelStatus(ctx, mcc)).To(Succeed())
+
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, mcc)).To(Succeed())
+		Expect(mcc.Status.Tunnel.State).To(Equal(operatorv1.TunnelStateConnected))
+		Expect(mcc.Status.Tunnel.Destinations).To(Equal([]string{"proxy.example.com:3128"}))
+		Expect(mcc.Status.Tunnel.ProxyError).To(BeEmpty())
+		Expect(meta.IsStatusConditionTrue(mcc.Status.Conditions, TunnelConnectedConditionType)).To(BeTrue())
+
+		families, err := metrics.Registry.Gather()
+		Expect(err).NotTo(HaveOccurred())
+		var connected *float64
+		for _, family := range families {
+			if family.GetName() == "tigera_operator_guardian_tunnel_connected" {
+				value := family.GetMetric()[0].GetGauge().GetValue()
+				connected = &value
+			}
+		}
+		Expect(connected).NotTo(BeNil())
+		Expect(*connected).To(Equal(1.0))
+	})
+
+	It("should only write the tunnel status when it changed", func() {
+		mcc := &operatorv1.ManagementClusterConnection{
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec:       operatorv1.ManagementClusterConnectionSpec{ManagementClusterAddr: "voltron.example.com:9449"},
+		}
+		Expect(cli.Create(ctx, mcc)).To(Succeed())
+
+		connected := true
+		r.pollTunnelHealth = func(context.Context, client.Client) ([]guardianTunnelHealth, error) {
+			return []guardianTunnelHealth{{Connected: connected, LastHandshake: &handshake}}, nil
+		}
+		Expect(r.updateTunnelStatus(ctx, mcc)).To(Succeed())
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, mcc)).To(Succeed())
+		resourceVersion := mcc.ResourceVersion
+
+		// Polling the same state again does not write the status, which would trigger another reconcile.
+		Expect(r.updateTunnelStatus(ctx, mcc)).To(Succeed())
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, mcc)).To(Succeed())
+		Expect(mcc.ResourceVersion).To(Equal(resourceVersion))
+
+		connected = false
+		Expect(r.updateTunnelStatus(ctx, mcc)).To(Succeed())
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, mcc)).To(Succeed())
+		Expect(mcc.ResourceVersion).NotTo(Equal(resourceVersion))
+		Expect(mcc.Status.Tunnel.State).To(Equal(operatorv1.TunnelStateDisconnected))
+	})
+
+	It("should report the tunnel state of the managed clusters on the ManagementCluster", func() {
+		mc := &operatorv1.ManagementCluster{ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"}}
+		Expect(cli.Create(ctx, mc)).To(Succeed())
+		connected := &v3.ManagedCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-a"},
+			Status: v3.ManagedClusterStatus{Conditions: []v3.ManagedClusterStatusCondition{
+				{Type: v3.ManagedClusterStatusTypeConnected, Status: v3.ManagedClusterStatusValueTrue},
+			}},
+		}
+		disconnected := &v3.ManagedCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-b"},
+			Status: v3.ManagedClusterStatus{Conditions: []v3.ManagedClusterStatusCondition{
+				{Type: v3.ManagedClusterStatusTypeConnected, Status: v3.ManagedClusterStatusValueFalse, Message: "tunnel closed"},
+			}},
+		}
+		Expect(cli.Create(ctx, connected)).To(Succeed())
+		Expect(cli.Create(ctx, disconnected)).To(Succeed())
+		Expect(cli.Create(ctx, &v3.ManagedCluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster-c"}})).To(Succeed())
+
+		Expect(r.updateManagedClusterStatus(ctx, mc)).To(Succeed())
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, mc)).To(Succeed())
+		Expect(mc.Status.ConnectedClusters).To(BeEquivalentTo(1))
+		Expect(mc.Status.ManagedClusters).To(HaveLen(3))
+		Expect(mc.Status.ManagedClusters[0].Name).To(Equal("cluster-a"))
+		Expect(mc.Status.ManagedClusters[0].State).To(Equal(operatorv1.TunnelStateConnected))
+		Expect(mc.Status.ManagedClusters[1].State).To(Equal(operatorv1.TunnelStateDisconnected))
+		Expect(mc.Status.ManagedClusters[1].Message).To(Equal("tunnel closed"))
+		Expect(mc.Status.ManagedClusters[2].State).To(Equal(operatorv1.TunnelStateUnknown))
+
+		// The transition time only moves when the state changes.
+		transition := mc.Status.ManagedClusters[0].LastTransitionTime
+		Expect(r.updateManagedClusterStatus(ctx, mc)).To(Succeed())
+		Expect(mc.Status.ManagedClusters[0].LastTransitionTime).To(Equal(transition))
+	})
+})
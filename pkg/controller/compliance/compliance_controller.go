@@ -143,6 +143,11 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		return fmt.Errorf("compliance-controller failed to watch resource: %w", err)
 	}
 
+	// The manager exposure may determine the issuer of the tokens that compliance validates.
+	if err = complianceController.WatchObject(&operatorv1.Manager{}, eventHandler); err != nil {
+		return fmt.Errorf("compliance-controller failed to watch resource: %w", err)
+	}
+
 	// Watch for changes to TigeraStatus.
 	if err = utils.AddTigeraStatusWatch(complianceController, ResourceName); err != nil {
 		return fmt.Errorf("compliance-controller failed to watch compliance Tigerastatus: %w", err)
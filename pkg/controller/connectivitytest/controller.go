@@ -0,0 +1,326 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectivitytest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/connectivityprobe"
+	"github.com/tigera/operator/pkg/controller/options"
+	"github.com/tigera/operator/pkg/controller/status"
+	"github.com/tigera/operator/pkg/controller/utils"
+	"github.com/tigera/operator/pkg/controller/utils/imageset"
+	"github.com/tigera/operator/pkg/ctrlruntime"
+	"github.com/tigera/operator/pkg/render/connectivitytest"
+)
+
+const (
+	controllerName = "connectivity-test-controller"
+	ResourceName   = "connectivity-test"
+
+	defaultInterval     = 10 * time.Minute
+	defaultProbeTimeout = 5 * time.Second
+)
+
+var log = logf.Log.WithName(controllerName)
+
+// Add creates a new Reconciler Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and start it when the Manager is started.
+func Add(mgr manager.Manager, opts options.AddOptions) error {
+	statusManager := status.New(mgr.GetClient(), ResourceName, opts.KubernetesVersion)
+	reconciler := newReconciler(mgr.GetClient(), mgr.GetScheme(), statusManager, opts)
+
+	c, err := ctrlruntime.NewController(controllerName, mgr, controller.Options{Reconciler: reconciler})
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", controllerName, err)
+	}
+
+	err = c.WatchObject(&operatorv1.ConnectivityTest{}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return fmt.Errorf("%s failed to watch primary resource: %w", controllerName, err)
+	}
+
+	if err = utils.AddInstallationWatch(c); err != nil {
+		return fmt.Errorf("%s failed to watch Installation resource: %w", controllerName, err)
+	}
+
+	if err = imageset.AddImageSetWatch(c); err != nil {
+		return fmt.Errorf("%s failed to watch ImageSet: %w", controllerName, err)
+	}
+
+	err = utils.AddNamespacedWatch(c, &appsv1.DaemonSet{
+		TypeMeta:   metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: connectivitytest.ProbeName, Namespace: connectivitytest.ProbeNamespace},
+	}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return fmt.Errorf("%s failed to watch connectivity probe DaemonSet: %w", controllerName, err)
+	}
+
+	if err = utils.AddSecretsWatch(c, connectivitytest.ProbeTokenSecretName, common.OperatorNamespace()); err != nil {
+		return fmt.Errorf("%s failed to watch connectivity probe token secret: %w", controllerName, err)
+	}
+
+	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
+		return fmt.Errorf("%s failed to watch connectivity-test Tigerastatus: %w", controllerName, err)
+	}
+
+	return nil
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(cli client.Client, schema *runtime.Scheme, statusMgr status.StatusManager, opts options.AddOptions) *Reconciler {
+	r := &Reconciler{
+		cli:           cli,
+		scheme:        schema,
+		status:        statusMgr,
+		clusterDomain: opts.ClusterDomain,
+		prober:        &httpProber{},
+	}
+	r.status.Run(opts.ShutdownContext)
+	return r
+}
+
+// blank assignment to verify that Reconciler implements reconcile.Reconciler
+var _ reconcile.Reconciler = &Reconciler{}
+
+// Reconciler reconciles a ConnectivityTest object
+type Reconciler struct {
+	cli           client.Client
+	scheme        *runtime.Scheme
+	status        status.StatusManager
+	clusterDomain string
+	prober        prober
+}
+
+// Reconcile deploys the connectivity probe pods for the ConnectivityTest, and runs a test whenever one is due. The
+// results are written to the ConnectivityTest status, and failures degrade the connectivity-test TigeraStatus.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling ConnectivityTest")
+
+	test, err := utils.GetIfExists[operatorv1.ConnectivityTest](ctx, utils.DefaultInstanceKey, r.cli)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying ConnectivityTest", err, reqLogger)
+		return reconcile.Result{}, err
+	} else if test == nil {
+		r.status.OnCRNotFound()
+		return reconcile.Result{}, nil
+	}
+	r.status.OnCRFound()
+	// SetMetaData in the TigeraStatus such as observedGenerations.
+	defer r.status.SetMetaData(&test.ObjectMeta)
+
+	variant, installation, err := utils.GetInstallation(ctx, r.cli)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			r.status.SetDegraded(operatorv1.ResourceNotFound, "Installation not found", err, reqLogger)
+			return reconcile.Result{}, nil
+		}
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying installation", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	if err := validateConnectivityTest(test); err != nil {
+		r.status.SetDegraded(operatorv1.InvalidConfigurationError, "Invalid ConnectivityTest provided", err, reqLogger)
+		return reconcile.Result{}, nil
+	}
+
+	pullSecrets, err := utils.GetNetworkingPullSecrets(installation, r.cli)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving pull secrets", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	tokenSecret, err := utils.GetSecret(ctx, r.cli, connectivitytest.ProbeTokenSecretName, common.OperatorNamespace())
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving connectivity probe token secret", err, reqLogger)
+		return reconcile.Result{}, err
+	} else if tokenSecret == nil {
+		tokenSecret = connectivitytest.CreateProbeTokenSecret()
+	}
+
+	sourceNets, err := utils.NodeAddressNets(ctx, r.cli)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying the node addresses", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	component := connectivitytest.ConnectivityTest(&connectivitytest.Configuration{
+		PullSecrets:        pullSecrets,
+		Installation:       installation,
+		ConnectivityTest:   test,
+		TokenSecret:        tokenSecret,
+		OperatorSourceNets: sourceNets,
+	})
+	if err = imageset.ApplyImageSet(ctx, r.cli, variant, component); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error with images from ImageSet", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	ch := utils.NewComponentHandler(log, r.cli, r.scheme, test)
+	if err := ch.CreateOrUpdateOrDelete(ctx, component, r.status); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error creating / updating resource", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	r.status.ReadyToMonitor()
+
+	interval := defaultInterval
+	if test.Spec.Interval != nil {
+		interval = test.Spec.Interval.Duration
+	}
+	if wait := untilDue(test, interval, time.Now()); wait > 0 {
+		r.reportResult(test, reqLogger)
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
+	targets, err := r.probeTargets(ctx)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error listing connectivity probe pods", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	if !targets.scheduled {
+		r.status.SetDegraded(operatorv1.ResourceNotReady, "Waiting for connectivity probe pods to be scheduled", nil, reqLogger)
+		return reconcile.Result{RequeueAfter: utils.StandardRetry}, nil
+	}
+
+	dnsName := test.Spec.DNSName
+	if dnsName == "" {
+		dnsName = fmt.Sprintf("kubernetes.default.svc.%s", r.clusterDomain)
+	}
+	timeout := defaultProbeTimeout
+	if test.Spec.ProbeTimeout != nil {
+		timeout = test.Spec.ProbeTimeout.Duration
+	}
+
+	reqLogger.Info("Running connectivity test", "nodes", len(targets.pods))
+	start := metav1.Now()
+	testStatus := r.runTest(ctx, targets, string(tokenSecret.Data[connectivitytest.ProbeTokenSecretKey]), connectivityprobe.Request{
+		Timeout:  timeout,
+		Services: targets.services,
+		DNSNames: []string{dnsName},
+		Egress:   test.Spec.EgressTargets,
+	})
+	testStatus.LastRunTime = &start
+	testStatus.ObservedRunRequest = test.Spec.RunRequest
+	testStatus.Conditions = test.Status.Conditions
+
+	patchFrom := client.MergeFrom(test.DeepCopy())
+	test.Status = testStatus
+	if err := r.cli.Status().Patch(ctx, test, patchFrom); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error updating ConnectivityTest status", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	r.reportResult(test, reqLogger)
+	return reconcile.Result{RequeueAfter: interval}, nil
+}
+
+// untilDue returns how long to wait before the next test run, or zero if a run is due now.
+func untilDue(test *operatorv1.ConnectivityTest, interval time.Duration, now time.Time) time.Duration {
+	if test.Status.LastRunTime == nil || test.Spec.RunRequest != test.Status.ObservedRunRequest {
+		return 0
+	}
+	wait := test.Status.LastRunTime.Add(interval).Sub(now)
+	if wait < 0 {
+		return 0
+	}
+	return wait
+}
+
+// reportResult degrades the TigeraStatus if the last test run failed, and clears it otherwise.
+func (r *Reconciler) reportResult(test *operatorv1.ConnectivityTest, reqLogger logr.Logger) {
+	if test.Status.Result == operatorv1.ConnectivityTestFailed {
+		r.status.SetDegraded(operatorv1.ConnectivityTestFailure, failureSummary(test.Status), nil, reqLogger)
+		return
+	}
+	r.status.ClearDegraded()
+}
+
+// validateConnectivityTest checks the parts of the spec that the CRD schema can't.
+func validateConnectivityTest(test *operatorv1.ConnectivityTest) error {
+	for _, t := range test.Spec.EgressTargets {
+		host, _, err := net.SplitHostPort(t)
+		if err != nil {
+			return fmt.Errorf("spec.egressTargets %q is not of the form <IP>:<port>: %w", t, err)
+		}
+		if net.ParseIP(host) == nil {
+			return fmt.Errorf("spec.egressTargets %q must use an IP address", t)
+		}
+	}
+	if test.Spec.Interval != nil && test.Spec.Interval.Duration < time.Minute {
+		return fmt.Errorf("spec.interval must be at least 1m")
+	}
+	if test.Spec.ProbeTimeout != nil && test.Spec.ProbeTimeout.Duration <= 0 {
+		return fmt.Errorf("spec.probeTimeout must be positive")
+	}
+	return nil
+}
+
+// prober sends a Request to the probe agent at the given address, authenticated with the given token.
+type prober interface {
+	probe(ctx context.Context, address, token string, req connectivityprobe.Request) (*connectivityprobe.Response, error)
+}
+
+type httpProber struct{}
+
+func (p *httpProber) probe(ctx context.Context, address, token string, req connectivityprobe.Request) (*connectivityprobe.Response, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	// Leave the agent time to report on probes that time out.
+	ctx, cancel := context.WithTimeout(ctx, req.Timeout+10*time.Second)
+	defer cancel()
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("http://%s%s", address, connectivityprobe.ProbePath), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+token)
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	out := &connectivityprobe.Response{}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,319 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectivitytest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/stretchr/testify/mock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/connectivityprobe"
+	"github.com/tigera/operator/pkg/controller/status"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/render/connectivitytest"
+)
+
+// fakeProber answers probe requests without a network. Requests to any target in failing fail.
+type fakeProber struct {
+	lock     sync.Mutex
+	failing  map[string]bool
+	requests map[string]connectivityprobe.Request
+	tokens   map[string]string
+}
+
+func (p *fakeProber) probe(_ context.Context, address, token string, req connectivityprobe.Request) (*connectivityprobe.Response, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.requests[address] = req
+	p.tokens[address] = token
+	if p.failing[address] {
+		return nil, fmt.Errorf("connection refused")
+	}
+
+	resp := &connectivityprobe.Response{}
+	add := func(t connectivityprobe.ProbeType, targets []string) {
+		for _, target := range targets {
+			res := connectivityprobe.Result{Type: t, Target: target, Success: true, Latency: time.Millisecond}
+			if p.failing[target] {
+				res = connectivityprobe.Result{Type: t, Target: target, Error: "i/o timeout"}
+			}
+			resp.Results = append(resp.Results, res)
+		}
+	}
+	add(connectivityprobe.ProbeTypePod, req.Pods)
+	add(connectivityprobe.ProbeTypeService, req.Services)
+	add(connectivityprobe.ProbeTypeDNS, req.DNSNames)
+	add(connectivityprobe.ProbeTypeEgress, req.Egress)
+	return resp, nil
+}
+
+var _ = Describe("ConnectivityTest controller", func() {
+	var (
+		cli        client.Client
+		ctx        context.Context
+		r          Reconciler
+		mockStatus *status.MockStatus
+		prober     *fakeProber
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme)).NotTo(HaveOccurred())
+		Expect(appsv1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+		Expect(corev1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+		Expect(netv1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+		cli = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+		ctx = context.Background()
+
+		mockStatus = &status.MockStatus{}
+		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("SetMetaData", mock.Anything).Return()
+		mockStatus.On("AddDaemonsets", mock.Anything)
+		mockStatus.On("AddDeployments", mock.Anything)
+		mockStatus.On("AddStatefulSets", mock.Anything)
+		mockStatus.On("AddCronJobs", mock.Anything)
+		mockStatus.On("ReadyToMonitor")
+
+		prober = &fakeProber{failing: map[string]bool{}, requests: map[string]connectivityprobe.Request{}, tokens: map[string]string{}}
+		r = Reconciler{
+			cli:           cli,
+			scheme:        scheme,
+			status:        mockStatus,
+			clusterDomain: "cluster.local",
+			prober:        prober,
+		}
+
+		Expect(cli.Create(ctx, &operatorv1.Installation{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec:       operatorv1.InstallationSpec{Variant: operatorv1.Calico},
+			Status:     operatorv1.InstallationStatus{Variant: operatorv1.Calico},
+		})).NotTo(HaveOccurred())
+		Expect(cli.Create(ctx, &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubernetes", Namespace: "default"},
+			Spec: corev1.ServiceSpec{
+				ClusterIP: "10.96.0.1",
+				Ports:     []corev1.ServicePort{{Port: 443}},
+			},
+		})).NotTo(HaveOccurred())
+	})
+
+	// scheduleProbePods marks the probe DaemonSet as rolled out and creates a probe pod on each node.
+	scheduleProbePods := func(nodes ...string) {
+		ds := &appsv1.DaemonSet{}
+		Expect(cli.Get(ctx, types.NamespacedName{Name: connectivitytest.ProbeName, Namespace: connectivitytest.ProbeNamespace}, ds)).NotTo(HaveOccurred())
+		ds.Status.ObservedGeneration = ds.Generation
+		ds.Status.DesiredNumberScheduled = int32(len(nodes))
+		ds.Status.CurrentNumberScheduled = int32(len(nodes))
+		ds.Status.UpdatedNumberScheduled = int32(len(nodes))
+		Expect(cli.Status().Update(ctx, ds)).NotTo(HaveOccurred())
+
+		for i, node := range nodes {
+			Expect(cli.Create(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("%s-%s", connectivitytest.ProbeName, node),
+					Namespace: connectivitytest.ProbeNamespace,
+					Labels:    map[string]string{"k8s-app": connectivitytest.ProbeName},
+				},
+				Spec:   corev1.PodSpec{NodeName: node},
+				Status: corev1.PodStatus{PodIP: fmt.Sprintf("192.168.%d.1", i)},
+			})).NotTo(HaveOccurred())
+		}
+	}
+
+	getTest := func() *operatorv1.ConnectivityTest {
+		test := &operatorv1.ConnectivityTest{}
+		Expect(cli.Get(ctx, types.NamespacedName{Name: "default"}, test)).NotTo(HaveOccurred())
+		return test
+	}
+
+	It("should do nothing if there is no ConnectivityTest", func() {
+		mockStatus = &status.MockStatus{}
+		mockStatus.On("OnCRNotFound").Return()
+		r.status = mockStatus
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		mockStatus.AssertExpectations(GinkgoT())
+	})
+
+	It("should deploy the probe DaemonSet and wait for it to be scheduled", func() {
+		Expect(cli.Create(ctx, &operatorv1.ConnectivityTest{ObjectMeta: metav1.ObjectMeta{Name: "default"}})).NotTo(HaveOccurred())
+		mockStatus.On("SetDegraded", operatorv1.ResourceNotReady, "Waiting for connectivity probe pods to be scheduled", nil, mock.Anything).Return()
+
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceNotReady, "Waiting for connectivity probe pods to be scheduled", nil, mock.Anything)
+
+		ds := &appsv1.DaemonSet{}
+		Expect(cli.Get(ctx, types.NamespacedName{Name: connectivitytest.ProbeName, Namespace: connectivitytest.ProbeNamespace}, ds)).NotTo(HaveOccurred())
+		Expect(ds.OwnerReferences).To(HaveLen(1))
+		Expect(ds.OwnerReferences[0].Kind).To(Equal("ConnectivityTest"))
+		Expect(prober.requests).To(BeEmpty())
+	})
+
+	It("should test every pair of nodes and record the results", func() {
+		Expect(cli.Create(ctx, &operatorv1.ConnectivityTest{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec:       operatorv1.ConnectivityTestSpec{EgressTargets: []string{"8.8.8.8:53"}},
+		})).NotTo(HaveOccurred())
+		mockStatus.On("SetDegraded", operatorv1.ResourceNotReady, mock.Anything, nil, mock.Anything).Return().Once()
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		scheduleProbePods("node-a", "node-b", "node-c")
+
+		mockStatus.On("ClearDegraded").Return()
+		result, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(defaultInterval))
+		mockStatus.AssertCalled(GinkgoT(), "ClearDegraded")
+
+		Expect(prober.requests).To(HaveLen(3))
+		req := prober.requests["192.168.0.1:9098"]
+		Expect(req.Pods).To(ConsistOf("192.168.1.1:9098", "192.168.2.1:9098"))
+		Expect(req.Services).To(Equal([]string{"10.96.0.1:443"}))
+		Expect(req.DNSNames).To(Equal([]string{"kubernetes.default.svc.cluster.local"}))
+		Expect(req.Egress).To(Equal([]string{"8.8.8.8:53"}))
+		Expect(req.Timeout).To(Equal(defaultProbeTimeout))
+
+		test := getTest()
+		Expect(test.Status.Result).To(Equal(operatorv1.ConnectivityTestPassed))
+		Expect(test.Status.TestedNodes).To(Equal(int32(3)))
+		Expect(test.Status.FailedChecks).To(BeZero())
+		Expect(test.Status.LastRunTime).NotTo(BeNil())
+		Expect(test.Status.TestedNodePairs).To(Equal(int32(6)))
+		Expect(test.Status.TestedChecks).To(Equal(int32(9)))
+		Expect(test.Status.AverageNodePairLatencyMilliseconds).To(Equal(int64(1)))
+		Expect(test.Status.MaxNodePairLatencyMilliseconds).To(Equal(int64(1)))
+		Expect(test.Status.NodePairs).To(BeEmpty())
+		Expect(test.Status.Checks).To(BeEmpty())
+
+		// The probe requests are authenticated with the token from the generated secret.
+		secret := &corev1.Secret{}
+		Expect(cli.Get(ctx, types.NamespacedName{Name: connectivitytest.ProbeTokenSecretName, Namespace: common.OperatorNamespace()}, secret)).NotTo(HaveOccurred())
+		token := string(secret.Data[connectivitytest.ProbeTokenSecretKey])
+		Expect(token).NotTo(BeEmpty())
+		Expect(prober.tokens).To(HaveKeyWithValue("192.168.0.1:9098", token))
+	})
+
+	It("should degrade when checks fail", func() {
+		Expect(cli.Create(ctx, &operatorv1.ConnectivityTest{ObjectMeta: metav1.ObjectMeta{Name: "default"}})).NotTo(HaveOccurred())
+		mockStatus.On("SetDegraded", operatorv1.ResourceNotReady, mock.Anything, nil, mock.Anything).Return().Once()
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		scheduleProbePods("node-a", "node-b")
+
+		// The pod on node-b is unreachable from node-a, and can't be asked to probe anything.
+		prober.failing["192.168.1.1:9098"] = true
+		mockStatus.On("SetDegraded", operatorv1.ConnectivityTestFailure,
+			"4 of 6 connectivity checks failed: pod on node-a cannot reach pod on node-b: i/o timeout; "+
+				"pod on node-b cannot reach pod on node-a: unable to reach probe pod: connection refused; "+
+				"Service check of 10.96.0.1:443 from node-b failed: unable to reach probe pod: connection refused; ...",
+			nil, mock.Anything).Return()
+		_, err = r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ConnectivityTestFailure, mock.Anything, nil, mock.Anything)
+
+		test := getTest()
+		Expect(test.Status.Result).To(Equal(operatorv1.ConnectivityTestFailed))
+		Expect(test.Status.FailedChecks).To(Equal(int32(4)))
+		Expect(test.Status.NodePairs).To(Equal([]operatorv1.ConnectivityNodePairStatus{
+			{Source: "node-a", Destination: "node-b", Error: "i/o timeout"},
+			{Source: "node-b", Destination: "node-a", Error: "unable to reach probe pod: connection refused"},
+		}))
+		Expect(test.Status.Checks).To(HaveLen(2))
+	})
+
+	It("should only rerun the test when it is due or requested", func() {
+		Expect(cli.Create(ctx, &operatorv1.ConnectivityTest{ObjectMeta: metav1.ObjectMeta{Name: "default"}})).NotTo(HaveOccurred())
+		mockStatus.On("SetDegraded", operatorv1.ResourceNotReady, mock.Anything, nil, mock.Anything).Return().Once()
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		scheduleProbePods("node-a", "node-b")
+
+		mockStatus.On("ClearDegraded").Return()
+		_, err = r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prober.requests).To(HaveLen(2))
+		firstRun := getTest().Status.LastRunTime
+
+		// The test isn't due again yet.
+		prober.requests = map[string]connectivityprobe.Request{}
+		result, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prober.requests).To(BeEmpty())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 9*time.Minute))
+		Expect(result.RequeueAfter).To(BeNumerically("<=", defaultInterval))
+
+		// Changing runRequest starts a new run straight away.
+		test := getTest()
+		test.Spec.RunRequest = "after-upgrade"
+		Expect(cli.Update(ctx, test)).NotTo(HaveOccurred())
+		_, err = r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prober.requests).To(HaveLen(2))
+		test = getTest()
+		Expect(test.Status.ObservedRunRequest).To(Equal("after-upgrade"))
+		Expect(test.Status.LastRunTime.Before(firstRun)).To(BeFalse())
+	})
+
+	It("should reject an invalid ConnectivityTest", func() {
+		Expect(cli.Create(ctx, &operatorv1.ConnectivityTest{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec:       operatorv1.ConnectivityTestSpec{EgressTargets: []string{"example.com:443"}},
+		})).NotTo(HaveOccurred())
+		mockStatus.On("SetDegraded", operatorv1.InvalidConfigurationError, "Invalid ConnectivityTest provided", mock.Anything, mock.Anything).Return()
+
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.InvalidConfigurationError, "Invalid ConnectivityTest provided", mock.Anything, mock.Anything)
+		mockStatus.AssertNotCalled(GinkgoT(), "ReadyToMonitor")
+	})
+})
+
+var _ = Describe("validateConnectivityTest", func() {
+	It("should validate the spec", func() {
+		valid := &operatorv1.ConnectivityTest{Spec: operatorv1.ConnectivityTestSpec{
+			Interval:      &metav1.Duration{Duration: 5 * time.Minute},
+			ProbeTimeout:  &metav1.Duration{Duration: time.Second},
+			EgressTargets: []string{"1.1.1.1:443", "[2001:db8::1]:80"},
+		}}
+		Expect(validateConnectivityTest(valid)).NotTo(HaveOccurred())
+
+		for _, spec := range []operatorv1.ConnectivityTestSpec{
+			{EgressTargets: []string{"1.1.1.1"}},
+			{EgressTargets: []string{"example.com:443"}},
+			{Interval: &metav1.Duration{Duration: 30 * time.Second}},
+			{ProbeTimeout: &metav1.Duration{}},
+		} {
+			Expect(validateConnectivityTest(&operatorv1.ConnectivityTest{Spec: spec})).To(HaveOccurred())
+		}
+	})
+})
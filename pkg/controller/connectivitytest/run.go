@@ -0,0 +1,240 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectivitytest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/connectivityprobe"
+	"github.com/tigera/operator/pkg/controller/utils"
+	"github.com/tigera/operator/pkg/render/connectivitytest"
+)
+
+const (
+	// maxReportedFailures is the number of failures described in the TigeraStatus message.
+	maxReportedFailures = 3
+
+	// maxListedFailures is the number of failed node pairs, and of failed checks, listed in the ConnectivityTest
+	// status. Only the failures are listed, and only up to this many, as a result for every pair of nodes would not
+	// fit in the resource on large clusters.
+	maxListedFailures = 100
+)
+
+// probePod is the probe pod on a node. The address is empty if the pod hasn't been given an IP yet.
+type probePod struct {
+	node    string
+	address string
+}
+
+// probeTargets are the things to probe in a test run.
+type probeTargets struct {
+	// scheduled is true once a probe pod of the current DaemonSet spec is on every selected node.
+	scheduled bool
+	// pods are the probe pods, in node order.
+	pods []probePod
+	// services are the service addresses to connect to.
+	services []string
+}
+
+// probeTargets returns the probe pods and services to test.
+func (r *Reconciler) probeTargets(ctx context.Context) (*probeTargets, error) {
+	targets := &probeTargets{}
+
+	ds, err := utils.GetIfExists[appsv1.DaemonSet](ctx, types.NamespacedName{Name: connectivitytest.ProbeName, Namespace: connectivitytest.ProbeNamespace}, r.cli)
+	if err != nil {
+		return nil, err
+	} else if ds == nil {
+		return targets, nil
+	}
+	targets.scheduled = ds.Status.ObservedGeneration >= ds.Generation &&
+		ds.Status.DesiredNumberScheduled > 0 &&
+		ds.Status.CurrentNumberScheduled == ds.Status.DesiredNumberScheduled &&
+		ds.Status.UpdatedNumberScheduled == ds.Status.DesiredNumberScheduled
+
+	pods := &corev1.PodList{}
+	if err := r.cli.List(ctx, pods, client.InNamespace(connectivitytest.ProbeNamespace), client.MatchingLabels{"k8s-app": connectivitytest.ProbeName}); err != nil {
+		return nil, err
+	}
+	byNode := map[string]string{}
+	for _, p := range pods.Items {
+		if p.Spec.NodeName == "" || p.DeletionTimestamp != nil {
+			continue
+		}
+		if addr, ok := byNode[p.Spec.NodeName]; ok && addr != "" {
+			continue
+		}
+		byNode[p.Spec.NodeName] = ""
+		if p.Status.PodIP != "" {
+			byNode[p.Spec.NodeName] = net.JoinHostPort(p.Status.PodIP, strconv.Itoa(connectivityprobe.Port))
+		}
+	}
+	for node, addr := range byNode {
+		targets.pods = append(targets.pods, probePod{node: node, address: addr})
+	}
+	sort.Slice(targets.pods, func(i, j int) bool { return targets.pods[i].node < targets.pods[j].node })
+
+	// Probe the kubernetes service, which exists in every cluster.
+	svc, err := utils.GetIfExists[corev1.Service](ctx, types.NamespacedName{Name: "kubernetes", Namespace: "default"}, r.cli)
+	if err != nil {
+		return nil, err
+	}
+	if svc != nil && svc.Spec.ClusterIP != "" && svc.Spec.ClusterIP != corev1.ClusterIPNone && len(svc.Spec.Ports) > 0 {
+		targets.services = append(targets.services, net.JoinHostPort(svc.Spec.ClusterIP, strconv.Itoa(int(svc.Spec.Ports[0].Port))))
+	}
+	return targets, nil
+}
+
+// runTest asks every probe pod to probe the others and the given services, DNS names and egress targets, and
+// returns the results. The requests are authenticated with the given token.
+func (r *Reconciler) runTest(ctx context.Context, targets *probeTargets, token string, base connectivityprobe.Request) operatorv1.ConnectivityTestStatus {
+	type outcome struct {
+		resp *connectivityprobe.Response
+		err  error
+	}
+	outcomes := make([]outcome, len(targets.pods))
+
+	var wg sync.WaitGroup
+	for i, src := range targets.pods {
+		if src.address == "" {
+			outcomes[i].err = fmt.Errorf("probe pod has no IP address")
+			continue
+		}
+		req := base
+		req.Pods = nil
+		for _, dst := range targets.pods {
+			if dst.node != src.node && dst.address != "" {
+				req.Pods = append(req.Pods, dst.address)
+			}
+		}
+		wg.Add(1)
+		go func(i int, address string, req connectivityprobe.Request) {
+			defer wg.Done()
+			resp, err := r.prober.probe(ctx, address, token, req)
+			if err != nil {
+				err = fmt.Errorf("unable to reach probe pod: %w", err)
+			}
+			outcomes[i] = outcome{resp: resp, err: err}
+		}(i, src.address, req)
+	}
+	wg.Wait()
+
+	out := operatorv1.ConnectivityTestStatus{Result: operatorv1.ConnectivityTestPassed, TestedNodes: int32(len(targets.pods))}
+	var succeeded, totalLatency int64
+	for i, src := range targets.pods {
+		results := map[string]connectivityprobe.Result{}
+		if o := outcomes[i]; o.resp != nil {
+			for _, res := range o.resp.Results {
+				results[string(res.Type)+"/"+res.Target] = res
+			}
+		}
+		lookup := func(t connectivityprobe.ProbeType, target string) connectivityprobe.Result {
+			if err := outcomes[i].err; err != nil {
+				return connectivityprobe.Result{Error: err.Error()}
+			}
+			if res, ok := results[string(t)+"/"+target]; ok {
+				return res
+			}
+			return connectivityprobe.Result{Error: "no result reported"}
+		}
+
+		for _, dst := range targets.pods {
+			if dst.node == src.node {
+				continue
+			}
+			res := connectivityprobe.Result{Error: "destination probe pod has no IP address"}
+			if dst.address != "" {
+				res = lookup(connectivityprobe.ProbeTypePod, dst.address)
+			}
+			out.TestedNodePairs++
+			if res.Success {
+				latency := res.Latency.Milliseconds()
+				succeeded++
+				totalLatency += latency
+				if latency > out.MaxNodePairLatencyMilliseconds {
+					out.MaxNodePairLatencyMilliseconds = latency
+				}
+				continue
+			}
+			out.FailedChecks++
+			if len(out.NodePairs) < maxListedFailures {
+				out.NodePairs = append(out.NodePairs, operatorv1.ConnectivityNodePairStatus{
+					Source:              src.node,
+					Destination:         dst.node,
+					LatencyMilliseconds: res.Latency.Milliseconds(),
+					Error:               res.Error,
+				})
+			}
+		}
+
+		addChecks := func(t operatorv1.ConnectivityCheckType, pt connectivityprobe.ProbeType, targets []string) {
+			for _, target := range targets {
+				res := lookup(pt, target)
+				out.TestedChecks++
+				if res.Success {
+					continue
+				}
+				out.FailedChecks++
+				if len(out.Checks) < maxListedFailures {
+					out.Checks = append(out.Checks, operatorv1.ConnectivityCheckStatus{
+						Node:                src.node,
+						Type:                t,
+						Target:              target,
+						LatencyMilliseconds: res.Latency.Milliseconds(),
+						Error:               res.Error,
+					})
+				}
+			}
+		}
+		addChecks(operatorv1.ConnectivityCheckService, connectivityprobe.ProbeTypeService, base.Services)
+		addChecks(operatorv1.ConnectivityCheckDNS, connectivityprobe.ProbeTypeDNS, base.DNSNames)
+		addChecks(operatorv1.ConnectivityCheckEgress, connectivityprobe.ProbeTypeEgress, base.Egress)
+	}
+
+	if succeeded > 0 {
+		out.AverageNodePairLatencyMilliseconds = totalLatency / succeeded
+	}
+	if out.FailedChecks > 0 {
+		out.Result = operatorv1.ConnectivityTestFailed
+	}
+	return out
+}
+
+// failureSummary describes the failures in the given test results.
+func failureSummary(s operatorv1.ConnectivityTestStatus) string {
+	var failures []string
+	for _, p := range s.NodePairs {
+		failures = append(failures, fmt.Sprintf("pod on %s cannot reach pod on %s: %s", p.Source, p.Destination, p.Error))
+	}
+	for _, c := range s.Checks {
+		failures = append(failures, fmt.Sprintf("%s check of %s from %s failed: %s", c.Type, c.Target, c.Node, c.Error))
+	}
+	total := s.TestedNodePairs + s.TestedChecks
+	if len(failures) > maxReportedFailures {
+		failures = append(failures[:maxReportedFailures], "...")
+	}
+	return fmt.Sprintf("%d of %d connectivity checks failed: %s", s.FailedChecks, total, strings.Join(failures, "; "))
+}
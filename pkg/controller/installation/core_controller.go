@@ -23,6 +23,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -139,6 +140,11 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		return fmt.Errorf("failed to create tigera-installation-controller: %w", err)
 	}
 
+	// The health of the nodes in a staged dataplane migration is checked through the Calico pods on each node.
+	if err := mgr.GetFieldIndexer().IndexField(opts.ShutdownContext, &corev1.Pod{}, PodNodeNameField, PodNodeName); err != nil {
+		return fmt.Errorf("failed to index pods by node name: %w", err)
+	}
+
 	// Established deferred watches against the v3 API that should succeed after the Enterprise API Server becomes available.
 	if opts.EnterpriseCRDExists {
 		k8sClient, err := kubernetes.NewForConfig(mgr.GetConfig())
@@ -198,6 +204,7 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions) (*ReconcileInst
 		tierWatchReady:       &utils.ReadyFlag{},
 		newComponentHandler:  utils.NewComponentHandler,
 		whiskerCRDExists:     opts.WhiskerCRDExists,
+		checkPodConnectivity: dialPods,
 	}
 	r.status.Run(opts.ShutdownContext)
 	r.typhaAutoscaler.start(opts.ShutdownContext)
@@ -333,6 +340,12 @@ func add(c ctrlruntime.Controller, r *ReconcileInstallation) error {
 		return fmt.Errorf("tigera-installation-controller failed to watch IPPool resource: %w", err)
 	}
 
+	// Watch for new nodes and node label changes, which change the nodes that Felix node overrides apply to.
+	err = c.WatchObject(&corev1.Node{}, &handler.EnqueueRequestForObject{}, predicate.LabelChangedPredicate{})
+	if err != nil {
+		return fmt.Errorf("tigera-installation-controller failed to watch Node resource: %w", err)
+	}
+
 	// Perform periodic reconciliation. This acts as a backstop to catch reconcile issues,
 	// and also makes sure we spot when things change that might not trigger a reconciliation.
 	err = utils.AddPeriodicReconcile(c, utils.PeriodicReconcileTime, &handler.EnqueueRequestForObject{})
@@ -388,6 +401,9 @@ type ReconcileInstallation struct {
 	whiskerCRDExists     bool
 	// newComponentHandler returns a new component handler. Useful stub for unit testing.
 	newComponentHandler func(log logr.Logger, client client.Client, scheme *runtime.Scheme, cr metav1.Object) utils.ComponentHandler
+	// checkPodConnectivity checks that pods on nodes moved to another dataplane can be reached. If nil, connectivity
+	// is not checked. Useful stub for unit testing.
+	checkPodConnectivity podConnectivityChecker
 }
 
 // getActivePools returns the full set of enabled IP pools in the cluster.
@@ -1125,25 +1141,52 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		return reconcile.Result{}, err
 	}
 
+	var goldmaneRunning bool
+	// Goldmane can only be running if the variant is Calico and the Whisker CRD exists.
+	if instance.Spec.Variant == operator.Calico && r.whiskerCRDExists {
+		whiskerCR, err := utils.GetIfExists[operatorv1.Whisker](ctx, utils.DefaultInstanceKey, r.client)
+		if err != nil {
+			r.status.SetDegraded(operator.ResourceReadError, "Unable retrieve Whisker CR", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+		goldmaneRunning = whiskerCR != nil
+	}
+
 	// Set any non-default FelixConfiguration values that we need.
+	var felixConflicts []operator.FelixConfigurationConflict
 	felixConfiguration, err := utils.PatchFelixConfiguration(ctx, r.client, func(fc *crdv1.FelixConfiguration) (bool, error) {
+		// Apply the Felix settings configured on the Installation. This goes first so that they take the place of
+		// the defaults below.
+		u, conflicts := applyFelixSettings(instance, fc, goldmaneRunning)
+		felixConflicts = conflicts
+
 		// Configure defaults.
-		u, err := r.setDefaultsOnFelixConfiguration(ctx, instance, fc, reqLogger)
+		u2, err := r.setDefaultsOnFelixConfiguration(ctx, instance, fc, goldmaneRunning, reqLogger)
 		if err != nil {
 			return false, err
 		}
 
 		// Configure nftables mode.
-		u2, err := r.setNftablesMode(ctx, instance, fc, reqLogger)
+		u3, err := r.setNftablesMode(ctx, instance, fc, reqLogger)
 		if err != nil {
 			return false, err
 		}
-		return u || u2, nil
+
+		// Make sure Felix recognises the pod interfaces of secondary networks.
+		u4 := addSecondaryNetworkInterfacePrefixes(instance, fc)
+		return u || u2 || u3 || u4, nil
 	})
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
+	nodeConflicts, err := r.reconcileFelixNodeOverrides(ctx, instance)
+	if err != nil {
+		r.status.SetDegraded(operator.ResourceUpdateError, "Error updating per-node FelixConfigurations", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	felixConflicts = append(felixConflicts, nodeConflicts...)
+
 	// nodeReporterMetricsPort is a port used in Enterprise to host internal metrics.
 	// Operator is responsible for creating a service which maps to that port.
 	// Here, we'll check the default felixconfiguration to see if the user is specifying
@@ -1380,15 +1423,23 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		return reconcile.Result{}, err
 	}
 
-	var goldmaneRunning bool
-	// Goldmane can only be running if the variant is Calico and the Whisker CRD exists.
-	if instance.Spec.Variant == operator.Calico && r.whiskerCRDExists {
-		whiskerCR, err := utils.GetIfExists[operatorv1.Whisker](ctx, utils.DefaultInstanceKey, r.client)
-		if err != nil {
-			r.status.SetDegraded(operator.ResourceReadError, "Unable retrieve Whisker CR", err, reqLogger)
-			return reconcile.Result{}, err
+	nads, err := r.networkAttachmentDefinitions(ctx)
+	if err != nil {
+		r.status.SetDegraded(operator.ResourceReadError, "Unable to read NetworkAttachmentDefinitions", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	missingNADNamespaces, err := r.missingSecondaryNetworkNamespaces(ctx, instance)
+	if err != nil {
+		r.status.SetDegraded(operator.ResourceReadError, "Unable to read the namespaces of secondary networks", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	if len(missingNADNamespaces) > 0 {
+		var names []string
+		for ns := range missingNADNamespaces {
+			names = append(names, ns)
 		}
-		goldmaneRunning = whiskerCR != nil
+		sort.Strings(names)
+		reqLogger.Info("Not creating NetworkAttachmentDefinitions for secondary networks in namespaces that do not exist", "namespaces", names)
 	}
 
 	// Build a configuration for rendering calico/node.
@@ -1396,7 +1447,7 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		GoldmaneRunning:               goldmaneRunning,
 		K8sServiceEp:                  k8sapi.Endpoint,
 		Installation:                  &instance.Spec,
-		IPPools:                       crdPoolsToOperator(currentPools.Items),
+		IPPools:                       primaryPools(instance, crdPoolsToOperator(currentPools.Items)),
 		LogCollector:                  logCollector,
 		BirdTemplates:                 birdTemplates,
 		TLS:                           typhaNodeTLS,
@@ -1411,6 +1462,10 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		BindMode:                      bgpConfiguration.Spec.BindMode,
 		FelixPrometheusMetricsEnabled: utils.IsFelixPrometheusMetricsEnabled(felixConfiguration),
 		FelixPrometheusMetricsPort:    felixPrometheusMetricsPort,
+		BPFMigration:                  bpfMigrationInProgress(instance, felixConfiguration),
+		NetworkAttachmentDefinitions:  nads,
+
+		MissingSecondaryNetworkNamespaces: missingNADNamespaces,
 	}
 	components = append(components, render.Node(&nodeCfg))
 
@@ -1568,8 +1623,22 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		return reconcile.Result{}, err
 	}
 
-	// We can clear the degraded state now since as far as we know everything is in order.
-	r.status.ClearDegraded()
+	// Move the next batch of nodes over if a staged dataplane migration is in progress.
+	migrationRequeue, err := r.reconcileDataplaneMigration(ctx, instance, reqLogger)
+	if err != nil {
+		r.status.SetDegraded(operator.ResourceUpdateError, "Error migrating nodes to the configured dataplane", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	// Felix settings that could not be applied and a failed dataplane migration are the only problems left to report.
+	// Otherwise, we can clear the degraded state now since as far as we know everything is in order.
+	if len(felixConflicts) > 0 {
+		r.status.SetDegraded(operator.FelixSettingsConflict, felixConflictsMessage(felixConflicts), nil, reqLogger)
+	} else if msg, failed := dataplaneMigrationFailed(instance); failed {
+		r.status.SetDegraded(operator.DataplaneMigrationFailure, msg, nil, reqLogger)
+	} else {
+		r.status.ClearDegraded()
+	}
 
 	if !r.status.IsAvailable() {
 		// Schedule a kick to check again in the near future. Hopefully by then
@@ -1591,12 +1660,19 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		instance.Status.ImageSet = imageSet.Name
 	}
 	instance.Status.Computed = &instance.Spec
+	instance.Status.FelixConfigurationConflicts = felixConflicts
 	if err = r.client.Status().Update(ctx, instance); err != nil {
 		return reconcile.Result{}, err
 	}
 
+	requeue := migrationRequeue
+	if len(missingNADNamespaces) > 0 && (requeue == 0 || requeue > utils.StandardRetry) {
+		// Check again later, so that the NetworkAttachmentDefinitions are created once the namespaces are.
+		requeue = utils.StandardRetry
+	}
+
 	reqLogger.V(1).Info("Finished reconciling Installation")
-	return reconcile.Result{}, nil
+	return reconcile.Result{RequeueAfter: requeue}, nil
 }
 
 func readMTUFile() (int, error) {
@@ -1702,7 +1778,7 @@ func (r *ReconcileInstallation) setNftablesMode(_ context.Context, install *oper
 	// we don't need to handle upgrades from versions that were previously FelixConfiguration only - nftables mode has always
 	// been controlled by the operator.
 	if install.Spec.CalicoNetwork.LinuxDataplane != nil {
-		if *install.Spec.CalicoNetwork.LinuxDataplane == operatorv1.LinuxDataplaneNftables {
+		if globalDataplane(install, fc) == operatorv1.LinuxDataplaneNftables {
 			// The operator is configured to use the nftables dataplane. Configure Felix to use nftables.
 			nftablesMode := crdv1.NFTablesModeEnabled
 			fc.Spec.NFTablesMode = &nftablesMode
@@ -1722,7 +1798,7 @@ func (r *ReconcileInstallation) setNftablesMode(_ context.Context, install *oper
 
 // setDefaultOnFelixConfiguration will take the passed in fc and add any defaulting needed
 // based on the install config.
-func (r *ReconcileInstallation) setDefaultsOnFelixConfiguration(ctx context.Context, install *operator.Installation, fc *crdv1.FelixConfiguration, reqLogger logr.Logger) (bool, error) {
+func (r *ReconcileInstallation) setDefaultsOnFelixConfiguration(ctx context.Context, install *operator.Installation, fc *crdv1.FelixConfiguration, goldmaneRunning bool, reqLogger logr.Logger) (bool, error) {
 	updated := false
 
 	switch install.Spec.CNI.Type {
@@ -1756,14 +1832,17 @@ func (r *ReconcileInstallation) setDefaultsOnFelixConfiguration(ctx context.Cont
 
 	// Determine the felix health port to use. Prefer the configuration from FelixConfiguration,
 	// but default to 9099 (or 9199 on OpenShift). We will also write back whatever we select to FelixConfiguration.
-	felixHealthPort := 9099
-	if install.Spec.KubernetesProvider.IsOpenShift() {
-		felixHealthPort = 9199
-	}
+	felixHealthPort := defaultFelixHealthPort(install)
 	if fc.Spec.HealthPort == nil {
 		fc.Spec.HealthPort = &felixHealthPort
 		updated = true
 	}
+	// Goldmane expects flow logs more often than Felix sends them by default.
+	if goldmaneRunning && fc.Spec.FlowLogsFlushInterval == nil {
+		fc.Spec.FlowLogsFlushInterval = &metav1.Duration{Duration: goldmaneFlowLogsFlushInterval}
+		updated = true
+	}
+
 	vxlanVNI := 4096
 	// MKE uses a vxlanVNI:4096 and vxlanPort:4789 for its docker swarm vxlan.
 	// This results in a conflict with calico's VXLAN and the vxlan.calico interface
@@ -1864,7 +1943,9 @@ func (r *ReconcileInstallation) setDefaultsOnFelixConfiguration(ctx context.Cont
 func (r *ReconcileInstallation) setBPFUpdatesOnFelixConfiguration(ctx context.Context, install *operator.Installation, fc *crdv1.FelixConfiguration, reqLogger logr.Logger) (bool, error) {
 	updated := false
 
-	bpfEnabledOnInstall := install.Spec.BPFEnabled()
+	// During a staged dataplane migration, the default FelixConfiguration keeps the eBPF setting it currently has
+	// until every node has been migrated.
+	bpfEnabledOnInstall := globalDataplane(install, fc) == operator.LinuxDataplaneBPF
 	if bpfEnabledOnInstall {
 		ds := &appsv1.DaemonSet{}
 		err := r.client.Get(ctx, types.NamespacedName{Namespace: common.CalicoNamespace, Name: common.NodeDaemonSetName}, ds)
@@ -960,6 +960,46 @@ var _ = Describe("Testing core-controller installation", func() {
 			Expect(*fc.Spec.BPFEnabled).To(BeFalse())
 		})
 
+		It("should apply Felix settings from the Installation and report conflicts", func() {
+			healthPort := int32(9199)
+			debug := operator.FelixLogSeverityDebug
+			cr.Spec.FelixConfiguration = &operator.FelixConfigurationSpec{FelixSettings: operator.FelixSettings{
+				HealthPort:           &healthPort,
+				LogSeverityScreen:    &debug,
+				RouteRefreshInterval: &metav1.Duration{Duration: time.Minute},
+			}}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+
+			// The health port was defaulted by the operator, but the log severity was set by the user.
+			defaultedPort := 9099
+			Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.FelixConfigurationSpec{HealthPort: &defaultedPort, LogSeverityScreen: "Info"},
+			})).NotTo(HaveOccurred())
+
+			mockStatus.On("SetDegraded", operator.FelixSettingsConflict,
+				"1 Felix settings were not applied because they were set directly on the FelixConfiguration: FelixConfiguration default has logSeverityScreen Info, not Debug",
+				nil, mock.Anything).Return()
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operator.FelixSettingsConflict, mock.Anything, nil, mock.Anything)
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).NotTo(HaveOccurred())
+			Expect(*fc.Spec.HealthPort).To(Equal(9199))
+			Expect(fc.Spec.RouteRefreshInterval.Duration).To(Equal(time.Minute))
+			Expect(fc.Spec.LogSeverityScreen).To(Equal("Info"))
+			// The fields the operator writes itself are recorded alongside the Felix settings.
+			Expect(fc.Annotations[utils.FelixSettingsAnnotation]).To(MatchJSON(
+				`{"healthPort":"9199","routeRefreshInterval":"1m0s","bpfEnabled":"false","nftablesMode":"Disabled","vxlanVNI":"4096"}`))
+
+			inst := &operator.Installation{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, inst)).NotTo(HaveOccurred())
+			Expect(inst.Status.FelixConfigurationConflicts).To(Equal([]operator.FelixConfigurationConflict{
+				{Resource: "default", Field: "logSeverityScreen", Value: "Info", DesiredValue: "Debug"},
+			}))
+		})
+
 		It("should set vxlanPort to 4798 when provider is DockerEE", func() {
 			cr.Spec.KubernetesProvider = operator.ProviderDockerEE
 			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
@@ -0,0 +1,744 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package installation
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operator "github.com/tigera/operator/api/v1"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/controller/utils"
+	"github.com/tigera/operator/pkg/render"
+	"github.com/tigera/operator/pkg/render/connectivitytest"
+)
+
+const (
+	// DataplaneNodeLabel is set on every Linux node to the dataplane it runs while a staged dataplane migration is
+	// configured. When kube-proxy management is enabled, kube-proxy is kept off nodes labelled with BPF.
+	DataplaneNodeLabel = "operator.tigera.io/dataplane"
+
+	// dataplaneMigrationAnnotation marks the per-node FelixConfigurations used to move a node to another
+	// dataplane. Its value is the dataplane the node is moved to.
+	dataplaneMigrationAnnotation = "operator.tigera.io/dataplane-migration"
+
+	managedByLabel      = "app.kubernetes.io/managed-by"
+	managedByLabelValue = "tigera-operator"
+
+	defaultMigrationBatchSize           = 10
+	defaultMigrationPauseBetweenBatches = 5 * time.Minute
+	defaultMigrationBatchTimeout        = 10 * time.Minute
+
+	// migrationSettleTime is how long to wait after migrating a batch before checking its health, giving calico-node
+	// time to restart Felix with the new dataplane.
+	migrationSettleTime = 30 * time.Second
+
+	// migrationPollInterval is how often the health of the batch being migrated is checked.
+	migrationPollInterval = 10 * time.Second
+
+	// PodNodeNameField is the field index on the node name of pods.
+	PodNodeNameField = "spec.nodeName"
+
+	// maxPodDials is how many pods on a node are tried when checking that pods on it can be connected to, and
+	// podDialTimeout is how long each attempt may take.
+	maxPodDials    = 3
+	podDialTimeout = 5 * time.Second
+
+	kubeProxyNamespace = "kube-system"
+	kubeProxyName      = "kube-proxy"
+)
+
+// migratableDataplane returns true for the Linux dataplanes that nodes can be moved between using per-node
+// FelixConfiguration overrides.
+func migratableDataplane(dp operator.LinuxDataplaneOption) bool {
+	switch dp {
+	case operator.LinuxDataplaneIptables, operator.LinuxDataplaneNftables, operator.LinuxDataplaneBPF:
+		return true
+	}
+	return false
+}
+
+// dataplaneFromFelixConfiguration returns the Linux dataplane the given FelixConfiguration selects.
+func dataplaneFromFelixConfiguration(fc *crdv1.FelixConfiguration) operator.LinuxDataplaneOption {
+	if bpfEnabledOnFelixConfig(fc) {
+		return operator.LinuxDataplaneBPF
+	}
+	if fc.Spec.NFTablesMode != nil && *fc.Spec.NFTablesMode == crdv1.NFTablesModeEnabled {
+		return operator.LinuxDataplaneNftables
+	}
+	return operator.LinuxDataplaneIptables
+}
+
+// globalDataplane returns the Linux dataplane that the default FelixConfiguration should select. This is the
+// dataplane configured on the Installation, unless a staged dataplane migration is configured for an existing
+// install. In that case the default FelixConfiguration keeps the dataplane it currently selects, nodes are moved over
+// individually, and it is only switched once the migration has completed.
+func globalDataplane(install *operator.Installation, fc *crdv1.FelixConfiguration) operator.LinuxDataplaneOption {
+	cn := install.Spec.CalicoNetwork
+	if cn == nil || cn.LinuxDataplane == nil {
+		return ""
+	}
+	target := *cn.LinuxDataplane
+	if cn.DataplaneMigration == nil || !migratableDataplane(target) {
+		return target
+	}
+	if install.Status.Variant == "" {
+		// Nothing has been installed yet, so there are no nodes to migrate.
+		return target
+	}
+	if s := install.Status.DataplaneMigration; s != nil && s.To == target && s.Phase == operator.DataplaneMigrationCompleted {
+		return target
+	}
+	return dataplaneFromFelixConfiguration(fc)
+}
+
+// bpfMigrationInProgress returns true if a staged dataplane migration is configured and nodes may still be running
+// the eBPF dataplane even though the Installation no longer selects it.
+func bpfMigrationInProgress(install *operator.Installation, fc *crdv1.FelixConfiguration) bool {
+	return install.Spec.CalicoNetwork != nil &&
+		install.Spec.CalicoNetwork.DataplaneMigration != nil &&
+		!install.Spec.BPFEnabled() &&
+		bpfEnabledOnFelixConfig(fc)
+}
+
+// reconcileDataplaneMigration moves nodes from the dataplane selected by the default FelixConfiguration to the one
+// configured on the Installation, one batch at a time, and records its progress in the Installation status. It
+// returns how long to wait before the migration should be checked again.
+func (r *ReconcileInstallation) reconcileDataplaneMigration(ctx context.Context, install *operator.Installation, reqLogger logr.Logger) (time.Duration, error) {
+	cn := install.Spec.CalicoNetwork
+	if cn == nil || cn.LinuxDataplane == nil || cn.DataplaneMigration == nil || !migratableDataplane(*cn.LinuxDataplane) {
+		if install.Status.DataplaneMigration == nil {
+			return 0, nil
+		}
+		// Staged migration has been turned off. The default FelixConfiguration now selects the configured dataplane
+		// so any per-node overrides left behind are no longer needed.
+		overrides, err := r.dataplaneOverrides(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for _, fc := range overrides {
+			if err := r.removeDataplaneOverride(ctx, fc); err != nil {
+				return 0, err
+			}
+		}
+		return 0, nil
+	}
+	cfg := cn.DataplaneMigration
+	target := *cn.LinuxDataplane
+
+	fc, err := utils.GetFelixConfiguration(ctx, r.client)
+	if err != nil {
+		return 0, err
+	}
+	current := dataplaneFromFelixConfiguration(fc)
+
+	nodes := &corev1.NodeList{}
+	if err := r.client.List(ctx, nodes, client.MatchingLabels{"kubernetes.io/os": "linux"}); err != nil {
+		return 0, fmt.Errorf("unable to list nodes: %w", err)
+	}
+	sort.Slice(nodes.Items, func(i, j int) bool { return nodes.Items[i].Name < nodes.Items[j].Name })
+
+	overrides, err := r.dataplaneOverrides(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := r.ensureKubeProxyAffinity(ctx, cfg); err != nil {
+		return 0, err
+	}
+
+	st := install.Status.DataplaneMigration.DeepCopy()
+	now := metav1.Now()
+
+	if current == target {
+		// Either there is nothing to migrate, or the migration has completed and the default FelixConfiguration has
+		// been switched over. Per-node overrides are no longer needed.
+		for name, o := range overrides {
+			if err := r.removeDataplaneOverride(ctx, o); err != nil {
+				return 0, err
+			}
+			delete(overrides, name)
+		}
+		if err := r.labelNodesWithDataplane(ctx, nodes.Items, overrides, current); err != nil {
+			return 0, err
+		}
+		if st != nil && (st.Phase == operator.DataplaneMigrationProgressing || st.Phase == operator.DataplaneMigrationRollingBack) {
+			// The Installation was changed back to the dataplane the cluster is running before the migration finished.
+			st.Phase = operator.DataplaneMigrationCompleted
+			st.CurrentBatch = nil
+			st.BatchStartTime = nil
+			st.CompletionTime = &now
+			st.Message = fmt.Sprintf("Migration cancelled, all nodes are running the %s dataplane", current)
+		}
+		return 0, r.updateDataplaneMigrationStatus(ctx, install, st)
+	}
+
+	switch {
+	case st == nil || st.From != current || st.To != target:
+		reqLogger.Info("Starting staged dataplane migration", "from", current, "to", target)
+		st = &operator.DataplaneMigrationStatus{
+			Phase:              operator.DataplaneMigrationProgressing,
+			From:               current,
+			To:                 target,
+			StartTime:          &now,
+			ObservedGeneration: install.Generation,
+		}
+	case st.Phase == operator.DataplaneMigrationRolledBack:
+		if st.ObservedGeneration == install.Generation {
+			return 0, nil
+		}
+		reqLogger.Info("Retrying staged dataplane migration after rollback", "from", current, "to", target)
+		st = &operator.DataplaneMigrationStatus{
+			Phase:              operator.DataplaneMigrationProgressing,
+			From:               current,
+			To:                 target,
+			StartTime:          &now,
+			ObservedGeneration: install.Generation,
+		}
+	case st.Phase == operator.DataplaneMigrationCompleted:
+		// Every node has been migrated, waiting for the default FelixConfiguration to be switched over.
+		return utils.StandardRetry, nil
+	}
+
+	// Drop overrides for nodes that no longer exist, and any that target a different dataplane.
+	nodeNames := map[string]bool{}
+	for _, n := range nodes.Items {
+		nodeNames[n.Name] = true
+	}
+	for name, o := range overrides {
+		if !nodeNames[name] || o.Annotations[dataplaneMigrationAnnotation] != string(target) {
+			if err := r.removeDataplaneOverride(ctx, o); err != nil {
+				return 0, err
+			}
+			delete(overrides, name)
+		}
+	}
+	st.TotalNodes = int32(len(nodes.Items))
+	if st.Phase == operator.DataplaneMigrationRollingBack {
+		return r.rollBackDataplaneMigration(ctx, install, st, cfg, nodes.Items, overrides, current, now, reqLogger)
+	}
+	st.MigratedNodes = int32(len(overrides) - len(st.CurrentBatch))
+
+	if current == operator.LinuxDataplaneBPF || target == operator.LinuxDataplaneBPF {
+		// Nodes can only be moved to or from BPF once calico-node has the BPF filesystem mounted everywhere.
+		ds := &appsv1.DaemonSet{}
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: common.CalicoNamespace, Name: common.NodeDaemonSetName}, ds); err != nil {
+			return 0, err
+		}
+		if !isRolloutCompleteWithBPFVolumes(ds) {
+			st.Message = "Waiting for calico-node to be rolled out with the BPF volumes"
+			return utils.StandardRetry, r.updateDataplaneMigrationStatus(ctx, install, st)
+		}
+	}
+
+	if len(st.CurrentBatch) > 0 {
+		reason, err := r.unhealthyMigratedNode(ctx, st.CurrentBatch)
+		if err != nil {
+			return 0, err
+		}
+		elapsed := now.Sub(st.BatchStartTime.Time)
+		switch {
+		case reason == "" && elapsed >= migrationSettleTime:
+			st.MigratedNodes += int32(len(st.CurrentBatch))
+			st.CurrentBatch = nil
+			st.BatchStartTime = nil
+			st.LastBatchCompletionTime = &now
+			st.Message = fmt.Sprintf("Migrated %d of %d nodes to the %s dataplane", st.MigratedNodes, st.TotalNodes, target)
+		case elapsed > durationOrDefault(cfg.BatchTimeout, defaultMigrationBatchTimeout):
+			if reason == "" {
+				reason = "the batch"
+			}
+			reqLogger.Info("Rolling back staged dataplane migration", "from", current, "to", target, "reason", reason)
+			st.Phase = operator.DataplaneMigrationRollingBack
+			st.ObservedGeneration = install.Generation
+			st.RollbackReason = fmt.Sprintf("%s did not become healthy within %s", reason,
+				durationOrDefault(cfg.BatchTimeout, defaultMigrationBatchTimeout))
+			// The nodes of the failed batch are moved back first.
+			return r.moveBackDataplaneBatch(ctx, install, st, nodes.Items, overrides, current, st.CurrentBatch, now, reqLogger)
+		default:
+			st.Message = fmt.Sprintf("Waiting for nodes %s to become healthy on the %s dataplane", strings.Join(st.CurrentBatch, ", "), target)
+			return migrationPollInterval, r.updateDataplaneMigrationStatus(ctx, install, st)
+		}
+	}
+
+	var pending []corev1.Node
+	for _, n := range nodes.Items {
+		if _, ok := overrides[n.Name]; !ok {
+			pending = append(pending, n)
+		}
+	}
+	if len(pending) == 0 {
+		reqLogger.Info("Staged dataplane migration completed", "from", current, "to", target)
+		st.Phase = operator.DataplaneMigrationCompleted
+		st.CompletionTime = &now
+		st.Message = fmt.Sprintf("All nodes migrated to the %s dataplane", target)
+		return utils.StandardRetry, r.updateDataplaneMigrationStatus(ctx, install, st)
+	}
+
+	if st.LastBatchCompletionTime != nil {
+		pause := durationOrDefault(cfg.PauseBetweenBatches, defaultMigrationPauseBetweenBatches)
+		if wait := pause - now.Sub(st.LastBatchCompletionTime.Time); wait > 0 {
+			return wait, r.updateDataplaneMigrationStatus(ctx, install, st)
+		}
+	}
+
+	batch, err := nextMigrationBatch(cfg, pending, len(overrides) == 0)
+	if err != nil {
+		return 0, err
+	}
+	for _, n := range batch {
+		o, err := r.setDataplaneOverride(ctx, n.Name, target)
+		if err != nil {
+			return 0, err
+		}
+		overrides[n.Name] = o
+		st.CurrentBatch = append(st.CurrentBatch, n.Name)
+	}
+	if err := r.labelNodesWithDataplane(ctx, nodes.Items, overrides, current); err != nil {
+		return 0, err
+	}
+	st.BatchStartTime = &now
+	st.Message = fmt.Sprintf("Waiting for nodes %s to become healthy on the %s dataplane", strings.Join(st.CurrentBatch, ", "), target)
+	reqLogger.Info("Migrating nodes to new dataplane", "to", target, "nodes", st.CurrentBatch)
+	return migrationPollInterval, r.updateDataplaneMigrationStatus(ctx, install, st)
+}
+
+// rollBackDataplaneMigration moves the migrated nodes back to the dataplane selected by the default
+// FelixConfiguration, one batch at a time, waiting for each batch to become healthy again before moving on to the next.
+func (r *ReconcileInstallation) rollBackDataplaneMigration(ctx context.Context, install *operator.Installation, st *operator.DataplaneMigrationStatus, cfg *operator.DataplaneMigration, nodes []corev1.Node, overrides map[string]*crdv1.FelixConfiguration, current operator.LinuxDataplaneOption, now metav1.Time, reqLogger logr.Logger) (time.Duration, error) {
+	if len(st.CurrentBatch) > 0 {
+		reason, err := r.unhealthyMigratedNode(ctx, st.CurrentBatch)
+		if err != nil {
+			return 0, err
+		}
+		elapsed := now.Sub(st.BatchStartTime.Time)
+		timeout := durationOrDefault(cfg.BatchTimeout, defaultMigrationBatchTimeout)
+		if (reason != "" || elapsed < migrationSettleTime) && elapsed <= timeout {
+			st.Message = fmt.Sprintf("Waiting for nodes %s to become healthy on the %s dataplane again", strings.Join(st.CurrentBatch, ", "), current)
+			return migrationPollInterval, r.updateDataplaneMigrationStatus(ctx, install, st)
+		}
+		if reason != "" {
+			// There is no other dataplane to fall back to, so carry on moving the remaining nodes back.
+			reqLogger.Info("Nodes did not become healthy after being rolled back", "to", current, "reason", reason)
+		}
+		st.CurrentBatch = nil
+		st.BatchStartTime = nil
+	}
+
+	if len(overrides) == 0 {
+		reqLogger.Info("Staged dataplane migration rolled back", "to", current)
+		st.Phase = operator.DataplaneMigrationRolledBack
+		st.MigratedNodes = 0
+		st.CompletionTime = &now
+		st.Message = fmt.Sprintf("Rolled back to the %s dataplane: %s", current, st.RollbackReason)
+		return 0, r.updateDataplaneMigrationStatus(ctx, install, st)
+	}
+
+	var names []string
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	size := defaultMigrationBatchSize
+	if cfg.BatchSize != nil {
+		size = int(*cfg.BatchSize)
+	}
+	if size > len(names) {
+		size = len(names)
+	}
+	return r.moveBackDataplaneBatch(ctx, install, st, nodes, overrides, current, names[:size], now, reqLogger)
+}
+
+// moveBackDataplaneBatch removes the dataplane overrides of the given nodes, moving them back to the dataplane
+// selected by the default FelixConfiguration, and records them as the batch being rolled back.
+func (r *ReconcileInstallation) moveBackDataplaneBatch(ctx context.Context, install *operator.Installation, st *operator.DataplaneMigrationStatus, nodes []corev1.Node, overrides map[string]*crdv1.FelixConfiguration, current operator.LinuxDataplaneOption, batch []string, now metav1.Time, reqLogger logr.Logger) (time.Duration, error) {
+	for _, name := range batch {
+		o, ok := overrides[name]
+		if !ok {
+			continue
+		}
+		if err := r.removeDataplaneOverride(ctx, o); err != nil {
+			return 0, err
+		}
+		delete(overrides, name)
+	}
+	if err := r.labelNodesWithDataplane(ctx, nodes, overrides, current); err != nil {
+		return 0, err
+	}
+	st.MigratedNodes = int32(len(overrides))
+	st.CurrentBatch = batch
+	st.BatchStartTime = &now
+	st.Message = fmt.Sprintf("Rolling back nodes %s to the %s dataplane: %s", strings.Join(batch, ", "), current, st.RollbackReason)
+	reqLogger.Info("Moving nodes back to previous dataplane", "to", current, "nodes", batch)
+	return migrationPollInterval, r.updateDataplaneMigrationStatus(ctx, install, st)
+}
+
+// dataplaneMigrationFailed returns the status message and true if the staged dataplane migration to the dataplane
+// currently configured on the Installation is being, or has been, rolled back.
+func dataplaneMigrationFailed(install *operator.Installation) (string, bool) {
+	cn := install.Spec.CalicoNetwork
+	st := install.Status.DataplaneMigration
+	if cn == nil || cn.LinuxDataplane == nil || cn.DataplaneMigration == nil || st == nil || st.To != *cn.LinuxDataplane {
+		return "", false
+	}
+	if st.Phase != operator.DataplaneMigrationRollingBack && st.Phase != operator.DataplaneMigrationRolledBack {
+		return "", false
+	}
+	return st.Message, true
+}
+
+// nextMigrationBatch picks the nodes to migrate next from the given pending nodes, which are sorted by name. The
+// canary nodes make up the first batch, if any are selected.
+func nextMigrationBatch(cfg *operator.DataplaneMigration, pending []corev1.Node, first bool) ([]corev1.Node, error) {
+	if first && cfg.CanaryNodeSelector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(cfg.CanaryNodeSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid canaryNodeSelector: %w", err)
+		}
+		var canaries []corev1.Node
+		for _, n := range pending {
+			if sel.Matches(labels.Set(n.Labels)) {
+				canaries = append(canaries, n)
+			}
+		}
+		if len(canaries) > 0 {
+			return canaries, nil
+		}
+	}
+
+	size := defaultMigrationBatchSize
+	if cfg.BatchSize != nil {
+		size = int(*cfg.BatchSize)
+	}
+	if size > len(pending) {
+		size = len(pending)
+	}
+	return pending[:size], nil
+}
+
+// unhealthyMigratedNode returns a description of the first of the given nodes that is not healthy, or an empty
+// string if they all are. Only Calico's own pods are considered, so that unrelated workloads can't trigger a rollback:
+// a node is healthy if it is Ready, its calico-node pod is Ready, and its connectivity test probe pod, if there is
+// one, can be connected to through the pod network.
+func (r *ReconcileInstallation) unhealthyMigratedNode(ctx context.Context, nodeNames []string) (string, error) {
+	for _, name := range nodeNames {
+		node := &corev1.Node{}
+		if err := r.client.Get(ctx, types.NamespacedName{Name: name}, node); err != nil {
+			if apierrors.IsNotFound(err) {
+				// The node has gone away, there is nothing left to check.
+				continue
+			}
+			return "", err
+		}
+		if !nodeReady(node) {
+			return fmt.Sprintf("node %s", name), nil
+		}
+
+		calicoNodePods, err := r.podsOnNode(ctx, name, render.CalicoNodeObjectName)
+		if err != nil {
+			return "", err
+		}
+		if len(calicoNodePods) == 0 || !podReady(&calicoNodePods[0]) {
+			return fmt.Sprintf("calico-node on node %s", name), nil
+		}
+
+		probePods, err := r.podsOnNode(ctx, name, connectivitytest.ProbeName)
+		if err != nil {
+			return "", err
+		}
+		var dialable []corev1.Pod
+		for _, p := range probePods {
+			if p.Status.Phase == corev1.PodRunning && p.Status.PodIP != "" && podTCPPort(&p) != 0 {
+				dialable = append(dialable, p)
+			}
+		}
+		if r.checkPodConnectivity != nil && len(dialable) > 0 {
+			if err := r.checkPodConnectivity(ctx, dialable); err != nil {
+				return fmt.Sprintf("pod connectivity on node %s (%v)", name, err), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// podsOnNode returns the pods of the given Calico component on the given node, using the pod node name index rather
+// than listing every pod in the cluster.
+func (r *ReconcileInstallation) podsOnNode(ctx context.Context, nodeName, k8sApp string) ([]corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := r.client.List(ctx, pods,
+		client.InNamespace(common.CalicoNamespace),
+		client.MatchingLabels{"k8s-app": k8sApp},
+		client.MatchingFields{PodNodeNameField: nodeName},
+	); err != nil {
+		return nil, fmt.Errorf("unable to list %s pods on node %s: %w", k8sApp, nodeName, err)
+	}
+	return pods.Items, nil
+}
+
+// PodNodeName indexes pods by the name of the node they are scheduled on.
+func PodNodeName(o client.Object) []string {
+	pod, ok := o.(*corev1.Pod)
+	if !ok || pod.Spec.NodeName == "" {
+		return nil
+	}
+	return []string{pod.Spec.NodeName}
+}
+
+// podConnectivityChecker returns an error if none of the given pods can be connected to.
+type podConnectivityChecker func(ctx context.Context, pods []corev1.Pod) error
+
+// dialPods opens a TCP connection to the first TCP port of each of the given pods in turn, up to maxPodDials of them,
+// and succeeds as soon as one connection is established. The operator runs on the host network, so this exercises
+// the dataplane of the node the pods are running on.
+func dialPods(ctx context.Context, pods []corev1.Pod) error {
+	var err error
+	dialer := net.Dialer{Timeout: podDialTimeout}
+	for i := range pods {
+		if i == maxPodDials {
+			break
+		}
+		addr := net.JoinHostPort(pods[i].Status.PodIP, strconv.Itoa(int(podTCPPort(&pods[i]))))
+		var conn net.Conn
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+		if err == nil {
+			_ = conn.Close()
+			return nil
+		}
+		err = fmt.Errorf("unable to connect to pod %s/%s at %s: %w", pods[i].Namespace, pods[i].Name, addr, err)
+	}
+	return err
+}
+
+// podTCPPort returns the first TCP port exposed by a container of the given pod, or 0 if there is none.
+func podTCPPort(pod *corev1.Pod) int32 {
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Protocol == "" || p.Protocol == corev1.ProtocolTCP {
+				return p.ContainerPort
+			}
+		}
+	}
+	return 0
+}
+
+func nodeReady(node *corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func durationOrDefault(d *metav1.Duration, def time.Duration) time.Duration {
+	if d == nil {
+		return def
+	}
+	return d.Duration
+}
+
+// dataplaneOverrides returns the per-node FelixConfigurations used for dataplane migration, keyed by node name.
+func (r *ReconcileInstallation) dataplaneOverrides(ctx context.Context) (map[string]*crdv1.FelixConfiguration, error) {
+	fcs := &crdv1.FelixConfigurationList{}
+	if err := r.client.List(ctx, fcs); err != nil {
+		return nil, fmt.Errorf("unable to list FelixConfigurations: %w", err)
+	}
+	overrides := map[string]*crdv1.FelixConfiguration{}
+	for i := range fcs.Items {
+		fc := &fcs.Items[i]
+		if _, ok := fc.Annotations[dataplaneMigrationAnnotation]; !ok || !strings.HasPrefix(fc.Name, "node.") {
+			continue
+		}
+		overrides[strings.TrimPrefix(fc.Name, "node.")] = fc
+	}
+	return overrides, nil
+}
+
+// setDataplaneOverride configures the per-node FelixConfiguration of the given node to select the given dataplane,
+// creating it if it doesn't exist.
+func (r *ReconcileInstallation) setDataplaneOverride(ctx context.Context, nodeName string, dp operator.LinuxDataplaneOption) (*crdv1.FelixConfiguration, error) {
+	fc := &crdv1.FelixConfiguration{}
+	err := r.client.Get(ctx, types.NamespacedName{Name: "node." + nodeName}, fc)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	exists := err == nil
+	patchFrom := client.MergeFrom(fc.DeepCopy())
+
+	bpfEnabled := dp == operator.LinuxDataplaneBPF
+	nftablesMode := crdv1.NFTablesModeDisabled
+	if dp == operator.LinuxDataplaneNftables {
+		nftablesMode = crdv1.NFTablesModeEnabled
+	}
+	fc.Spec.BPFEnabled = &bpfEnabled
+	fc.Spec.NFTablesMode = &nftablesMode
+	if fc.Annotations == nil {
+		fc.Annotations = map[string]string{}
+	}
+	fc.Annotations[dataplaneMigrationAnnotation] = string(dp)
+
+	if exists {
+		if err := r.client.Patch(ctx, fc, patchFrom); err != nil {
+			return nil, err
+		}
+		return fc, nil
+	}
+	fc.Name = "node." + nodeName
+	fc.Labels = map[string]string{managedByLabel: managedByLabelValue}
+	if err := r.client.Create(ctx, fc); err != nil {
+		return nil, err
+	}
+	return fc, nil
+}
+
+// removeDataplaneOverride deletes a per-node FelixConfiguration created for dataplane migration, or reverts the
+// dataplane settings on one that existed beforehand or that also holds Felix settings from the Installation.
+func (r *ReconcileInstallation) removeDataplaneOverride(ctx context.Context, fc *crdv1.FelixConfiguration) error {
+	if _, ok := fc.Annotations[utils.FelixSettingsAnnotation]; !ok && fc.Labels[managedByLabel] == managedByLabelValue {
+		if err := r.client.Delete(ctx, fc); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+	patchFrom := client.MergeFrom(fc.DeepCopy())
+	fc.Spec.BPFEnabled = nil
+	fc.Spec.NFTablesMode = nil
+	delete(fc.Annotations, dataplaneMigrationAnnotation)
+	return r.client.Patch(ctx, fc, patchFrom)
+}
+
+// labelNodesWithDataplane sets the dataplane label on each node to the dataplane its override selects, or to the
+// given default for nodes without an override.
+func (r *ReconcileInstallation) labelNodesWithDataplane(ctx context.Context, nodes []corev1.Node, overrides map[string]*crdv1.FelixConfiguration, def operator.LinuxDataplaneOption) error {
+	for i := range nodes {
+		n := &nodes[i]
+		dp := string(def)
+		if o, ok := overrides[n.Name]; ok {
+			dp = o.Annotations[dataplaneMigrationAnnotation]
+		}
+		if n.Labels[DataplaneNodeLabel] == dp {
+			continue
+		}
+		patchFrom := client.MergeFrom(n.DeepCopy())
+		if n.Labels == nil {
+			n.Labels = map[string]string{}
+		}
+		n.Labels[DataplaneNodeLabel] = dp
+		if err := r.client.Patch(ctx, n, patchFrom); err != nil {
+			return fmt.Errorf("unable to label node %s: %w", n.Name, err)
+		}
+	}
+	return nil
+}
+
+// ensureKubeProxyAffinity keeps kube-proxy off nodes running the eBPF dataplane when kube-proxy management is
+// enabled. It does nothing if there is no kube-proxy DaemonSet.
+func (r *ReconcileInstallation) ensureKubeProxyAffinity(ctx context.Context, cfg *operator.DataplaneMigration) error {
+	if cfg.KubeProxyManagement == nil || *cfg.KubeProxyManagement != operator.KubeProxyManagementEnabled {
+		return nil
+	}
+	ds := &appsv1.DaemonSet{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: kubeProxyNamespace, Name: kubeProxyName}, ds); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	patchFrom := client.MergeFrom(ds.DeepCopy())
+	requirement := corev1.NodeSelectorRequirement{
+		Key:      DataplaneNodeLabel,
+		Operator: corev1.NodeSelectorOpNotIn,
+		Values:   []string{string(operator.LinuxDataplaneBPF)},
+	}
+	spec := &ds.Spec.Template.Spec
+	if spec.Affinity == nil {
+		spec.Affinity = &corev1.Affinity{}
+	}
+	if spec.Affinity.NodeAffinity == nil {
+		spec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	na := spec.Affinity.NodeAffinity
+	if na.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		na.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+	terms := na.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	if len(terms) == 0 {
+		terms = []corev1.NodeSelectorTerm{{}}
+	}
+	updated := false
+	for i := range terms {
+		found := false
+		for _, req := range terms[i].MatchExpressions {
+			if reflect.DeepEqual(req, requirement) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			terms[i].MatchExpressions = append(terms[i].MatchExpressions, requirement)
+			updated = true
+		}
+	}
+	if !updated {
+		return nil
+	}
+	na.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms = terms
+	if err := r.client.Patch(ctx, ds, patchFrom); err != nil {
+		return fmt.Errorf("unable to update kube-proxy node affinity: %w", err)
+	}
+	return nil
+}
+
+// updateDataplaneMigrationStatus writes the given migration status to the Installation straight away, so that
+// progress is not lost if the rest of the reconcile doesn't get as far as writing the status.
+func (r *ReconcileInstallation) updateDataplaneMigrationStatus(ctx context.Context, install *operator.Installation, st *operator.DataplaneMigrationStatus) error {
+	if reflect.DeepEqual(install.Status.DataplaneMigration, st) {
+		return nil
+	}
+	obj := install.DeepCopy()
+	patchFrom := client.MergeFrom(obj.DeepCopy())
+	obj.Status.DataplaneMigration = st
+	if err := r.client.Status().Patch(ctx, obj, patchFrom); err != nil {
+		return fmt.Errorf("unable to update dataplane migration status: %w", err)
+	}
+	install.Status.DataplaneMigration = st
+	install.ResourceVersion = obj.ResourceVersion
+	return nil
+}
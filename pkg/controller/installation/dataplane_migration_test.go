@@ -0,0 +1,441 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package installation
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	operator "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	"github.com/tigera/operator/pkg/common"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/render"
+	"github.com/tigera/operator/pkg/render/connectivitytest"
+)
+
+var _ = Describe("Staged dataplane migration", func() {
+	var (
+		c        client.Client
+		ctx      context.Context
+		r        *ReconcileInstallation
+		instance *operator.Installation
+	)
+
+	readyNode := func(name string, labels map[string]string) *corev1.Node {
+		l := map[string]string{"kubernetes.io/os": "linux"}
+		for k, v := range labels {
+			l[k] = v
+		}
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: l},
+			Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			}},
+		}
+	}
+
+	calicoNodePod := func(node string, ready bool) *corev1.Pod {
+		status := corev1.ConditionFalse
+		if ready {
+			status = corev1.ConditionTrue
+		}
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "calico-node-" + node,
+				Namespace: common.CalicoNamespace,
+				Labels:    map[string]string{"k8s-app": render.CalicoNodeObjectName},
+			},
+			Spec: corev1.PodSpec{NodeName: node},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+			},
+		}
+	}
+
+	getInstallation := func() *operator.Installation {
+		i := &operator.Installation{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, i)).NotTo(HaveOccurred())
+		// The reconciler works on the defaulted spec, which is never written back.
+		i.Spec = instance.Spec
+		return i
+	}
+
+	// backdate moves the batch and pause timestamps of the stored migration status into the past.
+	backdate := func(d time.Duration) {
+		i := getInstallation()
+		patchFrom := client.MergeFrom(i.DeepCopy())
+		st := i.Status.DataplaneMigration
+		if st.BatchStartTime != nil {
+			st.BatchStartTime = &metav1.Time{Time: st.BatchStartTime.Add(-d)}
+		}
+		if st.LastBatchCompletionTime != nil {
+			st.LastBatchCompletionTime = &metav1.Time{Time: st.LastBatchCompletionTime.Add(-d)}
+		}
+		Expect(c.Status().Patch(ctx, i, patchFrom)).NotTo(HaveOccurred())
+	}
+
+	migrate := func() time.Duration {
+		requeue, err := r.reconcileDataplaneMigration(ctx, getInstallation(), logf.Log)
+		Expect(err).NotTo(HaveOccurred())
+		return requeue
+	}
+
+	status := func() *operator.DataplaneMigrationStatus {
+		return getInstallation().Status.DataplaneMigration
+	}
+
+	override := func(node string) *crdv1.FelixConfiguration {
+		fc := &crdv1.FelixConfiguration{}
+		err := c.Get(ctx, types.NamespacedName{Name: "node." + node}, fc)
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		Expect(err).NotTo(HaveOccurred())
+		return fc
+	}
+
+	nodeLabel := func(name string) string {
+		n := &corev1.Node{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: name}, n)).NotTo(HaveOccurred())
+		return n.Labels[DataplaneNodeLabel]
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme)).NotTo(HaveOccurred())
+		Expect(appsv1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+		Expect(corev1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+		c = ctrlrfake.DefaultFakeClientBuilder(scheme).WithIndex(&corev1.Pod{}, PodNodeNameField, PodNodeName).Build()
+		r = &ReconcileInstallation{client: c, scheme: scheme}
+
+		bpf := operator.LinuxDataplaneBPF
+		batchSize := int32(2)
+		kubeProxy := operator.KubeProxyManagementEnabled
+		instance = &operator.Installation{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Generation: 1},
+			Spec: operator.InstallationSpec{
+				CalicoNetwork: &operator.CalicoNetworkSpec{
+					LinuxDataplane: &bpf,
+					DataplaneMigration: &operator.DataplaneMigration{
+						CanaryNodeSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+						BatchSize:           &batchSize,
+						KubeProxyManagement: &kubeProxy,
+					},
+				},
+			},
+			Status: operator.InstallationStatus{Variant: operator.Calico},
+		}
+		Expect(c.Create(ctx, instance)).NotTo(HaveOccurred())
+
+		disabled := crdv1.NFTablesModeDisabled
+		Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec:       crdv1.FelixConfigurationSpec{NFTablesMode: &disabled},
+		})).NotTo(HaveOccurred())
+
+		Expect(c.Create(ctx, &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: common.NodeDaemonSetName, Namespace: common.CalicoNamespace},
+			Spec: appsv1.DaemonSetSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Volumes: []corev1.Volume{{Name: render.BPFVolumeName}},
+			}}},
+			Status: appsv1.DaemonSetStatus{CurrentNumberScheduled: 3, UpdatedNumberScheduled: 3, NumberAvailable: 3},
+		})).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-proxy", Namespace: "kube-system"},
+		})).NotTo(HaveOccurred())
+
+		for _, n := range []*corev1.Node{
+			readyNode("node-a", nil),
+			readyNode("node-b", nil),
+			readyNode("node-c", map[string]string{"canary": "true"}),
+		} {
+			Expect(c.Create(ctx, n)).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, calicoNodePod(n.Name, true))).NotTo(HaveOccurred())
+		}
+	})
+
+	It("keeps the default FelixConfiguration on the running dataplane until the migration completes", func() {
+		fc := &crdv1.FelixConfiguration{}
+		Expect(globalDataplane(instance, fc)).To(Equal(operator.LinuxDataplaneIptables))
+
+		instance.Status.DataplaneMigration = &operator.DataplaneMigrationStatus{
+			Phase: operator.DataplaneMigrationCompleted,
+			From:  operator.LinuxDataplaneIptables,
+			To:    operator.LinuxDataplaneBPF,
+		}
+		Expect(globalDataplane(instance, fc)).To(Equal(operator.LinuxDataplaneBPF))
+
+		By("switching straight away on a fresh install")
+		instance.Status = operator.InstallationStatus{}
+		Expect(globalDataplane(instance, fc)).To(Equal(operator.LinuxDataplaneBPF))
+
+		By("switching straight away without staged migration")
+		instance.Status.Variant = operator.Calico
+		instance.Spec.CalicoNetwork.DataplaneMigration = nil
+		Expect(globalDataplane(instance, fc)).To(Equal(operator.LinuxDataplaneBPF))
+	})
+
+	It("migrates the canary nodes first, then the rest in batches", func() {
+		Expect(migrate()).To(Equal(migrationPollInterval))
+		st := status()
+		Expect(st.Phase).To(Equal(operator.DataplaneMigrationProgressing))
+		Expect(st.From).To(Equal(operator.LinuxDataplaneIptables))
+		Expect(st.To).To(Equal(operator.LinuxDataplaneBPF))
+		Expect(st.TotalNodes).To(Equal(int32(3)))
+		Expect(st.CurrentBatch).To(Equal([]string{"node-c"}))
+		Expect(*override("node-c").Spec.BPFEnabled).To(BeTrue())
+		Expect(override("node-a")).To(BeNil())
+		Expect(nodeLabel("node-c")).To(Equal("BPF"))
+		Expect(nodeLabel("node-a")).To(Equal("Iptables"))
+
+		kubeProxy := &appsv1.DaemonSet{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "kube-proxy", Namespace: "kube-system"}, kubeProxy)).NotTo(HaveOccurred())
+		Expect(kubeProxy.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(
+			corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{{
+				Key: DataplaneNodeLabel, Operator: corev1.NodeSelectorOpNotIn, Values: []string{"BPF"},
+			}}},
+		))
+
+		By("waiting for the batch to settle before checking its health")
+		Expect(migrate()).To(Equal(migrationPollInterval))
+		Expect(status().CurrentBatch).To(Equal([]string{"node-c"}))
+
+		By("completing the batch once it is healthy and pausing before the next")
+		backdate(time.Minute)
+		requeue := migrate()
+		Expect(requeue).To(BeNumerically(">", 4*time.Minute))
+		st = status()
+		Expect(st.CurrentBatch).To(BeEmpty())
+		Expect(st.MigratedNodes).To(Equal(int32(1)))
+		Expect(st.LastBatchCompletionTime).NotTo(BeNil())
+
+		Expect(migrate()).To(BeNumerically(">", 4*time.Minute))
+		Expect(override("node-a")).To(BeNil())
+
+		By("starting the next batch after the pause")
+		backdate(6 * time.Minute)
+		migrate()
+		Expect(status().CurrentBatch).To(Equal([]string{"node-a", "node-b"}))
+		Expect(override("node-a")).NotTo(BeNil())
+		Expect(override("node-b")).NotTo(BeNil())
+
+		By("completing the migration once every node has been migrated")
+		backdate(time.Minute)
+		migrate()
+		Expect(status().MigratedNodes).To(Equal(int32(3)))
+		migrate()
+		Expect(status().Phase).To(Equal(operator.DataplaneMigrationCompleted))
+		Expect(globalDataplane(getInstallation(), &crdv1.FelixConfiguration{})).To(Equal(operator.LinuxDataplaneBPF))
+
+		By("removing the overrides once the default FelixConfiguration has been switched")
+		fc := &crdv1.FelixConfiguration{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).NotTo(HaveOccurred())
+		Expect(setBPFEnabledOnFelixConfiguration(fc, true)).NotTo(HaveOccurred())
+		Expect(c.Update(ctx, fc)).NotTo(HaveOccurred())
+		migrate()
+		for _, n := range []string{"node-a", "node-b", "node-c"} {
+			Expect(override(n)).To(BeNil())
+			Expect(nodeLabel(n)).To(Equal("BPF"))
+		}
+	})
+
+	It("rolls back when a batch does not become healthy in time", func() {
+		Expect(c.Delete(ctx, calicoNodePod("node-c", true))).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, calicoNodePod("node-c", false))).NotTo(HaveOccurred())
+
+		migrate()
+		backdate(time.Minute)
+		Expect(migrate()).To(Equal(migrationPollInterval))
+		Expect(status().Message).To(ContainSubstring("node-c"))
+
+		backdate(10 * time.Minute)
+		Expect(migrate()).To(Equal(migrationPollInterval))
+		st := status()
+		Expect(st.Phase).To(Equal(operator.DataplaneMigrationRollingBack))
+		Expect(st.RollbackReason).To(ContainSubstring("calico-node on node node-c"))
+		Expect(st.CurrentBatch).To(Equal([]string{"node-c"}))
+		Expect(override("node-c")).To(BeNil())
+		Expect(nodeLabel("node-c")).To(Equal("Iptables"))
+
+		By("reporting the failed migration as degraded")
+		msg, failed := dataplaneMigrationFailed(getInstallation())
+		Expect(failed).To(BeTrue())
+		Expect(msg).To(ContainSubstring("calico-node on node node-c"))
+
+		By("finishing the rollback once there are no migrated nodes left")
+		backdate(11 * time.Minute)
+		Expect(migrate()).To(BeZero())
+		st = status()
+		Expect(st.Phase).To(Equal(operator.DataplaneMigrationRolledBack))
+		Expect(st.Message).To(ContainSubstring("calico-node on node node-c"))
+		_, failed = dataplaneMigrationFailed(getInstallation())
+		Expect(failed).To(BeTrue())
+
+		By("not retrying until the Installation changes")
+		Expect(migrate()).To(BeZero())
+		Expect(status().Phase).To(Equal(operator.DataplaneMigrationRolledBack))
+
+		i := getInstallation()
+		i.Generation = 2
+		instance.Generation = 2
+		_, err := r.reconcileDataplaneMigration(ctx, i, logf.Log)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status().Phase).To(Equal(operator.DataplaneMigrationProgressing))
+		Expect(status().CurrentBatch).To(Equal([]string{"node-c"}))
+		_, failed = dataplaneMigrationFailed(getInstallation())
+		Expect(failed).To(BeFalse())
+	})
+
+	It("moves the migrated nodes back in batches", func() {
+		batchSize := int32(1)
+		instance.Spec.CalicoNetwork.DataplaneMigration.BatchSize = &batchSize
+		instance.Spec.CalicoNetwork.DataplaneMigration.CanaryNodeSelector = nil
+		Expect(c.Delete(ctx, calicoNodePod("node-c", true))).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, calicoNodePod("node-c", false))).NotTo(HaveOccurred())
+
+		for _, n := range []string{"node-a", "node-b", "node-c"} {
+			migrate()
+			Expect(status().CurrentBatch).To(Equal([]string{n}))
+			backdate(time.Minute)
+			migrate()
+			backdate(6 * time.Minute)
+		}
+		backdate(5 * time.Minute)
+		migrate()
+		st := status()
+		Expect(st.Phase).To(Equal(operator.DataplaneMigrationRollingBack))
+		Expect(st.CurrentBatch).To(Equal([]string{"node-c"}))
+		Expect(st.MigratedNodes).To(Equal(int32(2)))
+		Expect(override("node-a")).NotTo(BeNil())
+		Expect(override("node-b")).NotTo(BeNil())
+		Expect(override("node-c")).To(BeNil())
+
+		By("waiting for the nodes moved back to become healthy before moving on")
+		Expect(migrate()).To(Equal(migrationPollInterval))
+		Expect(status().Message).To(ContainSubstring("again"))
+		Expect(override("node-a")).NotTo(BeNil())
+
+		By("moving on once the timeout expires")
+		backdate(11 * time.Minute)
+		migrate()
+		Expect(status().CurrentBatch).To(Equal([]string{"node-a"}))
+		Expect(override("node-a")).To(BeNil())
+		Expect(override("node-b")).NotTo(BeNil())
+		Expect(nodeLabel("node-b")).To(Equal("BPF"))
+
+		By("moving the next batch back as soon as the previous one is healthy")
+		backdate(time.Minute)
+		migrate()
+		Expect(status().CurrentBatch).To(Equal([]string{"node-b"}))
+		Expect(override("node-b")).To(BeNil())
+
+		backdate(time.Minute)
+		Expect(migrate()).To(BeZero())
+		st = status()
+		Expect(st.Phase).To(Equal(operator.DataplaneMigrationRolledBack))
+		Expect(st.MigratedNodes).To(BeZero())
+		for _, n := range []string{"node-a", "node-b", "node-c"} {
+			Expect(nodeLabel(n)).To(Equal("Iptables"))
+		}
+	})
+
+	It("treats a connectivity probe pod on a migrated node that cannot be connected to as a failed check", func() {
+		Expect(c.Create(ctx, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "calico-connectivity-probe-c",
+				Namespace: common.CalicoNamespace,
+				Labels:    map[string]string{"k8s-app": connectivitytest.ProbeName},
+			},
+			Spec: corev1.PodSpec{
+				NodeName:   "node-c",
+				Containers: []corev1.Container{{Name: "probe", Ports: []corev1.ContainerPort{{ContainerPort: 9098}}}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "192.168.0.10"},
+		})).NotTo(HaveOccurred())
+
+		var dialed []string
+		r.checkPodConnectivity = func(_ context.Context, pods []corev1.Pod) error {
+			for _, p := range pods {
+				dialed = append(dialed, p.Name)
+			}
+			return fmt.Errorf("connection refused")
+		}
+		reason, err := r.unhealthyMigratedNode(ctx, []string{"node-a", "node-c"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reason).To(Equal("pod connectivity on node node-c (connection refused)"))
+		Expect(dialed).To(Equal([]string{"calico-connectivity-probe-c"}))
+	})
+
+	It("succeeds as soon as one pod can be connected to", func() {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		defer l.Close()
+		port := int32(l.Addr().(*net.TCPAddr).Port)
+		closed, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		closedPort := int32(closed.Addr().(*net.TCPAddr).Port)
+		Expect(closed.Close()).NotTo(HaveOccurred())
+
+		pod := func(name string, port int32) corev1.Pod {
+			return corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Ports: []corev1.ContainerPort{{ContainerPort: port}}}}},
+				Status:     corev1.PodStatus{PodIP: "127.0.0.1"},
+			}
+		}
+		Expect(dialPods(ctx, []corev1.Pod{pod("closed", closedPort), pod("open", port)})).NotTo(HaveOccurred())
+		Expect(dialPods(ctx, []corev1.Pod{pod("closed", closedPort)})).To(MatchError(ContainSubstring("pod default/closed")))
+	})
+
+	It("ignores workload pods on a migrated node", func() {
+		Expect(c.Create(ctx, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec: corev1.PodSpec{
+				NodeName:   "node-c",
+				Containers: []corev1.Container{{Name: "web", ReadinessProbe: &corev1.Probe{}, Ports: []corev1.ContainerPort{{ContainerPort: 8080}}}},
+			},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				PodIP:      "192.168.0.11",
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse}},
+			},
+		})).NotTo(HaveOccurred())
+		r.checkPodConnectivity = func(_ context.Context, pods []corev1.Pod) error {
+			return fmt.Errorf("connection refused")
+		}
+
+		reason, err := r.unhealthyMigratedNode(ctx, []string{"node-a", "node-c"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reason).To(BeEmpty())
+	})
+})
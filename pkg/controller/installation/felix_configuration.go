@@ -0,0 +1,204 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package installation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operator "github.com/tigera/operator/api/v1"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	"github.com/tigera/operator/pkg/controller/utils"
+)
+
+// maxReportedFelixConflicts is the number of conflicts described in the TigeraStatus message.
+const maxReportedFelixConflicts = 3
+
+// defaultFelixHealthPort returns the health port the operator configures Felix with if none is set.
+func defaultFelixHealthPort(install *operator.Installation) int {
+	if install.Spec.KubernetesProvider.IsOpenShift() {
+		return 9199
+	}
+	return 9099
+}
+
+// goldmaneFlowLogsFlushInterval is the flow log flush interval the operator configures Felix with if none is set
+// while Goldmane is running.
+const goldmaneFlowLogsFlushInterval = 15 * time.Second
+
+// applyFelixSettings writes the Felix settings for all nodes from the Installation to the default
+// FelixConfiguration. The health port and flow log flush interval the operator defaults the FelixConfiguration to are
+// taken over if different ones are configured.
+func applyFelixSettings(install *operator.Installation, fc *crdv1.FelixConfiguration, goldmaneRunning bool) (bool, []operator.FelixConfigurationConflict) {
+	var settings *operator.FelixSettings
+	if install.Spec.FelixConfiguration != nil {
+		settings = &install.Spec.FelixConfiguration.FelixSettings
+	}
+	adoptable := map[string]string{
+		"healthPort": strconv.Itoa(defaultFelixHealthPort(install)),
+	}
+	if goldmaneRunning {
+		adoptable["flowLogsFlushInterval"] = goldmaneFlowLogsFlushInterval.String()
+	}
+	return utils.ApplyFelixSettings(fc, settings, adoptable)
+}
+
+// reconcileFelixNodeOverrides writes the Felix settings of the Installation's node overrides to the per-node
+// FelixConfigurations of the selected nodes, and clears them from nodes that are no longer selected. Per-node
+// FelixConfigurations that the operator created are deleted once they hold nothing the operator manages.
+func (r *ReconcileInstallation) reconcileFelixNodeOverrides(ctx context.Context, install *operator.Installation) ([]operator.FelixConfigurationConflict, error) {
+	var overrides []operator.FelixNodeOverride
+	if install.Spec.FelixConfiguration != nil {
+		overrides = install.Spec.FelixConfiguration.NodeOverrides
+	}
+
+	// Work out the settings for each selected node, with later overrides taking precedence.
+	settings := map[string]*operator.FelixSettings{}
+	if len(overrides) > 0 {
+		selectors := make([]labels.Selector, len(overrides))
+		for i := range overrides {
+			sel, err := metav1.LabelSelectorAsSelector(&overrides[i].NodeSelector)
+			if err != nil {
+				return nil, err
+			}
+			selectors[i] = sel
+		}
+		nodes := &corev1.NodeList{}
+		if err := r.client.List(ctx, nodes); err != nil {
+			return nil, fmt.Errorf("unable to list nodes: %w", err)
+		}
+		for _, n := range nodes.Items {
+			for i, sel := range selectors {
+				if !sel.Matches(labels.Set(n.Labels)) {
+					continue
+				}
+				if settings[n.Name] == nil {
+					settings[n.Name] = &operator.FelixSettings{}
+				}
+				mergeFelixSettings(settings[n.Name], &overrides[i].Settings)
+			}
+		}
+	}
+
+	fcs := &crdv1.FelixConfigurationList{}
+	if err := r.client.List(ctx, fcs); err != nil {
+		return nil, fmt.Errorf("unable to list FelixConfigurations: %w", err)
+	}
+	existing := map[string]*crdv1.FelixConfiguration{}
+	var names []string
+	for i := range fcs.Items {
+		fc := &fcs.Items[i]
+		if !strings.HasPrefix(fc.Name, "node.") {
+			continue
+		}
+		name := strings.TrimPrefix(fc.Name, "node.")
+		existing[name] = fc
+		// Nodes that are no longer selected may still hold settings that need clearing.
+		if _, ok := fc.Annotations[utils.FelixSettingsAnnotation]; ok && settings[name] == nil {
+			names = append(names, name)
+		}
+	}
+	for name := range settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var conflicts []operator.FelixConfigurationConflict
+	for _, name := range names {
+		fc, exists := existing[name]
+		if !exists {
+			fc = &crdv1.FelixConfiguration{}
+		}
+		patchFrom := client.MergeFrom(fc.DeepCopy())
+		updated, c := utils.ApplyFelixSettings(fc, settings[name], nil)
+		conflicts = append(conflicts, c...)
+
+		switch {
+		case !exists:
+			if !updated {
+				continue
+			}
+			fc.Name = "node." + name
+			fc.Labels = map[string]string{managedByLabel: managedByLabelValue}
+			if err := r.client.Create(ctx, fc); err != nil {
+				return nil, err
+			}
+		case fc.Labels[managedByLabel] == managedByLabelValue && !holdsOperatorSettings(fc):
+			if err := r.client.Delete(ctx, fc); err != nil && !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+		case updated:
+			if err := r.client.Patch(ctx, fc, patchFrom); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return conflicts, nil
+}
+
+// holdsOperatorSettings returns true if the per-node FelixConfiguration holds Felix settings or a dataplane
+// migration override from the operator.
+func holdsOperatorSettings(fc *crdv1.FelixConfiguration) bool {
+	_, settings := fc.Annotations[utils.FelixSettingsAnnotation]
+	_, migration := fc.Annotations[dataplaneMigrationAnnotation]
+	return settings || migration
+}
+
+// mergeFelixSettings sets each setting in src on dst.
+func mergeFelixSettings(dst, src *operator.FelixSettings) {
+	if src.LogSeverityScreen != nil {
+		dst.LogSeverityScreen = src.LogSeverityScreen
+	}
+	if src.LogSeverityFile != nil {
+		dst.LogSeverityFile = src.LogSeverityFile
+	}
+	if src.FlowLogsFlushInterval != nil {
+		dst.FlowLogsFlushInterval = src.FlowLogsFlushInterval
+	}
+	if src.RouteRefreshInterval != nil {
+		dst.RouteRefreshInterval = src.RouteRefreshInterval
+	}
+	if src.IptablesBackend != nil {
+		dst.IptablesBackend = src.IptablesBackend
+	}
+	if src.BPFLogLevel != nil {
+		dst.BPFLogLevel = src.BPFLogLevel
+	}
+	if src.HealthPort != nil {
+		dst.HealthPort = src.HealthPort
+	}
+}
+
+// felixConflictsMessage describes the given conflicts.
+func felixConflictsMessage(conflicts []operator.FelixConfigurationConflict) string {
+	var descs []string
+	for _, c := range conflicts {
+		descs = append(descs, fmt.Sprintf("FelixConfiguration %s has %s %s, not %s", c.Resource, c.Field, c.Value, c.DesiredValue))
+	}
+	if len(descs) > maxReportedFelixConflicts {
+		descs = append(descs[:maxReportedFelixConflicts], "...")
+	}
+	return fmt.Sprintf("%d Felix settings were not applied because they were set directly on the FelixConfiguration: %s", len(conflicts), strings.Join(descs, "; "))
+}
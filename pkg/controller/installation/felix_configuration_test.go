@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package installation
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	operator "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	"github.com/tigera/operator/pkg/controller/utils"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+)
+
+var _ = Describe("Felix node overrides", func() {
+	var (
+		c        client.Client
+		ctx      context.Context
+		r        *ReconcileInstallation
+		instance *operator.Installation
+	)
+
+	debug := operator.FelixLogSeverityDebug
+	warning := operator.FelixLogSeverityWarning
+	bpfDebug := operator.BPFLogLevelDebug
+
+	nodeFC := func(node string) *crdv1.FelixConfiguration {
+		fc := &crdv1.FelixConfiguration{}
+		err := c.Get(ctx, types.NamespacedName{Name: "node." + node}, fc)
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		Expect(err).NotTo(HaveOccurred())
+		return fc
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme)).NotTo(HaveOccurred())
+		Expect(corev1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+		Expect(appsv1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+		c = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+		r = &ReconcileInstallation{client: c, scheme: scheme}
+
+		for name, pool := range map[string]string{"node-a": "gpu", "node-b": "gpu", "node-c": "general"} {
+			Expect(c.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{"pool": pool, "zone": name},
+			}})).NotTo(HaveOccurred())
+		}
+
+		instance = &operator.Installation{Spec: operator.InstallationSpec{
+			FelixConfiguration: &operator.FelixConfigurationSpec{
+				NodeOverrides: []operator.FelixNodeOverride{
+					{
+						NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pool": "gpu"}},
+						Settings:     operator.FelixSettings{LogSeverityScreen: &debug, BPFLogLevel: &bpfDebug},
+					},
+					{
+						NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"zone": "node-b"}},
+						Settings:     operator.FelixSettings{LogSeverityScreen: &warning},
+					},
+				},
+			},
+		}}
+	})
+
+	It("should write the settings to the selected nodes, with later overrides taking precedence", func() {
+		conflicts, err := r.reconcileFelixNodeOverrides(ctx, instance)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conflicts).To(BeEmpty())
+
+		a := nodeFC("node-a")
+		Expect(a).NotTo(BeNil())
+		Expect(a.Labels).To(HaveKeyWithValue(managedByLabel, managedByLabelValue))
+		Expect(a.Spec.LogSeverityScreen).To(Equal("Debug"))
+		Expect(a.Spec.BPFLogLevel).To(Equal("Debug"))
+
+		b := nodeFC("node-b")
+		Expect(b.Spec.LogSeverityScreen).To(Equal("Warning"))
+		Expect(b.Spec.BPFLogLevel).To(Equal("Debug"))
+
+		Expect(nodeFC("node-c")).To(BeNil())
+	})
+
+	It("should clear settings from nodes that are no longer selected", func() {
+		Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "node.node-b"},
+			Spec:       crdv1.FelixConfigurationSpec{LogSeverityFile: "Error"},
+		})).NotTo(HaveOccurred())
+		_, err := r.reconcileFelixNodeOverrides(ctx, instance)
+		Expect(err).NotTo(HaveOccurred())
+
+		instance.Spec.FelixConfiguration = nil
+		_, err = r.reconcileFelixNodeOverrides(ctx, instance)
+		Expect(err).NotTo(HaveOccurred())
+
+		// The operator created the FelixConfiguration for node-a, so it is removed.
+		Expect(nodeFC("node-a")).To(BeNil())
+
+		// The user created the one for node-b, so only the operator's settings are removed.
+		b := nodeFC("node-b")
+		Expect(b).NotTo(BeNil())
+		Expect(b.Spec.LogSeverityScreen).To(BeEmpty())
+		Expect(b.Spec.BPFLogLevel).To(BeEmpty())
+		Expect(b.Spec.LogSeverityFile).To(Equal("Error"))
+		Expect(b.Annotations).NotTo(HaveKey(utils.FelixSettingsAnnotation))
+	})
+
+	It("should report conflicts with values set directly on a per-node FelixConfiguration", func() {
+		Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "node.node-a"},
+			Spec:       crdv1.FelixConfigurationSpec{LogSeverityScreen: "Info"},
+		})).NotTo(HaveOccurred())
+
+		conflicts, err := r.reconcileFelixNodeOverrides(ctx, instance)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conflicts).To(Equal([]operator.FelixConfigurationConflict{
+			{Resource: "node.node-a", Field: "logSeverityScreen", Value: "Info", DesiredValue: "Debug"},
+		}))
+		a := nodeFC("node-a")
+		Expect(a.Spec.LogSeverityScreen).To(Equal("Info"))
+		Expect(a.Spec.BPFLogLevel).To(Equal("Debug"))
+
+		Expect(felixConflictsMessage(conflicts)).To(Equal("1 Felix settings were not applied because they were set directly on the FelixConfiguration: " +
+			"FelixConfiguration node.node-a has logSeverityScreen Info, not Debug"))
+	})
+
+	It("should keep per-node FelixConfigurations that also hold a dataplane migration override", func() {
+		_, err := r.reconcileFelixNodeOverrides(ctx, instance)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = r.setDataplaneOverride(ctx, "node-a", operator.LinuxDataplaneBPF)
+		Expect(err).NotTo(HaveOccurred())
+
+		instance.Spec.FelixConfiguration = nil
+		_, err = r.reconcileFelixNodeOverrides(ctx, instance)
+		Expect(err).NotTo(HaveOccurred())
+		a := nodeFC("node-a")
+		Expect(a).NotTo(BeNil())
+		Expect(*a.Spec.BPFEnabled).To(BeTrue())
+		Expect(a.Spec.LogSeverityScreen).To(BeEmpty())
+
+		// Removing the dataplane override now removes the FelixConfiguration.
+		Expect(r.removeDataplaneOverride(ctx, a)).NotTo(HaveOccurred())
+		Expect(nodeFC("node-a")).To(BeNil())
+	})
+
+	It("should default the flow log flush interval while Goldmane is running, unless one is configured", func() {
+		instance.Spec.CNI = &operator.CNISpec{Type: operator.PluginCalico}
+		fc := &crdv1.FelixConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		applyDefaults := func(goldmaneRunning bool) {
+			_, conflicts := applyFelixSettings(instance, fc, goldmaneRunning)
+			Expect(conflicts).To(BeEmpty())
+			_, err := r.setDefaultsOnFelixConfiguration(ctx, instance, fc, goldmaneRunning, logf.Log)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		applyDefaults(false)
+		Expect(fc.Spec.FlowLogsFlushInterval).To(BeNil())
+		applyDefaults(true)
+		Expect(fc.Spec.FlowLogsFlushInterval.Duration).To(Equal(15 * time.Second))
+
+		By("taking over the default when the Installation configures an interval")
+		instance.Spec.FelixConfiguration.FlowLogsFlushInterval = &metav1.Duration{Duration: time.Minute}
+		applyDefaults(true)
+		Expect(fc.Spec.FlowLogsFlushInterval.Duration).To(Equal(time.Minute))
+	})
+})
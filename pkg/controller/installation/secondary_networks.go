@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package installation
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operator "github.com/tigera/operator/api/v1"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	nadv1 "github.com/tigera/operator/pkg/apis/k8s.cni.cncf.io/v1"
+	"github.com/tigera/operator/pkg/render"
+)
+
+// defaultInterfacePrefix is the interface prefix Felix uses for workload interfaces if none is configured.
+const defaultInterfacePrefix = "cali"
+
+// addSecondaryNetworkInterfacePrefixes adds the interface prefixes of the Installation's secondary networks to the
+// interface prefixes configured on the FelixConfiguration, so that Felix treats their pod interfaces as workload
+// interfaces. Prefixes that are already configured are kept.
+func addSecondaryNetworkInterfacePrefixes(install *operator.Installation, fc *crdv1.FelixConfiguration) bool {
+	if install.Spec.CalicoNetwork == nil || len(install.Spec.CalicoNetwork.SecondaryNetworks) == 0 {
+		return false
+	}
+	prefixes := []string{defaultInterfacePrefix}
+	if fc.Spec.InterfacePrefix != "" {
+		prefixes = strings.Split(fc.Spec.InterfacePrefix, ",")
+	}
+	for _, n := range install.Spec.CalicoNetwork.SecondaryNetworks {
+		found := false
+		for _, p := range prefixes {
+			found = found || p == n.InterfacePrefix
+		}
+		if !found {
+			prefixes = append(prefixes, n.InterfacePrefix)
+		}
+	}
+	newSetting := strings.Join(prefixes, ",")
+	if newSetting == fc.Spec.InterfacePrefix {
+		return false
+	}
+	fc.Spec.InterfacePrefix = newSetting
+	return true
+}
+
+// primaryPools returns the given IP pools without the pools of the Installation's secondary networks, which are
+// not used for pods' primary interfaces.
+func primaryPools(install *operator.Installation, pools []operator.IPPool) []operator.IPPool {
+	secondary := map[string]bool{}
+	for _, p := range render.SecondaryNetworkPools(&install.Spec) {
+		secondary[p.CIDR] = true
+	}
+	primary := []operator.IPPool{}
+	for _, p := range pools {
+		if !secondary[p.CIDR] {
+			primary = append(primary, p)
+		}
+	}
+	return primary
+}
+
+// networkAttachmentDefinitions returns the NetworkAttachmentDefinitions the operator has rendered for secondary
+// networks. It returns none if the NetworkAttachmentDefinition CRD is not installed.
+func (r *ReconcileInstallation) networkAttachmentDefinitions(ctx context.Context) ([]nadv1.NetworkAttachmentDefinition, error) {
+	nads := &nadv1.NetworkAttachmentDefinitionList{}
+	if err := r.client.List(ctx, nads, client.HasLabels{render.SecondaryNetworkLabel}); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return nads.Items, nil
+}
+
+// missingSecondaryNetworkNamespaces returns the namespaces of the Installation's secondary networks that do not exist.
+func (r *ReconcileInstallation) missingSecondaryNetworkNamespaces(ctx context.Context, install *operator.Installation) (map[string]bool, error) {
+	missing := map[string]bool{}
+	if install.Spec.CalicoNetwork == nil {
+		return missing, nil
+	}
+	for _, n := range install.Spec.CalicoNetwork.SecondaryNetworks {
+		for _, namespace := range n.Namespaces {
+			if _, checked := missing[namespace]; checked {
+				continue
+			}
+			err := r.client.Get(ctx, client.ObjectKey{Name: namespace}, &corev1.Namespace{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+			missing[namespace] = apierrors.IsNotFound(err)
+		}
+	}
+
+	for namespace, isMissing := range missing {
+		if !isMissing {
+			delete(missing, namespace)
+		}
+	}
+	return missing, nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package installation
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	operator "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+)
+
+var _ = Describe("Secondary networks", func() {
+	var install *operator.Installation
+
+	BeforeEach(func() {
+		install = &operator.Installation{
+			Spec: operator.InstallationSpec{
+				CalicoNetwork: &operator.CalicoNetworkSpec{
+					SecondaryNetworks: []operator.SecondaryNetwork{
+						{Name: "net-a", IPPool: operator.IPPool{CIDR: "10.10.0.0/16"}, InterfacePrefix: "cna"},
+						{Name: "net-b", IPPool: operator.IPPool{CIDR: "10.20.0.0/16"}, InterfacePrefix: "cnb"},
+					},
+				},
+			},
+		}
+	})
+
+	It("should add the networks' interface prefixes to the FelixConfiguration", func() {
+		fc := &crdv1.FelixConfiguration{}
+		Expect(addSecondaryNetworkInterfacePrefixes(install, fc)).To(BeTrue())
+		Expect(fc.Spec.InterfacePrefix).To(Equal("cali,cna,cnb"))
+		Expect(addSecondaryNetworkInterfacePrefixes(install, fc)).To(BeFalse())
+
+		// Prefixes that are already configured are kept.
+		fc.Spec.InterfacePrefix = "cali,tap,cnb"
+		Expect(addSecondaryNetworkInterfacePrefixes(install, fc)).To(BeTrue())
+		Expect(fc.Spec.InterfacePrefix).To(Equal("cali,tap,cnb,cna"))
+
+		// Nothing is configured without secondary networks.
+		fc.Spec.InterfacePrefix = ""
+		install.Spec.CalicoNetwork.SecondaryNetworks = nil
+		Expect(addSecondaryNetworkInterfacePrefixes(install, fc)).To(BeFalse())
+		Expect(fc.Spec.InterfacePrefix).To(BeEmpty())
+	})
+
+	It("should leave the secondary networks' pools out of the primary pools", func() {
+		pools := []operator.IPPool{{CIDR: "192.168.0.0/16"}, {CIDR: "10.10.0.0/16"}, {CIDR: "10.20.0.0/16"}}
+		Expect(primaryPools(install, pools)).To(Equal([]operator.IPPool{{CIDR: "192.168.0.0/16"}}))
+	})
+
+	It("should find the secondary networks' namespaces that do not exist", func() {
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme)).NotTo(HaveOccurred())
+		Expect(corev1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+		cli := ctrlrfake.DefaultFakeClientBuilder(scheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}},
+		).Build()
+		r := &ReconcileInstallation{client: cli}
+
+		install.Spec.CalicoNetwork.SecondaryNetworks[0].Namespaces = []string{"ns1", "ns2"}
+		install.Spec.CalicoNetwork.SecondaryNetworks[1].Namespaces = []string{"ns2", "ns3"}
+		missing, err := r.missingSecondaryNetworkNamespaces(context.Background(), install)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(missing).To(Equal(map[string]bool{"ns2": true, "ns3": true}))
+	})
+})
@@ -33,6 +33,8 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 )
 
 // validateCustomResource validates that the given custom resource is correct. This
@@ -134,10 +136,30 @@ func validateCustomResource(instance *operatorv1.Installation) error {
 			instance.Spec.CNI.Type, strings.Join(operatorv1.CNIPluginTypesString, ","))
 	}
 
+	if instance.Spec.CNI.ChainedPlugins != nil {
+		if instance.Spec.CNI.Type != operatorv1.PluginCalico {
+			return fmt.Errorf("spec.cni.chainedPlugins is supported only for Calico CNI")
+		}
+		if err := render.ValidateCNIPluginChain(&instance.Spec); err != nil {
+			return fmt.Errorf("spec.cni.chainedPlugins is invalid: %w", err)
+		}
+	}
+
 	// Verify Calico settings, if specified.
 	if instance.Spec.CalicoNetwork != nil {
 		bpfDataplane := instance.Spec.CalicoNetwork.LinuxDataplane != nil && *instance.Spec.CalicoNetwork.LinuxDataplane == operatorv1.LinuxDataplaneBPF
 
+		if m := instance.Spec.CalicoNetwork.DataplaneMigration; m != nil {
+			if instance.Spec.CalicoNetwork.LinuxDataplane != nil && !migratableDataplane(*instance.Spec.CalicoNetwork.LinuxDataplane) {
+				return fmt.Errorf("spec.calicoNetwork.dataplaneMigration is not supported with the %s dataplane", *instance.Spec.CalicoNetwork.LinuxDataplane)
+			}
+			if m.CanaryNodeSelector != nil {
+				if _, err := metav1.LabelSelectorAsSelector(m.CanaryNodeSelector); err != nil {
+					return fmt.Errorf("spec.calicoNetwork.dataplaneMigration.canaryNodeSelector is invalid: %v", err)
+				}
+			}
+		}
+
 		// Perform validation on non-IPPool fields that rely on IP pool configuration. Validation of the IP pools themselves
 		// happens in the IP pool controller.
 		for _, pool := range instance.Spec.CalicoNetwork.IPPools {
@@ -253,6 +275,10 @@ func validateCustomResource(instance *operatorv1.Installation) error {
 			}
 		}
 
+		if err := validateSecondaryNetworks(instance); err != nil {
+			return err
+		}
+
 		if instance.Spec.CalicoNetwork.ContainerIPForwarding != nil {
 			if instance.Spec.CNI.Type != operatorv1.PluginCalico {
 				return fmt.Errorf("spec.calicoNetwork.containerIPForwarding is supported only for Calico CNI")
@@ -403,8 +429,16 @@ func validateCustomResource(instance *operatorv1.Installation) error {
 						return fmt.Errorf("IPv4 IPPool encapsulation %s is not supported by Calico for Windows", v4pool.Encapsulation)
 					}
 				}
+				if v6pool := render.GetIPv6Pool(instance.Spec.CalicoNetwork.IPPools); v6pool != nil {
+					if v6pool.Encapsulation != operatorv1.EncapsulationVXLAN && v6pool.Encapsulation != operatorv1.EncapsulationNone {
+						return fmt.Errorf("IPv6 IPPool encapsulation %s is not supported by Calico for Windows", v6pool.Encapsulation)
+					}
+				}
 			}
 		}
+		if err := validateWindowsBGP(instance); err != nil {
+			return err
+		}
 	} else {
 		if instance.Spec.WindowsNodes != nil {
 			return fmt.Errorf("Installation spec.WindowsNodes is not valid and should not be provided when Calico for Windows is disabled")
@@ -419,6 +453,33 @@ func validateCustomResource(instance *operatorv1.Installation) error {
 		return fmt.Errorf("Installation spec.Azure should be set only for AKS provider")
 	}
 
+	if fc := instance.Spec.FelixConfiguration; fc != nil {
+		for i, o := range fc.NodeOverrides {
+			if _, err := metav1.LabelSelectorAsSelector(&o.NodeSelector); err != nil {
+				return fmt.Errorf("spec.felixConfiguration.nodeOverrides[%d].nodeSelector is invalid: %v", i, err)
+			}
+			if o.Settings.HealthPort != nil {
+				return fmt.Errorf("spec.felixConfiguration.nodeOverrides[%d].settings.healthPort is not supported, the health port can only be set for all nodes", i)
+			}
+		}
+	}
+
+	if hp := instance.Spec.HostProtection; hp.BaselinePolicyMode() != operatorv1.HostBaselinePolicyDisabled {
+		if instance.Spec.Variant != operatorv1.TigeraSecureEnterprise {
+			return fmt.Errorf("spec.hostProtection.baselinePolicy is supported only for Variant=%s", operatorv1.TigeraSecureEnterprise)
+		}
+		if !hp.AutoHostEndpointsEnabled() {
+			return fmt.Errorf("spec.hostProtection.baselinePolicy requires spec.hostProtection.autoHostEndpoints to be Enabled")
+		}
+	}
+	if hp := instance.Spec.HostProtection; hp != nil {
+		for i, p := range hp.AllowedIngressPorts {
+			if p.EndPort != nil && *p.EndPort < p.Port {
+				return fmt.Errorf("spec.hostProtection.allowedIngressPorts[%d].endPort must not be less than port", i)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -475,3 +536,80 @@ func validateHostPorts(hp *operatorv1.HostPortsType) error {
 
 	return nil
 }
+
+// validateWindowsBGP checks that the BGP setting for Windows nodes can route pod traffic with the configured IP pools.
+func validateWindowsBGP(instance *operatorv1.Installation) error {
+	if instance.Spec.WindowsNodes == nil || instance.Spec.WindowsNodes.BGP == nil {
+		return nil
+	}
+	clusterBGP := instance.Spec.CalicoNetwork != nil && instance.Spec.CalicoNetwork.BGP != nil && *instance.Spec.CalicoNetwork.BGP == operatorv1.BGPEnabled
+	switch *instance.Spec.WindowsNodes.BGP {
+	case operatorv1.BGPEnabled:
+		if !clusterBGP {
+			return fmt.Errorf("Installation spec.WindowsNodes.BGP can only be enabled when spec.calicoNetwork.bgp is enabled")
+		}
+	case operatorv1.BGPDisabled:
+		if instance.Spec.CNI.Type != operatorv1.PluginCalico || instance.Spec.CalicoNetwork == nil {
+			return nil
+		}
+		for _, pool := range instance.Spec.CalicoNetwork.IPPools {
+			if pool.Encapsulation == operatorv1.EncapsulationNone {
+				return fmt.Errorf("IPPool %s has no encapsulation, which requires BGP on Windows nodes: spec.WindowsNodes.BGP must not be disabled", pool.CIDR)
+			}
+		}
+	}
+	return nil
+}
+
+// validateSecondaryNetworks checks that secondary networks can be attached to through Multus, and that their names,
+// interface prefixes and IP pools do not clash.
+func validateSecondaryNetworks(instance *operatorv1.Installation) error {
+	networks := instance.Spec.CalicoNetwork.SecondaryNetworks
+	if len(networks) == 0 {
+		return nil
+	}
+	if instance.Spec.CNI.Type != operatorv1.PluginCalico {
+		return fmt.Errorf("spec.calicoNetwork.secondaryNetworks is supported only for Calico CNI")
+	}
+	if mode := instance.Spec.CalicoNetwork.MultiInterfaceMode; mode == nil || *mode != operatorv1.MultiInterfaceModeMultus {
+		return fmt.Errorf("spec.calicoNetwork.secondaryNetworks requires spec.calicoNetwork.multiInterfaceMode to be Multus")
+	}
+
+	names := map[string]bool{}
+	prefixes := map[string]bool{"cali": true}
+	for i, n := range networks {
+		if errs := k8svalidation.IsDNS1123Label(n.Name); len(errs) > 0 {
+			return fmt.Errorf("spec.calicoNetwork.secondaryNetworks[%d].name %q is invalid: %s", i, n.Name, strings.Join(errs, ", "))
+		}
+		if names[n.Name] {
+			return fmt.Errorf("spec.calicoNetwork.secondaryNetworks[%d].name %q is used by more than one network", i, n.Name)
+		}
+		names[n.Name] = true
+
+		// Felix names host-side interfaces with the prefix followed by 11 characters, and interface names are limited
+		// to 15 characters.
+		if len(n.InterfacePrefix) == 0 || len(n.InterfacePrefix) > 4 || strings.Trim(n.InterfacePrefix, "abcdefghijklmnopqrstuvwxyz0123456789") != "" {
+			return fmt.Errorf("spec.calicoNetwork.secondaryNetworks[%d].interfacePrefix %q must be 1 to 4 lowercase letters or digits", i, n.InterfacePrefix)
+		}
+		if prefixes[n.InterfacePrefix] {
+			return fmt.Errorf("spec.calicoNetwork.secondaryNetworks[%d].interfacePrefix %q is already in use", i, n.InterfacePrefix)
+		}
+		prefixes[n.InterfacePrefix] = true
+
+		if len(n.Namespaces) == 0 {
+			return fmt.Errorf("spec.calicoNetwork.secondaryNetworks[%d].namespaces must not be empty", i)
+		}
+		for _, ns := range n.Namespaces {
+			if errs := k8svalidation.IsDNS1123Label(ns); len(errs) > 0 {
+				return fmt.Errorf("spec.calicoNetwork.secondaryNetworks[%d].namespaces contains invalid namespace %q: %s", i, ns, strings.Join(errs, ", "))
+			}
+		}
+
+		for _, use := range n.IPPool.AllowedUses {
+			if use != operatorv1.IPPoolAllowedUseWorkload {
+				return fmt.Errorf("spec.calicoNetwork.secondaryNetworks[%d].ipPool.allowedUses must only contain %s", i, operatorv1.IPPoolAllowedUseWorkload)
+			}
+		}
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	operator "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/controller/k8sapi"
@@ -421,6 +422,122 @@ var _ = Describe("Installation validation tests", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	It("should validate Felix node overrides", func() {
+		port := int32(9199)
+		instance.Spec.FelixConfiguration = &operator.FelixConfigurationSpec{
+			FelixSettings: operator.FelixSettings{HealthPort: &port},
+			NodeOverrides: []operator.FelixNodeOverride{{
+				NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pool": "gpu"}},
+			}},
+		}
+		Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+
+		instance.Spec.FelixConfiguration.NodeOverrides[0].Settings.HealthPort = &port
+		err := validateCustomResource(instance)
+		Expect(err).To(MatchError("spec.felixConfiguration.nodeOverrides[0].settings.healthPort is not supported, the health port can only be set for all nodes"))
+
+		instance.Spec.FelixConfiguration.NodeOverrides[0].Settings.HealthPort = nil
+		instance.Spec.FelixConfiguration.NodeOverrides[0].NodeSelector.MatchLabels = map[string]string{"pool": "not valid"}
+		Expect(validateCustomResource(instance)).To(HaveOccurred())
+	})
+
+	It("should validate host protection", func() {
+		enabled := operator.AutoHostEndpointsEnabled
+		enforce := operator.HostBaselinePolicyEnforce
+		instance.Spec.HostProtection = &operator.HostProtection{AutoHostEndpoints: &enabled}
+		Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+
+		instance.Spec.HostProtection.BaselinePolicy = &enforce
+		Expect(validateCustomResource(instance)).To(MatchError("spec.hostProtection.baselinePolicy is supported only for Variant=TigeraSecureEnterprise"))
+
+		instance.Spec.Variant = operator.TigeraSecureEnterprise
+		Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+
+		instance.Spec.HostProtection.AutoHostEndpoints = nil
+		Expect(validateCustomResource(instance)).To(MatchError("spec.hostProtection.baselinePolicy requires spec.hostProtection.autoHostEndpoints to be Enabled"))
+
+		instance.Spec.HostProtection.AutoHostEndpoints = &enabled
+		endPort := int32(8090)
+		instance.Spec.HostProtection.AllowedIngressPorts = []operator.HostProtectionPort{{Port: 8080, EndPort: &endPort}}
+		Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+
+		endPort = 8000
+		Expect(validateCustomResource(instance)).To(MatchError("spec.hostProtection.allowedIngressPorts[0].endPort must not be less than port"))
+	})
+
+	DescribeTable("should validate chained CNI plugins",
+		func(additional []operator.CNIChainedPlugin, expectedErr string) {
+			instance.Spec.CNI.ChainedPlugins = &operator.CNIChainedPlugins{Additional: additional}
+			err := validateCustomResource(instance)
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError("spec.cni.chainedPlugins is invalid: " + expectedErr))
+			}
+		},
+		Entry("valid plugins", []operator.CNIChainedPlugin{
+			{Type: "sbr", After: "calico"},
+			{Type: "firewall", After: "sbr", Config: `{"backend": "iptables"}`},
+		}, ""),
+		Entry("invalid type", []operator.CNIChainedPlugin{{Type: "../sbr"}},
+			`plugin type "../sbr" is invalid, it must be a plugin binary name`),
+		Entry("duplicate type", []operator.CNIChainedPlugin{{Type: "bandwidth"}},
+			"plugin bandwidth is already in the chain"),
+		Entry("after a missing plugin", []operator.CNIChainedPlugin{{Type: "firewall", After: "sbr"}, {Type: "sbr"}},
+			"plugin firewall is chained after sbr, which is not in the chain"),
+		Entry("config that is not an object", []operator.CNIChainedPlugin{{Type: "sbr", Config: `["a"]`}},
+			"config of plugin sbr is not a JSON object: json: cannot unmarshal array into Go value of type map[string]interface {}"),
+		Entry("config that sets the type", []operator.CNIChainedPlugin{{Type: "sbr", Config: `{"type": "other"}`}},
+			"config of plugin sbr must not set the type"),
+	)
+
+	It("should validate secondary networks", func() {
+		network := func() operator.SecondaryNetwork {
+			return operator.SecondaryNetwork{
+				Name:            "net-a",
+				IPPool:          operator.IPPool{CIDR: "10.10.0.0/16"},
+				InterfacePrefix: "cna",
+				Namespaces:      []string{"ns1"},
+			}
+		}
+		instance.Spec.CalicoNetwork.SecondaryNetworks = []operator.SecondaryNetwork{network()}
+		err := validateCustomResource(instance)
+		Expect(err).To(MatchError("spec.calicoNetwork.secondaryNetworks requires spec.calicoNetwork.multiInterfaceMode to be Multus"))
+
+		mm := operator.MultiInterfaceModeMultus
+		instance.Spec.CalicoNetwork.MultiInterfaceMode = &mm
+		Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+
+		second := network()
+		second.Name = "net-b"
+		instance.Spec.CalicoNetwork.SecondaryNetworks = append(instance.Spec.CalicoNetwork.SecondaryNetworks, second)
+		err = validateCustomResource(instance)
+		Expect(err).To(MatchError(`spec.calicoNetwork.secondaryNetworks[1].interfacePrefix "cna" is already in use`))
+
+		instance.Spec.CalicoNetwork.SecondaryNetworks[1].InterfacePrefix = "cali"
+		err = validateCustomResource(instance)
+		Expect(err).To(MatchError(`spec.calicoNetwork.secondaryNetworks[1].interfacePrefix "cali" is already in use`))
+
+		instance.Spec.CalicoNetwork.SecondaryNetworks[1].InterfacePrefix = "cnb-1"
+		err = validateCustomResource(instance)
+		Expect(err).To(MatchError(`spec.calicoNetwork.secondaryNetworks[1].interfacePrefix "cnb-1" must be 1 to 4 lowercase letters or digits`))
+
+		instance.Spec.CalicoNetwork.SecondaryNetworks[1].InterfacePrefix = "cnb"
+		instance.Spec.CalicoNetwork.SecondaryNetworks[1].Name = "net-a"
+		err = validateCustomResource(instance)
+		Expect(err).To(MatchError(`spec.calicoNetwork.secondaryNetworks[1].name "net-a" is used by more than one network`))
+
+		instance.Spec.CalicoNetwork.SecondaryNetworks[1].Name = "net-b"
+		instance.Spec.CalicoNetwork.SecondaryNetworks[1].Namespaces = nil
+		err = validateCustomResource(instance)
+		Expect(err).To(MatchError("spec.calicoNetwork.secondaryNetworks[1].namespaces must not be empty"))
+
+		instance.Spec.CalicoNetwork.SecondaryNetworks[1].Namespaces = []string{"ns1"}
+		instance.Spec.CalicoNetwork.SecondaryNetworks[1].IPPool.AllowedUses = []operator.IPPoolAllowedUse{operator.IPPoolAllowedUseTunnel}
+		err = validateCustomResource(instance)
+		Expect(err).To(MatchError("spec.calicoNetwork.secondaryNetworks[1].ipPool.allowedUses must only contain Workload"))
+	})
+
 	Describe("validate Calico CNI plugin Type", func() {
 		DescribeTable("test invalid IPAM",
 			func(ipam operator.IPAMPluginType) {
@@ -1045,6 +1162,40 @@ var _ = Describe("Installation validation tests", func() {
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("IPv4 IPPool encapsulation VXLANCrossSubnet is not supported by Calico for Windows"))
 			})
+
+			It("should return an error if IPv6 IP pool encapsulation is VXLANCrossSubnet", func() {
+				instance.Spec.CalicoNetwork.IPPools = append(instance.Spec.CalicoNetwork.IPPools, operator.IPPool{
+					CIDR:          "fd00::/64",
+					Encapsulation: operator.EncapsulationVXLANCrossSubnet,
+					NATOutgoing:   operator.NATOutgoingEnabled,
+					NodeSelector:  "all()",
+				})
+				err := validateCustomResource(instance)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("IPv6 IPPool encapsulation VXLANCrossSubnet is not supported by Calico for Windows"))
+			})
+
+			It("should return an error if BGP is enabled on Windows but not for the cluster", func() {
+				enabled := operator.BGPEnabled
+				instance.Spec.WindowsNodes = &operator.WindowsNodeSpec{BGP: &enabled}
+				err := validateCustomResource(instance)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Installation spec.WindowsNodes.BGP can only be enabled when spec.calicoNetwork.bgp is enabled"))
+			})
+
+			It("should return an error if BGP is disabled on Windows for an unencapsulated IP pool", func() {
+				enabled := operator.BGPEnabled
+				disabled := operator.BGPDisabled
+				instance.Spec.CalicoNetwork.BGP = &enabled
+				instance.Spec.CalicoNetwork.IPPools[0].Encapsulation = operator.EncapsulationNone
+				instance.Spec.WindowsNodes = &operator.WindowsNodeSpec{BGP: &disabled}
+				err := validateCustomResource(instance)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("IPPool 192.168.0.0/16 has no encapsulation, which requires BGP on Windows nodes: spec.WindowsNodes.BGP must not be disabled"))
+
+				instance.Spec.CalicoNetwork.IPPools[0].Encapsulation = operator.EncapsulationVXLAN
+				Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+			})
 		})
 		Context("AzureVNET CNI (to validate any non-Calico)", func() {
 			BeforeEach(func() {
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -27,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -60,6 +62,15 @@ import (
 
 var logw = logf.Log.WithName("controller_windows")
 
+const (
+	// windowsStatusName is the name of the TigeraStatus that reports on calico-node-windows.
+	windowsStatusName = "calico-windows"
+
+	// windowsConditionPrefix is prepended to the types of the calico-windows TigeraStatus conditions when they are
+	// merged into the Installation status, so that they don't conflict with the conditions of the calico TigeraStatus.
+	windowsConditionPrefix = "Windows"
+)
+
 // Add creates a new Tiers Controller and adds it to the Manager.
 // The Manager will set fields on the Controller and Start it when the Manager is Started.
 func AddWindowsController(mgr manager.Manager, opts options.AddOptions) error {
@@ -83,6 +94,9 @@ func AddWindowsController(mgr manager.Manager, opts options.AddOptions) error {
 	if err = utils.AddTigeraStatusWatch(c, InstallationName); err != nil {
 		return fmt.Errorf("tigera-windows-controller failed to watch calico Tigerastatus: %w", err)
 	}
+	if err = utils.AddTigeraStatusWatch(c, windowsStatusName); err != nil {
+		return fmt.Errorf("tigera-windows-controller failed to watch calico-windows Tigerastatus: %w", err)
+	}
 
 	if ri.autoDetectedProvider.IsOpenShift() {
 		// Watch for openshift network configuration as well. If we're running in OpenShift, we need to
@@ -191,7 +205,7 @@ type ReconcileWindows struct {
 
 // newWindowsReconciler returns a new reconcile.Reconciler
 func newWindowsReconciler(mgr manager.Manager, opts options.AddOptions) (*ReconcileWindows, error) {
-	statusManager := status.New(mgr.GetClient(), "calico-windows", opts.KubernetesVersion)
+	statusManager := status.New(mgr.GetClient(), windowsStatusName, opts.KubernetesVersion)
 
 	r := &ReconcileWindows{
 		config:               mgr.GetConfig(),
@@ -231,15 +245,29 @@ func (r *ReconcileWindows) Reconcile(ctx context.Context, request reconcile.Requ
 	// Don't render calico-node-windows if it's disabled in the installation
 	if !common.WindowsEnabled(instance.Spec) {
 		reqLogger.V(1).Info("Calico Windows daemonset is disabled in the operator installation")
+		if err := r.updateWindowsConditions(ctx, instance, nil); err != nil {
+			return reconcile.Result{}, err
+		}
 		return reconcile.Result{}, nil
 	}
 
 	// Mark CR found so we can report converter problems via tigerastatus
 	r.status.OnCRFound()
-	// FIXME: add logic to merge Installation status metadata
+	// SetMetaData in the TigeraStatus such as observedGenerations.
+	defer r.status.SetMetaData(&instance.ObjectMeta)
 
-	// FIXME: add logic to update Installation status conditions that doesn't conflict with
-	// core_controller
+	// Merge the calico-windows TigeraStatus conditions into the Installation status.
+	ts := &operatorv1.TigeraStatus{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: windowsStatusName}, ts); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+		ts = nil
+	}
+	if err := r.updateWindowsConditions(ctx, instance, ts); err != nil {
+		reqLogger.WithValues("reason", err).Info("Failed to update Installation Windows status conditions.")
+		return reconcile.Result{}, err
+	}
 
 	instanceStatus := instance.Status
 
@@ -432,3 +460,50 @@ func (r *ReconcileWindows) Reconcile(ctx context.Context, request reconcile.Requ
 	reqLogger.V(1).Info("Finished reconciling windows installation")
 	return reconcile.Result{}, nil
 }
+
+// updateWindowsConditions sets the Windows conditions of the Installation status from the given calico-windows
+// TigeraStatus, leaving the other conditions alone. The Windows conditions are removed if the TigeraStatus is nil.
+// The core controller writes the other conditions concurrently, so the patch is rejected if the Installation changed
+// since it was read, in which case the Installation is read again and the update retried.
+func (r *ReconcileWindows) updateWindowsConditions(ctx context.Context, instance *operatorv1.Installation, ts *operatorv1.TigeraStatus) error {
+	first := true
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if !first {
+			if err := r.client.Get(ctx, utils.DefaultInstanceKey, instance); err != nil {
+				return err
+			}
+		}
+		first = false
+
+		conditions := windowsConditions(instance.Status.Conditions, ts)
+		if len(conditions) == 0 && len(instance.Status.Conditions) == 0 || reflect.DeepEqual(conditions, instance.Status.Conditions) {
+			return nil
+		}
+		patchFrom := client.MergeFromWithOptions(instance.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		instance.Status.Conditions = conditions
+		return r.client.Status().Patch(ctx, instance, patchFrom)
+	})
+}
+
+// windowsConditions returns the given Installation conditions with the Windows conditions replaced by those of the
+// given calico-windows TigeraStatus, or removed if it is nil.
+func windowsConditions(current []metav1.Condition, ts *operatorv1.TigeraStatus) []metav1.Condition {
+	var others, windows []metav1.Condition
+	for _, c := range current {
+		if strings.HasPrefix(c.Type, windowsConditionPrefix) {
+			c.Type = strings.TrimPrefix(c.Type, windowsConditionPrefix)
+			windows = append(windows, c)
+		} else {
+			others = append(others, c)
+		}
+	}
+
+	conditions := others
+	if ts != nil {
+		for _, c := range status.UpdateStatusCondition(windows, ts.Status.Conditions) {
+			c.Type = windowsConditionPrefix + c.Type
+			conditions = append(conditions, c)
+		}
+	}
+	return conditions
+}
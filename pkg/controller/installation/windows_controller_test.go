@@ -304,6 +304,66 @@ var _ = Describe("windows-controller installation tests", func() {
 				Expect(degradedErr).To(ConsistOf([]string{}))
 			})
 
+			It("should merge the calico-windows TigeraStatus conditions into the Installation status", func() {
+				hns := operator.WindowsDataplaneHNS
+				cr.Spec.CalicoNetwork.WindowsDataplane = &hns
+				cr.Status.Conditions = []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue, Reason: "AllObjectsAvailable"}}
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &operator.TigeraStatus{
+					ObjectMeta: metav1.ObjectMeta{Name: "calico-windows"},
+					Status: operator.TigeraStatusStatus{Conditions: []operator.TigeraStatusCondition{
+						{Type: operator.ComponentAvailable, Status: operator.ConditionFalse, Reason: "ResourceNotReady", Message: "DaemonSet not available"},
+						{Type: operator.ComponentDegraded, Status: operator.ConditionFalse},
+					}},
+				})).NotTo(HaveOccurred())
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				instance := &operator.Installation{}
+				Expect(c.Get(ctx, utils.DefaultInstanceKey, instance)).NotTo(HaveOccurred())
+				Expect(instance.Status.Conditions).To(HaveLen(3))
+				Expect(instance.Status.Conditions[0].Type).To(Equal("Ready"))
+				Expect(instance.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
+				Expect(instance.Status.Conditions[1].Type).To(Equal("WindowsReady"))
+				Expect(instance.Status.Conditions[1].Status).To(Equal(metav1.ConditionFalse))
+				Expect(instance.Status.Conditions[1].Message).To(Equal("DaemonSet not available"))
+				Expect(instance.Status.Conditions[2].Type).To(Equal("WindowsDegraded"))
+
+				By("removing the Windows conditions once Windows is disabled")
+				disabled := operator.WindowsDataplaneDisabled
+				instance.Spec.CalicoNetwork.WindowsDataplane = &disabled
+				instance.Spec.WindowsNodes = nil
+				Expect(c.Update(ctx, instance)).NotTo(HaveOccurred())
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(c.Get(ctx, utils.DefaultInstanceKey, instance)).NotTo(HaveOccurred())
+				Expect(instance.Status.Conditions).To(HaveLen(1))
+				Expect(instance.Status.Conditions[0].Type).To(Equal("Ready"))
+			})
+
+			It("should not overwrite conditions written since the Installation was read", func() {
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				stale := &operator.Installation{}
+				Expect(c.Get(ctx, utils.DefaultInstanceKey, stale)).NotTo(HaveOccurred())
+
+				// The core controller writes its conditions after the Installation was read.
+				current := stale.DeepCopy()
+				current.Status.Conditions = []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue, Reason: "AllObjectsAvailable", LastTransitionTime: metav1.Now()}}
+				Expect(c.Status().Update(ctx, current)).NotTo(HaveOccurred())
+
+				ts := &operator.TigeraStatus{Status: operator.TigeraStatusStatus{Conditions: []operator.TigeraStatusCondition{
+					{Type: operator.ComponentAvailable, Status: operator.ConditionTrue, Reason: "AllObjectsAvailable"},
+				}}}
+				Expect(r.updateWindowsConditions(ctx, stale, ts)).NotTo(HaveOccurred())
+
+				instance := &operator.Installation{}
+				Expect(c.Get(ctx, utils.DefaultInstanceKey, instance)).NotTo(HaveOccurred())
+				Expect(instance.Status.Conditions).To(HaveLen(2))
+				Expect(instance.Status.Conditions[0].Type).To(Equal("Ready"))
+				Expect(instance.Status.Conditions[1].Type).To(Equal("WindowsReady"))
+			})
+
 			It("should not render the Windows daemonset when the kubernetes-service-endpoint configmap does not exist", func() {
 				hns := operator.WindowsDataplaneHNS
 				cr.Spec.CalicoNetwork.WindowsDataplane = &hns
@@ -161,50 +161,8 @@ func fillDefaults(ctx context.Context, client client.Client, instance *operator.
 	// Default any fields on each IP pool declared in the Installation object.
 	for i := 0; i < len(instance.Spec.CalicoNetwork.IPPools); i++ {
 		pool := &instance.Spec.CalicoNetwork.IPPools[i]
-
-		if len(pool.AllowedUses) == 0 {
-			pool.AllowedUses = []operator.IPPoolAllowedUse{operator.IPPoolAllowedUseWorkload, operator.IPPoolAllowedUseTunnel}
-		}
-
-		// Do per-IP-family defaulting.
 		addr, _, err := net.ParseCIDR(pool.CIDR)
-		if err == nil && addr.To4() != nil {
-			// This is an IPv4 pool.
-			if pool.Encapsulation == "" {
-				if instance.Spec.CNI.Type == operator.PluginCalico {
-					pool.Encapsulation = operator.EncapsulationIPIP
-				} else {
-					pool.Encapsulation = operator.EncapsulationNone
-				}
-			}
-			if pool.NATOutgoing == "" {
-				pool.NATOutgoing = operator.NATOutgoingEnabled
-			}
-			if pool.NodeSelector == "" {
-				pool.NodeSelector = operator.NodeSelectorDefault
-			}
-			if pool.BlockSize == nil {
-				pool.BlockSize = ptr.ToPtr[int32](26)
-			}
-		} else if err == nil && addr.To16() != nil {
-			// This is an IPv6 pool.
-			if pool.Encapsulation == "" {
-				pool.Encapsulation = operator.EncapsulationNone
-			}
-			if pool.NATOutgoing == "" {
-				pool.NATOutgoing = operator.NATOutgoingDisabled
-			}
-			if pool.NodeSelector == "" {
-				pool.NodeSelector = operator.NodeSelectorDefault
-			}
-			if pool.BlockSize == nil {
-				pool.BlockSize = ptr.ToPtr[int32](122)
-			}
-		}
-
-		if pool.DisableNewAllocations == nil {
-			pool.DisableNewAllocations = ptr.ToPtr(false)
-		}
+		defaultPoolFields(instance, pool)
 
 		// Default the name if it's not set.
 		if pool.Name == "" {
@@ -240,9 +198,75 @@ func fillDefaults(ctx context.Context, client client.Client, instance *operator.
 			pool.AssignmentMode = crdv1.Automatic
 		}
 	}
+
+	// Default the IP pools of secondary networks in the same way. Their pools are named after the network, only
+	// used for pod addresses, and only assigned from on request.
+	for i := range instance.Spec.CalicoNetwork.SecondaryNetworks {
+		network := &instance.Spec.CalicoNetwork.SecondaryNetworks[i]
+		pool := &network.IPPool
+		if len(pool.AllowedUses) == 0 {
+			pool.AllowedUses = []operator.IPPoolAllowedUse{operator.IPPoolAllowedUseWorkload}
+		}
+		defaultPoolFields(instance, pool)
+		if pool.Name == "" {
+			if name, ok := currentPoolLookup[pool.CIDR]; ok {
+				pool.Name = name
+			} else {
+				pool.Name = network.Name
+			}
+		}
+		pool.AssignmentMode = crdv1.Manual
+	}
 	return nil
 }
 
+// defaultPoolFields fills in the defaults of an IP pool's fields, other than its name and assignment mode.
+func defaultPoolFields(instance *operator.Installation, pool *operator.IPPool) {
+	if len(pool.AllowedUses) == 0 {
+		pool.AllowedUses = []operator.IPPoolAllowedUse{operator.IPPoolAllowedUseWorkload, operator.IPPoolAllowedUseTunnel}
+	}
+
+	// Do per-IP-family defaulting.
+	addr, _, err := net.ParseCIDR(pool.CIDR)
+	if err == nil && addr.To4() != nil {
+		// This is an IPv4 pool.
+		if pool.Encapsulation == "" {
+			if instance.Spec.CNI.Type == operator.PluginCalico {
+				pool.Encapsulation = operator.EncapsulationIPIP
+			} else {
+				pool.Encapsulation = operator.EncapsulationNone
+			}
+		}
+		if pool.NATOutgoing == "" {
+			pool.NATOutgoing = operator.NATOutgoingEnabled
+		}
+		if pool.NodeSelector == "" {
+			pool.NodeSelector = operator.NodeSelectorDefault
+		}
+		if pool.BlockSize == nil {
+			pool.BlockSize = ptr.ToPtr[int32](26)
+		}
+	} else if err == nil && addr.To16() != nil {
+		// This is an IPv6 pool.
+		if pool.Encapsulation == "" {
+			pool.Encapsulation = operator.EncapsulationNone
+		}
+		if pool.NATOutgoing == "" {
+			pool.NATOutgoing = operator.NATOutgoingDisabled
+		}
+		if pool.NodeSelector == "" {
+			pool.NodeSelector = operator.NodeSelectorDefault
+		}
+		if pool.BlockSize == nil {
+			pool.BlockSize = ptr.ToPtr[int32](122)
+		}
+	}
+
+	if pool.DisableNewAllocations == nil {
+		pool.DisableNewAllocations = ptr.ToPtr(false)
+	}
+}
+
 func isDualStack(i *operator.Installation) bool {
 	hasV4, hasV6 := false, false
 	for _, pool := range i.Spec.CalicoNetwork.IPPools {
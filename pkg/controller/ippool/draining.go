@@ -0,0 +1,222 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ippool
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operator "github.com/tigera/operator/api/v1"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+)
+
+// The secondary attributes that Calico IPAM records for the addresses it allocates to pods.
+const (
+	ipamAttrNode      = "node"
+	ipamAttrPod       = "pod"
+	ipamAttrNamespace = "namespace"
+)
+
+// poolStatuses returns the status of each IP pool managed by the operator: those in the Installation, including the
+// pools of its secondary networks, followed by the removed pools that are kept until they have been drained. It also
+// returns the CIDRs of the pools that are being drained and still have addresses allocated from them.
+func poolStatuses(installation *operator.Installation, pendingDeletion []crdv1.IPPool, usages map[string]poolUsage) ([]operator.IPPoolStatus, []string) {
+	statuses := []operator.IPPoolStatus{}
+	draining := []string{}
+	for _, p := range desiredPools(installation) {
+		s := poolStatus(p.Name, p.CIDR, operator.IPPoolStateActive, usages[p.CIDR])
+		if p.DisableNewAllocations != nil && *p.DisableNewAllocations {
+			s.State = operator.IPPoolStateDraining
+			if s.AllocatedAddresses > 0 {
+				draining = append(draining, p.CIDR)
+			}
+		}
+		statuses = append(statuses, s)
+	}
+
+	sort.Slice(pendingDeletion, func(i, j int) bool { return pendingDeletion[i].Spec.CIDR < pendingDeletion[j].Spec.CIDR })
+	for _, p := range pendingDeletion {
+		statuses = append(statuses, poolStatus(p.Name, p.Spec.CIDR, operator.IPPoolStatePendingDeletion, usages[p.Spec.CIDR]))
+		draining = append(draining, p.Spec.CIDR)
+	}
+	return statuses, draining
+}
+
+func poolStatus(name, cidr string, state operator.IPPoolState, u poolUsage) operator.IPPoolStatus {
+	return operator.IPPoolStatus{
+		Name:               name,
+		CIDR:               cidr,
+		State:              state,
+		AllocatedAddresses: u.allocated,
+		Capacity:           u.capacity,
+		Utilization:        u.utilization(),
+		AllocatedBlocks:    u.blocks,
+		BlockCapacity:      u.blockCapacity,
+		ExhaustedNodes:     u.exhaustedNodes,
+	}
+}
+
+// updatePoolStatus writes the given IP pool statuses and exhaustion condition to the Installation. The condition is
+// removed if it is nil.
+func (r *Reconciler) updatePoolStatus(ctx context.Context, installation *operator.Installation, statuses []operator.IPPoolStatus, exhaustion *metav1.Condition) error {
+	patchFrom := client.MergeFrom(installation.DeepCopy())
+	var conditionChanged bool
+	if exhaustion != nil {
+		conditionChanged = meta.SetStatusCondition(&installation.Status.Conditions, *exhaustion)
+	} else {
+		conditionChanged = meta.RemoveStatusCondition(&installation.Status.Conditions, IPPoolExhaustionConditionType)
+	}
+	if !conditionChanged && reflect.DeepEqual(installation.Status.IPPools, statuses) {
+		return nil
+	}
+	installation.Status.IPPools = statuses
+	return r.client.Status().Patch(ctx, installation, patchFrom)
+}
+
+// evictFromPools evicts the pods that hold addresses from the given pools on a single node, so that they are
+// recreated with addresses from the remaining pools. The pods are found through the allocation attributes of the IPAM
+// blocks carved out of the pools, rather than by listing every pod in the cluster. Nodes are handled in name order; a
+// node is only moved on from once none of its pods can be evicted any more. Pods that aren't managed by a controller
+// are never evicted, as they would not be recreated.
+func (r *Reconciler) evictFromPools(ctx context.Context, cidrs []string, reqLogger logr.Logger) error {
+	var nets []*net.IPNet
+	for _, c := range cidrs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			return fmt.Errorf("failed to parse CIDR %s: %w", c, err)
+		}
+		nets = append(nets, n)
+	}
+
+	byNode, err := allocatedPods(ctx, r.client, nets)
+	if err != nil {
+		return err
+	}
+	nodes := make([]string, 0, len(byNode))
+	for n := range byNode {
+		nodes = append(nodes, n)
+	}
+	sort.Strings(nodes)
+
+	for _, node := range nodes {
+		var pods []*corev1.Pod
+		for _, key := range byNode[node] {
+			p := &corev1.Pod{}
+			if err := r.client.Get(ctx, key, p); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return fmt.Errorf("unable to get pod %s: %w", key, err)
+			}
+			// The allocation attributes may be stale, so check the pod still holds an address from the pools.
+			if p.Spec.HostNetwork || p.Spec.NodeName != node || p.DeletionTimestamp != nil {
+				continue
+			}
+			if metav1.GetControllerOf(p) == nil || !podInNets(p, nets) {
+				continue
+			}
+			pods = append(pods, p)
+		}
+		if len(pods) == 0 {
+			continue
+		}
+
+		for _, p := range pods {
+			eviction := &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: p.Name, Namespace: p.Namespace}}
+			err := r.client.SubResource("eviction").Create(ctx, p, eviction)
+			switch {
+			case err == nil:
+				reqLogger.Info("Evicted pod to drain IP pool", "pod", p.Name, "namespace", p.Namespace, "node", node)
+			case apierrors.IsTooManyRequests(err):
+				// Blocked by a PodDisruptionBudget - try again later.
+				reqLogger.V(1).Info("Pod eviction blocked by disruption budget", "pod", p.Name, "namespace", p.Namespace)
+			case apierrors.IsNotFound(err):
+			default:
+				return fmt.Errorf("unable to evict pod %s/%s: %w", p.Namespace, p.Name, err)
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+// allocatedPods returns the pods that Calico IPAM has allocated addresses from the given networks to, keyed by the
+// node they were allocated on.
+func allocatedPods(ctx context.Context, c client.Client, nets []*net.IPNet) (map[string][]types.NamespacedName, error) {
+	blocks := &crdv1.IPAMBlockList{}
+	if err := c.List(ctx, blocks); err != nil {
+		if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to list IPAM blocks: %w", err)
+	}
+
+	byNode := map[string][]types.NamespacedName{}
+	for _, b := range blocks.Items {
+		if b.Spec.Deleted {
+			continue
+		}
+		ip, _, err := net.ParseCIDR(b.Spec.CIDR)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse IPAM block CIDR %s: %w", b.Spec.CIDR, err)
+		}
+		if !ipInNets(ip, nets) {
+			continue
+		}
+		for _, a := range b.Spec.Allocations {
+			if a == nil || *a < 0 || *a >= len(b.Spec.Attributes) {
+				continue
+			}
+			attrs := b.Spec.Attributes[*a].AttrSecondary
+			if attrs[ipamAttrPod] == "" || attrs[ipamAttrNamespace] == "" || attrs[ipamAttrNode] == "" {
+				// Not allocated to a pod, e.g. a tunnel address.
+				continue
+			}
+			node := attrs[ipamAttrNode]
+			byNode[node] = append(byNode[node], types.NamespacedName{Name: attrs[ipamAttrPod], Namespace: attrs[ipamAttrNamespace]})
+		}
+	}
+	return byNode, nil
+}
+
+func podInNets(p *corev1.Pod, nets []*net.IPNet) bool {
+	for _, podIP := range p.Status.PodIPs {
+		if ip := net.ParseIP(podIP.IP); ip != nil && ipInNets(ip, nets) {
+			return true
+		}
+	}
+	return false
+}
+
+func ipInNets(ip net.IP, nets []*net.IPNet) bool {
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
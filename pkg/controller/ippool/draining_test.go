@@ -0,0 +1,202 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ippool
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operator "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	"github.com/tigera/operator/pkg/controller/status"
+	"github.com/tigera/operator/pkg/controller/utils"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("IP pool draining", func() {
+	var ctx context.Context
+	var c client.Client
+	var mockStatus *status.MockStatus
+	var r Reconciler
+
+	block := func(name, cidr string, allocated int) *crdv1.IPAMBlock {
+		b := &crdv1.IPAMBlock{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: crdv1.IPAMBlockSpec{CIDR: cidr}}
+		for i := 0; i < 4; i++ {
+			if i < allocated {
+				idx := i
+				b.Spec.Allocations = append(b.Spec.Allocations, &idx)
+			} else {
+				b.Spec.Allocations = append(b.Spec.Allocations, nil)
+			}
+		}
+		return b
+	}
+
+	// allocatedBlock returns an IPAM block with an allocation for each of the given pods, in the node/pod form.
+	allocatedBlock := func(name, cidr string, pods ...string) *crdv1.IPAMBlock {
+		b := &crdv1.IPAMBlock{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: crdv1.IPAMBlockSpec{CIDR: cidr}}
+		for i, p := range pods {
+			node, pod, _ := strings.Cut(p, "/")
+			idx := i
+			b.Spec.Allocations = append(b.Spec.Allocations, &idx)
+			b.Spec.Attributes = append(b.Spec.Attributes, crdv1.AllocationAttribute{
+				AttrSecondary: map[string]string{"node": node, "pod": pod, "namespace": "default"},
+			})
+		}
+		return b
+	}
+
+	pod := func(name, node, ip string, owned bool) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: node},
+			Status:     corev1.PodStatus{PodIPs: []corev1.PodIP{{IP: ip}}},
+		}
+		if owned {
+			isController := true
+			p.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs", UID: "1", Controller: &isController}}
+		}
+		return p
+	}
+
+	podExists := func(name string) bool {
+		err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, &corev1.Pod{})
+		return err == nil
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme)).NotTo(HaveOccurred())
+		Expect(corev1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+		c = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+		ctx = context.Background()
+		mockStatus = &status.MockStatus{}
+		r = Reconciler{client: c, scheme: scheme, autoDetectedProvider: operator.ProviderNone, status: mockStatus}
+	})
+
+	It("counts allocations per pool from IPAM blocks", func() {
+		Expect(c.Create(ctx, block("a", "192.168.0.0/26", 3))).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, block("b", "192.168.1.0/26", 1))).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, block("c", "10.0.0.0/26", 2))).NotTo(HaveOccurred())
+		deleted := block("d", "10.0.1.0/26", 4)
+		deleted.Spec.Deleted = true
+		Expect(c.Create(ctx, deleted)).NotTo(HaveOccurred())
+
+		usages, err := poolUsages(ctx, c, map[string]int{"192.168.0.0/16": 26, "10.0.0.0/16": 26, "172.16.0.0/16": 26})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(usages["192.168.0.0/16"].allocated).To(Equal(int32(4)))
+		Expect(usages["10.0.0.0/16"].allocated).To(Equal(int32(2)))
+		Expect(usages["172.16.0.0/16"].allocated).To(Equal(int32(0)))
+	})
+
+	It("reports the state of each pool and which ones need draining", func() {
+		disabled := true
+		installation := &operator.Installation{Spec: operator.InstallationSpec{CalicoNetwork: &operator.CalicoNetworkSpec{
+			IPPools: []operator.IPPool{
+				{Name: "new", CIDR: "10.0.0.0/16"},
+				{Name: "old", CIDR: "192.168.0.0/16", DisableNewAllocations: &disabled},
+			},
+		}}}
+		removed := []crdv1.IPPool{{ObjectMeta: metav1.ObjectMeta{Name: "older"}, Spec: crdv1.IPPoolSpec{CIDR: "172.16.0.0/16"}}}
+
+		statuses, draining := poolStatuses(installation, removed, map[string]poolUsage{"192.168.0.0/16": {allocated: 4}, "172.16.0.0/16": {allocated: 1}})
+		Expect(statuses).To(Equal([]operator.IPPoolStatus{
+			{Name: "new", CIDR: "10.0.0.0/16", State: operator.IPPoolStateActive},
+			{Name: "old", CIDR: "192.168.0.0/16", State: operator.IPPoolStateDraining, AllocatedAddresses: 4},
+			{Name: "older", CIDR: "172.16.0.0/16", State: operator.IPPoolStatePendingDeletion, AllocatedAddresses: 1},
+		}))
+		Expect(draining).To(Equal([]string{"192.168.0.0/16", "172.16.0.0/16"}))
+	})
+
+	It("evicts controller-managed pods from draining pools one node at a time", func() {
+		Expect(c.Create(ctx, pod("a1", "node-a", "192.168.0.1", true))).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, pod("a2", "node-a", "192.168.0.2", false))).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, pod("a3", "node-a", "10.0.0.1", true))).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, pod("b1", "node-b", "192.168.0.3", true))).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, pod("c1", "node-c", "192.168.0.4", true))).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, allocatedBlock("a", "192.168.0.0/26", "node-a/a1", "node-a/a2", "node-b/b1"))).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, allocatedBlock("b", "10.0.0.0/26", "node-a/a3"))).NotTo(HaveOccurred())
+
+		Expect(r.evictFromPools(ctx, []string{"192.168.0.0/16"}, logf.Log)).NotTo(HaveOccurred())
+		Expect(podExists("a1")).To(BeFalse())
+		Expect(podExists("a2")).To(BeTrue())
+		Expect(podExists("a3")).To(BeTrue())
+		Expect(podExists("b1")).To(BeTrue())
+
+		Expect(r.evictFromPools(ctx, []string{"192.168.0.0/16"}, logf.Log)).NotTo(HaveOccurred())
+		Expect(podExists("a2")).To(BeTrue())
+		Expect(podExists("b1")).To(BeFalse())
+
+		// Pods without an allocation in the IPAM blocks of the pools are not found.
+		Expect(r.evictFromPools(ctx, []string{"192.168.0.0/16"}, logf.Log)).NotTo(HaveOccurred())
+		Expect(podExists("c1")).To(BeTrue())
+	})
+
+	It("keeps a removed pool with allocations until it has been drained", func() {
+		instance := &operator.Installation{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Finalizers: []string{"tigera.io/operator-cleanup"}},
+			Spec: operator.InstallationSpec{
+				Variant: operator.Calico,
+				CNI: &operator.CNISpec{
+					Type: operator.PluginCalico,
+					IPAM: &operator.IPAMSpec{Type: operator.IPAMPluginCalico},
+				},
+				CalicoNetwork: &operator.CalicoNetworkSpec{
+					IPPools: []operator.IPPool{{CIDR: "192.168.0.0/16", NATOutgoing: "Disabled"}},
+				},
+			},
+		}
+		Expect(c.Create(ctx, instance)).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, block("a", "192.168.0.0/26", 2))).NotTo(HaveOccurred())
+
+		mockStatus.On("OnCRFound")
+		mockStatus.On("SetMetaData", mock.Anything)
+		mockStatus.On("IsAvailable").Return(true)
+		mockStatus.On("ReadyToMonitor")
+		mockStatus.On("ClearDegraded")
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.Get(ctx, utils.DefaultInstanceKey, instance)).NotTo(HaveOccurred())
+		Expect(instance.Status.IPPools).To(HaveLen(1))
+		Expect(instance.Status.IPPools[0].State).To(Equal(operator.IPPoolStateActive))
+		Expect(instance.Status.IPPools[0].AllocatedAddresses).To(Equal(int32(2)))
+
+		By("removing the pool while addresses are still allocated from it")
+		instance.Spec.CalicoNetwork.IPPools = []operator.IPPool{}
+		Expect(c.Update(ctx, instance)).NotTo(HaveOccurred())
+		mockStatus.On("SetDegraded", operator.ResourceNotReady, "Unable to disable IP pools while Calico API server is unavailable", nil, mock.Anything)
+		_, err = r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		mockStatus.AssertExpectations(GinkgoT())
+
+		pools := crdv1.IPPoolList{}
+		Expect(c.List(ctx, &pools)).NotTo(HaveOccurred())
+		Expect(pools.Items).To(HaveLen(1))
+	})
+})
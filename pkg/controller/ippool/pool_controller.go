@@ -136,6 +136,12 @@ func hasOwnerLabel(pool *crdv1.IPPool) bool {
 	return false
 }
 
+// desiredPools returns the IP pools the Installation asks for: its IP pools, followed by those of its secondary
+// networks.
+func desiredPools(installation *operator.Installation) []operator.IPPool {
+	return append(append([]operator.IPPool{}, installation.Spec.CalicoNetwork.IPPools...), render.SecondaryNetworkPools(&installation.Spec)...)
+}
+
 // Reconcile reconciles IP pools in the cluster.
 //
 // - Query desired IP pools (from Installation)
@@ -214,6 +220,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, err
 	}
 	reqLogger.V(1).Info("Reconciling IP pools for installation", "installation", installation.Spec)
+	pools := desiredPools(installation)
 
 	// Get the APIServer. If healthy, we'll use the projectcalico.org/v3 API for managing pools.
 	// Otherwise, we'll use the internal v1 API for bootstrapping the cluster until the API server is available.
@@ -246,7 +253,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 			// Compare this pool to the pools in the Installation object and there is a match, consider it ours.
 			// Without this logic, this controller would consider these pools as not owned by itself, resulting in errors
 			// when it attempts to create overlappin IP pools.
-			for _, cnp := range installation.Spec.CalicoNetwork.IPPools {
+			for _, cnp := range pools {
 				v1p := v1.IPPool{}
 				v1p.FromProjectCalicoV1(p)
 				reqLogger.V(1).Info("Comparing IP pool", "clusterPool", p, "installationPool", cnp)
@@ -271,11 +278,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 	reqLogger.V(1).Info("Found IP pools owned by us", "count", len(ourPools))
 
+	// Work out how much of each pool is in use, so that we can report on it and know which pools can safely be deleted.
+	blockSizes := map[string]int{}
+	for _, p := range currentPools.Items {
+		blockSizes[p.Spec.CIDR] = p.Spec.BlockSize
+	}
+	for _, p := range pools {
+		if _, ok := blockSizes[p.CIDR]; !ok && p.BlockSize != nil {
+			blockSizes[p.CIDR] = int(*p.BlockSize)
+		}
+	}
+	usages, err := poolUsages(ctx, r.client, blockSizes)
+	if err != nil {
+		r.status.SetDegraded(operator.ResourceReadError, "Error querying IP pool usage", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
 	// For each pool that is desired, but doesn't exist, create it.
 	// We will install pools at start-of-day using the CRD API, but otherwise
 	// we require the v3 API to be running. This is so that we properly leverage the v3 API's validation.
 	toCreateOrUpdate := []client.Object{}
-	for _, p := range installation.Spec.CalicoNetwork.IPPools {
+	for _, p := range pools {
 		// We need to check if updates are required, but the installation uses the operator API format and the queried
 		// pools are in crd.projectcalico.org/v1 format. Compare the pools using the crd.projectcalico.org/v1 format.
 		v1res, err := p.ToProjectCalicoV1()
@@ -330,20 +353,41 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	// Check existing pools owned by this controller that are no longer in the Installation resource.
 	toDelete := []client.Object{}
+	pendingDeletion := []crdv1.IPPool{}
 	for cidr, v1res := range ourPools {
 		reqLogger.WithValues("cidr", cidr).V(1).Info("Checking if pool is still valid")
 		found := false
-		for _, p := range installation.Spec.CalicoNetwork.IPPools {
+		for _, p := range pools {
 			if p.CIDR == cidr {
 				found = true
 				break
 			}
 		}
-		if !found {
+		if !found && usages[cidr].allocated > 0 {
+			// This pool has been removed, but addresses are still allocated from it. Keep it, with new allocations
+			// disabled, until it has been drained.
+			reqLogger.WithValues("cidr", cidr, "allocated", usages[cidr].allocated).Info("Pool needs to be drained before it is deleted")
+			pendingDeletion = append(pendingDeletion, v1res)
+			if v1res.Spec.Disabled {
+				continue
+			}
+			if !apiAvailable {
+				r.status.SetDegraded(operator.ResourceNotReady, "Unable to disable IP pools while Calico API server is unavailable", nil, reqLogger)
+				return reconcile.Result{}, nil
+			}
+			disabled := v1res.DeepCopy()
+			disabled.Spec.Disabled = true
+			v3res, err := v1ToV3(disabled)
+			if err != nil {
+				r.status.SetDegraded(operator.ResourceValidationError, "error handling IP pool", err, reqLogger)
+				return reconcile.Result{}, err
+			}
+			toCreateOrUpdate = append(toCreateOrUpdate, v3res)
+		} else if !found {
 			// This pool needs to be deleted. We only ever send deletes via the API server,
 			// since deletion requires rather complex logic. If the API server isn't available,
 			// we won't delete the pool and will mark the controller as degraded.
-			reqLogger.WithValues("cidr", cidr, "valid", installation.Spec.CalicoNetwork.IPPools).Info("Pool needs to be deleted")
+			reqLogger.WithValues("cidr", cidr, "valid", pools).Info("Pool needs to be deleted")
 			if apiAvailable {
 				// v3 API is available - send a delete request.
 				v3res, err := v1ToV3(&v1res)
@@ -378,6 +422,29 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, err
 	}
 
+	// Report the state of our pools, and evict pods from the ones being drained if configured to do so.
+	// Pools running out of addresses are reported as a condition on the Installation rather than degrading the
+	// TigeraStatus, since nothing is wrong with the pools themselves.
+	statuses, draining := poolStatuses(installation, pendingDeletion, usages)
+	var exhaustion *metav1.Condition
+	if usageReportingEnabled(installation) {
+		c := exhaustionCondition(installation, statuses)
+		if c.Status == metav1.ConditionTrue {
+			reqLogger.Info("IP pools are close to exhaustion", "reason", c.Message)
+		}
+		exhaustion = &c
+	}
+	if err := r.updatePoolStatus(ctx, installation, statuses, exhaustion); err != nil {
+		r.status.SetDegraded(operator.ResourceUpdateError, "Error updating IP pool status", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	if d := installation.Spec.CalicoNetwork.IPPoolDraining; len(draining) > 0 && d != nil && d.PodEviction != nil && *d.PodEviction == operator.PodEvictionEnabled {
+		if err := r.evictFromPools(ctx, draining, reqLogger); err != nil {
+			r.status.SetDegraded(operator.ResourceUpdateError, "Error evicting pods from draining IP pools", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+	}
+
 	// Tell the status manager that we're ready to monitor the resources we've told it about and receive statuses.
 	r.status.ReadyToMonitor()
 
@@ -390,6 +457,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
 	}
 
+	if len(draining) > 0 {
+		// IPAM blocks aren't watched, so check back on the pools being drained.
+		return reconcile.Result{RequeueAfter: utils.StandardRetry}, nil
+	}
+	if len(statuses) > 0 && usageReportingEnabled(installation) {
+		// Likewise, refresh the reported pool usage every so often.
+		return reconcile.Result{RequeueAfter: usageRefreshInterval}, nil
+	}
 	return reconcile.Result{}, nil
 }
 
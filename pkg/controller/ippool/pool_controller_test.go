@@ -22,7 +22,6 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	configv1 "github.com/openshift/api/config/v1"
@@ -32,6 +31,7 @@ import (
 	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
 	"github.com/tigera/operator/pkg/controller/status"
 	"github.com/tigera/operator/pkg/controller/utils"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
 	"github.com/tigera/operator/pkg/render"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -39,6 +39,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	schedv1 "k8s.io/api/scheduling/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -66,7 +67,7 @@ var _ = Describe("IP Pool controller tests", func() {
 		Expect(storagev1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
 
 		// Create a client that will have a crud interface of k8s objects.
-		c = fake.NewClientBuilder().WithScheme(scheme).Build()
+		c = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
 		ctx, cancel = context.WithCancel(context.Background())
 
 		// Create an object we can use throughout the test to do the compliance reconcile loops.
@@ -261,6 +262,114 @@ var _ = Describe("IP Pool controller tests", func() {
 		}
 	})
 
+	It("should create IP pools for secondary networks", func() {
+		mm := operator.MultiInterfaceModeMultus
+		instance := &operator.Installation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "default",
+				Finalizers: []string{"tigera.io/operator-cleanup"},
+			},
+			Spec: operator.InstallationSpec{
+				Variant:  operator.Calico,
+				Registry: "some.registry.org/",
+				CNI: &operator.CNISpec{
+					Type: operator.PluginCalico,
+					IPAM: &operator.IPAMSpec{Type: operator.IPAMPluginCalico},
+				},
+				CalicoNetwork: &operator.CalicoNetworkSpec{
+					IPPools:            []operator.IPPool{{CIDR: "192.168.0.0/16"}},
+					MultiInterfaceMode: &mm,
+					SecondaryNetworks: []operator.SecondaryNetwork{
+						{Name: "net-a", IPPool: operator.IPPool{CIDR: "10.10.0.0/16"}, InterfacePrefix: "cna", Namespaces: []string{"ns1"}},
+					},
+				},
+			},
+		}
+		Expect(c.Create(ctx, instance)).ShouldNot(HaveOccurred())
+
+		// Set up expected mocks.
+		mockStatus.On("OnCRFound")
+		mockStatus.On("SetMetaData", mock.Anything)
+		mockStatus.On("IsAvailable").Return(true)
+		mockStatus.On("ReadyToMonitor")
+		mockStatus.On("ClearDegraded")
+
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).ShouldNot(HaveOccurred())
+		mockStatus.AssertExpectations(GinkgoT())
+
+		// The secondary network's pool is defaulted on the Installation.
+		installation := &operator.Installation{}
+		Expect(c.Get(ctx, utils.DefaultInstanceKey, installation)).ShouldNot(HaveOccurred())
+		pool := installation.Spec.CalicoNetwork.SecondaryNetworks[0].IPPool
+		Expect(pool.Name).To(Equal("net-a"))
+		Expect(pool.AssignmentMode).To(Equal(crdv1.Manual))
+		Expect(pool.AllowedUses).To(Equal([]operator.IPPoolAllowedUse{operator.IPPoolAllowedUseWorkload}))
+
+		// Both pools are created, and the secondary network's pool is only assigned from on request.
+		ipPools := crdv1.IPPoolList{}
+		Expect(c.List(ctx, &ipPools)).ShouldNot(HaveOccurred())
+		Expect(ipPools.Items).To(HaveLen(2))
+		poolsByCIDR := map[string]crdv1.IPPool{}
+		for _, p := range ipPools.Items {
+			poolsByCIDR[p.Spec.CIDR] = p
+		}
+		Expect(poolsByCIDR["192.168.0.0/16"].Spec.AssignmentMode).To(Equal(crdv1.Automatic))
+		Expect(poolsByCIDR["10.10.0.0/16"].Name).To(Equal("net-a"))
+		Expect(poolsByCIDR["10.10.0.0/16"].Spec.AssignmentMode).To(Equal(crdv1.Manual))
+		Expect(poolsByCIDR["10.10.0.0/16"].Labels).To(Equal(map[string]string{"app.kubernetes.io/managed-by": "tigera-operator"}))
+
+		// The secondary network's pool is reported along with the others.
+		Expect(installation.Status.IPPools).To(HaveLen(2))
+		Expect(installation.Status.IPPools[1].Name).To(Equal("net-a"))
+	})
+
+	It("should only report pool exhaustion and refresh pool usage with Calico IPAM", func() {
+		instance := &operator.Installation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "default",
+				Finalizers: []string{"tigera.io/operator-cleanup"},
+			},
+			Spec: operator.InstallationSpec{
+				Variant:  operator.Calico,
+				Registry: "some.registry.org/",
+				CNI: &operator.CNISpec{
+					Type: operator.PluginCalico,
+					IPAM: &operator.IPAMSpec{Type: operator.IPAMPluginCalico},
+				},
+				CalicoNetwork: &operator.CalicoNetworkSpec{
+					IPPools: []operator.IPPool{{CIDR: "192.168.0.0/16"}},
+				},
+			},
+		}
+		Expect(c.Create(ctx, instance)).ShouldNot(HaveOccurred())
+
+		mockStatus.On("OnCRFound")
+		mockStatus.On("SetMetaData", mock.Anything)
+		mockStatus.On("IsAvailable").Return(true)
+		mockStatus.On("ReadyToMonitor")
+		mockStatus.On("ClearDegraded")
+
+		result, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(usageRefreshInterval))
+		installation := &operator.Installation{}
+		Expect(c.Get(ctx, utils.DefaultInstanceKey, installation)).ShouldNot(HaveOccurred())
+		cond := meta.FindStatusCondition(installation.Status.Conditions, IPPoolExhaustionConditionType)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+
+		By("dropping the condition and the refresh with another IPAM")
+		installation.Spec.CNI.IPAM.Type = operator.IPAMPluginHostLocal
+		Expect(c.Update(ctx, installation)).ShouldNot(HaveOccurred())
+		result, err = r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(c.Get(ctx, utils.DefaultInstanceKey, installation)).ShouldNot(HaveOccurred())
+		Expect(meta.FindStatusCondition(installation.Status.Conditions, IPPoolExhaustionConditionType)).To(BeNil())
+		mockStatus.AssertExpectations(GinkgoT())
+	})
+
 	It("should disallow modification if there is no API server", func() {
 		instance := &operator.Installation{
 			ObjectMeta: metav1.ObjectMeta{
@@ -389,7 +498,7 @@ var _ = table.DescribeTable("Test OpenShift IP pool defaulting",
 		Expect(configv1.AddToScheme(scheme)).NotTo(HaveOccurred())
 		Expect(appsv1.SchemeBuilder.AddToScheme(scheme)).ShouldNot(HaveOccurred())
 		Expect(operator.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
-		cli := fake.NewClientBuilder().WithScheme(scheme).Build()
+		cli := ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
 		ctx := context.Background()
 		if on != nil {
 			on.Name = "cluster"
@@ -602,7 +711,7 @@ var _ = Describe("fillDefaults()", func() {
 		Expect(operator.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
 
 		// Create a client that will have a crud interface of k8s objects.
-		cli = fake.NewClientBuilder().WithScheme(scheme).Build()
+		cli = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
 		ctx = context.Background()
 	})
 
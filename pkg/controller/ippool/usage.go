@@ -0,0 +1,216 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ippool
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operator "github.com/tigera/operator/api/v1"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+)
+
+// defaultUtilizationThreshold is the percentage of a pool's addresses that may be allocated before the pool is
+// reported as close to exhaustion.
+const defaultUtilizationThreshold = 90
+
+// usageRefreshInterval is how often pool usage is recalculated, since IPAM blocks aren't watched.
+const usageRefreshInterval = 5 * time.Minute
+
+// IPPoolExhaustionConditionType is the Installation status condition that warns about IP pools that are running out
+// of addresses. It is only a warning: the ippools TigeraStatus is not degraded by it.
+const IPPoolExhaustionConditionType = "IPPoolExhaustion"
+
+// usageReportingEnabled returns true if pool usage is reported for the given Installation. The operator can only
+// work out pool usage from the IPAM blocks of Calico IPAM.
+func usageReportingEnabled(installation *operator.Installation) bool {
+	cni := installation.Spec.CNI
+	return cni == nil || cni.IPAM == nil || cni.IPAM.Type == "" || cni.IPAM.Type == operator.IPAMPluginCalico
+}
+
+// poolUsage describes how much of an IP pool is in use.
+type poolUsage struct {
+	// allocated is the number of addresses allocated from the pool.
+	allocated int32
+	// capacity is the number of addresses in the pool.
+	capacity int64
+	// blocks is the number of IPAM blocks claimed from the pool.
+	blocks int32
+	// blockCapacity is the number of IPAM blocks the pool can be split into.
+	blockCapacity int64
+	// exhaustedNodes are the nodes with no free addresses left in their blocks from the pool, when the pool has no
+	// unclaimed blocks left to give them.
+	exhaustedNodes []string
+}
+
+// utilization returns the percentage of the pool's addresses that are allocated.
+func (u poolUsage) utilization() int32 {
+	if u.capacity == 0 {
+		return 0
+	}
+	return int32(int64(u.allocated) * 100 / u.capacity)
+}
+
+// poolUsages returns the usage of each of the given pools, keyed by CIDR. The given map holds the block size of each
+// pool. Usage is worked out from the IPAM blocks carved out of each pool, and the node each block is affine to.
+func poolUsages(ctx context.Context, c client.Client, blockSizes map[string]int) (map[string]poolUsage, error) {
+	usages := map[string]poolUsage{}
+	poolNets := map[string]*net.IPNet{}
+	for cidr, blockSize := range blockSizes {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			// Nothing can have been allocated from a pool without a valid CIDR.
+			continue
+		}
+		poolNets[cidr] = n
+		ones, bits := n.Mask.Size()
+		if blockSize == 0 {
+			blockSize = 26
+			if bits == 128 {
+				blockSize = 122
+			}
+		}
+		usages[cidr] = poolUsage{capacity: powerOfTwo(bits - ones), blockCapacity: powerOfTwo(blockSize - ones)}
+	}
+
+	blocks := &crdv1.IPAMBlockList{}
+	if err := c.List(ctx, blocks); err != nil {
+		if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+			// IPAM hasn't allocated anything yet.
+			return usages, nil
+		}
+		return nil, fmt.Errorf("unable to list IPAM blocks: %w", err)
+	}
+
+	// Free addresses in the blocks affine to each node, per pool.
+	nodeFree := map[string]map[string]int{}
+	for _, b := range blocks.Items {
+		if b.Spec.Deleted {
+			continue
+		}
+		ip, _, err := net.ParseCIDR(b.Spec.CIDR)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse IPAM block CIDR %s: %w", b.Spec.CIDR, err)
+		}
+		for cidr, n := range poolNets {
+			if !n.Contains(ip) {
+				continue
+			}
+			u := usages[cidr]
+			u.blocks++
+			free := 0
+			for _, a := range b.Spec.Allocations {
+				if a != nil {
+					u.allocated++
+				} else {
+					free++
+				}
+			}
+			usages[cidr] = u
+
+			if b.Spec.Affinity != nil && strings.HasPrefix(*b.Spec.Affinity, "host:") {
+				node := strings.TrimPrefix(*b.Spec.Affinity, "host:")
+				if nodeFree[cidr] == nil {
+					nodeFree[cidr] = map[string]int{}
+				}
+				nodeFree[cidr][node] += free
+			}
+			break
+		}
+	}
+
+	for cidr, u := range usages {
+		if int64(u.blocks) < u.blockCapacity {
+			// Nodes can still claim new blocks from this pool.
+			continue
+		}
+		for node, free := range nodeFree[cidr] {
+			if free == 0 {
+				u.exhaustedNodes = append(u.exhaustedNodes, node)
+			}
+		}
+		sort.Strings(u.exhaustedNodes)
+		usages[cidr] = u
+	}
+	return usages, nil
+}
+
+// maxCount is the largest count reported in status: larger numbers can't be represented exactly in JSON.
+const maxCount = 1 << 53
+
+// powerOfTwo returns 2^n, capped at maxCount so that it survives a round trip through JSON.
+func powerOfTwo(n int) int64 {
+	if n < 0 {
+		return 0
+	}
+	if n >= 53 {
+		return maxCount
+	}
+	return int64(1) << n
+}
+
+// exhaustionWarning returns a description of the pools that are above the utilization threshold, or that have nodes
+// unable to get any more addresses from them. It returns an empty string if there are none.
+func exhaustionWarning(installation *operator.Installation, statuses []operator.IPPoolStatus) string {
+	threshold := int32(defaultUtilizationThreshold)
+	if ipam := installation.Spec.CNI.IPAM; ipam != nil && ipam.UtilizationThreshold != nil {
+		threshold = *ipam.UtilizationThreshold
+	}
+
+	var warnings []string
+	for _, s := range statuses {
+		if s.State != operator.IPPoolStateActive {
+			// Pools being drained are expected to run out.
+			continue
+		}
+		if s.Utilization >= threshold {
+			warnings = append(warnings, fmt.Sprintf("IP pool %s is %d%% allocated (%d of %d addresses)", s.Name, s.Utilization, s.AllocatedAddresses, s.Capacity))
+		}
+		if len(s.ExhaustedNodes) > 0 {
+			warnings = append(warnings, fmt.Sprintf("IP pool %s has no free blocks and nodes %s have no free addresses", s.Name, strings.Join(s.ExhaustedNodes, ", ")))
+		}
+	}
+	return strings.Join(warnings, "; ")
+}
+
+// exhaustionCondition returns the IPPoolExhaustion condition to report on the Installation for the given pool
+// statuses.
+func exhaustionCondition(installation *operator.Installation, statuses []operator.IPPoolStatus) metav1.Condition {
+	if msg := exhaustionWarning(installation, statuses); msg != "" {
+		return metav1.Condition{
+			Type:               IPPoolExhaustionConditionType,
+			Status:             metav1.ConditionTrue,
+			Reason:             "PoolsNearlyExhausted",
+			Message:            msg,
+			ObservedGeneration: installation.Generation,
+		}
+	}
+	return metav1.Condition{
+		Type:               IPPoolExhaustionConditionType,
+		Status:             metav1.ConditionFalse,
+		Reason:             "PoolsAvailable",
+		Message:            "No IP pools are close to exhaustion",
+		ObservedGeneration: installation.Generation,
+	}
+}
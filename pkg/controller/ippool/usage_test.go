@@ -0,0 +1,115 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ippool
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operator "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("IP pool usage", func() {
+	var ctx context.Context
+	var c client.Client
+
+	block := func(name, cidr, node string, size, allocated int) *crdv1.IPAMBlock {
+		b := &crdv1.IPAMBlock{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: crdv1.IPAMBlockSpec{CIDR: cidr}}
+		if node != "" {
+			affinity := "host:" + node
+			b.Spec.Affinity = &affinity
+		}
+		for i := 0; i < size; i++ {
+			if i < allocated {
+				idx := i
+				b.Spec.Allocations = append(b.Spec.Allocations, &idx)
+			} else {
+				b.Spec.Allocations = append(b.Spec.Allocations, nil)
+			}
+		}
+		return b
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme)).NotTo(HaveOccurred())
+		c = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+		ctx = context.Background()
+	})
+
+	It("reports capacity, blocks and utilization", func() {
+		Expect(c.Create(ctx, block("a", "10.0.0.0/26", "node-a", 64, 32))).NotTo(HaveOccurred())
+
+		usages, err := poolUsages(ctx, c, map[string]int{"10.0.0.0/24": 0, "fd00::/120": 0})
+		Expect(err).NotTo(HaveOccurred())
+		u := usages["10.0.0.0/24"]
+		Expect(u.allocated).To(Equal(int32(32)))
+		Expect(u.capacity).To(Equal(int64(256)))
+		Expect(u.blocks).To(Equal(int32(1)))
+		Expect(u.blockCapacity).To(Equal(int64(4)))
+		Expect(u.utilization()).To(Equal(int32(12)))
+		Expect(u.exhaustedNodes).To(BeEmpty())
+
+		// IPv6 pools default to /122 blocks.
+		Expect(usages["fd00::/120"].capacity).To(Equal(int64(256)))
+		Expect(usages["fd00::/120"].blockCapacity).To(Equal(int64(4)))
+	})
+
+	It("reports nodes with no free addresses once the pool has no blocks left", func() {
+		Expect(c.Create(ctx, block("a", "10.0.0.0/26", "node-a", 64, 64))).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, block("b", "10.0.0.64/26", "node-b", 64, 10))).NotTo(HaveOccurred())
+
+		usages, err := poolUsages(ctx, c, map[string]int{"10.0.0.0/25": 26})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(usages["10.0.0.0/25"].exhaustedNodes).To(Equal([]string{"node-a"}))
+
+		usages, err = poolUsages(ctx, c, map[string]int{"10.0.0.0/24": 26})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(usages["10.0.0.0/24"].exhaustedNodes).To(BeEmpty())
+	})
+
+	It("warns about active pools above the utilization threshold", func() {
+		installation := &operator.Installation{Spec: operator.InstallationSpec{CNI: &operator.CNISpec{IPAM: &operator.IPAMSpec{}}}}
+		statuses := []operator.IPPoolStatus{
+			{Name: "full", State: operator.IPPoolStateActive, AllocatedAddresses: 230, Capacity: 256, Utilization: 89},
+			{Name: "draining", State: operator.IPPoolStateDraining, AllocatedAddresses: 256, Capacity: 256, Utilization: 100},
+		}
+		Expect(exhaustionWarning(installation, statuses)).To(BeEmpty())
+
+		threshold := int32(80)
+		installation.Spec.CNI.IPAM.UtilizationThreshold = &threshold
+		Expect(exhaustionWarning(installation, statuses)).To(Equal("IP pool full is 89% allocated (230 of 256 addresses)"))
+
+		statuses[0].Utilization = 10
+		statuses[0].ExhaustedNodes = []string{"node-a", "node-b"}
+		Expect(exhaustionWarning(installation, statuses)).To(Equal("IP pool full has no free blocks and nodes node-a, node-b have no free addresses"))
+
+		cond := exhaustionCondition(installation, statuses)
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Message).To(ContainSubstring("IP pool full has no free blocks"))
+
+		statuses[0].ExhaustedNodes = nil
+		Expect(exhaustionCondition(installation, statuses).Status).To(Equal(metav1.ConditionFalse))
+	})
+})
@@ -22,11 +22,11 @@ import (
 	operator "github.com/tigera/operator/api/v1"
 )
 
-// ValidatePools validates the IP pools specified in the Installation object.
+// ValidatePools validates the IP pools specified in the Installation object, including those of its secondary networks.
 func ValidatePools(instance *operator.Installation) error {
 	cidrs := map[string]bool{}
 	names := map[string]bool{}
-	for _, pool := range instance.Spec.CalicoNetwork.IPPools {
+	for _, pool := range desiredPools(instance) {
 		_, cidr, err := net.ParseCIDR(pool.CIDR)
 		if err != nil {
 			return fmt.Errorf("IP pool CIDR (%s) is invalid: %s", pool.CIDR, err)
@@ -137,5 +137,19 @@ func ValidatePools(instance *operator.Installation) error {
 			return fmt.Errorf("IP pool disable bgp export must be false when AllowedUse is LoadBalancer")
 		}
 	}
+
+	// Evicted pods need a pool to get new addresses from.
+	if d := instance.Spec.CalicoNetwork.IPPoolDraining; d != nil && d.PodEviction != nil && *d.PodEviction == operator.PodEvictionEnabled {
+		active := false
+		for _, pool := range instance.Spec.CalicoNetwork.IPPools {
+			if pool.DisableNewAllocations == nil || !*pool.DisableNewAllocations {
+				active = true
+				break
+			}
+		}
+		if !active {
+			return fmt.Errorf("IP pool draining with pod eviction requires at least one IP pool with new allocations enabled")
+		}
+	}
 	return nil
 }
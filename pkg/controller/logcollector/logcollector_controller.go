@@ -18,18 +18,24 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 
@@ -67,7 +73,8 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 	tierWatchReady := &utils.ReadyFlag{}
 
 	// create the reconciler
-	reconciler := newReconciler(mgr, opts, licenseAPIReady, tierWatchReady)
+	storeCheckEvents := make(chan event.GenericEvent)
+	reconciler := newReconciler(mgr, opts, licenseAPIReady, tierWatchReady, storeCheckEvents)
 
 	// Create a new controller
 	c, err := ctrlruntime.NewController("logcollector-controller", mgr, controller.Options{Reconciler: reconcile.Reconciler(reconciler)})
@@ -75,6 +82,10 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		return fmt.Errorf("failed to create logcollector-controller: %v", err)
 	}
 
+	if err = c.Watch(source.Channel(storeCheckEvents, &handler.EnqueueRequestForObject{})); err != nil {
+		return fmt.Errorf("logcollector-controller failed to watch additional store checks: %w", err)
+	}
+
 	k8sClient, err := kubernetes.NewForConfig(mgr.GetConfig())
 	if err != nil {
 		log.Error(err, "Failed to establish a connection to k8s")
@@ -97,7 +108,7 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 }
 
 // newReconciler returns a new reconcile.Reconciler
-func newReconciler(mgr manager.Manager, opts options.AddOptions, licenseAPIReady *utils.ReadyFlag, tierWatchReady *utils.ReadyFlag) reconcile.Reconciler {
+func newReconciler(mgr manager.Manager, opts options.AddOptions, licenseAPIReady *utils.ReadyFlag, tierWatchReady *utils.ReadyFlag, storeCheckEvents chan event.GenericEvent) reconcile.Reconciler {
 	c := &ReconcileLogCollector{
 		client:          mgr.GetClient(),
 		scheme:          mgr.GetScheme(),
@@ -108,6 +119,17 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions, licenseAPIReady
 		tierWatchReady:  tierWatchReady,
 		multiTenant:     opts.MultiTenant,
 		externalElastic: opts.ElasticExternal,
+		checkS3Bucket:   checkS3Bucket,
+		checkSyslog:     checkSyslog,
+		checkSplunk:     checkSplunk,
+		checkKafka:      checkKafka,
+		checkEndpoint:   checkEndpoint,
+		checkLoki:       checkLoki,
+		scrapeMetrics:   scrapeFluentdMetrics,
+		runStoreChecks: func(f func()) {
+			go f()
+		},
+		storeCheckEvents: storeCheckEvents,
 	}
 	c.status.Run(opts.ShutdownContext)
 	return c
@@ -141,6 +163,8 @@ func add(mgr manager.Manager, c ctrlruntime.Controller) error {
 		render.ElasticsearchEksLogForwarderUserSecret,
 		render.S3FluentdSecretName, render.EksLogForwarderSecret,
 		render.SplunkFluentdTokenSecretName, monitor.PrometheusClientTLSSecretName,
+		render.KafkaFluentdCredentialsSecretName, render.HTTPFluentdCredentialsSecretName,
+		render.OTLPFluentdCredentialsSecretName, render.LokiFluentdCredentialsSecretName,
 		render.FluentdPrometheusTLSSecretName, render.TigeraLinseedSecret, render.VoltronLinseedPublicCert, render.EKSLogForwarderTLSSecretName,
 	} {
 		if err = utils.AddSecretsWatch(c, secretName, common.OperatorNamespace()); err != nil {
@@ -148,7 +172,9 @@ func add(mgr manager.Manager, c ctrlruntime.Controller) error {
 		}
 	}
 
-	for _, configMapName := range []string{render.FluentdFilterConfigMapName, relasticsearch.ClusterConfigConfigMapName} {
+	for _, configMapName := range []string{
+		render.FluentdFilterConfigMapName, relasticsearch.ClusterConfigConfigMapName, render.KafkaCAConfigMapName,
+	} {
 		if err = utils.AddConfigMapWatch(c, configMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
 			return fmt.Errorf("logcollector-controller failed to watch ConfigMap %s: %v", configMapName, err)
 		}
@@ -183,6 +209,41 @@ type ReconcileLogCollector struct {
 	tierWatchReady  *utils.ReadyFlag
 	multiTenant     bool
 	externalElastic bool
+
+	// checkS3Bucket verifies access to the configured S3 bucket. If nil, bucket access is not checked.
+	checkS3Bucket s3BucketChecker
+	// checkSyslog verifies the configured syslog server accepts connections. If nil, it is not checked.
+	checkSyslog syslogChecker
+	// checkSplunk verifies the health of the configured Splunk HTTP event collector. If nil, it is not checked.
+	checkSplunk splunkChecker
+	// checkKafka verifies the configured Kafka brokers accept connections. If nil, they are not checked.
+	checkKafka kafkaChecker
+	// checkEndpoint verifies the configured HTTP and OpenTelemetry endpoints accept connections. If nil, they are not
+	// checked.
+	checkEndpoint endpointChecker
+	// checkLoki verifies the configured Loki server is ready. If nil, it is not checked.
+	checkLoki lokiChecker
+	// scrapeMetrics collects the fluentd output plugin metrics. If nil, delivery status is not reported.
+	scrapeMetrics fluentdMetricsScraper
+	// runStoreChecks runs the additional store checks. If nil, they run as part of the reconcile.
+	runStoreChecks func(func())
+	// storeCheckEvents triggers a reconcile when the additional store checks complete. If nil, no reconcile is
+	// triggered.
+	storeCheckEvents chan event.GenericEvent
+
+	// storeCheckLock guards the following fields, which are shared with the background store checks.
+	storeCheckLock sync.Mutex
+	// storeCheckRunning is true while the additional stores are being checked.
+	storeCheckRunning bool
+	// storeCheckResults holds the conditions of the last store check, until they are set on the LogCollector.
+	storeCheckResults []metav1.Condition
+	// storeCheckSequence is incremented whenever a store check completes.
+	storeCheckSequence int
+	// lastStoreCheck and lastCheckedGeneration record when the additional stores were last checked.
+	lastStoreCheck        time.Time
+	lastCheckedGeneration int64
+	// outputErrors holds the fluentd output plugin error counts seen by the last check, keyed by plugin type.
+	outputErrors map[string]float64
 }
 
 // GetLogCollector returns the default LogCollector instance with defaults populated.
@@ -194,13 +255,41 @@ func GetLogCollector(ctx context.Context, cli client.Client) (*operatorv1.LogCol
 		return nil, err
 	}
 
-	if instance.Spec.AdditionalStores != nil {
-		if instance.Spec.AdditionalStores.Syslog != nil {
-			_, _, _, err := url.ParseEndpoint(instance.Spec.AdditionalStores.Syslog.Endpoint)
+	if stores := instance.Spec.AdditionalStores; stores != nil {
+		if stores.Syslog != nil {
+			_, _, _, err := url.ParseEndpoint(stores.Syslog.Endpoint)
 			if err != nil {
 				return nil, fmt.Errorf("syslog config has invalid Endpoint: %s", err)
 			}
 		}
+		if stores.S3 != nil {
+			if err := validateS3Store(stores.S3); err != nil {
+				return nil, fmt.Errorf("s3 config is invalid: %s", err)
+			}
+		}
+		if stores.Kafka != nil && len(stores.Kafka.Brokers) == 0 {
+			return nil, fmt.Errorf("kafka config must specify at least one broker")
+		}
+		if stores.HTTP != nil {
+			if err := url.ValidateHTTPEndpoint(stores.HTTP.Endpoint); err != nil {
+				return nil, fmt.Errorf("http config has invalid Endpoint: %s", err)
+			}
+		}
+		if stores.OpenTelemetry != nil {
+			if err := url.ValidateHTTPEndpoint(stores.OpenTelemetry.Endpoint); err != nil {
+				return nil, fmt.Errorf("openTelemetry config has invalid Endpoint: %s", err)
+			}
+		}
+		if stores.Loki != nil {
+			if err := url.ValidateHTTPEndpoint(stores.Loki.Endpoint); err != nil {
+				return nil, fmt.Errorf("loki config has invalid Endpoint: %s", err)
+			}
+		}
+		for store, filters := range storeFilters(stores) {
+			if err := validateStoreFilters(filters); err != nil {
+				return nil, fmt.Errorf("%s config has invalid Filters: %s", store, err)
+			}
+		}
 	}
 
 	return instance, nil
@@ -242,6 +331,56 @@ func fillDefaults(instance *operatorv1.LogCollector) []string {
 				modifiedFields = append(modifiedFields, "AdditionalStores.Syslog.Encryption")
 			}
 		}
+		if s3 := instance.Spec.AdditionalStores.S3; s3 != nil {
+			if len(s3.LogTypes) == 0 {
+				s3.LogTypes = render.DefaultAdditionalStoreLogTypes()
+				modifiedFields = append(modifiedFields, "AdditionalStores.S3.LogTypes")
+			}
+			if len(s3.Credentials) == 0 {
+				s3.Credentials = operatorv1.S3CredentialsAccessKey
+				modifiedFields = append(modifiedFields, "AdditionalStores.S3.Credentials")
+			}
+			if len(s3.Compression) == 0 {
+				s3.Compression = operatorv1.S3CompressionGzip
+				modifiedFields = append(modifiedFields, "AdditionalStores.S3.Compression")
+			}
+		}
+		if splunk := instance.Spec.AdditionalStores.Splunk; splunk != nil && len(splunk.LogTypes) == 0 {
+			splunk.LogTypes = render.DefaultAdditionalStoreLogTypes()
+			modifiedFields = append(modifiedFields, "AdditionalStores.Splunk.LogTypes")
+		}
+		if kafka := instance.Spec.AdditionalStores.Kafka; kafka != nil {
+			if len(kafka.LogTypes) == 0 {
+				kafka.LogTypes = render.DefaultAdditionalStoreLogTypes()
+				modifiedFields = append(modifiedFields, "AdditionalStores.Kafka.LogTypes")
+			}
+			if len(kafka.SASLMechanism) == 0 {
+				kafka.SASLMechanism = operatorv1.KafkaSASLMechanismNone
+				modifiedFields = append(modifiedFields, "AdditionalStores.Kafka.SASLMechanism")
+			}
+			if len(kafka.Encryption) == 0 {
+				kafka.Encryption = operatorv1.EncryptionNone
+				modifiedFields = append(modifiedFields, "AdditionalStores.Kafka.Encryption")
+			}
+		}
+		if httpStore := instance.Spec.AdditionalStores.HTTP; httpStore != nil && len(httpStore.LogTypes) == 0 {
+			httpStore.LogTypes = render.DefaultAdditionalStoreLogTypes()
+			modifiedFields = append(modifiedFields, "AdditionalStores.HTTP.LogTypes")
+		}
+		if otlp := instance.Spec.AdditionalStores.OpenTelemetry; otlp != nil {
+			if len(otlp.LogTypes) == 0 {
+				otlp.LogTypes = render.DefaultAdditionalStoreLogTypes()
+				modifiedFields = append(modifiedFields, "AdditionalStores.OpenTelemetry.LogTypes")
+			}
+			if len(otlp.Protocol) == 0 {
+				otlp.Protocol = operatorv1.OTLPProtocolHTTP
+				modifiedFields = append(modifiedFields, "AdditionalStores.OpenTelemetry.Protocol")
+			}
+		}
+		if loki := instance.Spec.AdditionalStores.Loki; loki != nil && len(loki.LogTypes) == 0 {
+			loki.LogTypes = render.DefaultAdditionalStoreLogTypes()
+			modifiedFields = append(modifiedFields, "AdditionalStores.Loki.LogTypes")
+		}
 	}
 	return modifiedFields
 }
@@ -457,7 +596,7 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 
 	var s3Credential *render.S3Credential
 	if instance.Spec.AdditionalStores != nil {
-		if instance.Spec.AdditionalStores.S3 != nil {
+		if instance.Spec.AdditionalStores.S3 != nil && instance.Spec.AdditionalStores.S3.Credentials == operatorv1.S3CredentialsAccessKey {
 			s3Credential, err = getS3Credential(r.client)
 			if err != nil {
 				r.status.SetDegraded(operatorv1.ResourceValidationError, "Error with S3 credential secret", err, reqLogger)
@@ -486,9 +625,10 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 	}
 
 	var useSyslogCertificate bool
+	var syslogCert certificatemanagement.CertificateInterface
 	if instance.Spec.AdditionalStores != nil {
 		if instance.Spec.AdditionalStores.Syslog != nil && instance.Spec.AdditionalStores.Syslog.Encryption == operatorv1.EncryptionTLS {
-			syslogCert, err := getSysLogCertificate(r.client)
+			syslogCert, err = getSysLogCertificate(r.client)
 			if err != nil {
 				r.status.SetDegraded(operatorv1.ResourceReadError, "Error loading Syslog certificate", err, reqLogger)
 				return reconcile.Result{}, err
@@ -500,28 +640,81 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 		}
 	}
 
-	if instance.Spec.AdditionalStores != nil {
-		if instance.Spec.AdditionalStores.Syslog != nil {
-			syslog := instance.Spec.AdditionalStores.Syslog
+	var kafkaCredential *render.KafkaCredential
+	var httpCredential, otlpCredential *render.HTTPCredential
+	var lokiCredential *render.LokiCredential
+	if stores := instance.Spec.AdditionalStores; stores != nil {
+		if stores.Kafka != nil {
+			if stores.Kafka.SASLMechanism != operatorv1.KafkaSASLMechanismNone {
+				kafkaCredential, err = getKafkaCredential(r.client)
+				if err != nil {
+					r.status.SetDegraded(operatorv1.ResourceValidationError, "Error with Kafka credential secret", err, reqLogger)
+					return reconcile.Result{}, err
+				}
+				if kafkaCredential == nil {
+					r.status.SetDegraded(operatorv1.ResourceNotFound, "Kafka credential secret does not exist", nil, reqLogger)
+					return reconcile.Result{}, nil
+				}
+			}
+			if stores.Kafka.Encryption == operatorv1.EncryptionTLS {
+				kafkaCert, err := getCACertificate(r.client, render.KafkaCAConfigMapName)
+				if err != nil {
+					r.status.SetDegraded(operatorv1.ResourceReadError, "Error loading Kafka certificate", err, reqLogger)
+					return reconcile.Result{}, err
+				}
+				if kafkaCert != nil {
+					trustedBundle.AddCertificates(kafkaCert)
+				}
+			}
+		}
+		if stores.HTTP != nil {
+			if httpCredential, err = getAuthorizationCredential(r.client, render.HTTPFluentdCredentialsSecretName); err != nil {
+				r.status.SetDegraded(operatorv1.ResourceValidationError, "Error with HTTP credential secret", err, reqLogger)
+				return reconcile.Result{}, err
+			}
+		}
+		if stores.OpenTelemetry != nil {
+			if otlpCredential, err = getAuthorizationCredential(r.client, render.OTLPFluentdCredentialsSecretName); err != nil {
+				r.status.SetDegraded(operatorv1.ResourceValidationError, "Error with OpenTelemetry credential secret", err, reqLogger)
+				return reconcile.Result{}, err
+			}
+		}
+		if stores.Loki != nil {
+			if lokiCredential, err = getLokiCredential(r.client); err != nil {
+				r.status.SetDegraded(operatorv1.ResourceValidationError, "Error with Loki credential secret", err, reqLogger)
+				return reconcile.Result{}, err
+			}
+		}
 
-			// If the user set Syslog.logTypes, we need to ensure that they did not include
-			// the v1.SyslogLogIDSEvents option if this is a managed cluster (i.e.
-			// ManagementClusterConnection CR is present). This is because IDS events
-			// are only forwarded within a non-managed cluster (where LogStorage is present).
-			if syslog.LogTypes != nil {
-				if err == nil && managedCluster {
-					for _, l := range syslog.LogTypes {
-						// Set status to degraded to warn user and let them fix the issue themselves.
-						if l == operatorv1.SyslogLogIDSEvents {
-							r.status.SetDegraded(operatorv1.ResourceValidationError, "IDSEvents option is not supported for Syslog config in a managed cluster", nil, reqLogger)
-							return reconcile.Result{}, err
-						}
+		// We need to ensure that the configured log types do not include the v1.SyslogLogIDSEvents
+		// option if this is a managed cluster (i.e. ManagementClusterConnection CR is present). This
+		// is because IDS events are only forwarded within a non-managed cluster (where LogStorage is present).
+		if managedCluster {
+			for store, logTypes := range storeLogTypes(stores) {
+				for _, l := range logTypes {
+					// Set status to degraded to warn user and let them fix the issue themselves.
+					if l == operatorv1.SyslogLogIDSEvents {
+						r.status.SetDegraded(operatorv1.ResourceValidationError, fmt.Sprintf("IDSEvents option is not supported for %s config in a managed cluster", store), nil, reqLogger)
+						return reconcile.Result{}, nil
 					}
 				}
 			}
 		}
 	}
 
+	// Report whether the additional stores are reachable and receive logs. Failed checks do not block the
+	// reconcile, since a store may only be reachable from the nodes.
+	storeInputs := storeCheckInputs{
+		s3Credential:     s3Credential,
+		splunkCredential: splunkCredential,
+		lokiCredential:   lokiCredential,
+		syslogCA:         syslogCert,
+		trustedCAs:       []byte(trustedBundle.ConfigMap(render.LogCollectorNamespace).Data[certificatemanagement.TrustedCertConfigMapKeyName]),
+	}
+	if err = r.updateStoreConditions(ctx, instance, storeInputs); err != nil {
+		reqLogger.Error(err, "Failed to update the additional store status conditions")
+	}
+
 	filters, err := getFluentdFilters(r.client)
 	if err != nil {
 		r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving Fluentd filters", err, reqLogger)
@@ -578,6 +771,10 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 		ESClusterConfig:        esClusterConfig,
 		S3Credential:           s3Credential,
 		SplkCredential:         splunkCredential,
+		KafkaCredential:        kafkaCredential,
+		HTTPCredential:         httpCredential,
+		OTLPCredential:         otlpCredential,
+		LokiCredential:         lokiCredential,
 		Filters:                filters,
 		EKSConfig:              eksConfig,
 		PullSecrets:            pullSecrets,
@@ -596,6 +793,11 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 	// Render the fluentd component for Linux
 	comp := render.Fluentd(fluentdCfg)
 
+	hasWindowsNodes, err := common.HasWindowsNodes(r.client)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	certificateComponent := rcertificatemanagement.Config{
 		Namespace:       render.LogCollectorNamespace,
 		ServiceAccounts: []string{render.FluentdNodeName},
@@ -604,6 +806,9 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 		},
 		TrustedBundle: trustedBundle,
 	}
+	if hasWindowsNodes {
+		certificateComponent.ServiceAccounts = append(certificateComponent.ServiceAccounts, render.FluentdNodeWindowsName)
+	}
 
 	if installation.KubernetesProvider.IsEKS() {
 		if instance.Spec.AdditionalSources != nil {
@@ -632,29 +837,12 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 	}
 
 	// Render a fluentd component for Windows if the cluster has Windows nodes.
-	hasWindowsNodes, err := common.HasWindowsNodes(r.client)
-	if err != nil {
-		return reconcile.Result{}, err
-	}
-
 	if hasWindowsNodes {
-		fluentdCfg = &render.FluentdConfiguration{
-			LogCollector:           instance,
-			ESClusterConfig:        esClusterConfig,
-			S3Credential:           s3Credential,
-			SplkCredential:         splunkCredential,
-			Filters:                filters,
-			EKSConfig:              eksConfig,
-			PullSecrets:            pullSecrets,
-			Installation:           installation,
-			ClusterDomain:          r.clusterDomain,
-			OSType:                 rmeta.OSTypeWindows,
-			TrustedBundle:          trustedBundle,
-			ManagedCluster:         managedCluster,
-			UseSyslogCertificate:   useSyslogCertificate,
-			FluentdKeyPair:         fluentdKeyPair,
-			EKSLogForwarderKeyPair: eksLogForwarderKeyPair,
-		}
+		// The Windows DaemonSet is configured identically to the Linux one, so that logs from Windows nodes are
+		// collected and forwarded to the same stores.
+		windowsCfg := *fluentdCfg
+		windowsCfg.OSType = rmeta.OSTypeWindows
+		fluentdCfg = &windowsCfg
 		comp = render.Fluentd(fluentdCfg)
 
 		if err = imageset.ApplyImageSet(ctx, r.client, variant, comp); err != nil {
@@ -685,6 +873,10 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 	if err = r.client.Status().Update(ctx, instance); err != nil {
 		return reconcile.Result{}, err
 	}
+	if hasCheckedStores(instance.Spec.AdditionalStores) {
+		// Check the additional stores again periodically.
+		return reconcile.Result{RequeueAfter: storeCheckInterval}, nil
+	}
 	return reconcile.Result{}, nil
 }
 
@@ -743,6 +935,179 @@ func getSplunkCredential(client client.Client) (*render.SplunkCredential, error)
 	}, nil
 }
 
+func getKafkaCredential(client client.Client) (*render.KafkaCredential, error) {
+	secret := &corev1.Secret{}
+	secretNamespacedName := types.NamespacedName{
+		Name:      render.KafkaFluentdCredentialsSecretName,
+		Namespace: common.OperatorNamespace(),
+	}
+	if err := client.Get(context.Background(), secretNamespacedName, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read secret %q: %s", render.KafkaFluentdCredentialsSecretName, err)
+	}
+
+	for _, key := range []string{render.KafkaFluentdSecretUsernameKey, render.KafkaFluentdSecretPasswordKey} {
+		if len(secret.Data[key]) == 0 {
+			return nil, fmt.Errorf("expected secret %q to have a field named %q",
+				render.KafkaFluentdCredentialsSecretName, key)
+		}
+	}
+
+	return &render.KafkaCredential{
+		Username: secret.Data[render.KafkaFluentdSecretUsernameKey],
+		Password: secret.Data[render.KafkaFluentdSecretPasswordKey],
+	}, nil
+}
+
+// getAuthorizationCredential reads the optional Authorization header value for an HTTP based log store from the
+// named secret. It returns nil if the secret does not exist.
+func getAuthorizationCredential(client client.Client, secretName string) (*render.HTTPCredential, error) {
+	secret := &corev1.Secret{}
+	secretNamespacedName := types.NamespacedName{
+		Name:      secretName,
+		Namespace: common.OperatorNamespace(),
+	}
+	if err := client.Get(context.Background(), secretNamespacedName, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read secret %q: %s", secretName, err)
+	}
+
+	authorization, ok := secret.Data[render.FluentdSecretAuthorizationKey]
+	if !ok || len(authorization) == 0 {
+		return nil, fmt.Errorf("expected secret %q to have a field named %q",
+			secretName, render.FluentdSecretAuthorizationKey)
+	}
+
+	return &render.HTTPCredential{
+		Authorization: authorization,
+	}, nil
+}
+
+// getLokiCredential reads the optional Loki basic auth credentials. It returns nil if the secret does not exist.
+func getLokiCredential(client client.Client) (*render.LokiCredential, error) {
+	secret := &corev1.Secret{}
+	secretNamespacedName := types.NamespacedName{
+		Name:      render.LokiFluentdCredentialsSecretName,
+		Namespace: common.OperatorNamespace(),
+	}
+	if err := client.Get(context.Background(), secretNamespacedName, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read secret %q: %s", render.LokiFluentdCredentialsSecretName, err)
+	}
+
+	for _, key := range []string{render.LokiFluentdSecretUsernameKey, render.LokiFluentdSecretPasswordKey} {
+		if len(secret.Data[key]) == 0 {
+			return nil, fmt.Errorf("expected secret %q to have a field named %q",
+				render.LokiFluentdCredentialsSecretName, key)
+		}
+	}
+
+	return &render.LokiCredential{
+		Username: secret.Data[render.LokiFluentdSecretUsernameKey],
+		Password: secret.Data[render.LokiFluentdSecretPasswordKey],
+	}, nil
+}
+
+// storeLogTypes returns the configured log types of each additional store, keyed by store name.
+func storeLogTypes(stores *operatorv1.AdditionalLogStoreSpec) map[string][]operatorv1.SyslogLogType {
+	logTypes := map[string][]operatorv1.SyslogLogType{}
+	if stores.S3 != nil {
+		logTypes["S3"] = stores.S3.LogTypes
+	}
+	if stores.Splunk != nil {
+		logTypes["Splunk"] = stores.Splunk.LogTypes
+	}
+	if stores.Syslog != nil {
+		logTypes["Syslog"] = stores.Syslog.LogTypes
+	}
+	if stores.Kafka != nil {
+		logTypes["Kafka"] = stores.Kafka.LogTypes
+	}
+	if stores.HTTP != nil {
+		logTypes["HTTP"] = stores.HTTP.LogTypes
+	}
+	if stores.OpenTelemetry != nil {
+		logTypes["OpenTelemetry"] = stores.OpenTelemetry.LogTypes
+	}
+	if stores.Loki != nil {
+		logTypes["Loki"] = stores.Loki.LogTypes
+	}
+	return logTypes
+}
+
+// validateS3Store checks that the options of the S3 store are consistent.
+func validateS3Store(s3 *operatorv1.S3StoreSpec) error {
+	if s3.Endpoint != "" {
+		if err := url.ValidateHTTPEndpoint(s3.Endpoint); err != nil {
+			return fmt.Errorf("invalid Endpoint: %s", err)
+		}
+	}
+	switch s3.Credentials {
+	case operatorv1.S3CredentialsServiceAccountRole, operatorv1.S3CredentialsWebIdentity:
+		if s3.RoleARN == "" {
+			return fmt.Errorf("roleARN must be set for %s credentials", s3.Credentials)
+		}
+	}
+	if s3.ServerSideEncryption == operatorv1.S3ServerSideEncryptionKMS && s3.KMSKeyID == "" {
+		return fmt.Errorf("kmsKeyID must be set for KMS server-side encryption")
+	}
+	return nil
+}
+
+// storeFilters returns the configured filters of each additional store, keyed by store name.
+func storeFilters(stores *operatorv1.AdditionalLogStoreSpec) map[string]*operatorv1.AdditionalStoreFilters {
+	filters := map[string]*operatorv1.AdditionalStoreFilters{}
+	if stores.S3 != nil && stores.S3.Filters != nil {
+		filters["s3"] = stores.S3.Filters
+	}
+	if stores.Syslog != nil && stores.Syslog.Filters != nil {
+		filters["syslog"] = stores.Syslog.Filters
+	}
+	if stores.Splunk != nil && stores.Splunk.Filters != nil {
+		filters["splunk"] = stores.Splunk.Filters
+	}
+	if stores.Kafka != nil && stores.Kafka.Filters != nil {
+		filters["kafka"] = stores.Kafka.Filters
+	}
+	if stores.HTTP != nil && stores.HTTP.Filters != nil {
+		filters["http"] = stores.HTTP.Filters
+	}
+	if stores.OpenTelemetry != nil && stores.OpenTelemetry.Filters != nil {
+		filters["openTelemetry"] = stores.OpenTelemetry.Filters
+	}
+	if stores.Loki != nil && stores.Loki.Filters != nil {
+		filters["loki"] = stores.Loki.Filters
+	}
+	return filters
+}
+
+// validateStoreFilters checks that the namespaces and tiers referenced by the filters are valid names. The values
+// are rendered into the fluentd configuration, so anything else is rejected.
+func validateStoreFilters(filters *operatorv1.AdditionalStoreFilters) error {
+	for _, f := range []*operatorv1.FlowLogFilter{filters.Include, filters.Exclude} {
+		if f == nil {
+			continue
+		}
+		for _, ns := range f.Namespaces {
+			if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+				return fmt.Errorf("invalid namespace %q: %s", ns, strings.Join(errs, ", "))
+			}
+		}
+		for _, tier := range f.PolicyTiers {
+			if errs := validation.IsDNS1123Label(tier); len(errs) > 0 {
+				return fmt.Errorf("invalid policy tier %q: %s", tier, strings.Join(errs, ", "))
+			}
+		}
+	}
+	return nil
+}
+
 func getFluentdFilters(client client.Client) (*render.FluentdFilters, error) {
 	cm := &corev1.ConfigMap{}
 	cmNamespacedName := types.NamespacedName{
@@ -807,23 +1172,28 @@ func getEksCloudwatchLogConfig(client client.Client, interval int32, region, gro
 }
 
 func getSysLogCertificate(client client.Client) (certificatemanagement.CertificateInterface, error) {
+	return getCACertificate(client, render.SyslogCAConfigMapName)
+}
+
+// getCACertificate reads a user provided CA certificate for an additional log store from the named ConfigMap.
+// It returns nil if the ConfigMap or its certificate does not exist, in which case the store's certificate is
+// assumed to be signed by a publicly trusted CA.
+func getCACertificate(client client.Client, configMapName string) (certificatemanagement.CertificateInterface, error) {
 	cm := &corev1.ConfigMap{}
 	cmNamespacedName := types.NamespacedName{
-		Name:      render.SyslogCAConfigMapName,
+		Name:      configMapName,
 		Namespace: common.OperatorNamespace(),
 	}
 	if err := client.Get(context.Background(), cmNamespacedName, cm); err != nil {
 		if errors.IsNotFound(err) {
-			log.Info(fmt.Sprintf("ConfigMap %q is not found, assuming syslog's certificate is signed by publicly trusted CA", render.SyslogCAConfigMapName))
+			log.Info(fmt.Sprintf("ConfigMap %q is not found, assuming the certificate is signed by publicly trusted CA", configMapName))
 			return nil, nil
 		}
-		return nil, fmt.Errorf("failed to read ConfigMap %q: %s", render.SyslogCAConfigMapName, err)
+		return nil, fmt.Errorf("failed to read ConfigMap %q: %s", configMapName, err)
 	}
 	if len(cm.Data[corev1.TLSCertKey]) == 0 {
-		log.Info(fmt.Sprintf("ConfigMap %q does not have a field named %q, assuming syslog's certificate is signed by publicly trusted CA", render.SyslogCAConfigMapName, corev1.TLSCertKey))
+		log.Info(fmt.Sprintf("ConfigMap %q does not have a field named %q, assuming the certificate is signed by publicly trusted CA", configMapName, corev1.TLSCertKey))
 		return nil, nil
 	}
-	syslogCert := certificatemanagement.NewCertificate(render.SyslogCAConfigMapName, common.OperatorNamespace(), []byte(cm.Data[corev1.TLSCertKey]), nil)
-
-	return syslogCert, nil
+	return certificatemanagement.NewCertificate(configMapName, common.OperatorNamespace(), []byte(cm.Data[corev1.TLSCertKey]), nil), nil
 }
@@ -17,6 +17,7 @@ package logcollector
 import (
 	"context"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -27,10 +28,12 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
@@ -311,6 +314,93 @@ var _ = Describe("LogCollector controller tests", func() {
 				Expect(node.Env).To(ContainElements(s3Vars))
 			})
 
+			It("should report bucket access in the LogCollector status", func() {
+				r.checkS3Bucket = func(_ context.Context, s3 *operatorv1.S3StoreSpec, credential *render.S3Credential) error {
+					Expect(s3.BucketName).To(Equal("s3Bucket"))
+					Expect(credential.KeyId).To(Equal([]byte("id")))
+					return fmt.Errorf("403 Forbidden")
+				}
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				instance := &operatorv1.LogCollector{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, instance)).NotTo(HaveOccurred())
+				condition := meta.FindStatusCondition(instance.Status.Conditions, S3ReachableConditionType)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				Expect(condition.Reason).To(Equal("BucketNotAccessible"))
+				Expect(condition.Message).To(ContainSubstring("403 Forbidden"))
+
+				r.checkS3Bucket = func(context.Context, *operatorv1.S3StoreSpec, *render.S3Credential) error { return nil }
+				r.lastStoreCheck = time.Time{}
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, instance)).NotTo(HaveOccurred())
+				condition = meta.FindStatusCondition(instance.Status.Conditions, S3ReachableConditionType)
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			})
+
+			It("should check the stores in the background", func() {
+				checked := make(chan struct{})
+				r.checkS3Bucket = func(context.Context, *operatorv1.S3StoreSpec, *render.S3Credential) error {
+					<-checked
+					return nil
+				}
+				r.runStoreChecks = func(f func()) { go f() }
+				r.storeCheckEvents = make(chan event.GenericEvent)
+
+				By("reconciling while the check is still running")
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				instance := &operatorv1.LogCollector{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, instance)).NotTo(HaveOccurred())
+				Expect(meta.FindStatusCondition(instance.Status.Conditions, S3ReachableConditionType)).To(BeNil())
+
+				By("reconciling when the check completes")
+				close(checked)
+				Eventually(r.storeCheckEvents).Should(Receive())
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, instance)).NotTo(HaveOccurred())
+				condition := meta.FindStatusCondition(instance.Status.Conditions, S3ReachableConditionType)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			})
+
+			It("should report delivery errors from the fluentd metrics", func() {
+				numErrors := 3.0
+				r.scrapeMetrics = func(context.Context, client.Client) (map[string]fluentdOutputStatus, error) {
+					return map[string]fluentdOutputStatus{"s3": {NumErrors: numErrors}}, nil
+				}
+				result, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(storeCheckInterval))
+
+				instance := &operatorv1.LogCollector{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, instance)).NotTo(HaveOccurred())
+				condition := meta.FindStatusCondition(instance.Status.Conditions, S3DeliveringConditionType)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+
+				By("checking again before the check interval elapsed")
+				numErrors = 5
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, instance)).NotTo(HaveOccurred())
+				condition = meta.FindStatusCondition(instance.Status.Conditions, S3DeliveringConditionType)
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+
+				By("checking again after the check interval elapsed")
+				r.lastStoreCheck = time.Time{}
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, instance)).NotTo(HaveOccurred())
+				condition = meta.FindStatusCondition(instance.Status.Conditions, S3DeliveringConditionType)
+				Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				Expect(condition.Reason).To(Equal("DeliveryErrors"))
+				Expect(condition.Message).To(ContainSubstring("reported 2 errors"))
+			})
+
 			Context("Disable feature via license", func() {
 				BeforeEach(func() {
 					By("Deleting the previous license")
@@ -413,6 +503,63 @@ var _ = Describe("LogCollector controller tests", func() {
 				Expect(node.Env).To(ContainElements(splunkVars))
 			})
 
+			It("should forward logs from Windows nodes to splunk", func() {
+				Expect(c.Create(ctx, &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "windows-node",
+						Labels: map[string]string{"kubernetes.io/os": "windows"},
+					},
+				})).ToNot(HaveOccurred())
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ds := appsv1.DaemonSet{
+					TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fluentd-node-windows",
+						Namespace: render.LogCollectorNamespace,
+					},
+				}
+				Expect(test.GetResource(c, &ds)).To(BeNil())
+				Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
+				Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(splunkVars))
+			})
+
+			It("should report the health of the HTTP event collector in the LogCollector status", func() {
+				r.checkSplunk = func(_ context.Context, splunk *operatorv1.SplunkStoreSpec, credential *render.SplunkCredential, caPEM []byte) error {
+					Expect(splunk.Endpoint).To(Equal("https://localhost:1234"))
+					Expect(credential.Token).To(Equal([]byte("token")))
+					Expect(string(caPEM)).To(ContainSubstring("BEGIN CERTIFICATE"))
+					return fmt.Errorf("unexpected response 403 Forbidden: Invalid token")
+				}
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				instance := &operatorv1.LogCollector{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, instance)).NotTo(HaveOccurred())
+				condition := meta.FindStatusCondition(instance.Status.Conditions, SplunkReachableConditionType)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				Expect(condition.Reason).To(Equal("EndpointNotHealthy"))
+				Expect(condition.Message).To(ContainSubstring("Invalid token"))
+				condition = meta.FindStatusCondition(instance.Status.Conditions, SplunkDeliveringConditionType)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionUnknown))
+				Expect(condition.Reason).To(Equal("MetricsUnavailable"))
+				Expect(meta.FindStatusCondition(instance.Status.Conditions, S3ReachableConditionType)).To(BeNil())
+
+				By("removing the Splunk store")
+				instance.Spec.AdditionalStores = nil
+				instance.Generation++
+				Expect(c.Update(ctx, instance)).NotTo(HaveOccurred())
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, instance)).NotTo(HaveOccurred())
+				Expect(meta.FindStatusCondition(instance.Status.Conditions, SplunkReachableConditionType)).To(BeNil())
+				Expect(meta.FindStatusCondition(instance.Status.Conditions, SplunkDeliveringConditionType)).To(BeNil())
+			})
+
 			Context("Disable feature via license", func() {
 				BeforeEach(func() {
 					By("Deleting the previous license")
@@ -446,6 +593,117 @@ var _ = Describe("LogCollector controller tests", func() {
 			})
 		})
 
+		Context("Forward to Kafka", func() {
+			BeforeEach(func() {
+				By("Specify kafka log storage")
+				Expect(c.Delete(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+					Spec: operatorv1.LogCollectorSpec{
+						AdditionalStores: &operatorv1.AdditionalLogStoreSpec{
+							Kafka: &operatorv1.KafkaStoreSpec{
+								Brokers:       []string{"kafka-0:9092", "kafka-1:9092"},
+								SASLMechanism: operatorv1.KafkaSASLMechanismSCRAMSHA512,
+							},
+						},
+					},
+				})).NotTo(HaveOccurred())
+				By("Setting the license to export logs")
+				Expect(c.Delete(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{}}})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{common.ExportLogsFeature}}})).NotTo(HaveOccurred())
+			})
+
+			It("should degrade when the kafka credentials are missing", func() {
+				mockStatus.On("SetDegraded", operatorv1.ResourceNotFound, "Kafka credential secret does not exist", mock.Anything, mock.Anything).Return()
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceNotFound, "Kafka credential secret does not exist", mock.Anything, mock.Anything)
+			})
+
+			It("should default the log types and forward logs to kafka", func() {
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      render.KafkaFluentdCredentialsSecretName,
+						Namespace: "tigera-operator",
+					},
+					Data: map[string][]byte{
+						"username": []byte("user"),
+						"password": []byte("pass"),
+					},
+				})).NotTo(HaveOccurred())
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				instance := &operatorv1.LogCollector{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, instance)).NotTo(HaveOccurred())
+				Expect(instance.Spec.AdditionalStores.Kafka.LogTypes).To(Equal([]operatorv1.SyslogLogType{
+					operatorv1.SyslogLogAudit, operatorv1.SyslogLogDNS, operatorv1.SyslogLogFlows,
+				}))
+				Expect(instance.Spec.AdditionalStores.Kafka.Encryption).To(Equal(operatorv1.EncryptionNone))
+
+				ds := appsv1.DaemonSet{
+					TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fluentd-node",
+						Namespace: render.LogCollectorNamespace,
+					},
+				}
+				Expect(test.GetResource(c, &ds)).To(BeNil())
+				Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
+				Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+					corev1.EnvVar{Name: "KAFKA_BROKERS", Value: "kafka-0:9092,kafka-1:9092"},
+					corev1.EnvVar{Name: "KAFKA_SASL_MECHANISM", Value: "scram-sha-512"},
+					corev1.EnvVar{Name: "KAFKA_AUDIT_LOG", Value: "true"},
+					corev1.EnvVar{Name: "KAFKA_DNS_LOG", Value: "true"},
+					corev1.EnvVar{Name: "KAFKA_FLOW_LOG", Value: "true"},
+				))
+			})
+
+			It("should report broker reachability in the LogCollector status", func() {
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      render.KafkaFluentdCredentialsSecretName,
+						Namespace: "tigera-operator",
+					},
+					Data: map[string][]byte{
+						"username": []byte("user"),
+						"password": []byte("pass"),
+					},
+				})).NotTo(HaveOccurred())
+				r.checkKafka = func(_ context.Context, kafka *operatorv1.KafkaStoreSpec, _ []byte) error {
+					Expect(kafka.Brokers).To(Equal([]string{"kafka-0:9092", "kafka-1:9092"}))
+					return fmt.Errorf("connection refused")
+				}
+				r.scrapeMetrics = func(context.Context, client.Client) (map[string]fluentdOutputStatus, error) {
+					return map[string]fluentdOutputStatus{"kafka2": {}}, nil
+				}
+				result, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(storeCheckInterval))
+
+				instance := &operatorv1.LogCollector{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, instance)).NotTo(HaveOccurred())
+				condition := meta.FindStatusCondition(instance.Status.Conditions, KafkaReachableConditionType)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				Expect(condition.Message).To(ContainSubstring("connection refused"))
+				condition = meta.FindStatusCondition(instance.Status.Conditions, KafkaDeliveringConditionType)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			})
+
+			AfterEach(func() {
+				Expect(c.Delete(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				})).NotTo(HaveOccurred())
+				Expect(c.Delete(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{}}})).NotTo(HaveOccurred())
+			})
+		})
+
 		Context("Forward to Syslog", func() {
 			syslogVars := []corev1.EnvVar{
 				{Name: "SYSLOG_HOST", Value: "localhost"},
@@ -788,5 +1046,37 @@ var _ = Describe("LogCollector controller tests", func() {
 			Expect(len(modifiedFields)).To(Equal(0))
 			Expect(logCollector.Spec.AdditionalStores.Syslog.LogTypes).To(Equal(expectedLogTypes))
 		})
+		It("should set default log types for S3 and Splunk", func() {
+			logCollector := operatorv1.LogCollector{Spec: operatorv1.LogCollectorSpec{AdditionalStores: &operatorv1.AdditionalLogStoreSpec{
+				S3:     &operatorv1.S3StoreSpec{},
+				Splunk: &operatorv1.SplunkStoreSpec{LogTypes: []operatorv1.SyslogLogType{operatorv1.SyslogLogFlows}},
+			}}}
+			modifiedFields := fillDefaults(&logCollector)
+			Expect(modifiedFields).To(ConsistOf("CollectProcessPath", "AdditionalStores.S3.LogTypes", "AdditionalStores.S3.Credentials", "AdditionalStores.S3.Compression"))
+			Expect(logCollector.Spec.AdditionalStores.S3.Compression).To(Equal(operatorv1.S3CompressionGzip))
+			Expect(logCollector.Spec.AdditionalStores.S3.LogTypes).To(Equal([]operatorv1.SyslogLogType{
+				operatorv1.SyslogLogAudit,
+				operatorv1.SyslogLogDNS,
+				operatorv1.SyslogLogFlows,
+			}))
+			Expect(logCollector.Spec.AdditionalStores.Splunk.LogTypes).To(Equal([]operatorv1.SyslogLogType{operatorv1.SyslogLogFlows}))
+		})
+	})
+
+	Context("should validate additional store filters", func() {
+		It("should accept valid namespaces and tiers", func() {
+			Expect(validateStoreFilters(&operatorv1.AdditionalStoreFilters{
+				Include: &operatorv1.FlowLogFilter{Namespaces: []string{"default"}, PolicyTiers: []string{"security"}},
+				Exclude: &operatorv1.FlowLogFilter{Namespaces: []string{"kube-system"}},
+			})).NotTo(HaveOccurred())
+		})
+		It("should reject values that are not valid names", func() {
+			Expect(validateStoreFilters(&operatorv1.AdditionalStoreFilters{
+				Include: &operatorv1.FlowLogFilter{Namespaces: []string{"foo/ bar"}},
+			})).To(HaveOccurred())
+			Expect(validateStoreFilters(&operatorv1.AdditionalStoreFilters{
+				Exclude: &operatorv1.FlowLogFilter{PolicyTiers: []string{"Tier|1"}},
+			})).To(HaveOccurred())
+		})
 	})
 })
@@ -0,0 +1,809 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logcollector
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/prometheus/common/expfmt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/render"
+	"github.com/tigera/operator/pkg/render/monitor"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
+	"github.com/tigera/operator/pkg/url"
+)
+
+const (
+	// S3ReachableConditionType is the LogCollector status condition reporting whether the configured S3 bucket
+	// can be accessed.
+	S3ReachableConditionType = "S3Reachable"
+	// SyslogReachableConditionType is the LogCollector status condition reporting whether the configured syslog
+	// server accepts connections.
+	SyslogReachableConditionType = "SyslogReachable"
+	// SplunkReachableConditionType is the LogCollector status condition reporting whether the configured Splunk
+	// HTTP event collector is healthy and accepts the configured token.
+	SplunkReachableConditionType = "SplunkReachable"
+	// KafkaReachableConditionType is the LogCollector status condition reporting whether any of the configured Kafka
+	// brokers accepts connections.
+	KafkaReachableConditionType = "KafkaReachable"
+	// HTTPReachableConditionType is the LogCollector status condition reporting whether the configured HTTP endpoint
+	// accepts connections.
+	HTTPReachableConditionType = "HTTPReachable"
+	// OpenTelemetryReachableConditionType is the LogCollector status condition reporting whether the configured OTLP
+	// receiver accepts connections.
+	OpenTelemetryReachableConditionType = "OpenTelemetryReachable"
+	// LokiReachableConditionType is the LogCollector status condition reporting whether the configured Loki server
+	// reports itself ready.
+	LokiReachableConditionType = "LokiReachable"
+
+	// The delivering conditions report whether fluentd is delivering logs to the store without errors, based on the
+	// metrics of its output plugins.
+	S3DeliveringConditionType            = "S3Delivering"
+	SyslogDeliveringConditionType        = "SyslogDelivering"
+	SplunkDeliveringConditionType        = "SplunkDelivering"
+	KafkaDeliveringConditionType         = "KafkaDelivering"
+	HTTPDeliveringConditionType          = "HTTPDelivering"
+	OpenTelemetryDeliveringConditionType = "OpenTelemetryDelivering"
+	LokiDeliveringConditionType          = "LokiDelivering"
+
+	storeCheckTimeout  = 10 * time.Second
+	storeCheckInterval = 5 * time.Minute
+
+	// The fluentd output plugin types used for each store. Output plugin metrics are labelled with these.
+	s3OutputPluginType     = "s3"
+	syslogOutputPluginType = "remote_syslog"
+	splunkOutputPluginType = "splunk_hec"
+	kafkaOutputPluginType  = "kafka2"
+	httpOutputPluginType   = "http"
+	otlpOutputPluginType   = "opentelemetry"
+	lokiOutputPluginType   = "loki"
+
+	fluentdOutputErrorsMetric    = "fluentd_output_status_num_errors"
+	fluentdOutputRetryWaitMetric = "fluentd_output_status_retry_wait"
+)
+
+// s3BucketChecker verifies that the bucket of the given S3 store can be accessed with the given credential.
+type s3BucketChecker func(ctx context.Context, s3 *operatorv1.S3StoreSpec, credential *render.S3Credential) error
+
+// syslogChecker verifies that the given syslog server accepts connections. The caPEM is the user provided CA used to
+// verify the server certificate in addition to the system root certificates, if any.
+type syslogChecker func(ctx context.Context, syslog *operatorv1.SyslogStoreSpec, caPEM []byte) error
+
+// splunkChecker verifies that the HTTP event collector of the given Splunk store is healthy and accepts the token. The
+// caPEM holds the certificates fluentd trusts in addition to the system root certificates.
+type splunkChecker func(ctx context.Context, splunk *operatorv1.SplunkStoreSpec, credential *render.SplunkCredential, caPEM []byte) error
+
+// kafkaChecker verifies that at least one of the brokers of the given Kafka store accepts connections. The caPEM holds
+// the certificates fluentd trusts in addition to the system root certificates.
+type kafkaChecker func(ctx context.Context, kafka *operatorv1.KafkaStoreSpec, caPEM []byte) error
+
+// endpointChecker verifies that the given HTTP(S) endpoint accepts connections, without sending a request to it. The
+// caPEM holds the certificates fluentd trusts in addition to the system root certificates.
+type endpointChecker func(ctx context.Context, endpoint string, caPEM []byte) error
+
+// lokiChecker verifies that the given Loki server reports itself ready. The caPEM holds the certificates fluentd
+// trusts in addition to the system root certificates.
+type lokiChecker func(ctx context.Context, loki *operatorv1.LokiStoreSpec, credential *render.LokiCredential, caPEM []byte) error
+
+// fluentdMetricsScraper collects the status of the fluentd output plugins, keyed by plugin type.
+type fluentdMetricsScraper func(ctx context.Context, cli client.Client) (map[string]fluentdOutputStatus, error)
+
+// fluentdOutputStatus is the status of a fluentd output plugin aggregated across all fluentd pods.
+type fluentdOutputStatus struct {
+	// NumErrors is the total number of errors the output plugin has reported.
+	NumErrors float64
+	// Retrying is true if the output plugin is waiting to retry a failed flush on any of the pods.
+	Retrying bool
+}
+
+// checkS3Bucket issues a HeadBucket request against the configured bucket.
+func checkS3Bucket(ctx context.Context, spec *operatorv1.S3StoreSpec, credential *render.S3Credential) error {
+	cfg := aws.NewConfig().
+		WithRegion(spec.Region).
+		WithCredentials(credentials.NewStaticCredentials(string(credential.KeyId), string(credential.KeySecret), "")).
+		WithS3ForcePathStyle(spec.AddressingStyle == operatorv1.S3AddressingStylePath)
+	if spec.Endpoint != "" {
+		cfg = cfg.WithEndpoint(spec.Endpoint)
+	}
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, storeCheckTimeout)
+	defer cancel()
+	_, err = s3.New(sess).HeadBucketWithContext(ctx, &s3.HeadBucketInput{Bucket: aws.String(spec.BucketName)})
+	return err
+}
+
+// checkSyslog opens a TCP connection to the syslog server and, when TLS is enabled, completes a TLS handshake.
+func checkSyslog(ctx context.Context, spec *operatorv1.SyslogStoreSpec, caPEM []byte) error {
+	_, host, port, err := url.ParseEndpoint(spec.Endpoint)
+	if err != nil {
+		return err
+	}
+	dialer := &net.Dialer{Timeout: storeCheckTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if spec.Encryption != operatorv1.EncryptionTLS {
+		return nil
+	}
+	roots, err := certPool(caPEM)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, storeCheckTimeout)
+	defer cancel()
+	return tls.Client(conn, &tls.Config{ServerName: host, RootCAs: roots, MinVersion: tls.VersionTLS12}).HandshakeContext(ctx)
+}
+
+// checkSplunk queries the health endpoint of the Splunk HTTP event collector using the configured token. Splunk
+// rejects the request if the token is invalid or disabled. The server certificate is verified against the same
+// certificates as fluentd uses.
+func checkSplunk(ctx context.Context, spec *operatorv1.SplunkStoreSpec, credential *render.SplunkCredential, caPEM []byte) error {
+	roots, err := certPool(caPEM)
+	if err != nil {
+		return err
+	}
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, storeCheckTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(spec.Endpoint, "/")+"/services/collector/health", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Splunk "+string(credential.Token))
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected response %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// checkKafka opens a connection to each broker of the Kafka store and, when TLS is enabled, completes a TLS handshake.
+// Fluentd only needs a single broker to bootstrap, so the check succeeds if any broker is reachable.
+func checkKafka(ctx context.Context, spec *operatorv1.KafkaStoreSpec, caPEM []byte) error {
+	var errs []string
+	for _, broker := range spec.Brokers {
+		host, _, err := net.SplitHostPort(broker)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		var serverName string
+		if spec.Encryption == operatorv1.EncryptionTLS {
+			serverName = host
+		}
+		if err := dialEndpoint(ctx, broker, serverName, caPEM); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", broker, err))
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("no broker is reachable: %s", strings.Join(errs, "; "))
+}
+
+// checkEndpoint opens a connection to the host of the endpoint and, for https endpoints, completes a TLS handshake. No
+// request is sent, since the endpoint would treat it as a log delivery. Endpoints without a scheme, such as OTLP gRPC
+// receivers, are given as host:port.
+func checkEndpoint(ctx context.Context, endpoint string, caPEM []byte) error {
+	if !strings.Contains(endpoint, "://") {
+		return dialEndpoint(ctx, endpoint, "", caPEM)
+	}
+	u, err := neturl.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	address, err := url.ParseHostPortFromHTTPProxyURL(u)
+	if err != nil {
+		return err
+	}
+	var serverName string
+	if u.Scheme == "https" {
+		serverName = u.Hostname()
+	}
+	return dialEndpoint(ctx, address, serverName, caPEM)
+}
+
+// dialEndpoint opens a TCP connection to the given address and, if a server name is given, completes a TLS handshake
+// with it.
+func dialEndpoint(ctx context.Context, address, serverName string, caPEM []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, storeCheckTimeout)
+	defer cancel()
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if serverName == "" {
+		return nil
+	}
+	roots, err := certPool(caPEM)
+	if err != nil {
+		return err
+	}
+	return tls.Client(conn, &tls.Config{ServerName: serverName, RootCAs: roots, MinVersion: tls.VersionTLS12}).HandshakeContext(ctx)
+}
+
+// checkLoki queries the readiness endpoint of the Loki server, authenticating in the same way as fluentd.
+func checkLoki(ctx context.Context, spec *operatorv1.LokiStoreSpec, credential *render.LokiCredential, caPEM []byte) error {
+	roots, err := certPool(caPEM)
+	if err != nil {
+		return err
+	}
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, storeCheckTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(spec.Endpoint, "/")+"/ready", nil)
+	if err != nil {
+		return err
+	}
+	if credential != nil {
+		req.SetBasicAuth(string(credential.Username), string(credential.Password))
+	}
+	if spec.TenantID != "" {
+		req.Header.Set("X-Scope-OrgID", spec.TenantID)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected response %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// scrapeFluentdMetrics reads the metrics of every running fluentd pod and aggregates the status of the output plugins.
+// The operator authenticates with the Prometheus client certificate, so metrics are only available when the operator
+// manages that key pair.
+func scrapeFluentdMetrics(ctx context.Context, cli client.Client) (map[string]fluentdOutputStatus, error) {
+	clientSecret := &corev1.Secret{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: monitor.PrometheusClientTLSSecretName, Namespace: common.OperatorNamespace()}, clientSecret); err != nil {
+		return nil, fmt.Errorf("failed to read the Prometheus client certificate: %w", err)
+	}
+	clientCert, err := tls.X509KeyPair(clientSecret.Data[corev1.TLSCertKey], clientSecret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the Prometheus client certificate: %w", err)
+	}
+	caSecret := &corev1.Secret{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: certificatemanagement.CASecretName, Namespace: common.OperatorNamespace()}, caSecret); err != nil {
+		return nil, fmt.Errorf("failed to read the operator CA: %w", err)
+	}
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(caSecret.Data[corev1.TLSCertKey])
+
+	httpClient := &http.Client{
+		Timeout: storeCheckTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				Certificates: []tls.Certificate{clientCert},
+				RootCAs:      roots,
+				ServerName:   render.FluentdPrometheusTLSSecretName,
+				MinVersion:   tls.VersionTLS12,
+			},
+		},
+	}
+
+	pods := &corev1.PodList{}
+	if err := cli.List(ctx, pods, client.InNamespace(render.LogCollectorNamespace)); err != nil {
+		return nil, err
+	}
+	outputs := map[string]fluentdOutputStatus{}
+	var scraped int
+	var lastErr error
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" {
+			continue
+		}
+		if app := pod.Labels["k8s-app"]; app != render.FluentdNodeName && app != render.FluentdNodeName+"-windows" {
+			continue
+		}
+		if err := scrapeFluentdPod(ctx, httpClient, pod.Status.PodIP, outputs); err != nil {
+			lastErr = fmt.Errorf("failed to scrape metrics of pod %s: %w", pod.Name, err)
+			continue
+		}
+		scraped++
+	}
+	if scraped == 0 {
+		if lastErr == nil {
+			lastErr = fmt.Errorf("no running fluentd pods found")
+		}
+		return nil, lastErr
+	}
+	return outputs, nil
+}
+
+func scrapeFluentdPod(ctx context.Context, httpClient *http.Client, podIP string, outputs map[string]fluentdOutputStatus) error {
+	endpoint := fmt.Sprintf("https://%s/metrics", net.JoinHostPort(podIP, fmt.Sprint(render.FluentdMetricsPort)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response %s", resp.Status)
+	}
+	return parseFluentdOutputMetrics(resp.Body, outputs)
+}
+
+// parseFluentdOutputMetrics adds the output plugin metrics read from r to outputs.
+func parseFluentdOutputMetrics(r io.Reader, outputs map[string]fluentdOutputStatus) error {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return err
+	}
+	for _, name := range []string{fluentdOutputErrorsMetric, fluentdOutputRetryWaitMetric} {
+		family, ok := families[name]
+		if !ok {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			var pluginType string
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "type" {
+					pluginType = l.GetValue()
+				}
+			}
+			if pluginType == "" {
+				continue
+			}
+			var value float64
+			switch {
+			case m.GetGauge() != nil:
+				value = m.GetGauge().GetValue()
+			case m.GetCounter() != nil:
+				value = m.GetCounter().GetValue()
+			case m.GetUntyped() != nil:
+				value = m.GetUntyped().GetValue()
+			}
+			status := outputs[pluginType]
+			if name == fluentdOutputErrorsMetric {
+				status.NumErrors += value
+			} else if value > 0 {
+				status.Retrying = true
+			}
+			outputs[pluginType] = status
+		}
+	}
+	return nil
+}
+
+func certPool(caPEM []byte) (*x509.CertPool, error) {
+	roots, err := x509.SystemCertPool()
+	if err != nil {
+		roots = x509.NewCertPool()
+	}
+	if len(caPEM) > 0 && !roots.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("failed to parse the CA certificate")
+	}
+	return roots, nil
+}
+
+// storeCheckInputs holds the credentials and certificates needed to check the additional stores.
+type storeCheckInputs struct {
+	s3Credential     *render.S3Credential
+	splunkCredential *render.SplunkCredential
+	lokiCredential   *render.LokiCredential
+	syslogCA         certificatemanagement.CertificateInterface
+	// trustedCAs holds the certificates of the trusted bundle that fluentd verifies the stores with.
+	trustedCAs []byte
+}
+
+// s3Condition returns the S3Reachable condition for the given S3 store. Bucket access can only be verified by the
+// operator when a static access key is used, for other credential types the condition is Unknown.
+func (r *ReconcileLogCollector) s3Condition(ctx context.Context, instance *operatorv1.LogCollector, credential *render.S3Credential) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               S3ReachableConditionType,
+		ObservedGeneration: instance.Generation,
+	}
+	spec := instance.Spec.AdditionalStores.S3
+	if credential == nil || r.checkS3Bucket == nil {
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = "NotChecked"
+		condition.Message = fmt.Sprintf("Access to bucket %q is not verified for %s credentials", spec.BucketName, spec.Credentials)
+		return condition
+	}
+	if err := r.checkS3Bucket(ctx, spec, credential); err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "BucketNotAccessible"
+		condition.Message = fmt.Sprintf("Failed to access bucket %q: %s", spec.BucketName, err)
+		return condition
+	}
+	condition.Status = metav1.ConditionTrue
+	condition.Reason = "BucketAccessible"
+	condition.Message = fmt.Sprintf("Bucket %q is accessible", spec.BucketName)
+	return condition
+}
+
+// syslogCondition returns the SyslogReachable condition for the given syslog store. Only TCP endpoints can be checked,
+// for UDP the condition is Unknown.
+func (r *ReconcileLogCollector) syslogCondition(ctx context.Context, instance *operatorv1.LogCollector, ca certificatemanagement.CertificateInterface) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               SyslogReachableConditionType,
+		ObservedGeneration: instance.Generation,
+	}
+	spec := instance.Spec.AdditionalStores.Syslog
+	if proto, _, _, _ := url.ParseEndpoint(spec.Endpoint); proto != "tcp" || r.checkSyslog == nil {
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = "NotChecked"
+		condition.Message = fmt.Sprintf("Reachability of %s is not verified", spec.Endpoint)
+		return condition
+	}
+	var caPEM []byte
+	if ca != nil {
+		caPEM = ca.GetCertificatePEM()
+	}
+	if err := r.checkSyslog(ctx, spec, caPEM); err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "EndpointNotReachable"
+		condition.Message = fmt.Sprintf("Failed to connect to %s: %s", spec.Endpoint, err)
+		return condition
+	}
+	condition.Status = metav1.ConditionTrue
+	condition.Reason = "EndpointReachable"
+	condition.Message = fmt.Sprintf("%s is reachable", spec.Endpoint)
+	return condition
+}
+
+// splunkCondition returns the SplunkReachable condition for the given Splunk store.
+func (r *ReconcileLogCollector) splunkCondition(ctx context.Context, instance *operatorv1.LogCollector, credential *render.SplunkCredential, caPEM []byte) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               SplunkReachableConditionType,
+		ObservedGeneration: instance.Generation,
+	}
+	spec := instance.Spec.AdditionalStores.Splunk
+	if credential == nil || r.checkSplunk == nil {
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = "NotChecked"
+		condition.Message = fmt.Sprintf("Health of the HTTP event collector at %s is not verified", spec.Endpoint)
+		return condition
+	}
+	if err := r.checkSplunk(ctx, spec, credential, caPEM); err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "EndpointNotHealthy"
+		condition.Message = fmt.Sprintf("HTTP event collector at %s is not healthy: %s", spec.Endpoint, err)
+		return condition
+	}
+	condition.Status = metav1.ConditionTrue
+	condition.Reason = "EndpointHealthy"
+	condition.Message = fmt.Sprintf("HTTP event collector at %s is healthy", spec.Endpoint)
+	return condition
+}
+
+// kafkaCondition returns the KafkaReachable condition for the given Kafka store.
+func (r *ReconcileLogCollector) kafkaCondition(ctx context.Context, instance *operatorv1.LogCollector, caPEM []byte) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               KafkaReachableConditionType,
+		ObservedGeneration: instance.Generation,
+	}
+	spec := instance.Spec.AdditionalStores.Kafka
+	brokers := strings.Join(spec.Brokers, ", ")
+	if r.checkKafka == nil {
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = "NotChecked"
+		condition.Message = fmt.Sprintf("Reachability of brokers %s is not verified", brokers)
+		return condition
+	}
+	if err := r.checkKafka(ctx, spec, caPEM); err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "EndpointNotReachable"
+		condition.Message = fmt.Sprintf("Failed to connect to brokers %s: %s", brokers, err)
+		return condition
+	}
+	condition.Status = metav1.ConditionTrue
+	condition.Reason = "EndpointReachable"
+	condition.Message = fmt.Sprintf("Brokers %s are reachable", brokers)
+	return condition
+}
+
+// endpointCondition returns the reachability condition of the given type for a store that exports to the given
+// endpoint.
+func (r *ReconcileLogCollector) endpointCondition(ctx context.Context, instance *operatorv1.LogCollector, conditionType, endpoint string, caPEM []byte) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		ObservedGeneration: instance.Generation,
+	}
+	if r.checkEndpoint == nil {
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = "NotChecked"
+		condition.Message = fmt.Sprintf("Reachability of %s is not verified", endpoint)
+		return condition
+	}
+	if err := r.checkEndpoint(ctx, endpoint, caPEM); err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "EndpointNotReachable"
+		condition.Message = fmt.Sprintf("Failed to connect to %s: %s", endpoint, err)
+		return condition
+	}
+	condition.Status = metav1.ConditionTrue
+	condition.Reason = "EndpointReachable"
+	condition.Message = fmt.Sprintf("%s is reachable", endpoint)
+	return condition
+}
+
+// lokiCondition returns the LokiReachable condition for the given Loki store.
+func (r *ReconcileLogCollector) lokiCondition(ctx context.Context, instance *operatorv1.LogCollector, credential *render.LokiCredential, caPEM []byte) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               LokiReachableConditionType,
+		ObservedGeneration: instance.Generation,
+	}
+	spec := instance.Spec.AdditionalStores.Loki
+	if r.checkLoki == nil {
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = "NotChecked"
+		condition.Message = fmt.Sprintf("Readiness of the Loki server at %s is not verified", spec.Endpoint)
+		return condition
+	}
+	if err := r.checkLoki(ctx, spec, credential, caPEM); err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "EndpointNotHealthy"
+		condition.Message = fmt.Sprintf("Loki server at %s is not ready: %s", spec.Endpoint, err)
+		return condition
+	}
+	condition.Status = metav1.ConditionTrue
+	condition.Reason = "EndpointHealthy"
+	condition.Message = fmt.Sprintf("Loki server at %s is ready", spec.Endpoint)
+	return condition
+}
+
+// deliveryCondition returns the condition of the given type, reporting whether the fluentd output plugin of the given
+// type delivers logs without errors. Errors are counted relative to the previous check.
+func (r *ReconcileLogCollector) deliveryCondition(instance *operatorv1.LogCollector, conditionType, pluginType string, outputs map[string]fluentdOutputStatus, scrapeErr error) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		ObservedGeneration: instance.Generation,
+	}
+	status, ok := outputs[pluginType]
+	if scrapeErr != nil || !ok {
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = "MetricsUnavailable"
+		if scrapeErr != nil {
+			condition.Message = fmt.Sprintf("Failed to read fluentd metrics: %s", scrapeErr)
+		} else {
+			condition.Message = fmt.Sprintf("fluentd does not report metrics for the %s output", pluginType)
+		}
+		return condition
+	}
+
+	previous, seen := r.outputErrors[pluginType]
+	r.outputErrors[pluginType] = status.NumErrors
+	// Counters reset when fluentd restarts, in which case the current value is the new baseline.
+	var newErrors float64
+	if seen && status.NumErrors > previous {
+		newErrors = status.NumErrors - previous
+	}
+
+	switch {
+	case newErrors > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "DeliveryErrors"
+		condition.Message = fmt.Sprintf("fluentd reported %.0f errors for the %s output since the last check", newErrors, pluginType)
+	case status.Retrying:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "DeliveryRetrying"
+		condition.Message = fmt.Sprintf("fluentd is retrying failed flushes for the %s output", pluginType)
+	default:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Delivering"
+		condition.Message = fmt.Sprintf("fluentd reports no errors for the %s output", pluginType)
+	}
+	return condition
+}
+
+// storeConditionTypes holds the condition types and output plugin type of an additional store.
+type storeConditionTypes struct {
+	configured     bool
+	reachableType  string
+	deliveringType string
+	pluginType     string
+}
+
+// additionalStoreConditionTypes returns the condition types reported for each additional store, and whether the store
+// is configured.
+func additionalStoreConditionTypes(stores *operatorv1.AdditionalLogStoreSpec) []storeConditionTypes {
+	return []storeConditionTypes{
+		{stores.S3 != nil, S3ReachableConditionType, S3DeliveringConditionType, s3OutputPluginType},
+		{stores.Syslog != nil, SyslogReachableConditionType, SyslogDeliveringConditionType, syslogOutputPluginType},
+		{stores.Splunk != nil, SplunkReachableConditionType, SplunkDeliveringConditionType, splunkOutputPluginType},
+		{stores.Kafka != nil, KafkaReachableConditionType, KafkaDeliveringConditionType, kafkaOutputPluginType},
+		{stores.HTTP != nil, HTTPReachableConditionType, HTTPDeliveringConditionType, httpOutputPluginType},
+		{stores.OpenTelemetry != nil, OpenTelemetryReachableConditionType, OpenTelemetryDeliveringConditionType, otlpOutputPluginType},
+		{stores.Loki != nil, LokiReachableConditionType, LokiDeliveringConditionType, lokiOutputPluginType},
+	}
+}
+
+// hasCheckedStores returns true if any additional store that reports status conditions is configured.
+func hasCheckedStores(stores *operatorv1.AdditionalLogStoreSpec) bool {
+	if stores == nil {
+		return false
+	}
+	for _, t := range additionalStoreConditionTypes(stores) {
+		if t.configured {
+			return true
+		}
+	}
+	return false
+}
+
+// checkStores checks the configured additional stores and returns their reachability and delivery conditions.
+func (r *ReconcileLogCollector) checkStores(ctx context.Context, instance *operatorv1.LogCollector, inputs storeCheckInputs) []metav1.Condition {
+	var outputs map[string]fluentdOutputStatus
+	var scrapeErr error
+	if r.scrapeMetrics != nil {
+		outputs, scrapeErr = r.scrapeMetrics(ctx, r.client)
+	} else {
+		scrapeErr = fmt.Errorf("metrics collection is disabled")
+	}
+
+	stores := instance.Spec.AdditionalStores
+	var conditions []metav1.Condition
+	for _, t := range additionalStoreConditionTypes(stores) {
+		if !t.configured {
+			continue
+		}
+		switch t.reachableType {
+		case S3ReachableConditionType:
+			conditions = append(conditions, r.s3Condition(ctx, instance, inputs.s3Credential))
+		case SyslogReachableConditionType:
+			conditions = append(conditions, r.syslogCondition(ctx, instance, inputs.syslogCA))
+		case SplunkReachableConditionType:
+			conditions = append(conditions, r.splunkCondition(ctx, instance, inputs.splunkCredential, inputs.trustedCAs))
+		case KafkaReachableConditionType:
+			conditions = append(conditions, r.kafkaCondition(ctx, instance, inputs.trustedCAs))
+		case HTTPReachableConditionType:
+			conditions = append(conditions, r.endpointCondition(ctx, instance, t.reachableType, stores.HTTP.Endpoint, inputs.trustedCAs))
+		case OpenTelemetryReachableConditionType:
+			conditions = append(conditions, r.endpointCondition(ctx, instance, t.reachableType, stores.OpenTelemetry.Endpoint, inputs.trustedCAs))
+		case LokiReachableConditionType:
+			conditions = append(conditions, r.lokiCondition(ctx, instance, inputs.lokiCredential, inputs.trustedCAs))
+		}
+		r.storeCheckLock.Lock()
+		conditions = append(conditions, r.deliveryCondition(instance, t.deliveringType, t.pluginType, outputs, scrapeErr))
+		r.storeCheckLock.Unlock()
+	}
+	return conditions
+}
+
+// updateStoreConditions sets the reachability and delivery conditions of the configured additional stores on the
+// LogCollector, removes the conditions of stores that are not configured and persists the status if it changed.
+// Stores are checked at most once per storeCheckInterval, unless the LogCollector spec changed. The checks connect to
+// external endpoints, so they run in the background and their results are applied by a subsequent reconcile.
+func (r *ReconcileLogCollector) updateStoreConditions(ctx context.Context, instance *operatorv1.LogCollector, inputs storeCheckInputs) error {
+	stores := instance.Spec.AdditionalStores
+	if stores == nil {
+		stores = &operatorv1.AdditionalLogStoreSpec{}
+	}
+
+	r.storeCheckLock.Lock()
+	due := !r.storeCheckRunning && hasCheckedStores(stores) &&
+		(time.Since(r.lastStoreCheck) >= storeCheckInterval || r.lastCheckedGeneration != instance.Generation)
+	if due {
+		r.storeCheckRunning = true
+		r.lastStoreCheck = time.Now()
+		r.lastCheckedGeneration = instance.Generation
+		if r.outputErrors == nil {
+			r.outputErrors = map[string]float64{}
+		}
+	}
+	r.storeCheckLock.Unlock()
+
+	if due {
+		checked := instance.DeepCopy()
+		run := r.runStoreChecks
+		if run == nil {
+			run = func(f func()) { f() }
+		}
+		run(func() {
+			conditions := r.checkStores(context.WithoutCancel(ctx), checked, inputs)
+			r.storeCheckLock.Lock()
+			r.storeCheckResults = conditions
+			r.storeCheckSequence++
+			r.storeCheckRunning = false
+			r.storeCheckLock.Unlock()
+			if r.storeCheckEvents != nil {
+				r.storeCheckEvents <- event.GenericEvent{Object: checked}
+			}
+		})
+	}
+
+	r.storeCheckLock.Lock()
+	results := r.storeCheckResults
+	sequence := r.storeCheckSequence
+	configuredTypes := map[string]bool{}
+	var changed bool
+	for _, t := range additionalStoreConditionTypes(stores) {
+		if t.configured {
+			configuredTypes[t.reachableType] = true
+			configuredTypes[t.deliveringType] = true
+			continue
+		}
+		changed = meta.RemoveStatusCondition(&instance.Status.Conditions, t.reachableType) || changed
+		changed = meta.RemoveStatusCondition(&instance.Status.Conditions, t.deliveringType) || changed
+		delete(r.outputErrors, t.pluginType)
+	}
+	r.storeCheckLock.Unlock()
+
+	// Results of stores that were removed while they were being checked are dropped.
+	for _, condition := range results {
+		if configuredTypes[condition.Type] {
+			changed = meta.SetStatusCondition(&instance.Status.Conditions, condition) || changed
+		}
+	}
+
+	if changed {
+		if err := r.client.Status().Update(ctx, instance); err != nil {
+			// Keep the results, so that they are applied again by the next reconcile.
+			return err
+		}
+	}
+	// The results are applied, unless a newer check completed in the meantime.
+	r.storeCheckLock.Lock()
+	if r.storeCheckSequence == sequence {
+		r.storeCheckResults = nil
+	}
+	r.storeCheckLock.Unlock()
+	return nil
+}
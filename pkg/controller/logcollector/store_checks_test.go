@@ -0,0 +1,171 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logcollector
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/render"
+)
+
+var _ = Describe("Additional store checks", func() {
+	It("should aggregate the fluentd output plugin metrics by plugin type", func() {
+		node1 := `# TYPE fluentd_output_status_num_errors gauge
+fluentd_output_status_num_errors{hostname="node1",plugin_id="out_s3",type="s3"} 2.0
+fluentd_output_status_num_errors{hostname="node1",plugin_id="out_splunk",type="splunk_hec"} 0.0
+# TYPE fluentd_output_status_retry_wait gauge
+fluentd_output_status_retry_wait{hostname="node1",plugin_id="out_s3",type="s3"} 0.0
+fluentd_output_status_retry_wait{hostname="node1",plugin_id="out_splunk",type="splunk_hec"} 0.0
+`
+		node2 := `# TYPE fluentd_output_status_num_errors gauge
+fluentd_output_status_num_errors{hostname="node2",plugin_id="out_s3",type="s3"} 1.0
+# TYPE fluentd_output_status_retry_wait gauge
+fluentd_output_status_retry_wait{hostname="node2",plugin_id="out_s3",type="s3"} 8.0
+`
+		outputs := map[string]fluentdOutputStatus{}
+		Expect(parseFluentdOutputMetrics(strings.NewReader(node1), outputs)).To(Succeed())
+		Expect(parseFluentdOutputMetrics(strings.NewReader(node2), outputs)).To(Succeed())
+		Expect(outputs).To(Equal(map[string]fluentdOutputStatus{
+			"s3":         {NumErrors: 3, Retrying: true},
+			"splunk_hec": {},
+		}))
+	})
+
+	It("should check that the syslog server accepts TCP connections", func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		endpoint := "tcp://" + listener.Addr().String()
+
+		spec := &operatorv1.SyslogStoreSpec{Endpoint: endpoint}
+		Expect(checkSyslog(context.Background(), spec, nil)).To(Succeed())
+
+		Expect(listener.Close()).To(Succeed())
+		Expect(checkSyslog(context.Background(), spec, nil)).NotTo(Succeed())
+	})
+
+	It("should verify the Splunk HTTP event collector with the trusted certificates", func() {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/services/collector/health" || req.Header.Get("Authorization") != "Splunk token" {
+				w.WriteHeader(http.StatusForbidden)
+			}
+		}))
+		defer server.Close()
+		caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+		spec := &operatorv1.SplunkStoreSpec{Endpoint: server.URL}
+		credential := &render.SplunkCredential{Token: []byte("token")}
+		Expect(checkSplunk(context.Background(), spec, credential, nil)).To(MatchError(ContainSubstring("certificate")))
+		Expect(checkSplunk(context.Background(), spec, credential, caPEM)).To(Succeed())
+		Expect(checkSplunk(context.Background(), spec, &render.SplunkCredential{Token: []byte("other")}, caPEM)).To(MatchError(ContainSubstring("403")))
+	})
+
+	It("should check that any of the Kafka brokers accepts connections", func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		defer listener.Close()
+		closed, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(closed.Close()).To(Succeed())
+
+		spec := &operatorv1.KafkaStoreSpec{Brokers: []string{closed.Addr().String(), listener.Addr().String()}}
+		Expect(checkKafka(context.Background(), spec, nil)).To(Succeed())
+
+		spec.Brokers = []string{closed.Addr().String()}
+		Expect(checkKafka(context.Background(), spec, nil)).To(MatchError(ContainSubstring("no broker is reachable")))
+	})
+
+	It("should verify the TLS certificate of HTTP endpoints without sending a request", func() {
+		var requests int
+		server := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { requests++ }))
+		defer server.Close()
+		caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+		Expect(checkEndpoint(context.Background(), server.URL+"/ingest", nil)).To(MatchError(ContainSubstring("certificate")))
+		Expect(checkEndpoint(context.Background(), server.URL+"/ingest", caPEM)).To(Succeed())
+		Expect(checkEndpoint(context.Background(), server.Listener.Addr().String(), nil)).To(Succeed())
+		Expect(requests).To(BeZero())
+	})
+
+	It("should check the readiness of the Loki server", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			user, password, _ := req.BasicAuth()
+			if req.URL.Path != "/ready" || user != "user" || password != "pass" || req.Header.Get("X-Scope-OrgID") != "tenant" {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		}))
+		defer server.Close()
+
+		spec := &operatorv1.LokiStoreSpec{Endpoint: server.URL, TenantID: "tenant"}
+		credential := &render.LokiCredential{Username: []byte("user"), Password: []byte("pass")}
+		Expect(checkLoki(context.Background(), spec, credential, nil)).To(Succeed())
+		Expect(checkLoki(context.Background(), spec, nil, nil)).To(MatchError(ContainSubstring("401")))
+	})
+
+	It("should keep the check results until the status is updated", func() {
+		scheme := runtime.NewScheme()
+		Expect(operatorv1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+		instance := &operatorv1.LogCollector{
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec: operatorv1.LogCollectorSpec{
+				AdditionalStores: &operatorv1.AdditionalLogStoreSpec{
+					HTTP: &operatorv1.HTTPStoreSpec{Endpoint: "https://logs.example.com"},
+				},
+			},
+		}
+		failUpdate := true
+		cli := ctrlrfake.DefaultFakeClientBuilder(scheme).
+			WithObjects(instance).
+			WithStatusSubresource(instance).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					if failUpdate {
+						return fmt.Errorf("conflict")
+					}
+					return c.SubResource(subResource).Update(ctx, obj, opts...)
+				},
+			}).Build()
+		r := &ReconcileLogCollector{
+			client:        cli,
+			checkEndpoint: func(context.Context, string, []byte) error { return nil },
+		}
+
+		Expect(r.updateStoreConditions(context.Background(), instance.DeepCopy(), storeCheckInputs{})).To(MatchError("conflict"))
+		Expect(r.storeCheckResults).NotTo(BeEmpty())
+
+		failUpdate = false
+		Expect(r.updateStoreConditions(context.Background(), instance.DeepCopy(), storeCheckInputs{})).To(Succeed())
+		Expect(r.storeCheckResults).To(BeEmpty())
+		Expect(cli.Get(context.Background(), client.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		condition := meta.FindStatusCondition(instance.Status.Conditions, HTTPReachableConditionType)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+	})
+})
@@ -17,7 +17,6 @@ package elastic
 import (
 	"context"
 	"fmt"
-	"net/url"
 
 	cmnv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/common/v1"
 	esv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/elasticsearch/v1"
@@ -139,6 +138,9 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 	if err = c.WatchObject(&operatorv1.Authentication{}, &handler.EnqueueRequestForObject{}); err != nil {
 		return fmt.Errorf("log-storage-elastic-controller failed to watch Authentication resource: %w", err)
 	}
+	if err = c.WatchObject(&operatorv1.Manager{}, &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("log-storage-elastic-controller failed to watch Manager resource: %w", err)
+	}
 
 	// Start goroutines to establish watches against projectcalico.org/v3 resources.
 	go utils.WaitToAddTierWatch(networkpolicy.TigeraComponentTierName, c, k8sClient, log, r.tierWatchReady)
@@ -444,14 +446,14 @@ func (r *ElasticSubController) Reconcile(ctx context.Context, request reconcile.
 	}
 
 	var baseURL string
-	if authentication != nil && authentication.Spec.ManagerDomain != "" {
-		baseURL = authentication.Spec.ManagerDomain
-		if u, err := url.Parse(baseURL); err == nil {
-			if u.Scheme == "" {
-				baseURL = fmt.Sprintf("https://%s", baseURL)
-			}
-		} else {
-			reqLogger.Error(err, "Parsing Authentication ManagerDomain failed so baseUrl is not set")
+	if authentication != nil {
+		manager, err := utils.GetIfExists[operatorv1.Manager](ctx, utils.DefaultTSEEInstanceKey, r.client)
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "An error occurred trying to retrieve the Manager", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+		if baseURL, err = utils.ManagerDomain(authentication, manager); err != nil {
+			reqLogger.Error(err, "Deriving the manager domain failed so baseUrl is not set")
 		}
 	}
 
@@ -20,8 +20,11 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -36,6 +39,7 @@ import (
 	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
 	"github.com/tigera/operator/pkg/controller/compliance"
+	"github.com/tigera/operator/pkg/controller/gatewayapi"
 	"github.com/tigera/operator/pkg/controller/options"
 	"github.com/tigera/operator/pkg/controller/status"
 	"github.com/tigera/operator/pkg/controller/utils"
@@ -143,6 +147,9 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 	if err = c.WatchObject(&operatorv1.Authentication{}, eventHandler); err != nil {
 		return fmt.Errorf("manager-controller failed to watch resource: %w", err)
 	}
+	if err = c.WatchObject(&operatorv1.GatewayAPI{}, eventHandler); err != nil {
+		return fmt.Errorf("manager-controller failed to watch GatewayAPI resource: %w", err)
+	}
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("manager-controller failed to watch manager Tigerastatus: %w", err)
 	}
@@ -304,6 +311,16 @@ func (r *ReconcileManager) Reconcile(ctx context.Context, request reconcile.Requ
 		}
 	}
 
+	if err = validateExposure(instance.Spec.Exposure); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "Invalid Manager exposure", err, logc)
+		return reconcile.Result{}, nil
+	}
+
+	if err = validateFeatures(instance.Spec.Features, r.multiTenant); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "Invalid Manager features", err, logc)
+		return reconcile.Result{}, nil
+	}
+
 	if !utils.IsAPIServerReady(r.client, logc) {
 		r.status.SetDegraded(operatorv1.ResourceNotReady, "Waiting for Tigera API server to be ready", nil, logc)
 		return reconcile.Result{}, nil
@@ -366,17 +383,44 @@ func (r *ReconcileManager) Reconcile(ctx context.Context, request reconcile.Requ
 		return reconcile.Result{}, err
 	}
 
-	// Get or create a certificate for clients of the manager pod ui-apis container.
+	// Get or create a certificate for clients of the manager pod ui-apis container. When the manager is exposed
+	// outside of the cluster, Voltron also presents this certificate for the exposure hostname.
+	managerDNSNames := []string{"localhost"}
+	if hostname := render.ManagerExposureHostname(instance); hostname != "" {
+		managerDNSNames = append(managerDNSNames, hostname)
+	}
 	tlsSecret, err := certificateManager.GetOrCreateKeyPair(
 		r.client,
 		render.ManagerTLSSecretName,
 		helper.TruthNamespace(),
-		[]string{"localhost"})
+		managerDNSNames)
 	if err != nil {
 		r.status.SetDegraded(operatorv1.ResourceReadError, "Error getting or creating manager TLS certificate", err, logc)
 		return reconcile.Result{}, err
 	}
 
+	if exposure := instance.Spec.Exposure; exposure != nil {
+		// Ingress controllers and gateways terminating TLS read the manager certificate from its secret, which is
+		// not populated when certificates are issued through certificate management.
+		terminatesTLS := exposure.Type == operatorv1.ManagerExposureTypeIngress ||
+			(exposure.Type == operatorv1.ManagerExposureTypeGatewayAPI && exposure.GatewayAPI.GetRouteType() == operatorv1.ManagerGatewayRouteTypeHTTPRoute)
+		if terminatesTLS && tlsSecret.UseCertificateManagement() {
+			r.status.SetDegraded(operatorv1.ResourceValidationError, fmt.Sprintf("Manager exposure %s requires a %s secret, which is not available with certificate management; use a LoadBalancer or a TLSRoute instead", exposure.Type, render.ManagerTLSSecretName), nil, logc)
+			return reconcile.Result{}, nil
+		}
+
+		if exposure.Type == operatorv1.ManagerExposureTypeGatewayAPI {
+			if _, msg, err := gatewayapi.GetGatewayAPI(ctx, r.client); err != nil {
+				if errors.IsNotFound(err) {
+					r.status.SetDegraded(operatorv1.ResourceNotFound, "Manager exposure type GatewayAPI requires the GatewayAPI resource", nil, logc)
+					return reconcile.Result{}, nil
+				}
+				r.status.SetDegraded(operatorv1.ResourceReadError, msg, err, logc)
+				return reconcile.Result{}, err
+			}
+		}
+	}
+
 	// Get or create a certificate for the manager pod to use within the cluster.
 	dnsNames := dns.GetServiceDNSNames(render.ManagerServiceName, helper.InstallNamespace(), r.clusterDomain)
 	internalTrafficSecret, err := certificateManager.GetOrCreateKeyPair(
@@ -737,6 +781,38 @@ func (r *ReconcileManager) Reconcile(ctx context.Context, request reconcile.Requ
 	return reconcile.Result{}, nil
 }
 
+// validateExposure checks that the manager exposure has a valid hostname and only configures the exposure type
+// that is in use.
+func validateExposure(exposure *operatorv1.ManagerExposure) error {
+	if exposure == nil {
+		return nil
+	}
+	if errs := validation.IsDNS1123Subdomain(exposure.Hostname); len(errs) != 0 {
+		return fmt.Errorf("exposure hostname %q is not a valid DNS name: %s", exposure.Hostname, errs[0])
+	}
+	if exposure.Ingress != nil && exposure.Type != operatorv1.ManagerExposureTypeIngress {
+		return fmt.Errorf("exposure ingress may only be set when the exposure type is %s", operatorv1.ManagerExposureTypeIngress)
+	}
+	if exposure.GatewayAPI != nil && exposure.Type != operatorv1.ManagerExposureTypeGatewayAPI {
+		return fmt.Errorf("exposure gatewayAPI may only be set when the exposure type is %s", operatorv1.ManagerExposureTypeGatewayAPI)
+	}
+	if exposure.LoadBalancer != nil && exposure.Type != operatorv1.ManagerExposureTypeLoadBalancer {
+		return fmt.Errorf("exposure loadBalancer may only be set when the exposure type is %s", operatorv1.ManagerExposureTypeLoadBalancer)
+	}
+	return nil
+}
+
+// validateFeatures checks that the requested manager features are available in this cluster.
+func validateFeatures(features *operatorv1.ManagerFeatures, multiTenant bool) error {
+	if features == nil {
+		return nil
+	}
+	if multiTenant && features.Kibana != nil && *features.Kibana == operatorv1.ManagerFeatureEnabled {
+		return fmt.Errorf("the Kibana feature cannot be enabled in a multi-tenant management cluster")
+	}
+	return nil
+}
+
 func fillDefaults(mc *operatorv1.ManagementCluster) {
 	if mc.Spec.TLS == nil {
 		mc.Spec.TLS = &operatorv1.TLS{}
@@ -763,35 +839,34 @@ func getVoltronRouteConfig(ctx context.Context, cli client.Client, managerNamesp
 
 	builder := rmanager.NewVoltronRouteConfigBuilder()
 	for _, route := range terminatedRouteList.Items {
+		// Missing ConfigMaps and Secrets are not added to the builder, which rejects the routes referencing them
+		// without affecting the other routes.
 		if route.Spec.CABundle != nil {
 			cm := &corev1.ConfigMap{}
-			// Verify that the ConfigMap exists in the manager namespace.
-			if err := cli.Get(ctx, client.ObjectKey{Name: route.Spec.CABundle.Name, Namespace: managerNamespace}, cm); err != nil {
+			if err := getRouteReference(ctx, cli, route.Spec.CABundle.Name, managerNamespace, cm); err != nil {
 				return nil, fmt.Errorf("failed to retrieve the ConfigMap containing the CA for TLS terminated route %s: %w", route.Name, err)
+			} else if cm.Name != "" {
+				// Add the config map to the builder to rerender the annotations if it changes.
+				builder.AddConfigMap(cm)
 			}
-
-			// Add the config map to the builder to rerender the annotations if it changes.
-			builder.AddConfigMap(cm)
 		}
 
 		if route.Spec.ForwardingMTLSCert != nil {
 			certSecret := &corev1.Secret{}
-			// Verify that the MTLS cert secret exist in the manager namespace.
-			if err := cli.Get(ctx, client.ObjectKey{Name: route.Spec.ForwardingMTLSCert.Name, Namespace: managerNamespace}, certSecret); err != nil {
+			if err := getRouteReference(ctx, cli, route.Spec.ForwardingMTLSCert.Name, managerNamespace, certSecret); err != nil {
 				return nil, fmt.Errorf("failed to retrieve the Secret containing the MTLS certificate for TLS terminated route %s: %w", route.Name, err)
+			} else if certSecret.Name != "" {
+				builder.AddSecret(certSecret)
 			}
-
-			builder.AddSecret(certSecret)
 		}
 
 		if route.Spec.ForwardingMTLSKey != nil {
 			keySecret := &corev1.Secret{}
-			// Verify that the MTLS secrets exist in the manager namespace.
-			if err := cli.Get(ctx, client.ObjectKey{Name: route.Spec.ForwardingMTLSKey.Name, Namespace: managerNamespace}, keySecret); err != nil {
+			if err := getRouteReference(ctx, cli, route.Spec.ForwardingMTLSKey.Name, managerNamespace, keySecret); err != nil {
 				return nil, fmt.Errorf("failed to retrieve the Secret containing the MTLS key for TLS terminated route %s: %w", route.Name, err)
+			} else if keySecret.Name != "" {
+				builder.AddSecret(keySecret)
 			}
-
-			builder.AddSecret(keySecret)
 		}
 
 		builder.AddTLSTerminatedRoute(route)
@@ -801,5 +876,62 @@ func getVoltronRouteConfig(ctx context.Context, cli client.Client, managerNamesp
 		builder.AddTLSPassThroughRoute(route)
 	}
 
-	return builder.Build()
+	routeConfig, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range terminatedRouteList.Items {
+		route := &terminatedRouteList.Items[i]
+		routeErr := routeConfig.RouteError(rmanager.TLSTerminatedRouteKind, route.Name)
+		if err := updateRouteStatus(ctx, cli, route, &route.Status, routeErr, rmanager.TLSTerminatedRouteKind); err != nil {
+			return nil, err
+		}
+	}
+	for i := range passThroughRouteList.Items {
+		route := &passThroughRouteList.Items[i]
+		routeErr := routeConfig.RouteError(rmanager.TLSPassThroughRouteKind, route.Name)
+		if err := updateRouteStatus(ctx, cli, route, &route.Status, routeErr, rmanager.TLSPassThroughRouteKind); err != nil {
+			return nil, err
+		}
+	}
+
+	return routeConfig, nil
+}
+
+// getRouteReference reads a ConfigMap or Secret referenced by a route. A missing object is not an error and leaves
+// obj empty.
+func getRouteReference(ctx context.Context, cli client.Client, name, namespace string, obj client.Object) error {
+	if err := cli.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, obj); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// updateRouteStatus sets the Accepted condition of a route, writing the status only when the condition changed.
+func updateRouteStatus(ctx context.Context, cli client.Client, route client.Object, status *operatorv1.TLSRouteStatus, routeErr *rmanager.RouteError, kind string) error {
+	condition := metav1.Condition{
+		Type:               operatorv1.TLSRouteConditionAccepted,
+		Status:             metav1.ConditionTrue,
+		Reason:             operatorv1.TLSRouteReasonAccepted,
+		Message:            "Route is applied to the manager",
+		ObservedGeneration: route.GetGeneration(),
+	}
+	if routeErr != nil {
+		log.Info("Rejecting manager route", "kind", kind, "name", route.GetName(), "reason", routeErr.Reason, "message", routeErr.Message)
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = routeErr.Reason
+		condition.Message = routeErr.Message
+	}
+
+	if !meta.SetStatusCondition(&status.Conditions, condition) {
+		return nil
+	}
+	if err := cli.Status().Update(ctx, route); err != nil {
+		return fmt.Errorf("failed to update the status of route %s: %w", route.GetName(), err)
+	}
+	return nil
 }
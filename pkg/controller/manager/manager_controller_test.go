@@ -29,8 +29,10 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	kerror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -72,6 +74,7 @@ var _ = Describe("Manager controller tests", func() {
 		Expect(apis.AddToScheme(scheme)).NotTo(HaveOccurred())
 		Expect(appsv1.SchemeBuilder.AddToScheme(scheme)).ShouldNot(HaveOccurred())
 		Expect(rbacv1.SchemeBuilder.AddToScheme(scheme)).ShouldNot(HaveOccurred())
+		Expect(netv1.AddToScheme(scheme)).ShouldNot(HaveOccurred())
 		c = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
 		ctx = context.Background()
 		replicas = 2
@@ -123,6 +126,14 @@ var _ = Describe("Manager controller tests", func() {
 		Expect(instance).To(BeNil())
 	})
 
+	It("should reject enabling Kibana in a multi-tenant management cluster", func() {
+		enabled := operatorv1.ManagerFeatureEnabled
+		features := &operatorv1.ManagerFeatures{Kibana: &enabled}
+		Expect(validateFeatures(features, false)).NotTo(HaveOccurred())
+		Expect(validateFeatures(features, true)).To(HaveOccurred())
+		Expect(validateFeatures(nil, true)).NotTo(HaveOccurred())
+	})
+
 	Context("cert tests", func() {
 		var r ReconcileManager
 		var cr *operatorv1.Manager
@@ -330,6 +341,64 @@ var _ = Describe("Manager controller tests", func() {
 			Expect(c.Get(ctx, types.NamespacedName{Name: render.ManagerInternalTLSSecretName, Namespace: render.ManagerNamespace}, internalSecret)).ShouldNot(HaveOccurred())
 		})
 
+		It("should add the exposure hostname to the manager TLS cert and render an Ingress", func() {
+			cr.Spec.Exposure = &operatorv1.ManagerExposure{
+				Type:     operatorv1.ManagerExposureTypeIngress,
+				Hostname: "manager.example.com",
+			}
+			Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			managerTLSSecret := &corev1.Secret{
+				TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: render.ManagerTLSSecretName, Namespace: common.OperatorNamespace()},
+			}
+			Expect(test.GetResource(c, managerTLSSecret)).To(BeNil())
+			test.VerifyCert(managerTLSSecret, "localhost", "manager.example.com")
+
+			ingress := &netv1.Ingress{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: render.ManagerExposureName, Namespace: render.ManagerNamespace}, ingress)).ShouldNot(HaveOccurred())
+			Expect(ingress.Spec.Rules).To(HaveLen(1))
+			Expect(ingress.Spec.Rules[0].Host).To(Equal("manager.example.com"))
+
+			// Removing the exposure removes the Ingress again.
+			Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, cr)).ShouldNot(HaveOccurred())
+			cr.Spec.Exposure = nil
+			Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			err = c.Get(ctx, types.NamespacedName{Name: render.ManagerExposureName, Namespace: render.ManagerNamespace}, ingress)
+			Expect(kerror.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should degrade when the manager is exposed through the Gateway API without a GatewayAPI resource", func() {
+			mockStatus.On("SetDegraded", operatorv1.ResourceNotFound, "Manager exposure type GatewayAPI requires the GatewayAPI resource", mock.Anything, mock.Anything).Return()
+			cr.Spec.Exposure = &operatorv1.ManagerExposure{
+				Type:     operatorv1.ManagerExposureTypeGatewayAPI,
+				Hostname: "manager.example.com",
+			}
+			Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceNotFound, "Manager exposure type GatewayAPI requires the GatewayAPI resource", mock.Anything, mock.Anything)
+		})
+
+		It("should degrade when the exposure hostname is invalid", func() {
+			mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "Invalid Manager exposure", mock.Anything, mock.Anything).Return()
+			cr.Spec.Exposure = &operatorv1.ManagerExposure{
+				Type:     operatorv1.ManagerExposureTypeLoadBalancer,
+				Hostname: "https://manager.example.com",
+			}
+			Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceValidationError, "Invalid Manager exposure", mock.Anything, mock.Anything)
+		})
+
 		It("should not add OwnerReference to an user supplied manager TLS cert", func() {
 			// Create a manager cert secret.
 			dnsNames := []string{"manager.example.com", "192.168.10.22"}
@@ -1340,6 +1409,63 @@ var _ = Describe("Manager controller tests", func() {
 
 				Expect(kerror.IsNotFound(test.GetResource(c, &tenantBRoutes))).Should(BeTrue())
 			})
+
+			It("should report the status of each TLSRoute and skip rejected routes", func() {
+				validRoute := &operatorv1.TLSTerminatedRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: tenantANamespace,
+						Name:      "tenant-a-route",
+					},
+					Spec: operatorv1.TLSTerminatedRouteSpec{
+						CABundle: &corev1.ConfigMapKeySelector{
+							Key: "tigera-ca-bundle.crt",
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: certificatemanagement.TrustedCertConfigMapNamePublic,
+							},
+						},
+						Destination: "https://internal.foo.svc",
+						PathMatch: &operatorv1.PathMatch{
+							Path: "/foo/",
+						},
+						Target: "UI",
+					},
+				}
+				brokenRoute := validRoute.DeepCopy()
+				brokenRoute.Name = "tenant-a-broken-route"
+				brokenRoute.Spec.CABundle.Name = "does-not-exist"
+				brokenRoute.Spec.PathMatch.Path = "/bar/"
+				Expect(c.Create(ctx, validRoute)).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, brokenRoute)).NotTo(HaveOccurred())
+
+				_, err := r.Reconcile(ctx, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: tenantANamespace,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				routes := corev1.ConfigMap{
+					TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "voltron-routes",
+						Namespace: tenantANamespace,
+					},
+				}
+				Expect(test.GetResource(c, &routes)).ToNot(HaveOccurred())
+				Expect(routes.Data["uiTLSTermRoutes.json"]).To(ContainSubstring("/foo/"))
+				Expect(routes.Data["uiTLSTermRoutes.json"]).NotTo(ContainSubstring("/bar/"))
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(validRoute), validRoute)).NotTo(HaveOccurred())
+				accepted := meta.FindStatusCondition(validRoute.Status.Conditions, operatorv1.TLSRouteConditionAccepted)
+				Expect(accepted).NotTo(BeNil())
+				Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(brokenRoute), brokenRoute)).NotTo(HaveOccurred())
+				accepted = meta.FindStatusCondition(brokenRoute.Status.Conditions, operatorv1.TLSRouteConditionAccepted)
+				Expect(accepted).NotTo(BeNil())
+				Expect(accepted.Status).To(Equal(metav1.ConditionFalse))
+				Expect(accepted.Reason).To(Equal(operatorv1.TLSRouteReasonRefNotFound))
+			})
 		})
 	})
 })
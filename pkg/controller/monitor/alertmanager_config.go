@@ -0,0 +1,215 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/controller/utils"
+	"github.com/tigera/operator/pkg/render/monitor"
+	"github.com/tigera/operator/pkg/url"
+)
+
+// The types below mirror the subset of the Alertmanager configuration file that can be generated from the Monitor CR.
+type alertmanagerFile struct {
+	Global    map[string]string          `json:"global,omitempty"`
+	Route     alertmanagerFileRoute      `json:"route"`
+	Receivers []alertmanagerFileReceiver `json:"receivers"`
+}
+
+type alertmanagerFileRoute struct {
+	Receiver       string                  `json:"receiver"`
+	Matchers       []string                `json:"matchers,omitempty"`
+	GroupBy        []string                `json:"group_by,omitempty"`
+	GroupWait      string                  `json:"group_wait,omitempty"`
+	GroupInterval  string                  `json:"group_interval,omitempty"`
+	RepeatInterval string                  `json:"repeat_interval,omitempty"`
+	Continue       bool                    `json:"continue,omitempty"`
+	Routes         []alertmanagerFileRoute `json:"routes,omitempty"`
+}
+
+type alertmanagerFileReceiver struct {
+	Name             string                      `json:"name"`
+	SlackConfigs     []alertmanagerFileSlack     `json:"slack_configs,omitempty"`
+	PagerDutyConfigs []alertmanagerFilePagerDuty `json:"pagerduty_configs,omitempty"`
+	WebhookConfigs   []alertmanagerFileWebhook   `json:"webhook_configs,omitempty"`
+}
+
+type alertmanagerFileSlack struct {
+	APIURL       string `json:"api_url"`
+	Channel      string `json:"channel,omitempty"`
+	SendResolved *bool  `json:"send_resolved,omitempty"`
+}
+
+type alertmanagerFilePagerDuty struct {
+	RoutingKey   string `json:"routing_key"`
+	Severity     string `json:"severity,omitempty"`
+	SendResolved *bool  `json:"send_resolved,omitempty"`
+}
+
+type alertmanagerFileWebhook struct {
+	URL          string `json:"url"`
+	SendResolved *bool  `json:"send_resolved,omitempty"`
+}
+
+// getAlertmanagerConfig returns the structured Alertmanager configuration of the Monitor CR, if any.
+func getAlertmanagerConfig(instance *operatorv1.Monitor) *operatorv1.AlertmanagerConfig {
+	if instance.Spec.AlertManager == nil || instance.Spec.AlertManager.AlertManagerSpec == nil {
+		return nil
+	}
+	return instance.Spec.AlertManager.AlertManagerSpec.Config
+}
+
+// validateAlertmanagerConfig checks that the receivers are well-formed and that every route refers to a receiver.
+func validateAlertmanagerConfig(cfg *operatorv1.AlertmanagerConfig) error {
+	receivers := map[string]bool{}
+	for _, r := range cfg.Receivers {
+		if r.Name == "" {
+			return fmt.Errorf("receivers must have a name")
+		}
+		if receivers[r.Name] {
+			return fmt.Errorf("receiver name %q is not unique", r.Name)
+		}
+		receivers[r.Name] = true
+
+		for _, w := range r.WebhookConfigs {
+			if (w.URL == "") == (w.URLSecret == nil) {
+				return fmt.Errorf("webhook of receiver %q must set exactly one of url and urlSecret", r.Name)
+			}
+			if w.URL != "" {
+				if err := url.ValidateHTTPEndpoint(w.URL); err != nil {
+					return fmt.Errorf("webhook of receiver %q has an invalid url: %w", r.Name, err)
+				}
+			}
+		}
+	}
+
+	var validateRoute func(route operatorv1.AlertmanagerRoute) error
+	validateRoute = func(route operatorv1.AlertmanagerRoute) error {
+		if !receivers[route.Receiver] {
+			return fmt.Errorf("route refers to unknown receiver %q", route.Receiver)
+		}
+		for _, child := range route.Routes {
+			if err := validateRoute(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return validateRoute(cfg.Route)
+}
+
+// alertmanagerConfigSecret renders the structured Alertmanager configuration into the Alertmanager configuration
+// secret. The values of the referenced secret keys are read from the operator namespace and embedded in the file.
+func (r *ReconcileMonitor) alertmanagerConfigSecret(ctx context.Context, cfg *operatorv1.AlertmanagerConfig) (*corev1.Secret, error) {
+	values := map[corev1.SecretKeySelector]string{}
+	for _, selector := range cfg.SecretKeySelectors() {
+		if _, ok := values[selector]; ok {
+			continue
+		}
+		secret, err := utils.GetSecret(ctx, r.client, selector.Name, common.OperatorNamespace())
+		if err != nil {
+			return nil, err
+		} else if secret == nil {
+			return nil, fmt.Errorf("secret %s/%s not found", common.OperatorNamespace(), selector.Name)
+		}
+		value, ok := secret.Data[selector.Key]
+		if !ok {
+			return nil, fmt.Errorf("secret %s/%s does not contain key %q", common.OperatorNamespace(), selector.Name, selector.Key)
+		}
+		values[selector] = string(value)
+	}
+
+	file := alertmanagerFile{
+		Global: map[string]string{"resolve_timeout": "5m"},
+		Route:  alertmanagerRoute(cfg.Route, true),
+	}
+	for _, receiver := range cfg.Receivers {
+		fr := alertmanagerFileReceiver{Name: receiver.Name}
+		for _, s := range receiver.SlackConfigs {
+			fr.SlackConfigs = append(fr.SlackConfigs, alertmanagerFileSlack{
+				APIURL:       values[s.APIURLSecret],
+				Channel:      s.Channel,
+				SendResolved: s.SendResolved,
+			})
+		}
+		for _, p := range receiver.PagerDutyConfigs {
+			fr.PagerDutyConfigs = append(fr.PagerDutyConfigs, alertmanagerFilePagerDuty{
+				RoutingKey:   values[p.RoutingKeySecret],
+				Severity:     p.Severity,
+				SendResolved: p.SendResolved,
+			})
+		}
+		for _, w := range receiver.WebhookConfigs {
+			webhookURL := w.URL
+			if w.URLSecret != nil {
+				webhookURL = values[*w.URLSecret]
+			}
+			fr.WebhookConfigs = append(fr.WebhookConfigs, alertmanagerFileWebhook{
+				URL:          webhookURL,
+				SendResolved: w.SendResolved,
+			})
+		}
+		file.Receivers = append(file.Receivers, fr)
+	}
+
+	data, err := yaml.Marshal(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      monitor.AlertmanagerConfigSecret,
+			Namespace: common.OperatorNamespace(),
+		},
+		Data: map[string][]byte{
+			"alertmanager.yaml": data,
+		},
+	}, nil
+}
+
+func alertmanagerRoute(route operatorv1.AlertmanagerRoute, root bool) alertmanagerFileRoute {
+	fr := alertmanagerFileRoute{
+		Receiver: route.Receiver,
+		GroupBy:  route.GroupBy,
+	}
+	// Alertmanager rejects matchers and continue on the root route.
+	if !root {
+		fr.Matchers = route.Matchers
+		fr.Continue = route.Continue
+	}
+	if route.GroupWait != nil {
+		fr.GroupWait = string(*route.GroupWait)
+	}
+	if route.GroupInterval != nil {
+		fr.GroupInterval = string(*route.GroupInterval)
+	}
+	if route.RepeatInterval != nil {
+		fr.RepeatInterval = string(*route.RepeatInterval)
+	}
+	for _, child := range route.Routes {
+		fr.Routes = append(fr.Routes, alertmanagerRoute(child, false))
+	}
+	return fr
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/render"
+	"github.com/tigera/operator/pkg/render/common/metrics"
+	"github.com/tigera/operator/pkg/render/kubecontrollers"
+	"github.com/tigera/operator/pkg/render/logstorage/esmetrics"
+	"github.com/tigera/operator/pkg/render/monitor"
+)
+
+// metricsTargets collects the metrics endpoints declared by the render packages of the components, so that Prometheus
+// is configured to scrape all of them. The metrics of the operator are only scraped if operatorMetricsPort is set.
+func metricsTargets(install *operatorv1.InstallationSpec, kubeControllersMetricsPort int, felixPrometheusMetricsEnabled bool, operatorMetricsPort int32) []metrics.Target {
+	targets := []metrics.Target{
+		render.CalicoNodeMetricsTarget(felixPrometheusMetricsEnabled),
+		esmetrics.MetricsTarget(),
+		render.FluentdMetricsTarget(),
+		render.QueryServerMetricsTarget(install.Variant),
+		kubecontrollers.MetricsTarget(kubeControllersMetricsPort),
+		render.GatewayAPIMetricsTarget(),
+	}
+	if install.TyphaMetricsPort != nil {
+		targets = append(targets, render.TyphaMetricsTarget())
+	}
+	if operatorMetricsPort != 0 {
+		targets = append(targets, monitor.OperatorMetricsTarget(operatorMetricsPort))
+	}
+	return targets
+}
@@ -19,6 +19,7 @@ import (
 	_ "embed"
 	"fmt"
 	"reflect"
+	"sync"
 
 	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
 
@@ -33,6 +34,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
@@ -54,6 +56,7 @@ import (
 	"github.com/tigera/operator/pkg/render/logstorage/esmetrics"
 	"github.com/tigera/operator/pkg/render/monitor"
 	"github.com/tigera/operator/pkg/tls/certificatemanagement"
+	"github.com/tigera/operator/pkg/url"
 )
 
 const ResourceName = "monitor"
@@ -67,9 +70,10 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 
 	prometheusReady := &utils.ReadyFlag{}
 	tierWatchReady := &utils.ReadyFlag{}
+	remoteWriteSecretNames := &secretNames{}
 
 	// Create the reconciler
-	reconciler := newReconciler(mgr, opts, prometheusReady, tierWatchReady)
+	reconciler := newReconciler(mgr, opts, prometheusReady, tierWatchReady, remoteWriteSecretNames)
 
 	// Create a new controller
 	c, err := ctrlruntime.NewController("monitor-controller", mgr, controller.Options{Reconciler: reconciler})
@@ -99,19 +103,21 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 
 	go waitToAddPrometheusWatch(c, k8sClient, log, prometheusReady)
 
-	return add(mgr, c)
+	return add(mgr, c, remoteWriteSecretNames)
 }
 
-func newReconciler(mgr manager.Manager, opts options.AddOptions, prometheusReady *utils.ReadyFlag, tierWatchReady *utils.ReadyFlag) reconcile.Reconciler {
+func newReconciler(mgr manager.Manager, opts options.AddOptions, prometheusReady *utils.ReadyFlag, tierWatchReady *utils.ReadyFlag, remoteWriteSecretNames *secretNames) reconcile.Reconciler {
 	r := &ReconcileMonitor{
-		client:          mgr.GetClient(),
-		scheme:          mgr.GetScheme(),
-		provider:        opts.DetectedProvider,
-		status:          status.New(mgr.GetClient(), "monitor", opts.KubernetesVersion),
-		prometheusReady: prometheusReady,
-		tierWatchReady:  tierWatchReady,
-		clusterDomain:   opts.ClusterDomain,
-		multiTenant:     opts.MultiTenant,
+		client:                 mgr.GetClient(),
+		scheme:                 mgr.GetScheme(),
+		provider:               opts.DetectedProvider,
+		status:                 status.New(mgr.GetClient(), "monitor", opts.KubernetesVersion),
+		prometheusReady:        prometheusReady,
+		tierWatchReady:         tierWatchReady,
+		clusterDomain:          opts.ClusterDomain,
+		multiTenant:            opts.MultiTenant,
+		remoteWriteSecretNames: remoteWriteSecretNames,
+		operatorMetricsPort:    common.OperatorMetricsPort(),
 	}
 
 	r.status.AddStatefulSets([]types.NamespacedName{
@@ -123,7 +129,7 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions, prometheusReady
 	return r
 }
 
-func add(_ manager.Manager, c ctrlruntime.Controller) error {
+func add(_ manager.Manager, c ctrlruntime.Controller, remoteWriteSecretNames *secretNames) error {
 	var err error
 
 	// watch for primary resource changes
@@ -157,12 +163,23 @@ func add(_ manager.Manager, c ctrlruntime.Controller) error {
 		render.NodePrometheusTLSServerSecret,
 		kubecontrollers.KubeControllerPrometheusTLSSecret,
 		render.EKSLogForwarderTLSSecretName,
+		monitor.GrafanaAdminSecretName,
+		render.DexObjectName,
 	} {
 		if err = utils.AddSecretsWatch(c, secret, common.OperatorNamespace()); err != nil {
 			return fmt.Errorf("monitor-controller failed to watch secret: %w", err)
 		}
 	}
 
+	// The secrets referenced by the Prometheus remote write endpoints are named by the user. Only watch the ones
+	// that the Monitor currently references.
+	err = c.WatchObject(&corev1.Secret{}, &handler.EnqueueRequestForObject{}, predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetNamespace() == common.OperatorNamespace() && remoteWriteSecretNames.contains(o.GetName())
+	}))
+	if err != nil {
+		return fmt.Errorf("monitor-controller failed to watch remote write secrets: %w", err)
+	}
+
 	// Namespaces are watched in case external monitoring config is used.
 	err = c.WatchObject(&corev1.Namespace{}, &handler.EnqueueRequestForObject{})
 	if err != nil {
@@ -174,6 +191,12 @@ func add(_ manager.Manager, c ctrlruntime.Controller) error {
 		return fmt.Errorf("monitor-controller failed to watch resource: %w", err)
 	}
 
+	// The manager exposure may determine the issuer of the tokens that are validated.
+	err = c.WatchObject(&operatorv1.Manager{}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return fmt.Errorf("monitor-controller failed to watch resource: %w", err)
+	}
+
 	// Watch for changes to TigeraStatus.
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("monitor-controller failed to watch monitor Tigerastatus: %w", err)
@@ -194,6 +217,13 @@ type ReconcileMonitor struct {
 	tierWatchReady  *utils.ReadyFlag
 	clusterDomain   string
 	multiTenant     bool
+
+	// remoteWriteSecretNames are the secrets referenced by the Prometheus remote write endpoints. Only these are
+	// watched among the secrets that the user names.
+	remoteWriteSecretNames *secretNames
+
+	// operatorMetricsPort is the port the operator serves metrics on, or 0 if it does not serve metrics.
+	operatorMetricsPort int32
 }
 
 func (r *ReconcileMonitor) getMonitor(ctx context.Context) (*operatorv1.Monitor, error) {
@@ -252,6 +282,31 @@ func (r *ReconcileMonitor) Reconcile(ctx context.Context, request reconcile.Requ
 		}
 	}
 
+	if instance.Spec.Grafana != nil && !monitor.GrafanaDeployed(instance.Spec) {
+		dashboardsNamespace := monitor.GrafanaDashboardsNamespace(instance.Spec)
+		if err = r.client.Get(ctx, client.ObjectKey{Name: dashboardsNamespace}, &corev1.Namespace{}); err != nil {
+			if errors.IsNotFound(err) {
+				// The dashboards namespace is usually created alongside the user's Grafana, so wait for it to appear.
+				reqLogger.Info("Waiting for the Grafana dashboards namespace to be created", "namespace", dashboardsNamespace)
+				return reconcile.Result{RequeueAfter: utils.StandardRetry}, nil
+			}
+			r.status.SetDegraded(operatorv1.ResourceReadError, fmt.Sprintf("Failed to get Grafana dashboards namespace %s",
+				dashboardsNamespace), err, reqLogger)
+			return reconcile.Result{}, err
+		}
+	}
+
+	if err = validatePrometheusSpec(instance); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "Invalid Prometheus configuration", err, reqLogger)
+		return reconcile.Result{}, nil
+	}
+	if amConfig := getAlertmanagerConfig(instance); amConfig != nil {
+		if err = validateAlertmanagerConfig(amConfig); err != nil {
+			r.status.SetDegraded(operatorv1.ResourceValidationError, "Invalid Alertmanager configuration", err, reqLogger)
+			return reconcile.Result{}, nil
+		}
+	}
+
 	variant, install, err := utils.GetInstallation(context.Background(), r.client)
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -302,6 +357,33 @@ func (r *ReconcileMonitor) Reconcile(ctx context.Context, request reconcile.Requ
 		return reconcile.Result{}, err
 	}
 
+	serviceAccounts := []string{monitor.PrometheusServiceAccountName}
+	keyPairOptions := []rcertificatemanagement.KeyPairOption{
+		rcertificatemanagement.NewKeyPairOption(serverTLSSecret, true, true),
+		rcertificatemanagement.NewKeyPairOption(clientTLSSecret, true, true),
+	}
+
+	var grafanaTLSSecret certificatemanagement.KeyPairInterface
+	var grafanaAdminSecret *corev1.Secret
+	if monitor.GrafanaDeployed(instance.Spec) {
+		grafanaTLSSecret, err = certificateManager.GetOrCreateKeyPair(r.client, monitor.GrafanaTLSSecretName, common.OperatorNamespace(), dns.GetServiceDNSNames(monitor.GrafanaName, common.TigeraPrometheusNamespace, r.clusterDomain))
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceCreateError, "Error creating TLS certificate", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+		keyPairOptions = append(keyPairOptions, rcertificatemanagement.NewKeyPairOption(grafanaTLSSecret, true, true))
+		serviceAccounts = append(serviceAccounts, monitor.GrafanaName)
+
+		grafanaAdminSecret, err = utils.GetSecret(ctx, r.client, monitor.GrafanaAdminSecretName, common.OperatorNamespace())
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving Grafana admin secret", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+		if grafanaAdminSecret == nil {
+			grafanaAdminSecret = monitor.CreateGrafanaAdminSecret()
+		}
+	}
+
 	trustedBundle := certificateManager.CreateTrustedBundle()
 	for _, certificateName := range []string{
 		esmetrics.ElasticsearchMetricsServerTLSSecret,
@@ -344,6 +426,19 @@ func (r *ReconcileMonitor) Reconcile(ctx context.Context, request reconcile.Requ
 		return reconcile.Result{}, err
 	}
 
+	// Grafana signs users in through Dex with the client secret that the authentication controller generates.
+	var dexClientSecret *corev1.Secret
+	if _, ok := keyValidatorConfig.(*render.DexKeyValidatorConfig); ok && monitor.GrafanaURL(instance.Spec) != "" {
+		dexClientSecret, err = utils.GetSecret(ctx, r.client, render.DexObjectName, common.OperatorNamespace())
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving the Dex client secret", err, reqLogger)
+			return reconcile.Result{}, err
+		} else if dexClientSecret == nil {
+			r.status.SetDegraded(operatorv1.ResourceNotReady, "Waiting for the Dex client secret to be created", nil, reqLogger)
+			return reconcile.Result{RequeueAfter: utils.StandardRetry}, nil
+		}
+	}
+
 	// Validate that the tier watch is ready before querying the tier to ensure we utilize the cache.
 	if !r.tierWatchReady.IsReady() {
 		r.status.SetDegraded(operatorv1.ResourceNotReady, "Waiting for Tier watch to be established", nil, reqLogger)
@@ -364,13 +459,32 @@ func (r *ReconcileMonitor) Reconcile(ctx context.Context, request reconcile.Requ
 		includeV3NetworkPolicy = true
 	}
 
+	grafanaDeployment := types.NamespacedName{Name: monitor.GrafanaName, Namespace: common.TigeraPrometheusNamespace}
+	if monitor.GrafanaDeployed(instance.Spec) {
+		r.status.AddDeployments([]types.NamespacedName{grafanaDeployment})
+	} else {
+		r.status.RemoveDeployments(grafanaDeployment)
+	}
+
 	// Create a component handler to manage the rendered component.
 	hdler := utils.NewComponentHandler(log, r.client, r.scheme, instance)
 
-	alertmanagerConfigSecret, createInOperatorNamespace, err := r.readAlertmanagerConfigSecret(ctx)
-	if err != nil {
-		r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving Alertmanager configuration secret", err, reqLogger)
-		return reconcile.Result{}, err
+	var alertmanagerConfigSecret *corev1.Secret
+	var createInOperatorNamespace bool
+	if amConfig := getAlertmanagerConfig(instance); amConfig != nil {
+		// The structured configuration on the Monitor CR takes precedence over a user-managed secret.
+		alertmanagerConfigSecret, err = r.alertmanagerConfigSecret(ctx, amConfig)
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Error rendering Alertmanager configuration secret", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+		createInOperatorNamespace = true
+	} else {
+		alertmanagerConfigSecret, createInOperatorNamespace, err = r.readAlertmanagerConfigSecret(ctx)
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving Alertmanager configuration secret", err, reqLogger)
+			return reconcile.Result{}, err
+		}
 	}
 
 	kubeControllersMetricsPort, err := utils.GetKubeControllerMetricsPort(ctx, r.client)
@@ -385,19 +499,43 @@ func (r *ReconcileMonitor) Reconcile(ctx context.Context, request reconcile.Requ
 		return reconcile.Result{}, err
 	}
 
+	remoteWriteSecrets, err := r.getRemoteWriteSecrets(ctx, instance)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving Prometheus remote write secrets", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	copiedRemoteWriteSecrets, err := r.getCopiedRemoteWriteSecrets(ctx)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving Prometheus remote write secrets", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	grafanaDashboards, err := r.getGrafanaDashboards(ctx)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving Grafana dashboards", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
 	monitorCfg := &monitor.Config{
-		Monitor:                       instance.Spec,
-		Installation:                  install,
-		PullSecrets:                   pullSecrets,
-		AlertmanagerConfigSecret:      alertmanagerConfigSecret,
-		KeyValidatorConfig:            keyValidatorConfig,
-		ServerTLSSecret:               serverTLSSecret,
-		ClientTLSSecret:               clientTLSSecret,
-		ClusterDomain:                 r.clusterDomain,
-		TrustedCertBundle:             trustedBundle,
-		OpenShift:                     r.provider.IsOpenShift(),
-		KubeControllerPort:            kubeControllersMetricsPort,
-		FelixPrometheusMetricsEnabled: utils.IsFelixPrometheusMetricsEnabled(felixConfiguration),
+		Monitor:                  instance.Spec,
+		Installation:             install,
+		PullSecrets:              pullSecrets,
+		AlertmanagerConfigSecret: alertmanagerConfigSecret,
+		KeyValidatorConfig:       keyValidatorConfig,
+		ServerTLSSecret:          serverTLSSecret,
+		ClientTLSSecret:          clientTLSSecret,
+		ClusterDomain:            r.clusterDomain,
+		TrustedCertBundle:        trustedBundle,
+		OpenShift:                r.provider.IsOpenShift(),
+		KubeControllerPort:       kubeControllersMetricsPort,
+		RemoteWriteSecrets:       remoteWriteSecrets,
+		CopiedRemoteWriteSecrets: copiedRemoteWriteSecrets,
+		MetricsTargets:           metricsTargets(install, kubeControllersMetricsPort, utils.IsFelixPrometheusMetricsEnabled(felixConfiguration), r.operatorMetricsPort),
+		GrafanaDashboards:        grafanaDashboards,
+		GrafanaTLSSecret:         grafanaTLSSecret,
+		GrafanaAdminSecret:       grafanaAdminSecret,
+		DexClientSecret:          dexClientSecret,
 	}
 
 	// Render prometheus component
@@ -405,25 +543,25 @@ func (r *ReconcileMonitor) Reconcile(ctx context.Context, request reconcile.Requ
 		monitor.Monitor(monitorCfg),
 		rcertificatemanagement.CertificateManagement(&rcertificatemanagement.Config{
 			Namespace:       common.TigeraPrometheusNamespace,
-			ServiceAccounts: []string{monitor.PrometheusServiceAccountName},
-			KeyPairOptions: []rcertificatemanagement.KeyPairOption{
-				rcertificatemanagement.NewKeyPairOption(serverTLSSecret, true, true),
-				rcertificatemanagement.NewKeyPairOption(clientTLSSecret, true, true),
-			},
-			TrustedBundle: trustedBundle,
+			ServiceAccounts: serviceAccounts,
+			KeyPairOptions:  keyPairOptions,
+			TrustedBundle:   trustedBundle,
 		}),
 	}
 
 	if createInOperatorNamespace {
 		components = append(components, render.NewPassthrough(alertmanagerConfigSecret))
 	}
+	if grafanaAdminSecret != nil {
+		components = append(components, render.NewPassthrough(grafanaAdminSecret))
+	}
 
 	// v3 NetworkPolicy will fail to reconcile if the Tier is not created, which can only occur once a License is created.
 	// In managed clusters, the monitor controller is a dependency for the License to be created. In case the License is
 	// unavailable and reconciliation of non-NetworkPolicy resources in the monitor controller would resolve it, we
 	// render network policies last to prevent a chicken-and-egg scenario.
 	if includeV3NetworkPolicy {
-		components = append(components, monitor.MonitorPolicy(monitorCfg))
+		components = append(components, monitor.MonitorPolicy(monitorCfg), monitor.MonitorPolicyToDelete(monitorCfg))
 	}
 
 	if err = imageset.ApplyImageSet(ctx, r.client, variant, components...); err != nil {
@@ -488,6 +626,120 @@ func fillDefaults(instance *operatorv1.Monitor) {
 	}
 }
 
+// validatePrometheusSpec validates the parts of the Prometheus configuration that cannot be expressed in the CRD schema.
+func validatePrometheusSpec(instance *operatorv1.Monitor) error {
+	if instance.Spec.Prometheus == nil || instance.Spec.Prometheus.PrometheusSpec == nil {
+		return nil
+	}
+
+	names := map[string]bool{}
+	for i, rw := range instance.Spec.Prometheus.PrometheusSpec.RemoteWrite {
+		if err := url.ValidateHTTPEndpoint(rw.URL); err != nil {
+			return fmt.Errorf("remoteWrite[%d] has an invalid url: %w", i, err)
+		}
+		if rw.Name != "" {
+			if names[rw.Name] {
+				return fmt.Errorf("remoteWrite[%d] name %q is not unique", i, rw.Name)
+			}
+			names[rw.Name] = true
+		}
+		if rw.BasicAuth != nil && rw.BearerTokenSecret != nil {
+			return fmt.Errorf("remoteWrite[%d] cannot set both basicAuth and bearerTokenSecret", i)
+		}
+		if rw.TLS != nil && (rw.TLS.Cert == nil) != (rw.TLS.Key == nil) {
+			return fmt.Errorf("remoteWrite[%d] must set both tls.cert and tls.key, or neither", i)
+		}
+	}
+	return nil
+}
+
+// getRemoteWriteSecrets returns the secrets in the operator namespace that are referenced by the Prometheus remote
+// write endpoints.
+func (r *ReconcileMonitor) getRemoteWriteSecrets(ctx context.Context, instance *operatorv1.Monitor) ([]*corev1.Secret, error) {
+	var names []string
+	if instance.Spec.Prometheus != nil && instance.Spec.Prometheus.PrometheusSpec != nil {
+		seen := map[string]bool{}
+		for _, rw := range instance.Spec.Prometheus.PrometheusSpec.RemoteWrite {
+			for _, name := range rw.SecretNames() {
+				if !seen[name] {
+					seen[name] = true
+					names = append(names, name)
+				}
+			}
+		}
+	}
+	// Record the names before reading the secrets, so that the creation of a missing secret triggers a reconcile.
+	r.remoteWriteSecretNames.set(names)
+
+	var secrets []*corev1.Secret
+	for _, name := range names {
+		secret, err := utils.GetSecret(ctx, r.client, name, common.OperatorNamespace())
+		if err != nil {
+			return nil, err
+		} else if secret == nil {
+			return nil, fmt.Errorf("secret %s/%s not found", common.OperatorNamespace(), name)
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets, nil
+}
+
+// getCopiedRemoteWriteSecrets returns the names of the remote write secrets that have been copied to the
+// tigera-prometheus namespace.
+func (r *ReconcileMonitor) getCopiedRemoteWriteSecrets(ctx context.Context) ([]string, error) {
+	secrets := &corev1.SecretList{}
+	if err := r.client.List(ctx, secrets, client.InNamespace(common.TigeraPrometheusNamespace), client.HasLabels{monitor.RemoteWriteSecretLabel}); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, s := range secrets.Items {
+		names = append(names, s.Name)
+	}
+	return names, nil
+}
+
+// getGrafanaDashboards returns the keys of the dashboard ConfigMaps managed by the operator, in any namespace.
+func (r *ReconcileMonitor) getGrafanaDashboards(ctx context.Context) ([]client.ObjectKey, error) {
+	configMaps := &corev1.ConfigMapList{}
+	if err := r.client.List(ctx, configMaps, client.HasLabels{monitor.GrafanaDashboardLabel}); err != nil {
+		return nil, err
+	}
+
+	var keys []client.ObjectKey
+	for _, cm := range configMaps.Items {
+		keys = append(keys, client.ObjectKeyFromObject(&cm))
+	}
+	return keys, nil
+}
+
+// secretNames is a set of secret names that is safe for concurrent use.
+type secretNames struct {
+	lock  sync.RWMutex
+	names map[string]bool
+}
+
+func (s *secretNames) set(names []string) {
+	if s == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.names = map[string]bool{}
+	for _, name := range names {
+		s.names[name] = true
+	}
+}
+
+func (s *secretNames) contains(name string) bool {
+	if s == nil {
+		return false
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.names[name]
+}
+
 // PrometheusTLSServerDNSNames returns all the DNS names valid for the prometheus server TLS asset.
 func PrometheusTLSServerDNSNames(clusterDomain string) []string {
 	return dns.GetServiceDNSNames(monitor.PrometheusServiceServiceName, common.TigeraPrometheusNamespace, clusterDomain)
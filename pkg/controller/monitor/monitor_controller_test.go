@@ -27,6 +27,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -166,6 +167,151 @@ var _ = Describe("Monitor controller tests", func() {
 			Expect(cli.Get(ctx, client.ObjectKey{Name: monitor.FluentdMetrics, Namespace: common.TigeraPrometheusNamespace}, sm)).NotTo(HaveOccurred())
 		})
 
+		It("should create monitors for the metrics endpoints declared by the components", func() {
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "envoy-gateway", Namespace: common.TigeraPrometheusNamespace}, sm)).NotTo(HaveOccurred())
+
+			// The operator serves metrics only when configured to.
+			pm := &monitoringv1.PodMonitor{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitor.OperatorMetricsName, Namespace: common.TigeraPrometheusNamespace}, pm)).To(HaveOccurred())
+			r.operatorMetricsPort = 8484
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitor.OperatorMetricsName, Namespace: common.TigeraPrometheusNamespace}, pm)).NotTo(HaveOccurred())
+		})
+
+		It("should copy the remote write secrets and degrade when one is missing", func() {
+			r.remoteWriteSecretNames = &secretNames{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitorCR.Name}, monitorCR)).NotTo(HaveOccurred())
+			monitorCR.Spec.Prometheus = &operatorv1.Prometheus{
+				PrometheusSpec: &operatorv1.PrometheusSpec{
+					RemoteWrite: []operatorv1.PrometheusRemoteWrite{{
+						URL: "https://thanos.example.com/api/v1/receive",
+						BearerTokenSecret: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "thanos-token"},
+							Key:                  "token",
+						},
+					}},
+				},
+			}
+			Expect(cli.Update(ctx, monitorCR)).NotTo(HaveOccurred())
+
+			mockStatus.On("SetDegraded", operatorv1.ResourceReadError, "Error retrieving Prometheus remote write secrets", mock.Anything, mock.Anything).Return().Once()
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceReadError, "Error retrieving Prometheus remote write secrets", mock.Anything, mock.Anything)
+
+			Expect(cli.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "thanos-token", Namespace: common.OperatorNamespace()},
+				Data:       map[string][]byte{"token": []byte("secret")},
+			})).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			secret := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "thanos-token", Namespace: common.TigeraPrometheusNamespace}, secret)).NotTo(HaveOccurred())
+			Expect(secret.Data["token"]).To(Equal([]byte("secret")))
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitor.CalicoNodePrometheus, Namespace: common.TigeraPrometheusNamespace}, p)).NotTo(HaveOccurred())
+			Expect(p.Spec.RemoteWrite).To(HaveLen(1))
+			Expect(p.Spec.RemoteWrite[0].Authorization.Credentials.Name).To(Equal("thanos-token"))
+			Expect(r.remoteWriteSecretNames.contains("thanos-token")).To(BeTrue())
+
+			// The copy is removed once the remote write endpoint is removed.
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitorCR.Name}, monitorCR)).NotTo(HaveOccurred())
+			monitorCR.Spec.Prometheus.PrometheusSpec.RemoteWrite = nil
+			Expect(cli.Update(ctx, monitorCR)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = cli.Get(ctx, client.ObjectKey{Name: "thanos-token", Namespace: common.TigeraPrometheusNamespace}, secret)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "thanos-token", Namespace: common.OperatorNamespace()}, secret)).NotTo(HaveOccurred())
+			Expect(r.remoteWriteSecretNames.contains("thanos-token")).To(BeFalse())
+		})
+
+		It("should deploy Grafana with an admin secret and a TLS certificate", func() {
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitorCR.Name}, monitorCR)).NotTo(HaveOccurred())
+			monitorCR.Spec.Grafana = &operatorv1.Grafana{}
+			Expect(cli.Update(ctx, monitorCR)).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitor.GrafanaName, Namespace: common.TigeraPrometheusNamespace}, &appsv1.Deployment{})).NotTo(HaveOccurred())
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitor.GrafanaTLSSecretName, Namespace: common.OperatorNamespace()}, &corev1.Secret{})).NotTo(HaveOccurred())
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitor.GrafanaTLSSecretName, Namespace: common.TigeraPrometheusNamespace}, &corev1.Secret{})).NotTo(HaveOccurred())
+
+			adminSecret := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitor.GrafanaAdminSecretName, Namespace: common.OperatorNamespace()}, adminSecret)).NotTo(HaveOccurred())
+			password := adminSecret.Data[monitor.GrafanaAdminPasswordKey]
+			Expect(password).NotTo(BeEmpty())
+
+			// The admin password is kept across reconciliations.
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitor.GrafanaAdminSecretName, Namespace: common.TigeraPrometheusNamespace}, adminSecret)).NotTo(HaveOccurred())
+			Expect(adminSecret.Data[monitor.GrafanaAdminPasswordKey]).To(Equal(password))
+		})
+
+		It("should export the Grafana dashboards once their namespace exists", func() {
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitorCR.Name}, monitorCR)).NotTo(HaveOccurred())
+			mode := operatorv1.GrafanaModeDashboardsOnly
+			monitorCR.Spec.Grafana = &operatorv1.Grafana{
+				Mode:       &mode,
+				Dashboards: &operatorv1.GrafanaDashboards{Namespace: "monitoring"},
+			}
+			Expect(cli.Update(ctx, monitorCR)).NotTo(HaveOccurred())
+
+			result, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(utils.StandardRetry))
+			mockStatus.AssertNotCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceReadError, "Failed to get Grafana dashboards namespace monitoring", mock.Anything, mock.Anything)
+
+			Expect(cli.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "monitoring"}})).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			cm := &corev1.ConfigMap{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitor.GrafanaDashboardConfigMapPrefix + "felix", Namespace: "monitoring"}, cm)).NotTo(HaveOccurred())
+			Expect(cm.Labels).To(HaveKeyWithValue("grafana_dashboard", "1"))
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitor.GrafanaName, Namespace: common.TigeraPrometheusNamespace}, &appsv1.Deployment{})).To(HaveOccurred())
+
+			// Moving the dashboards to another namespace removes them from the previous one.
+			Expect(cli.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dashboards"}})).NotTo(HaveOccurred())
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitorCR.Name}, monitorCR)).NotTo(HaveOccurred())
+			monitorCR.Spec.Grafana.Dashboards.Namespace = "dashboards"
+			Expect(cli.Update(ctx, monitorCR)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitor.GrafanaDashboardConfigMapPrefix + "felix", Namespace: "dashboards"}, cm)).NotTo(HaveOccurred())
+			err = cli.Get(ctx, client.ObjectKey{Name: monitor.GrafanaDashboardConfigMapPrefix + "felix", Namespace: "monitoring"}, cm)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should degrade when a remote write endpoint sets both basic auth and a bearer token", func() {
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitorCR.Name}, monitorCR)).NotTo(HaveOccurred())
+			selector := corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "auth"}, Key: "key"}
+			monitorCR.Spec.Prometheus = &operatorv1.Prometheus{
+				PrometheusSpec: &operatorv1.PrometheusSpec{
+					RemoteWrite: []operatorv1.PrometheusRemoteWrite{{
+						URL:               "https://thanos.example.com/api/v1/receive",
+						BasicAuth:         &operatorv1.PrometheusRemoteWriteBasicAuth{Username: selector, Password: selector},
+						BearerTokenSecret: &selector,
+					}},
+				},
+			}
+			Expect(cli.Update(ctx, monitorCR)).NotTo(HaveOccurred())
+
+			mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "Invalid Prometheus configuration", mock.Anything, mock.Anything).Return()
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceValidationError, "Invalid Prometheus configuration", mock.Anything, mock.Anything)
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitor.CalicoNodePrometheus, Namespace: common.TigeraPrometheusNamespace}, p)).To(HaveOccurred())
+		})
+
 		It("should create Prometheus related resources even when a cert with missing key usages is configured for other components", func() {
 			By("Creating a fluentd certificate secret without all necessary usages")
 			cryptoCA, err := tls.MakeCA(rmeta.TigeraOperatorCAIssuerPrefix)
@@ -420,6 +566,102 @@ var _ = Describe("Monitor controller tests", func() {
 		})
 	})
 
+	Context("Alertmanager structured configuration", func() {
+		BeforeEach(func() {
+			Expect(cli.Get(ctx, client.ObjectKey{Name: monitorCR.Name}, monitorCR)).NotTo(HaveOccurred())
+			groupWait := monitoringv1.Duration("10s")
+			monitorCR.Spec.AlertManager = &operatorv1.AlertManager{
+				AlertManagerSpec: &operatorv1.AlertManagerSpec{
+					Config: &operatorv1.AlertmanagerConfig{
+						Route: operatorv1.AlertmanagerRoute{
+							Receiver:  "default",
+							GroupBy:   []string{"alertname"},
+							GroupWait: &groupWait,
+							Routes: []operatorv1.AlertmanagerRoute{
+								{Receiver: "oncall", Matchers: []string{`severity="critical"`}},
+							},
+						},
+						Receivers: []operatorv1.AlertmanagerReceiver{
+							{
+								Name:           "default",
+								WebhookConfigs: []operatorv1.WebhookConfig{{URL: "https://hooks.example.com/alerts"}},
+							},
+							{
+								Name: "oncall",
+								SlackConfigs: []operatorv1.SlackConfig{{
+									APIURLSecret: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "alerting"}, Key: "slack"},
+									Channel:      "#oncall",
+								}},
+								PagerDutyConfigs: []operatorv1.PagerDutyConfig{{
+									RoutingKeySecret: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "alerting"}, Key: "pagerduty"},
+								}},
+							},
+						},
+					},
+				},
+			}
+			Expect(cli.Update(ctx, monitorCR)).NotTo(HaveOccurred())
+		})
+
+		It("should render the Alertmanager configuration secret from the Monitor CR", func() {
+			Expect(cli.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "alerting", Namespace: common.OperatorNamespace()},
+				Data: map[string][]byte{
+					"slack":     []byte("https://hooks.slack.com/services/T0/B0/X"),
+					"pagerduty": []byte("pd-key"),
+				},
+			})).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := `global:
+  resolve_timeout: 5m
+receivers:
+- name: default
+  webhook_configs:
+  - url: https://hooks.example.com/alerts
+- name: oncall
+  pagerduty_configs:
+  - routing_key: pd-key
+  slack_configs:
+  - api_url: https://hooks.slack.com/services/T0/B0/X
+    channel: '#oncall'
+route:
+  group_by:
+  - alertname
+  group_wait: 10s
+  receiver: default
+  routes:
+  - matchers:
+    - severity="critical"
+    receiver: oncall
+`
+			for _, ns := range []string{common.OperatorNamespace(), common.TigeraPrometheusNamespace} {
+				secret := &corev1.Secret{}
+				Expect(cli.Get(ctx, client.ObjectKey{Name: monitor.AlertmanagerConfigSecret, Namespace: ns}, secret)).NotTo(HaveOccurred())
+				Expect(string(secret.Data["alertmanager.yaml"])).To(Equal(expected))
+			}
+		})
+
+		It("should degrade when a referenced secret is missing", func() {
+			mockStatus.On("SetDegraded", operatorv1.ResourceReadError, "Error rendering Alertmanager configuration secret", mock.Anything, mock.Anything).Return()
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceReadError, "Error rendering Alertmanager configuration secret", mock.Anything, mock.Anything)
+		})
+
+		It("should degrade when a route refers to an unknown receiver", func() {
+			monitorCR.Spec.AlertManager.AlertManagerSpec.Config.Route.Routes[0].Receiver = "missing"
+			Expect(cli.Update(ctx, monitorCR)).NotTo(HaveOccurred())
+
+			mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "Invalid Alertmanager configuration", mock.Anything, mock.Anything).Return()
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceValidationError, "Invalid Alertmanager configuration", mock.Anything, mock.Anything)
+		})
+	})
+
 	Context("Reconcile for Condition status", func() {
 		generation := int64(2)
 		It("should reconcile with creating new status condition with one item", func() {
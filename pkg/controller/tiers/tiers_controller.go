@@ -38,6 +38,7 @@ import (
 	operatorv1 "github.com/tigera/operator/api/v1"
 
 	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/controller/k8sapi"
 	"github.com/tigera/operator/pkg/controller/options"
 	"github.com/tigera/operator/pkg/controller/status"
 	"github.com/tigera/operator/pkg/controller/utils"
@@ -77,6 +78,7 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 	}
 
 	go utils.WaitToAddTierWatch(networkpolicy.TigeraComponentTierName, c, k8sClient, log, nil)
+	go utils.WaitToAddTierWatch(tiers.HostProtectionTierName, c, k8sClient, log, nil)
 
 	go utils.WaitToAddNetworkPolicyWatches(c, k8sClient, log, []types.NamespacedName{
 		{Name: tiers.ClusterDNSPolicyName, Namespace: "openshift-dns"},
@@ -182,8 +184,27 @@ func (r *ReconcileTiers) Reconcile(ctx context.Context, request reconcile.Reques
 
 func (r *ReconcileTiers) prepareTiersConfig(ctx context.Context, reqLogger logr.Logger) (*tiers.Config, *reconcile.Result) {
 	tiersConfig := tiers.Config{
-		OpenShift:      r.provider.IsOpenShift(),
-		DNSEgressCIDRs: tiers.DNSEgressCIDR{},
+		OpenShift:             r.provider.IsOpenShift(),
+		DNSEgressCIDRs:        tiers.DNSEgressCIDR{},
+		KubernetesServicePort: k8sapi.Endpoint.Port,
+	}
+
+	// The Installation is only needed for host protection, so its absence doesn't prevent the tiers from being
+	// reconciled.
+	instance, err := utils.GetIfExists[operatorv1.Installation](ctx, utils.DefaultInstanceKey, r.client)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying installation", err, reqLogger)
+		return nil, &reconcile.Result{RequeueAfter: utils.StandardRetry}
+	}
+	if instance != nil && instance.Spec.HostProtection != nil {
+		_, installation, err := utils.GetInstallation(ctx, r.client)
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying installation", err, reqLogger)
+			return nil, &reconcile.Result{RequeueAfter: utils.StandardRetry}
+		}
+		tiersConfig.HostProtection = installation.HostProtection
+		tiersConfig.NodeMetricsPort = installation.NodeMetricsPort
+		tiersConfig.TyphaMetricsPort = installation.TyphaMetricsPort
 	}
 
 	// Determine the namespaces that should be allowed to access the DNS service. For single tenant clusters, this is a
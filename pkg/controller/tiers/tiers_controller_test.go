@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,6 +36,7 @@ import (
 	"github.com/tigera/operator/pkg/controller/status"
 	"github.com/tigera/operator/pkg/controller/utils"
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/render/tiers"
 )
 
 var _ = Describe("tier controller tests", func() {
@@ -128,6 +130,46 @@ var _ = Describe("tier controller tests", func() {
 		Expect(c.Get(ctx, client.ObjectKey{Name: "allow-tigera"}, &tier)).To(BeNil())
 	})
 
+	It("reconciles the host protection tier when the baseline host policy is enabled", func() {
+		mockStatus.On("ReadyToMonitor")
+		mockStatus.On("ClearDegraded")
+
+		installation := &operatorv1.Installation{}
+		Expect(c.Get(ctx, utils.DefaultInstanceKey, installation)).NotTo(HaveOccurred())
+		autoHostEndpoints := operatorv1.AutoHostEndpointsEnabled
+		baselinePolicy := operatorv1.HostBaselinePolicyAudit
+		installation.Spec.HostProtection = &operatorv1.HostProtection{AutoHostEndpoints: &autoHostEndpoints, BaselinePolicy: &baselinePolicy}
+		Expect(c.Update(ctx, installation)).NotTo(HaveOccurred())
+
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		tier := v3.Tier{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: tiers.HostProtectionTierName}, &tier)).To(BeNil())
+		policy := v3.GlobalNetworkPolicy{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: tiers.HostProtectionPolicyName}, &policy)).To(BeNil())
+
+		// Disabling the baseline host policy removes the tier.
+		installation.Spec.HostProtection = nil
+		Expect(c.Update(ctx, installation)).NotTo(HaveOccurred())
+		_, err = r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(errors.IsNotFound(c.Get(ctx, client.ObjectKey{Name: tiers.HostProtectionTierName}, &tier))).To(BeTrue())
+	})
+
+	It("reconciles the allow-tigera tier when the Installation doesn't exist", func() {
+		mockStatus.On("ReadyToMonitor")
+		mockStatus.On("ClearDegraded")
+		Expect(c.Delete(ctx, &operatorv1.Installation{ObjectMeta: metav1.ObjectMeta{Name: "default"}})).NotTo(HaveOccurred())
+
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		tier := v3.Tier{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "allow-tigera"}, &tier)).To(BeNil())
+		Expect(errors.IsNotFound(c.Get(ctx, client.ObjectKey{Name: tiers.HostProtectionTierName}, &tier))).To(BeTrue())
+	})
+
 	It("waits for API server to be available before reconciling", func() {
 		err := c.Delete(ctx, &operatorv1.APIServer{ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"}})
 		Expect(err).ShouldNot(HaveOccurred())
@@ -18,7 +18,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
+	"strings"
 
 	"github.com/go-ldap/ldap"
 	"github.com/tigera/operator/pkg/tls/certificatemanagement"
@@ -69,6 +71,17 @@ func GetKeyValidatorConfig(ctx context.Context, cli client.Client, authenticatio
 				return nil, err
 			}
 		} else {
+			// The issuer of dex is served under the manager domain, which may be derived from the manager exposure.
+			manager, err := GetIfExists[operatorv1.Manager](ctx, DefaultTSEEInstanceKey, cli)
+			if err != nil {
+				return nil, err
+			}
+			managerDomain, err := ManagerDomain(authenticationCR, manager)
+			if err != nil {
+				return nil, err
+			}
+			authenticationCR = authenticationCR.DeepCopy()
+			authenticationCR.Spec.ManagerDomain = managerDomain
 			keyValidatorConfig = render.NewDexKeyValidatorConfig(authenticationCR, idpSecret, clusterDomain)
 		}
 	}
@@ -76,6 +89,32 @@ func GetKeyValidatorConfig(ctx context.Context, cli client.Client, authenticatio
 	return keyValidatorConfig, nil
 }
 
+// ManagerDomain returns the URL that users reach the manager, and therefore dex, under. It is the manager domain of the
+// Authentication if set, and is otherwise derived from the hostname that the manager is exposed under. An error is
+// returned if neither is set, or if the manager domain refers to a different host than the exposure.
+func ManagerDomain(authentication *operatorv1.Authentication, manager *operatorv1.Manager) (string, error) {
+	hostname := render.ManagerExposureHostname(manager)
+	if authentication.Spec.ManagerDomain == "" {
+		if hostname == "" {
+			return "", fmt.Errorf("managerDomain must be set unless the Manager is exposed through Manager.Spec.Exposure")
+		}
+		return fmt.Sprintf("https://%s", hostname), nil
+	}
+
+	domain := authentication.Spec.ManagerDomain
+	if !strings.HasPrefix(domain, "http://") && !strings.HasPrefix(domain, "https://") {
+		domain = fmt.Sprintf("https://%s", domain)
+	}
+	u, err := url.Parse(domain)
+	if err != nil {
+		return "", fmt.Errorf("invalid managerDomain %q: %w", authentication.Spec.ManagerDomain, err)
+	}
+	if hostname != "" && u.Hostname() != hostname {
+		return "", fmt.Errorf("managerDomain %q does not match the Manager exposure hostname %q", authentication.Spec.ManagerDomain, hostname)
+	}
+	return domain, nil
+}
+
 // GetIDPSecret retrieves the Secret containing sensitive information for the configuration IdP specified in the given
 // operatorv1.Authentication CR.
 func GetIDPSecret(ctx context.Context, client client.Client, authentication *operatorv1.Authentication) (*corev1.Secret, error) {
@@ -90,8 +129,50 @@ func GetIDPSecret(ctx context.Context, client client.Client, authentication *ope
 	} else if authentication.Spec.LDAP != nil {
 		secretName = render.LDAPSecretName
 		requiredFields = append(requiredFields, render.BindDNSecretField, render.BindPWSecretField, render.RootCASecretField)
+	} else {
+		// Only Authentication.Spec.Connectors are configured, whose secrets are retrieved by GetConnectorSecrets.
+		return nil, nil
 	}
 
+	return getIDPSecret(ctx, client, secretName, requiredFields)
+}
+
+// GetConnectorSecrets retrieves the Secrets referenced by the connectors in Authentication.Spec.Connectors, keyed
+// by connector ID.
+func GetConnectorSecrets(ctx context.Context, client client.Client, authentication *operatorv1.Authentication) (map[string]*corev1.Secret, error) {
+	secrets := map[string]*corev1.Secret{}
+	for _, connector := range authentication.Spec.Connectors {
+		var requiredFields []string
+		switch connector.Type {
+		case operatorv1.ConnectorTypeOIDC, operatorv1.ConnectorTypeGitHub, operatorv1.ConnectorTypeGitLab:
+			requiredFields = []string{render.ClientIDSecretField, render.ClientSecretSecretField}
+		case operatorv1.ConnectorTypeLDAP:
+			requiredFields = []string{render.BindDNSecretField, render.BindPWSecretField}
+		case operatorv1.ConnectorTypeSAML:
+			requiredFields = []string{render.RootCASecretField}
+		}
+
+		secret, err := getIDPSecret(ctx, client, connector.SecretName, requiredFields)
+		if err != nil {
+			return nil, fmt.Errorf("connector %s: %w", connector.ID, err)
+		}
+		secrets[connector.ID] = secret
+	}
+	return secrets, nil
+}
+
+// GetDexStorageSecret retrieves the Secret with the database credentials of Dex, or nil if Dex does not store its
+// state in a SQL database.
+func GetDexStorageSecret(ctx context.Context, client client.Client, authentication *operatorv1.Authentication) (*corev1.Secret, error) {
+	storage := authentication.Spec.DexStorage
+	if storage.GetType() == operatorv1.DexStorageTypeKubernetes || storage.SQL == nil {
+		return nil, nil
+	}
+	return getIDPSecret(ctx, client, storage.SQL.SecretName, []string{render.DexStorageUsernameSecretField, render.DexStoragePasswordSecretField})
+}
+
+// getIDPSecret retrieves the named Secret from the operator namespace and validates its contents.
+func getIDPSecret(ctx context.Context, client client.Client, secretName string, requiredFields []string) (*corev1.Secret, error) {
 	secret := &corev1.Secret{}
 	if err := client.Get(ctx, types.NamespacedName{Name: secretName, Namespace: common.OperatorNamespace()}, secret); err != nil {
 		return nil, fmt.Errorf("missing secret %s/%s: %w", common.OperatorNamespace(), secretName, err)
@@ -32,6 +32,8 @@ import (
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
 	"github.com/tigera/operator/pkg/controller/utils"
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/dns"
+	"github.com/tigera/operator/pkg/render"
 )
 
 var _ = Describe("LDAP secrets tests", func() {
@@ -89,3 +91,54 @@ var _ = Describe("LDAP secrets tests", func() {
 		Entry("invalid rootCA", `CN=example,OU=finance",DC=com`, "tige\ra-secure", &invalidCert, true),
 	)
 })
+
+var _ = Describe("Manager domain tests", func() {
+	exposed := &operatorv1.Manager{Spec: operatorv1.ManagerSpec{Exposure: &operatorv1.ManagerExposure{
+		Type:     operatorv1.ManagerExposureTypeIngress,
+		Hostname: "manager.example.com",
+	}}}
+	DescribeTable("should derive the manager domain from the manager exposure", func(managerDomain string, manager *operatorv1.Manager, expectedDomain string, expectPass bool) {
+		auth := &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{ManagerDomain: managerDomain}}
+		domain, err := utils.ManagerDomain(auth, manager)
+		if !expectPass {
+			Expect(err).To(HaveOccurred())
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+		Expect(domain).To(Equal(expectedDomain))
+	},
+		Entry("Expect an explicit domain without exposure to be kept", "https://example.com", nil, "https://example.com", true),
+		Entry("Expect a domain without a scheme to default to https", "example.com", nil, "https://example.com", true),
+		Entry("Expect a missing domain without exposure to fail", "", nil, "", false),
+		Entry("Expect a missing domain to default to the exposure hostname", "", exposed, "https://manager.example.com", true),
+		Entry("Expect a matching domain to be kept", "manager.example.com:443", exposed, "https://manager.example.com:443", true),
+		Entry("Expect a mismatching domain to fail", "https://other.example.com", exposed, "", false),
+	)
+
+	It("should issue dex tokens under the domain derived from the manager exposure", func() {
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme)).NotTo(HaveOccurred())
+		Expect(corev1.SchemeBuilder.AddToScheme(scheme)).ShouldNot(HaveOccurred())
+		cli := ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+		ctx := context.Background()
+
+		manager := exposed.DeepCopy()
+		manager.Name = utils.DefaultTSEEInstanceKey.Name
+		Expect(cli.Create(ctx, manager)).NotTo(HaveOccurred())
+		Expect(cli.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: render.OIDCSecretName, Namespace: common.OperatorNamespace()},
+			Data: map[string][]byte{
+				render.ClientIDSecretField:     []byte("id"),
+				render.ClientSecretSecretField: []byte("secret"),
+			},
+		})).NotTo(HaveOccurred())
+
+		auth := &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{
+			OIDC: &operatorv1.AuthenticationOIDC{IssuerURL: "https://accounts.google.com", UsernameClaim: "email"},
+		}}
+		kvc, err := utils.GetKeyValidatorConfig(ctx, cli, auth, dns.DefaultClusterDomain)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvc.Issuer()).To(Equal("https://manager.example.com/dex"))
+		Expect(auth.Spec.ManagerDomain).To(BeEmpty())
+	})
+})
@@ -32,6 +32,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -382,7 +383,8 @@ func (c *componentHandler) CreateOrUpdateOrDelete(ctx context.Context, component
 
 	for _, obj := range objsToDelete {
 		err := c.client.Delete(ctx, obj)
-		if err != nil && !errors.IsNotFound(err) {
+		// An object whose kind is not served by the API server cannot exist, so there is nothing to delete.
+		if err != nil && !errors.IsNotFound(err) && !meta.IsNoMatchError(err) {
 			logCtx := ContextLoggerForResource(c.log, obj)
 			logCtx.Error(err, fmt.Sprintf("Error deleting object %v", obj))
 			return err
@@ -622,6 +624,20 @@ func mergeState(desired client.Object, current runtime.Object) client.Object {
 			return nil
 		}
 		return dt
+	case *v3.BGPPeer:
+		cp := current.(*v3.BGPPeer)
+		dp := desired.(*v3.BGPPeer)
+		if reflect.DeepEqual(cp.Spec, dp.Spec) && reflect.DeepEqual(cp.Labels, dp.Labels) {
+			return nil
+		}
+		return dp
+	case *v3.BGPFilter:
+		cf := current.(*v3.BGPFilter)
+		df := desired.(*v3.BGPFilter)
+		if reflect.DeepEqual(cf.Spec, df.Spec) && reflect.DeepEqual(cf.Labels, df.Labels) {
+			return nil
+		}
+		return df
 	default:
 		// Default to just using the desired state, with an updated RV.
 		return desired
@@ -1,4 +1,4 @@
-// Copyright (c) 2023-2024 Tigera, Inc. All rights reserved.
+// Copyright (c) 2023-2026 Tigera, Inc. All rights reserved.
 
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -16,44 +16,62 @@ package utils
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"strconv"
 
+	operatorv1 "github.com/tigera/operator/api/v1"
 	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// PatchFelixConfiguration applies patchFn to the default FelixConfiguration, creating it if needed. The fields that
+// patchFn writes are recorded as managed by the operator in the FelixSettingsAnnotation, so that they are not taken
+// for values set by someone else. The patch is retried with a fresh copy if the FelixConfiguration is changed
+// concurrently, so patchFn may be called more than once. No patch is sent if patchFn leaves the FelixConfiguration
+// as it was, even if it reports an update, for example when it clears a value and then writes it back.
 func PatchFelixConfiguration(ctx context.Context, c client.Client, patchFn func(fc *crdv1.FelixConfiguration) (bool, error)) (*crdv1.FelixConfiguration, error) {
-	// Fetch any existing default FelixConfiguration object.
-	fc := &crdv1.FelixConfiguration{}
-	err := c.Get(ctx, types.NamespacedName{Name: "default"}, fc)
-	if err != nil && !errors.IsNotFound(err) {
-		return nil, fmt.Errorf("unable to read FelixConfiguration: %w", err)
-	}
+	var fc *crdv1.FelixConfiguration
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// Fetch any existing default FelixConfiguration object.
+		fc = &crdv1.FelixConfiguration{}
+		err := c.Get(ctx, types.NamespacedName{Name: "default"}, fc)
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("unable to read FelixConfiguration: %w", err)
+		}
 
-	// Create a base state for the upcoming patch operation.
-	patchFrom := client.MergeFrom(fc.DeepCopy())
+		// Create a base state for the upcoming patch operation.
+		orig := fc.DeepCopy()
+		patchFrom := client.MergeFromWithOptions(orig, client.MergeFromWithOptimisticLock{})
+
+		// Apply desired changes to the FelixConfiguration.
+		updated, err := patchFn(fc)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return nil
+		}
+		recordManagedFelixFields(orig, fc)
 
-	// Apply desired changes to the FelixConfiguration.
-	updated, err := patchFn(fc)
-	if err != nil {
-		return nil, err
-	}
-	if updated {
 		// Apply the patch.
 		if fc.ResourceVersion == "" {
 			fc.ObjectMeta.Name = "default"
-			if err := c.Create(ctx, fc); err != nil {
-				return nil, err
-			}
-		} else {
-			if err := c.Patch(ctx, fc, patchFrom); err != nil {
-				return nil, err
-			}
+			return c.Create(ctx, fc)
 		}
+		if reflect.DeepEqual(orig.Spec, fc.Spec) && reflect.DeepEqual(orig.Annotations, fc.Annotations) {
+			return nil
+		}
+		return c.Patch(ctx, fc, patchFrom)
+	})
+	if err != nil {
+		return nil, err
 	}
-
 	return fc, nil
 }
 
@@ -72,3 +90,240 @@ func IsFelixPrometheusMetricsEnabled(felixConfiguration *crdv1.FelixConfiguratio
 	}
 	return false
 }
+
+// FelixSettingsAnnotation records the fields that the operator manages on a FelixConfiguration, as a JSON object
+// mapping each field name to the value the operator last wrote. This covers both the operatorv1.FelixSettings and
+// the fields written through PatchFelixConfiguration.
+const FelixSettingsAnnotation = "operator.tigera.io/felix-settings"
+
+// managedFelixFields returns the fields recorded in the FelixSettingsAnnotation of the FelixConfiguration.
+func managedFelixFields(fc *crdv1.FelixConfiguration) map[string]string {
+	owned := map[string]string{}
+	if v, ok := fc.Annotations[FelixSettingsAnnotation]; ok {
+		// An unreadable annotation is treated as if the operator owns nothing, so that nothing is cleared.
+		_ = json.Unmarshal([]byte(v), &owned)
+	}
+	return owned
+}
+
+// setManagedFelixFields records the given fields in the FelixSettingsAnnotation of the FelixConfiguration. It returns
+// true if the annotation was changed.
+func setManagedFelixFields(fc *crdv1.FelixConfiguration, owned map[string]string) bool {
+	if reflect.DeepEqual(managedFelixFields(fc), owned) {
+		return false
+	}
+	if len(owned) == 0 {
+		delete(fc.Annotations, FelixSettingsAnnotation)
+		return true
+	}
+	v, _ := json.Marshal(owned)
+	if fc.Annotations == nil {
+		fc.Annotations = map[string]string{}
+	}
+	fc.Annotations[FelixSettingsAnnotation] = string(v)
+	return true
+}
+
+// felixFieldValues returns the value of each set field of the FelixConfiguration spec, keyed by its JSON name.
+// String values are given unquoted, and others as JSON, which matches the values compared for the felixSettings.
+func felixFieldValues(spec *crdv1.FelixConfigurationSpec) map[string]string {
+	raw := map[string]json.RawMessage{}
+	if b, err := json.Marshal(spec); err == nil {
+		_ = json.Unmarshal(b, &raw)
+	}
+	values := map[string]string{}
+	for field, v := range raw {
+		var str string
+		if json.Unmarshal(v, &str) == nil {
+			values[field] = str
+		} else {
+			values[field] = string(v)
+		}
+	}
+	return values
+}
+
+// recordManagedFelixFields records the fields that were changed between orig and fc as managed by the operator,
+// and stops recording those that were unset. A field that was cleared and then written back with the value the
+// operator last wrote, such as a Felix setting that is no longer configured being replaced by the default the
+// operator sets, stays recorded.
+func recordManagedFelixFields(orig, fc *crdv1.FelixConfiguration) {
+	before, after := felixFieldValues(&orig.Spec), felixFieldValues(&fc.Spec)
+	lastWritten, owned := managedFelixFields(orig), managedFelixFields(fc)
+	fields := map[string]bool{}
+	for f := range before {
+		fields[f] = true
+	}
+	for f := range after {
+		fields[f] = true
+	}
+	for f := range fields {
+		switch {
+		case before[f] != after[f] && after[f] == "":
+			delete(owned, f)
+		case before[f] != after[f], after[f] != "" && after[f] == lastWritten[f]:
+			owned[f] = after[f]
+		}
+	}
+	setManagedFelixFields(fc, owned)
+}
+
+// felixSetting is a FelixConfiguration field that can be configured through operatorv1.FelixSettings. Values are
+// compared as strings, with the empty string meaning unset.
+type felixSetting struct {
+	field   string
+	current func(spec *crdv1.FelixConfigurationSpec) string
+	desired func(s *operatorv1.FelixSettings) string
+	set     func(spec *crdv1.FelixConfigurationSpec, s *operatorv1.FelixSettings)
+	clear   func(spec *crdv1.FelixConfigurationSpec)
+}
+
+var felixSettings = []felixSetting{
+	{
+		field:   "logSeverityScreen",
+		current: func(spec *crdv1.FelixConfigurationSpec) string { return spec.LogSeverityScreen },
+		desired: func(s *operatorv1.FelixSettings) string { return stringOrEmpty(s.LogSeverityScreen) },
+		set: func(spec *crdv1.FelixConfigurationSpec, s *operatorv1.FelixSettings) {
+			spec.LogSeverityScreen = string(*s.LogSeverityScreen)
+		},
+		clear: func(spec *crdv1.FelixConfigurationSpec) { spec.LogSeverityScreen = "" },
+	},
+	{
+		field:   "logSeverityFile",
+		current: func(spec *crdv1.FelixConfigurationSpec) string { return spec.LogSeverityFile },
+		desired: func(s *operatorv1.FelixSettings) string { return stringOrEmpty(s.LogSeverityFile) },
+		set: func(spec *crdv1.FelixConfigurationSpec, s *operatorv1.FelixSettings) {
+			spec.LogSeverityFile = string(*s.LogSeverityFile)
+		},
+		clear: func(spec *crdv1.FelixConfigurationSpec) { spec.LogSeverityFile = "" },
+	},
+	{
+		field:   "flowLogsFlushInterval",
+		current: func(spec *crdv1.FelixConfigurationSpec) string { return durationOrEmpty(spec.FlowLogsFlushInterval) },
+		desired: func(s *operatorv1.FelixSettings) string { return durationOrEmpty(s.FlowLogsFlushInterval) },
+		set: func(spec *crdv1.FelixConfigurationSpec, s *operatorv1.FelixSettings) {
+			spec.FlowLogsFlushInterval = s.FlowLogsFlushInterval.DeepCopy()
+		},
+		clear: func(spec *crdv1.FelixConfigurationSpec) { spec.FlowLogsFlushInterval = nil },
+	},
+	{
+		field:   "routeRefreshInterval",
+		current: func(spec *crdv1.FelixConfigurationSpec) string { return durationOrEmpty(spec.RouteRefreshInterval) },
+		desired: func(s *operatorv1.FelixSettings) string { return durationOrEmpty(s.RouteRefreshInterval) },
+		set: func(spec *crdv1.FelixConfigurationSpec, s *operatorv1.FelixSettings) {
+			spec.RouteRefreshInterval = s.RouteRefreshInterval.DeepCopy()
+		},
+		clear: func(spec *crdv1.FelixConfigurationSpec) { spec.RouteRefreshInterval = nil },
+	},
+	{
+		field:   "iptablesBackend",
+		current: func(spec *crdv1.FelixConfigurationSpec) string { return stringOrEmpty(spec.IptablesBackend) },
+		desired: func(s *operatorv1.FelixSettings) string { return stringOrEmpty(s.IptablesBackend) },
+		set: func(spec *crdv1.FelixConfigurationSpec, s *operatorv1.FelixSettings) {
+			backend := crdv1.IptablesBackend(*s.IptablesBackend)
+			spec.IptablesBackend = &backend
+		},
+		clear: func(spec *crdv1.FelixConfigurationSpec) { spec.IptablesBackend = nil },
+	},
+	{
+		field:   "bpfLogLevel",
+		current: func(spec *crdv1.FelixConfigurationSpec) string { return spec.BPFLogLevel },
+		desired: func(s *operatorv1.FelixSettings) string { return stringOrEmpty(s.BPFLogLevel) },
+		set: func(spec *crdv1.FelixConfigurationSpec, s *operatorv1.FelixSettings) {
+			spec.BPFLogLevel = string(*s.BPFLogLevel)
+		},
+		clear: func(spec *crdv1.FelixConfigurationSpec) { spec.BPFLogLevel = "" },
+	},
+	{
+		field: "healthPort",
+		current: func(spec *crdv1.FelixConfigurationSpec) string {
+			if spec.HealthPort == nil {
+				return ""
+			}
+			return strconv.Itoa(*spec.HealthPort)
+		},
+		desired: func(s *operatorv1.FelixSettings) string {
+			if s.HealthPort == nil {
+				return ""
+			}
+			return strconv.Itoa(int(*s.HealthPort))
+		},
+		set: func(spec *crdv1.FelixConfigurationSpec, s *operatorv1.FelixSettings) {
+			port := int(*s.HealthPort)
+			spec.HealthPort = &port
+		},
+		clear: func(spec *crdv1.FelixConfigurationSpec) { spec.HealthPort = nil },
+	},
+}
+
+func stringOrEmpty[T ~string](s *T) string {
+	if s == nil {
+		return ""
+	}
+	return string(*s)
+}
+
+func durationOrEmpty(d *metav1.Duration) string {
+	if d == nil {
+		return ""
+	}
+	return d.Duration.String()
+}
+
+// ApplyFelixSettings writes the given settings to the FelixConfiguration and records them in its
+// FelixSettingsAnnotation. A setting is only written if the field is unset, already holds the value the operator
+// last wrote, or holds the value given for the field in adoptable (values the operator itself defaults the field
+// to). Otherwise the FelixConfiguration value is kept and a conflict is returned. Settings that the operator wrote
+// before but are no longer configured are cleared, unless they have since been changed. The settings may be nil to
+// clear every setting the operator manages. It returns true if the FelixConfiguration was changed.
+func ApplyFelixSettings(fc *crdv1.FelixConfiguration, settings *operatorv1.FelixSettings, adoptable map[string]string) (bool, []operatorv1.FelixConfigurationConflict) {
+	owned := managedFelixFields(fc)
+	if settings == nil {
+		settings = &operatorv1.FelixSettings{}
+	}
+
+	// Fields that the operator manages other than the Felix settings are kept as they are.
+	newOwned := map[string]string{}
+	for field, v := range owned {
+		newOwned[field] = v
+	}
+	for _, f := range felixSettings {
+		delete(newOwned, f.field)
+	}
+
+	updated := false
+	var conflicts []operatorv1.FelixConfigurationConflict
+	for _, f := range felixSettings {
+		current, desired := f.current(&fc.Spec), f.desired(settings)
+		last, wasOwned := owned[f.field]
+		switch {
+		case desired == "":
+			if wasOwned && current == last && current != "" {
+				f.clear(&fc.Spec)
+				updated = true
+			}
+		case current == desired:
+			newOwned[f.field] = desired
+		case current == "" || (wasOwned && current == last) || (adoptable[f.field] != "" && current == adoptable[f.field]):
+			f.set(&fc.Spec, settings)
+			newOwned[f.field] = desired
+			updated = true
+		default:
+			conflicts = append(conflicts, operatorv1.FelixConfigurationConflict{
+				Resource:     fc.Name,
+				Field:        f.field,
+				Value:        current,
+				DesiredValue: desired,
+			})
+			if wasOwned {
+				// Keep the value the operator wrote, so that the setting is applied again if the field is changed back.
+				newOwned[f.field] = last
+			}
+		}
+	}
+
+	if setManagedFelixFields(fc, newOwned) {
+		updated = true
+	}
+	return updated, conflicts
+}
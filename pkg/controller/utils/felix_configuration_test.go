@@ -0,0 +1,238 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+)
+
+var _ = Describe("ApplyFelixSettings", func() {
+	var fc *crdv1.FelixConfiguration
+
+	BeforeEach(func() {
+		fc = &crdv1.FelixConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	})
+
+	It("should write the settings and record them", func() {
+		debug := operatorv1.FelixLogSeverityDebug
+		nft := operatorv1.IptablesBackendNFT
+		updated, conflicts := ApplyFelixSettings(fc, &operatorv1.FelixSettings{
+			LogSeverityScreen:     &debug,
+			FlowLogsFlushInterval: &metav1.Duration{Duration: 15 * time.Second},
+			IptablesBackend:       &nft,
+		}, nil)
+		Expect(updated).To(BeTrue())
+		Expect(conflicts).To(BeEmpty())
+		Expect(fc.Spec.LogSeverityScreen).To(Equal("Debug"))
+		Expect(fc.Spec.FlowLogsFlushInterval.Duration).To(Equal(15 * time.Second))
+		Expect(*fc.Spec.IptablesBackend).To(Equal(crdv1.IptablesBackend("NFT")))
+		Expect(fc.Annotations[FelixSettingsAnnotation]).To(MatchJSON(`{"logSeverityScreen":"Debug","flowLogsFlushInterval":"15s","iptablesBackend":"NFT"}`))
+
+		// Applying the same settings again changes nothing.
+		updated, conflicts = ApplyFelixSettings(fc, &operatorv1.FelixSettings{
+			LogSeverityScreen:     &debug,
+			FlowLogsFlushInterval: &metav1.Duration{Duration: 15 * time.Second},
+			IptablesBackend:       &nft,
+		}, nil)
+		Expect(updated).To(BeFalse())
+		Expect(conflicts).To(BeEmpty())
+	})
+
+	It("should update and clear the settings it owns and leave other fields alone", func() {
+		info := operatorv1.FelixLogSeverityInfo
+		warning := operatorv1.FelixLogSeverityWarning
+		fc.Spec.LogSeverityFile = "Error"
+		ApplyFelixSettings(fc, &operatorv1.FelixSettings{LogSeverityScreen: &info}, nil)
+
+		updated, _ := ApplyFelixSettings(fc, &operatorv1.FelixSettings{LogSeverityScreen: &warning}, nil)
+		Expect(updated).To(BeTrue())
+		Expect(fc.Spec.LogSeverityScreen).To(Equal("Warning"))
+
+		updated, _ = ApplyFelixSettings(fc, nil, nil)
+		Expect(updated).To(BeTrue())
+		Expect(fc.Spec.LogSeverityScreen).To(BeEmpty())
+		Expect(fc.Spec.LogSeverityFile).To(Equal("Error"))
+		Expect(fc.Annotations).NotTo(HaveKey(FelixSettingsAnnotation))
+	})
+
+	It("should report a conflict instead of overwriting a value it did not write", func() {
+		fc.Spec.BPFLogLevel = "Debug"
+		off := operatorv1.BPFLogLevelOff
+		updated, conflicts := ApplyFelixSettings(fc, &operatorv1.FelixSettings{BPFLogLevel: &off}, nil)
+		Expect(updated).To(BeFalse())
+		Expect(fc.Spec.BPFLogLevel).To(Equal("Debug"))
+		Expect(conflicts).To(Equal([]operatorv1.FelixConfigurationConflict{
+			{Resource: "default", Field: "bpfLogLevel", Value: "Debug", DesiredValue: "Off"},
+		}))
+
+		// Once the field is removed, the operator takes it over.
+		fc.Spec.BPFLogLevel = ""
+		updated, conflicts = ApplyFelixSettings(fc, &operatorv1.FelixSettings{BPFLogLevel: &off}, nil)
+		Expect(updated).To(BeTrue())
+		Expect(conflicts).To(BeEmpty())
+		Expect(fc.Spec.BPFLogLevel).To(Equal("Off"))
+	})
+
+	It("should report a conflict when a value it wrote was changed, and not clear it", func() {
+		port := int32(9199)
+		ApplyFelixSettings(fc, &operatorv1.FelixSettings{HealthPort: &port}, nil)
+		edited := 9300
+		fc.Spec.HealthPort = &edited
+
+		_, conflicts := ApplyFelixSettings(fc, &operatorv1.FelixSettings{HealthPort: &port}, nil)
+		Expect(conflicts).To(HaveLen(1))
+		Expect(*fc.Spec.HealthPort).To(Equal(9300))
+
+		ApplyFelixSettings(fc, nil, nil)
+		Expect(*fc.Spec.HealthPort).To(Equal(9300))
+		Expect(fc.Annotations).NotTo(HaveKey(FelixSettingsAnnotation))
+	})
+
+	It("should take over adoptable values", func() {
+		defaulted := 9099
+		fc.Spec.HealthPort = &defaulted
+		port := int32(9199)
+		updated, conflicts := ApplyFelixSettings(fc, &operatorv1.FelixSettings{HealthPort: &port}, map[string]string{"healthPort": "9099"})
+		Expect(updated).To(BeTrue())
+		Expect(conflicts).To(BeEmpty())
+		Expect(*fc.Spec.HealthPort).To(Equal(9199))
+	})
+})
+
+var _ = Describe("PatchFelixConfiguration", func() {
+	var (
+		ctx context.Context
+		cli client.Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme)).NotTo(HaveOccurred())
+		cli = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+	})
+
+	getFelixConfiguration := func() *crdv1.FelixConfiguration {
+		fc := &crdv1.FelixConfiguration{}
+		Expect(cli.Get(ctx, types.NamespacedName{Name: "default"}, fc)).NotTo(HaveOccurred())
+		return fc
+	}
+
+	It("should record the fields it writes as managed by the operator", func() {
+		_, err := PatchFelixConfiguration(ctx, cli, func(fc *crdv1.FelixConfiguration) (bool, error) {
+			enabled := true
+			port := 9099
+			fc.Spec.BPFEnabled = &enabled
+			fc.Spec.HealthPort = &port
+			fc.Spec.PolicySyncPathPrefix = "/var/run/nodeagent"
+			return true, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getFelixConfiguration().Annotations[FelixSettingsAnnotation]).To(MatchJSON(
+			`{"bpfEnabled":"true","healthPort":"9099","policySyncPathPrefix":"/var/run/nodeagent"}`))
+
+		// Fields set by someone else are not recorded, and unset fields are dropped.
+		fc := getFelixConfiguration()
+		fc.Spec.LogSeverityScreen = "Debug"
+		Expect(cli.Update(ctx, fc)).NotTo(HaveOccurred())
+		_, err = PatchFelixConfiguration(ctx, cli, func(fc *crdv1.FelixConfiguration) (bool, error) {
+			fc.Spec.PolicySyncPathPrefix = ""
+			return true, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getFelixConfiguration().Annotations[FelixSettingsAnnotation]).To(MatchJSON(`{"bpfEnabled":"true","healthPort":"9099"}`))
+	})
+
+	It("should let the Felix settings replace values that other writers set", func() {
+		_, err := PatchFelixConfiguration(ctx, cli, func(fc *crdv1.FelixConfiguration) (bool, error) {
+			port := 9099
+			fc.Spec.HealthPort = &port
+			return true, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		port := int32(9199)
+		var conflicts []operatorv1.FelixConfigurationConflict
+		_, err = PatchFelixConfiguration(ctx, cli, func(fc *crdv1.FelixConfiguration) (bool, error) {
+			var updated bool
+			updated, conflicts = ApplyFelixSettings(fc, &operatorv1.FelixSettings{HealthPort: &port}, nil)
+			return updated, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conflicts).To(BeEmpty())
+		fc := getFelixConfiguration()
+		Expect(*fc.Spec.HealthPort).To(Equal(9199))
+		Expect(fc.Annotations[FelixSettingsAnnotation]).To(MatchJSON(`{"healthPort":"9199"}`))
+	})
+
+	It("should keep a field recorded when it is cleared and written back with the same value", func() {
+		setDefault := func(fc *crdv1.FelixConfiguration) {
+			if fc.Spec.HealthPort == nil {
+				port := 9099
+				fc.Spec.HealthPort = &port
+			}
+		}
+		_, err := PatchFelixConfiguration(ctx, cli, func(fc *crdv1.FelixConfiguration) (bool, error) {
+			setDefault(fc)
+			return true, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		resourceVersion := getFelixConfiguration().ResourceVersion
+
+		// With no Felix settings configured, the operator's default is cleared and then written again, which leaves
+		// the FelixConfiguration unchanged, so it isn't patched.
+		_, err = PatchFelixConfiguration(ctx, cli, func(fc *crdv1.FelixConfiguration) (bool, error) {
+			updated, _ := ApplyFelixSettings(fc, nil, nil)
+			setDefault(fc)
+			return updated, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		fc := getFelixConfiguration()
+		Expect(*fc.Spec.HealthPort).To(Equal(9099))
+		Expect(fc.Annotations[FelixSettingsAnnotation]).To(MatchJSON(`{"healthPort":"9099"}`))
+		Expect(fc.ResourceVersion).To(Equal(resourceVersion))
+	})
+
+	It("should keep the fields of other writers when applying the Felix settings", func() {
+		_, err := PatchFelixConfiguration(ctx, cli, func(fc *crdv1.FelixConfiguration) (bool, error) {
+			enabled := true
+			fc.Spec.BPFEnabled = &enabled
+			return true, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		debug := operatorv1.FelixLogSeverityDebug
+		_, err = PatchFelixConfiguration(ctx, cli, func(fc *crdv1.FelixConfiguration) (bool, error) {
+			updated, _ := ApplyFelixSettings(fc, &operatorv1.FelixSettings{LogSeverityScreen: &debug}, nil)
+			return updated, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getFelixConfiguration().Annotations[FelixSettingsAnnotation]).To(MatchJSON(`{"bpfEnabled":"true","logSeverityScreen":"Debug"}`))
+	})
+})
@@ -219,6 +219,16 @@ func OverrideInstallationSpec(cfg, override operatorv1.InstallationSpec) operato
 		inst.Proxy = override.Proxy
 	}
 
+	switch compareFields(inst.FelixConfiguration, override.FelixConfiguration) {
+	case BOnlySet, Different:
+		inst.FelixConfiguration = override.FelixConfiguration.DeepCopy()
+	}
+
+	switch compareFields(inst.HostProtection, override.HostProtection) {
+	case BOnlySet, Different:
+		inst.HostProtection = override.HostProtection.DeepCopy()
+	}
+
 	return inst
 }
 
@@ -254,6 +264,11 @@ func mergeCNISpecs(cfg, override *operatorv1.CNISpec) *operatorv1.CNISpec {
 		out.IPAM = override.IPAM.DeepCopy()
 	}
 
+	switch compareFields(out.ChainedPlugins, override.ChainedPlugins) {
+	case BOnlySet, Different:
+		out.ChainedPlugins = override.ChainedPlugins.DeepCopy()
+	}
+
 	return out
 }
 
@@ -265,6 +280,21 @@ func mergeCalicoNetwork(cfg, override *operatorv1.CalicoNetworkSpec) *operatorv1
 		out.BGP = override.BGP
 	}
 
+	switch compareFields(out.BGPTopology, override.BGPTopology) {
+	case BOnlySet, Different:
+		out.BGPTopology = override.BGPTopology
+	}
+
+	switch compareFields(out.IPPoolDraining, override.IPPoolDraining) {
+	case BOnlySet, Different:
+		out.IPPoolDraining = override.IPPoolDraining
+	}
+
+	switch compareFields(out.DataplaneMigration, override.DataplaneMigration) {
+	case BOnlySet, Different:
+		out.DataplaneMigration = override.DataplaneMigration
+	}
+
 	switch compareFields(out.IPPools, override.IPPools) {
 	case BOnlySet, Different:
 		out.IPPools = make([]operatorv1.IPPool, len(override.IPPools))
@@ -313,6 +343,14 @@ func mergeCalicoNetwork(cfg, override *operatorv1.CalicoNetworkSpec) *operatorv1
 		out.MultiInterfaceMode = override.MultiInterfaceMode
 	}
 
+	switch compareFields(out.SecondaryNetworks, override.SecondaryNetworks) {
+	case BOnlySet, Different:
+		out.SecondaryNetworks = make([]operatorv1.SecondaryNetwork, len(override.SecondaryNetworks))
+		for i := range override.SecondaryNetworks {
+			override.SecondaryNetworks[i].DeepCopyInto(&out.SecondaryNetworks[i])
+		}
+	}
+
 	switch compareFields(out.ContainerIPForwarding, override.ContainerIPForwarding) {
 	case BOnlySet, Different:
 		out.ContainerIPForwarding = override.ContainerIPForwarding
@@ -891,5 +929,20 @@ func mergeWindowsNodes(cfg, override *operatorv1.WindowsNodeSpec) *operatorv1.Wi
 		out.VXLANAdapter = override.VXLANAdapter
 	}
 
+	switch compareFields(out.HostProcessUserName, override.HostProcessUserName) {
+	case BOnlySet, Different:
+		out.HostProcessUserName = override.HostProcessUserName
+	}
+
+	switch compareFields(out.BGP, override.BGP) {
+	case BOnlySet, Different:
+		out.BGP = override.BGP
+	}
+
+	switch compareFields(out.FirewallRules, override.FirewallRules) {
+	case BOnlySet, Different:
+		out.FirewallRules = override.FirewallRules
+	}
+
 	return out
 }
@@ -17,6 +17,7 @@ package utils
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"sort"
 	"strings"
@@ -986,3 +987,59 @@ func RemoveInstallationFinalizer(i *operatorv1.Installation, finalizer string) {
 		i.SetFinalizers(stringsutil.RemoveStringInSlice(finalizer, i.GetFinalizers()))
 	}
 }
+
+// nodeAddressAnnotations are the annotations Calico sets on the Kubernetes nodes with the addresses that host networked
+// traffic to pods on other nodes may be sourced from, depending on the encapsulation in use.
+var nodeAddressAnnotations = []string{
+	"projectcalico.org/IPv4Address",
+	"projectcalico.org/IPv6Address",
+	"projectcalico.org/IPv4IPIPTunnelAddr",
+	"projectcalico.org/IPv4VXLANTunnelAddr",
+	"projectcalico.org/IPv6VXLANTunnelAddr",
+	"projectcalico.org/IPv4WireguardInterfaceAddr",
+	"projectcalico.org/IPv6WireguardInterfaceAddr",
+}
+
+// NodeAddressNets returns the addresses of all nodes as /32 or /128 CIDRs. The operator is host networked, so the
+// connections it makes to pods are sourced from one of these, whichever node it runs on.
+func NodeAddressNets(ctx context.Context, cli client.Client) ([]string, error) {
+	nodes := &corev1.NodeList{}
+	if err := cli.List(ctx, nodes); err != nil {
+		return nil, err
+	}
+
+	nets := map[string]bool{}
+	addNet := func(address string) {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			// The Calico node address annotations include the prefix length.
+			if ip, _, _ = net.ParseCIDR(address); ip == nil {
+				return
+			}
+		}
+		if ip.To4() != nil {
+			nets[ip.String()+"/32"] = true
+		} else {
+			nets[ip.String()+"/128"] = true
+		}
+	}
+	for _, node := range nodes.Items {
+		for _, address := range node.Status.Addresses {
+			if address.Type == corev1.NodeInternalIP {
+				addNet(address.Address)
+			}
+		}
+		for _, annotation := range nodeAddressAnnotations {
+			if address, ok := node.Annotations[annotation]; ok {
+				addNet(address)
+			}
+		}
+	}
+
+	var sorted []string
+	for n := range nets {
+		sorted = append(sorted, n)
+	}
+	sort.Strings(sorted)
+	return sorted, nil
+}
@@ -447,3 +447,37 @@ var _ = Describe("CreatePredicateForObject", func() {
 			&opv1.Authentication{Spec: opv1.AuthenticationSpec{OIDC: &opv1.AuthenticationOIDC{Type: opv1.OIDCTypeDex}}}, true),
 	)
 })
+
+var _ = Describe("NodeAddressNets", func() {
+	It("should return the addresses of the nodes", func() {
+		ctx := context.Background()
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme)).NotTo(HaveOccurred())
+		Expect(corev1.AddToScheme(scheme)).NotTo(HaveOccurred())
+		cli := ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+
+		Expect(cli.Create(ctx, &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "node-a",
+				Annotations: map[string]string{
+					"projectcalico.org/IPv4Address":         "10.0.0.1/24",
+					"projectcalico.org/IPv4VXLANTunnelAddr": "192.168.10.1",
+				},
+			},
+			Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+				{Type: corev1.NodeInternalIP, Address: "10.0.0.1"},
+				{Type: corev1.NodeHostName, Address: "node-a"},
+			}},
+		})).To(Succeed())
+		Expect(cli.Create(ctx, &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-b"},
+			Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+				{Type: corev1.NodeInternalIP, Address: "fd00::2"},
+			}},
+		})).To(Succeed())
+
+		nets, err := NodeAddressNets(ctx, cli)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nets).To(Equal([]string{"10.0.0.1/32", "192.168.10.1/32", "fd00::2/128"}))
+	})
+})
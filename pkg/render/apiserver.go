@@ -39,6 +39,7 @@ import (
 	"github.com/tigera/operator/pkg/render/common/authentication"
 	rcomp "github.com/tigera/operator/pkg/render/common/components"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/metrics"
 	"github.com/tigera/operator/pkg/render/common/networkpolicy"
 	"github.com/tigera/operator/pkg/render/common/podaffinity"
 	"github.com/tigera/operator/pkg/render/common/secret"
@@ -96,6 +97,24 @@ func ProjectCalicoAPIServerServiceName(v operatorv1.ProductVariant) string {
 	return "tigera-api"
 }
 
+// QueryServerMetricsTarget declares the metrics endpoint of the query server that is exposed by the API server
+// service.
+func QueryServerMetricsTarget(v operatorv1.ProductVariant) metrics.Target {
+	return metrics.Target{
+		Name:      QueryserverServiceName,
+		Namespace: QueryserverNamespace,
+		Selector:  metrics.AppSelector(QueryserverServiceName),
+		Endpoints: []metrics.Endpoint{
+			{
+				Port:        "queryserver",
+				Scheme:      metrics.SchemeHTTPS,
+				ServerName:  ProjectCalicoAPIServerServiceName(v),
+				BearerToken: true,
+			},
+		},
+	}
+}
+
 func APIServerServiceAccountName(v operatorv1.ProductVariant) string {
 	if v == operatorv1.Calico {
 		return "calico-apiserver"
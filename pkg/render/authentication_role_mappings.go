@@ -0,0 +1,157 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"fmt"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+)
+
+const (
+	// RoleMappingLabel is set on the bindings rendered for Authentication.Spec.RoleMappings. Its value is the name
+	// of the mapping.
+	RoleMappingLabel = "operator.tigera.io/authentication-role-mapping"
+
+	roleMappingPrefix = "tigera-idp-"
+)
+
+// RoleMappingClusterRoles are the ClusterRoles that role mappings may grant. They are limited to the roles of the
+// manager UI, so that an identity provider group cannot be made cluster-admin through the Authentication CR.
+var RoleMappingClusterRoles = []string{"tigera-network-admin", "tigera-ui-user"}
+
+// AuthenticationRoleMappingConfiguration contains all the config information needed to render the bindings for the
+// role mappings of the Authentication CR.
+type AuthenticationRoleMappingConfiguration struct {
+	Authentication *operatorv1.Authentication
+
+	// ExistingBindings are the ClusterRoleBindings and RoleBindings carrying the RoleMappingLabel. Those that no
+	// longer correspond to a role mapping are deleted.
+	ExistingBindings []client.Object
+
+	// MissingNamespaces are the namespaces referenced by role mappings that do not exist. No RoleBindings are
+	// rendered in them.
+	MissingNamespaces map[string]bool
+}
+
+func AuthenticationRoleMappings(cfg *AuthenticationRoleMappingConfiguration) Component {
+	return &authenticationRoleMappingComponent{cfg: cfg}
+}
+
+type authenticationRoleMappingComponent struct {
+	cfg *AuthenticationRoleMappingConfiguration
+}
+
+func (c *authenticationRoleMappingComponent) ResolveImages(is *operatorv1.ImageSet) error {
+	return nil
+}
+
+func (c *authenticationRoleMappingComponent) SupportedOSType() rmeta.OSType {
+	return rmeta.OSTypeAny
+}
+
+func (c *authenticationRoleMappingComponent) Ready() bool {
+	return true
+}
+
+func (c *authenticationRoleMappingComponent) Objects() ([]client.Object, []client.Object) {
+	var objsToCreate []client.Object
+	if c.cfg.Authentication != nil {
+		for _, mapping := range c.cfg.Authentication.Spec.RoleMappings {
+			objsToCreate = append(objsToCreate, c.bindings(mapping)...)
+		}
+	}
+
+	desired := map[string]bool{}
+	for _, obj := range objsToCreate {
+		desired[bindingKey(obj)] = true
+	}
+	var objsToDelete []client.Object
+	for _, obj := range c.cfg.ExistingBindings {
+		if !desired[bindingKey(obj)] {
+			objsToDelete = append(objsToDelete, obj)
+		}
+	}
+
+	return objsToCreate, objsToDelete
+}
+
+// bindings returns a ClusterRoleBinding for a mapping without namespaces, or a RoleBinding in each of its namespaces.
+func (c *authenticationRoleMappingComponent) bindings(mapping operatorv1.AuthenticationRoleMapping) []client.Object {
+	objectMeta := metav1.ObjectMeta{
+		Name:   RoleMappingBindingName(mapping.Name),
+		Labels: map[string]string{RoleMappingLabel: mapping.Name},
+	}
+	roleRef := rbacv1.RoleRef{
+		APIGroup: "rbac.authorization.k8s.io",
+		Kind:     "ClusterRole",
+		Name:     mapping.ClusterRole,
+	}
+
+	// Kubernetes sees the groups of a user with the configured prefix, so the subjects need to include it as well.
+	var subjects []rbacv1.Subject
+	for _, group := range mapping.Groups {
+		subjects = append(subjects, rbacv1.Subject{
+			Kind:     rbacv1.GroupKind,
+			APIGroup: "rbac.authorization.k8s.io",
+			Name:     c.cfg.Authentication.Spec.GroupsPrefix + group,
+		})
+	}
+
+	if len(mapping.Namespaces) == 0 {
+		return []client.Object{
+			&rbacv1.ClusterRoleBinding{
+				TypeMeta:   metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
+				ObjectMeta: objectMeta,
+				RoleRef:    roleRef,
+				Subjects:   subjects,
+			},
+		}
+	}
+
+	var bindings []client.Object
+	for _, namespace := range mapping.Namespaces {
+		if c.cfg.MissingNamespaces[namespace] {
+			continue
+		}
+		meta := *objectMeta.DeepCopy()
+		meta.Namespace = namespace
+		bindings = append(bindings, &rbacv1.RoleBinding{
+			TypeMeta:   metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
+			ObjectMeta: meta,
+			RoleRef:    roleRef,
+			Subjects:   subjects,
+		})
+	}
+	return bindings
+}
+
+// RoleMappingBindingName returns the name of the bindings rendered for the role mapping with the given name.
+func RoleMappingBindingName(mappingName string) string {
+	return roleMappingPrefix + mappingName
+}
+
+func bindingKey(obj client.Object) string {
+	kind := "ClusterRoleBinding"
+	if _, ok := obj.(*rbacv1.RoleBinding); ok {
+		kind = "RoleBinding"
+	}
+	return fmt.Sprintf("%s/%s/%s", kind, obj.GetNamespace(), obj.GetName())
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/render"
+	rtest "github.com/tigera/operator/pkg/render/common/test"
+)
+
+var _ = Describe("Authentication role mapping rendering tests", func() {
+	var auth *operatorv1.Authentication
+
+	BeforeEach(func() {
+		auth = &operatorv1.Authentication{
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec: operatorv1.AuthenticationSpec{
+				GroupsPrefix: "idp:",
+				RoleMappings: []operatorv1.AuthenticationRoleMapping{
+					{Name: "admins", Groups: []string{"admins", "sre"}, ClusterRole: "tigera-network-admin"},
+					{Name: "viewers", Groups: []string{"viewers"}, ClusterRole: "tigera-ui-user", Namespaces: []string{"team-a", "team-b"}},
+				},
+			},
+		}
+	})
+
+	It("should render a ClusterRoleBinding or RoleBindings for each mapping", func() {
+		component := render.AuthenticationRoleMappings(&render.AuthenticationRoleMappingConfiguration{Authentication: auth})
+		toCreate, toDelete := component.Objects()
+		Expect(toDelete).To(BeEmpty())
+
+		expected := []client.Object{
+			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tigera-idp-admins"}},
+			&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tigera-idp-viewers", Namespace: "team-a"}},
+			&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tigera-idp-viewers", Namespace: "team-b"}},
+		}
+		rtest.ExpectResources(toCreate, expected)
+
+		crb := rtest.GetResource(toCreate, "tigera-idp-admins", "", "rbac.authorization.k8s.io", "v1", "ClusterRoleBinding").(*rbacv1.ClusterRoleBinding)
+		Expect(crb.Labels).To(HaveKeyWithValue(render.RoleMappingLabel, "admins"))
+		Expect(crb.RoleRef).To(Equal(rbacv1.RoleRef{APIGroup: "rbac.authorization.k8s.io", Kind: "ClusterRole", Name: "tigera-network-admin"}))
+		Expect(crb.Subjects).To(ConsistOf(
+			rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: "rbac.authorization.k8s.io", Name: "idp:admins"},
+			rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: "rbac.authorization.k8s.io", Name: "idp:sre"},
+		))
+
+		rb := rtest.GetResource(toCreate, "tigera-idp-viewers", "team-b", "rbac.authorization.k8s.io", "v1", "RoleBinding").(*rbacv1.RoleBinding)
+		Expect(rb.RoleRef.Name).To(Equal("tigera-ui-user"))
+		Expect(rb.Subjects).To(ConsistOf(rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: "rbac.authorization.k8s.io", Name: "idp:viewers"}))
+	})
+
+	It("should skip RoleBindings in missing namespaces", func() {
+		component := render.AuthenticationRoleMappings(&render.AuthenticationRoleMappingConfiguration{
+			Authentication:    auth,
+			MissingNamespaces: map[string]bool{"team-b": true},
+		})
+		toCreate, _ := component.Objects()
+		Expect(rtest.GetResource(toCreate, "tigera-idp-viewers", "team-a", "rbac.authorization.k8s.io", "v1", "RoleBinding")).NotTo(BeNil())
+		Expect(rtest.GetResource(toCreate, "tigera-idp-viewers", "team-b", "rbac.authorization.k8s.io", "v1", "RoleBinding")).To(BeNil())
+		Expect(rtest.GetResource(toCreate, "tigera-idp-viewers", "", "rbac.authorization.k8s.io", "v1", "ClusterRoleBinding")).To(BeNil())
+	})
+
+	It("should delete bindings of removed mappings", func() {
+		stale := []client.Object{
+			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tigera-idp-admins"}},
+			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tigera-idp-removed"}},
+			&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tigera-idp-viewers", Namespace: "team-c"}},
+			&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tigera-idp-viewers", Namespace: "team-a"}},
+		}
+		component := render.AuthenticationRoleMappings(&render.AuthenticationRoleMappingConfiguration{
+			Authentication:   auth,
+			ExistingBindings: stale,
+		})
+		_, toDelete := component.Objects()
+		Expect(toDelete).To(ConsistOf(stale[1], stale[2]))
+	})
+})
@@ -0,0 +1,99 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics contains the types that components use to declare their metrics endpoints. The monitor controller
+// collects these declarations and generates the configuration that lets Prometheus scrape them.
+package metrics
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	SchemeHTTP  = "http"
+	SchemeHTTPS = "https"
+)
+
+// Target declares the metrics endpoints of a component.
+type Target struct {
+	// Name is the name of the object that is generated to scrape the target.
+	Name string
+
+	// Namespace is the namespace of the selected services or pods. When empty, all namespaces are selected.
+	Namespace string
+
+	// Selector selects the services, or pods if Pods is set, that expose the endpoints.
+	Selector metav1.LabelSelector
+
+	// Pods indicates that the endpoints are scraped directly from the pods, for components that do not have a
+	// service that exposes them.
+	Pods bool
+
+	// HostNetwork indicates that the pods run on the host network. Egress to them can only be restricted by port.
+	HostNetwork bool
+
+	Endpoints []Endpoint
+}
+
+// Endpoint is a single metrics endpoint of a Target.
+type Endpoint struct {
+	// Port is the name of the service or container port. For pod targets it may be left empty, in which case
+	// PortNumber is used.
+	Port string
+
+	// PortNumber is the port that the metrics are served on. When set, Prometheus is allowed to connect to it.
+	PortNumber uint16
+
+	// Scheme is either SchemeHTTP or SchemeHTTPS.
+	Scheme string
+
+	// Path is the HTTP path of the endpoint. Defaults to /metrics.
+	Path string
+
+	// ServerName is the name used to verify the certificate of an HTTPS endpoint against the trusted bundle.
+	ServerName string
+
+	// ClientAuth indicates that Prometheus must present its client certificate.
+	ClientAuth bool
+
+	// BearerToken indicates that Prometheus must authenticate with its service account token.
+	BearerToken bool
+}
+
+// Ports returns the non-zero port numbers of the endpoints of the target.
+func (t Target) Ports() []uint16 {
+	var ports []uint16
+	for _, ep := range t.Endpoints {
+		if ep.PortNumber != 0 {
+			ports = append(ports, ep.PortNumber)
+		}
+	}
+	return ports
+}
+
+// AppSelector returns a selector that matches the given values of the k8s-app label.
+func AppSelector(apps ...string) metav1.LabelSelector {
+	if len(apps) == 1 {
+		return metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": apps[0]}}
+	}
+	return metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      "k8s-app",
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   apps,
+			},
+		},
+	}
+}
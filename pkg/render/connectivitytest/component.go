@@ -0,0 +1,196 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectivitytest
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/components"
+	"github.com/tigera/operator/pkg/connectivityprobe"
+	calicrypto "github.com/tigera/operator/pkg/crypto"
+	"github.com/tigera/operator/pkg/ptr"
+	"github.com/tigera/operator/pkg/render"
+	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/secret"
+	"github.com/tigera/operator/pkg/render/common/securitycontext"
+)
+
+const (
+	ProbeName          = "calico-connectivity-probe"
+	ProbeNamespace     = common.CalicoNamespace
+	ProbeContainerName = "connectivity-probe"
+
+	// ProbeTokenSecretName is the secret holding the token that the operator authenticates to the probe agents with.
+	ProbeTokenSecretName = "calico-connectivity-probe"
+	ProbeTokenSecretKey  = "token"
+
+	probeTokenAnnotation = "hash.operator.tigera.io/connectivity-probe-token"
+)
+
+// CreateProbeTokenSecret returns a new secret in the operator namespace with a random probe token.
+func CreateProbeTokenSecret() *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ProbeTokenSecretName,
+			Namespace: common.OperatorNamespace(),
+		},
+		Data: map[string][]byte{
+			ProbeTokenSecretKey: []byte(calicrypto.GeneratePassword(32)),
+		},
+	}
+}
+
+func ConnectivityTest(cfg *Configuration) render.Component {
+	return &Component{cfg: cfg}
+}
+
+// Configuration contains all the config information needed to render the component.
+type Configuration struct {
+	PullSecrets      []*corev1.Secret
+	Installation     *operatorv1.InstallationSpec
+	ConnectivityTest *operatorv1.ConnectivityTest
+
+	// TokenSecret is the secret in the operator namespace with the token the probe agents require.
+	TokenSecret *corev1.Secret
+
+	// OperatorSourceNets are the addresses of the nodes. The operator is host networked, so its requests to the probe
+	// agents are sourced from one of these.
+	OperatorSourceNets []string
+}
+
+type Component struct {
+	cfg   *Configuration
+	image string
+}
+
+func (c *Component) ResolveImages(is *operatorv1.ImageSet) error {
+	reg := c.cfg.Installation.Registry
+	path := c.cfg.Installation.ImagePath
+	prefix := c.cfg.Installation.ImagePrefix
+
+	var err error
+	// The probe agent is built into the operator binary.
+	c.image, err = components.GetReference(components.ComponentOperatorInit, reg, path, prefix, is)
+	return err
+}
+
+func (c *Component) SupportedOSType() rmeta.OSType {
+	return rmeta.OSTypeLinux
+}
+
+func (c *Component) Objects() ([]client.Object, []client.Object) {
+	objs := []client.Object{c.networkPolicy()}
+	objs = append(objs, secret.ToRuntimeObjects(c.cfg.TokenSecret)...)
+	objs = append(objs, secret.ToRuntimeObjects(secret.CopyToNamespace(ProbeNamespace, c.cfg.TokenSecret)...)...)
+	objs = append(objs, c.daemonSet())
+	return objs, nil
+}
+
+func (c *Component) Ready() bool {
+	return true
+}
+
+func (c *Component) daemonSet() *appsv1.DaemonSet {
+	nodeSelector := map[string]string{}
+	for k, v := range c.cfg.ConnectivityTest.Spec.NodeSelector {
+		nodeSelector[k] = v
+	}
+
+	return &appsv1.DaemonSet{
+		TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ProbeName,
+			Namespace: ProbeNamespace,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: ProbeName,
+					Annotations: map[string]string{
+						probeTokenAnnotation: rmeta.AnnotationHash(c.cfg.TokenSecret.Data),
+					},
+				},
+				Spec: corev1.PodSpec{
+					NodeSelector:                 nodeSelector,
+					Tolerations:                  rmeta.TolerateAll,
+					ImagePullSecrets:             secret.GetReferenceList(c.cfg.PullSecrets),
+					AutomountServiceAccountToken: ptr.BoolToPtr(false),
+					Containers: []corev1.Container{{
+						Name:            ProbeContainerName,
+						Image:           c.image,
+						ImagePullPolicy: render.ImagePullPolicy(),
+						Args:            []string{"--connectivity-probe"},
+						Env: []corev1.EnvVar{{
+							Name: connectivityprobe.TokenEnvVar,
+							ValueFrom: &corev1.EnvVarSource{
+								SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: ProbeTokenSecretName},
+									Key:                  ProbeTokenSecretKey,
+								},
+							},
+						}},
+						Ports: []corev1.ContainerPort{{
+							Name:          "probe",
+							ContainerPort: connectivityprobe.Port,
+							Protocol:      corev1.ProtocolTCP,
+						}},
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								HTTPGet: &corev1.HTTPGetAction{
+									Path: connectivityprobe.HealthPath,
+									Port: intstr.FromInt(connectivityprobe.Port),
+								},
+							},
+						},
+						SecurityContext: securitycontext.NewNonRootContext(),
+					}},
+				},
+			},
+		},
+	}
+}
+
+// networkPolicy only admits connections to the probe agents from the other probe pods, which probe each other, and
+// from the nodes, which the operator's requests to run the probes come from.
+func (c *Component) networkPolicy() *netv1.NetworkPolicy {
+	tcp := corev1.ProtocolTCP
+	port := intstr.FromInt(connectivityprobe.Port)
+	selector := metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": ProbeName}}
+
+	peers := []netv1.NetworkPolicyPeer{{PodSelector: &selector}}
+	for _, n := range c.cfg.OperatorSourceNets {
+		peers = append(peers, netv1.NetworkPolicyPeer{IPBlock: &netv1.IPBlock{CIDR: n}})
+	}
+	return &netv1.NetworkPolicy{
+		TypeMeta:   metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "networking.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: ProbeName, Namespace: ProbeNamespace},
+		Spec: netv1.NetworkPolicySpec{
+			PodSelector: selector,
+			PolicyTypes: []netv1.PolicyType{netv1.PolicyTypeIngress},
+			Ingress: []netv1.NetworkPolicyIngressRule{{
+				Ports: []netv1.NetworkPolicyPort{{Protocol: &tcp, Port: &port}},
+				From:  peers,
+			}},
+		},
+	}
+}
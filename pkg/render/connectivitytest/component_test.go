@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectivitytest_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	rtest "github.com/tigera/operator/pkg/render/common/test"
+	"github.com/tigera/operator/pkg/render/connectivitytest"
+)
+
+var _ = Describe("Connectivity test rendering", func() {
+	It("should render the probe DaemonSet", func() {
+		component := connectivitytest.ConnectivityTest(&connectivitytest.Configuration{
+			Installation: &operatorv1.InstallationSpec{Registry: "example.io/"},
+			PullSecrets:  []*corev1.Secret{{ObjectMeta: metav1.ObjectMeta{Name: "pull-secret"}}},
+			ConnectivityTest: &operatorv1.ConnectivityTest{Spec: operatorv1.ConnectivityTestSpec{
+				NodeSelector: map[string]string{"pool": "workers"},
+			}},
+			TokenSecret:        connectivitytest.CreateProbeTokenSecret(),
+			OperatorSourceNets: []string{"10.0.0.1/32", "fd00::1/128"},
+		})
+		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+		toCreate, toDelete := component.Objects()
+		Expect(toDelete).To(BeEmpty())
+		rtest.ExpectResources(toCreate, []client.Object{
+			&netv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: connectivitytest.ProbeName, Namespace: connectivitytest.ProbeNamespace}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: connectivitytest.ProbeTokenSecretName, Namespace: common.OperatorNamespace()}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: connectivitytest.ProbeTokenSecretName, Namespace: connectivitytest.ProbeNamespace}},
+			&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: connectivitytest.ProbeName, Namespace: connectivitytest.ProbeNamespace}},
+		})
+
+		ds, err := rtest.GetResourceOfType[*appsv1.DaemonSet](toCreate, connectivitytest.ProbeName, connectivitytest.ProbeNamespace)
+		Expect(err).NotTo(HaveOccurred())
+		spec := ds.Spec.Template.Spec
+		Expect(spec.NodeSelector).To(Equal(map[string]string{"pool": "workers"}))
+		Expect(spec.Tolerations).To(Equal(rmeta.TolerateAll))
+		Expect(spec.ImagePullSecrets).To(Equal([]corev1.LocalObjectReference{{Name: "pull-secret"}}))
+		Expect(*spec.AutomountServiceAccountToken).To(BeFalse())
+		Expect(spec.HostNetwork).To(BeFalse())
+		Expect(spec.Containers).To(HaveLen(1))
+		Expect(spec.Containers[0].Image).To(HavePrefix("example.io/tigera/operator:"))
+		Expect(spec.Containers[0].Args).To(Equal([]string{"--connectivity-probe"}))
+		Expect(spec.Containers[0].ReadinessProbe.HTTPGet.Path).To(Equal("/healthz"))
+		Expect(spec.Containers[0].Env).To(ConsistOf(corev1.EnvVar{
+			Name: "CONNECTIVITY_PROBE_TOKEN",
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: connectivitytest.ProbeTokenSecretName},
+				Key:                  connectivitytest.ProbeTokenSecretKey,
+			}},
+		}))
+	})
+
+	It("should only admit the probe pods and the nodes to the probe port", func() {
+		component := connectivitytest.ConnectivityTest(&connectivitytest.Configuration{
+			Installation:       &operatorv1.InstallationSpec{},
+			ConnectivityTest:   &operatorv1.ConnectivityTest{},
+			TokenSecret:        connectivitytest.CreateProbeTokenSecret(),
+			OperatorSourceNets: []string{"10.0.0.1/32", "fd00::1/128"},
+		})
+		toCreate, _ := component.Objects()
+		policy, err := rtest.GetResourceOfType[*netv1.NetworkPolicy](toCreate, connectivitytest.ProbeName, connectivitytest.ProbeNamespace)
+		Expect(err).NotTo(HaveOccurred())
+
+		selector := metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": connectivitytest.ProbeName}}
+		Expect(policy.Spec.PodSelector).To(Equal(selector))
+		Expect(policy.Spec.PolicyTypes).To(Equal([]netv1.PolicyType{netv1.PolicyTypeIngress}))
+		Expect(policy.Spec.Ingress).To(HaveLen(1))
+		rule := policy.Spec.Ingress[0]
+		Expect(rule.Ports).To(HaveLen(1))
+		Expect(rule.Ports[0].Port.IntValue()).To(Equal(9098))
+		Expect(rule.From).To(Equal([]netv1.NetworkPolicyPeer{
+			{PodSelector: &selector},
+			{IPBlock: &netv1.IPBlock{CIDR: "10.0.0.1/32"}},
+			{IPBlock: &netv1.IPBlock{CIDR: "fd00::1/128"}},
+		}))
+	})
+})
@@ -51,15 +51,20 @@ const (
 	DexPort          = 5556
 	DexTLSSecretName = "tigera-dex-tls"
 	DexClientId      = "tigera-manager"
-	DexPolicyName    = networkpolicy.TigeraComponentPolicyPrefix + "allow-tigera-dex"
+	// DexGrafanaClientID is the ID of the Dex client that Grafana signs users in with.
+	DexGrafanaClientID = "tigera-grafana"
+
+	// dexGrafanaName is the name of the Grafana deployment, which cannot be imported from the monitor package.
+	dexGrafanaName = "tigera-grafana"
+	DexPolicyName  = networkpolicy.TigeraComponentPolicyPrefix + "allow-tigera-dex"
 )
 
 var DexEntityRule = networkpolicy.CreateEntityRule(DexNamespace, DexObjectName, DexPort)
 
 func Dex(cfg *DexComponentConfiguration) Component {
 	return &dexComponent{
-		cfg:       cfg,
-		connector: cfg.DexConfig.Connector(),
+		cfg:        cfg,
+		connectors: cfg.DexConfig.Connectors(),
 	}
 }
 
@@ -76,6 +81,12 @@ type DexComponentConfiguration struct {
 
 	Authentication *operatorv1.Authentication
 
+	// GrafanaURL is the address that users open Grafana on. If set, Grafana is registered as a Dex client.
+	GrafanaURL string
+
+	// StorageSecret contains the database credentials of Dex. It is nil unless Dex stores its state in a SQL database.
+	StorageSecret *corev1.Secret
+
 	// PodProxies represents the resolved proxy configuration for each Dex pod.
 	// If this slice is empty, then resolution has not yet occurred. Pods with no proxy
 	// configured are represented with a nil value.
@@ -84,7 +95,7 @@ type DexComponentConfiguration struct {
 
 type dexComponent struct {
 	cfg          *DexComponentConfiguration
-	connector    map[string]interface{}
+	connectors   []map[string]interface{}
 	image        string
 	csrInitImage string
 }
@@ -133,6 +144,13 @@ func (c *dexComponent) Objects() ([]client.Object, []client.Object) {
 		c.configMap(),
 	}
 
+	var objsToDelete []client.Object
+	if c.highlyAvailable() {
+		objs = append(objs, c.podDisruptionBudget())
+	} else {
+		objsToDelete = append(objsToDelete, c.podDisruptionBudget())
+	}
+
 	// TODO Some of the secrets created in the operator namespace are created by the customer (i.e. oidc credentials)
 	// TODO so we can't just do a blanket delete of the secrets in the operator namespace. We need to refactor
 	// TODO the RequiredSecrets in the dex condig to not pass back secrets of this type.
@@ -142,6 +160,9 @@ func (c *dexComponent) Objects() ([]client.Object, []client.Object) {
 		// The Dex namespace exists only for non-Tigera OIDC types to create secrets within the namespace.
 		objs = append(objs, secret.ToRuntimeObjects(c.cfg.DexConfig.RequiredSecrets(DexNamespace)...)...)
 		objs = append(objs, secret.ToRuntimeObjects(secret.CopyToNamespace(DexNamespace, c.cfg.PullSecrets...)...)...)
+		if c.cfg.StorageSecret != nil {
+			objs = append(objs, secret.ToRuntimeObjects(secret.CopyToNamespace(DexNamespace, c.cfg.StorageSecret)...)...)
+		}
 	}
 
 	if c.cfg.Installation.CertificateManagement != nil {
@@ -149,10 +170,10 @@ func (c *dexComponent) Objects() ([]client.Object, []client.Object) {
 	}
 
 	if c.cfg.DeleteDex {
-		return nil, objs
+		return nil, append(objs, objsToDelete...)
 	}
 
-	return objs, nil
+	return objs, objsToDelete
 }
 
 func (c *dexComponent) Ready() bool {
@@ -229,6 +250,10 @@ func (c *dexComponent) deployment() client.Object {
 	for k, v := range c.cfg.TrustedBundle.HashAnnotations() {
 		annotations[k] = v
 	}
+	for k, v := range c.settingsAnnotations() {
+		annotations[k] = v
+	}
+	annotations[dexClientsAnnotation] = rmeta.AnnotationHash(c.staticClients())
 	annotations[c.cfg.TLSKeyPair.HashAnnotationKey()] = c.cfg.TLSKeyPair.HashAnnotationValue()
 
 	mounts := c.cfg.DexConfig.RequiredVolumeMounts()
@@ -241,6 +266,7 @@ func (c *dexComponent) deployment() client.Object {
 	}
 
 	envVars := c.cfg.DexConfig.RequiredEnv("")
+	envVars = append(envVars, c.storageEnv()...)
 	envVars = append(envVars, c.cfg.Installation.Proxy.EnvVars()...)
 
 	d := &appsv1.Deployment{
@@ -250,10 +276,8 @@ func (c *dexComponent) deployment() client.Object {
 			Namespace: DexNamespace,
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: c.cfg.Installation.ControlPlaneReplicas,
-			Strategy: appsv1.DeploymentStrategy{
-				Type: appsv1.RecreateDeploymentStrategyType,
-			},
+			Replicas: c.replicas(),
+			Strategy: c.deploymentStrategy(),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:        DexObjectName,
@@ -292,7 +316,7 @@ func (c *dexComponent) deployment() client.Object {
 		},
 	}
 
-	if c.cfg.Installation.ControlPlaneReplicas != nil && *c.cfg.Installation.ControlPlaneReplicas > 1 {
+	if c.highlyAvailable() {
 		d.Spec.Template.Spec.Affinity = podaffinity.NewPodAntiAffinity(DexObjectName, DexNamespace)
 	}
 
@@ -347,13 +371,8 @@ func (c *dexComponent) probe() *corev1.Probe {
 
 func (c *dexComponent) configMap() *corev1.ConfigMap {
 	bytes, err := yaml.Marshal(map[string]interface{}{
-		"issuer": c.cfg.DexConfig.Issuer(),
-		"storage": map[string]interface{}{
-			"type": "kubernetes",
-			"config": map[string]bool{
-				"inCluster": true,
-			},
-		},
+		"issuer":  c.cfg.DexConfig.Issuer(),
+		"storage": c.storageConfig(),
 		"web": map[string]interface{}{
 			"https":                   "0.0.0.0:5556",
 			"tlsCert":                 c.cfg.TLSKeyPair.VolumeMountCertificateFilePath(),
@@ -367,23 +386,13 @@ func (c *dexComponent) configMap() *corev1.ConfigMap {
 				"Strict-Transport-Security": "max-age=31536000; includeSubDomains",
 			},
 		},
-		"connectors": []map[string]interface{}{c.connector},
+		"connectors": c.connectors,
 		"oauth2": map[string]interface{}{
 			"skipApprovalScreen": true,
 			"responseTypes":      []string{"id_token", "code", "token"},
 		},
-		"staticClients": []map[string]interface{}{
-			{
-				"id":           DexClientId,
-				"redirectURIs": c.cfg.DexConfig.RedirectURIs(),
-				"name":         "Calico Enterprise Manager",
-				"secretEnv":    dexSecretEnv,
-			},
-		},
-		"expiry": map[string]string{
-			// Default duration is 24h. This is too high for most organizations. Setting it to 15m.
-			"idTokens": "15m",
-		},
+		"staticClients": c.staticClients(),
+		"expiry":        c.expiryConfig(),
 	})
 	if err != nil {
 		// Panic since this would be a developer error, as the marshaled struct is one created by our code.
@@ -401,6 +410,28 @@ func (c *dexComponent) configMap() *corev1.ConfigMap {
 	}
 }
 
+// staticClients returns the clients that Dex issues tokens to: the manager and, if its address is known, Grafana. Both
+// authenticate with the Dex client secret.
+func (c *dexComponent) staticClients() []map[string]interface{} {
+	clients := []map[string]interface{}{
+		{
+			"id":           DexClientId,
+			"redirectURIs": c.cfg.DexConfig.RedirectURIs(),
+			"name":         "Calico Enterprise Manager",
+			"secretEnv":    dexSecretEnv,
+		},
+	}
+	if c.cfg.GrafanaURL != "" {
+		clients = append(clients, map[string]interface{}{
+			"id":           DexGrafanaClientID,
+			"redirectURIs": []string{fmt.Sprintf("%s/login/generic_oauth", strings.TrimSuffix(c.cfg.GrafanaURL, "/"))},
+			"name":         "Calico Grafana",
+			"secretEnv":    dexSecretEnv,
+		})
+	}
+	return clients
+}
+
 func (c *dexComponent) allowTigeraNetworkPolicy(installationVariant operatorv1.ProductVariant) *v3.NetworkPolicy {
 	egressRules := []v3.Rule{}
 	egressRules = networkpolicy.AppendDNSEgressRules(egressRules, c.cfg.OpenShift)
@@ -414,6 +445,9 @@ func (c *dexComponent) allowTigeraNetworkPolicy(installationVariant operatorv1.P
 	for _, egressRule := range c.resolveEgressRulesByDestination() {
 		egressRules = append(egressRules, egressRule)
 	}
+	if storageRule := c.storageEgressRule(); storageRule != nil {
+		egressRules = append(egressRules, *storageRule)
+	}
 
 	dexIngressPortDestination := v3.EntityRule{
 		Ports: networkpolicy.Ports(DexPort),
@@ -421,7 +455,7 @@ func (c *dexComponent) allowTigeraNetworkPolicy(installationVariant operatorv1.P
 
 	networkpolicyHelper := networkpolicy.DefaultHelper()
 
-	return &v3.NetworkPolicy{
+	policy := &v3.NetworkPolicy{
 		TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      DexPolicyName,
@@ -473,6 +507,16 @@ func (c *dexComponent) allowTigeraNetworkPolicy(installationVariant operatorv1.P
 			Egress: egressRules,
 		},
 	}
+	if c.cfg.GrafanaURL != "" {
+		// Grafana exchanges the authorization codes of the users that sign in to it.
+		policy.Spec.Ingress = append(policy.Spec.Ingress, v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Source:      networkpolicy.CreateSourceEntityRule(common.TigeraPrometheusNamespace, dexGrafanaName),
+			Destination: dexIngressPortDestination,
+		})
+	}
+	return policy
 }
 
 func (c *dexComponent) resolveEgressRulesByDestination() map[string]v3.Rule {
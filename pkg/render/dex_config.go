@@ -35,12 +35,18 @@ const (
 	connectorTypeOpenshift = "openshift"
 	connectorTypeGoogle    = "google"
 	connectorTypeLDAP      = "ldap"
+	connectorTypeGitHub    = "github"
+	connectorTypeGitLab    = "gitlab"
+	connectorTypeSAML      = "saml"
 
 	// Various annotations to keep the pod up-to-date
-	authenticationAnnotation = "hash.operator.tigera.io/tigera-dex-auth"
-	dexConfigMapAnnotation   = "hash.operator.tigera.io/tigera-dex-config"
-	dexIdpSecretAnnotation   = "hash.operator.tigera.io/tigera-idp-secret"
-	dexSecretAnnotation      = "hash.operator.tigera.io/tigera-dex-secret"
+	authenticationAnnotation     = "hash.operator.tigera.io/tigera-dex-auth"
+	dexConfigMapAnnotation       = "hash.operator.tigera.io/tigera-dex-config"
+	dexIdpSecretAnnotation       = "hash.operator.tigera.io/tigera-idp-secret"
+	dexSecretAnnotation          = "hash.operator.tigera.io/tigera-dex-secret"
+	dexConnectorsAnnotation      = "hash.operator.tigera.io/tigera-dex-connectors"
+	dexConnectorSecretAnnotation = "hash.operator.tigera.io/tigera-dex-connector-secrets"
+	dexClientsAnnotation         = "hash.operator.tigera.io/tigera-dex-clients"
 
 	// Constants related to secrets.
 	serviceAccountSecretField    = "serviceAccountSecret"
@@ -53,6 +59,7 @@ const (
 	LDAPSecretName               = "tigera-ldap-credentials"
 	serviceAccountSecretLocation = "/etc/dex/secrets/google-groups.json"
 	rootCASecretLocation         = "/etc/ssl/certs/idp.pem"
+	connectorSecretsLocation     = "/etc/dex/connectors"
 	ClientIDSecretField          = "clientID"
 	BindDNSecretField            = "bindDN"
 	BindPWSecretField            = "bindPW"
@@ -78,7 +85,10 @@ const (
 
 // DexConfig is a config for DexIdP itself.
 type DexConfig interface {
+	// Connector returns the connector for the identity provider configured through OIDC, Openshift or LDAP.
 	Connector() map[string]interface{}
+	// Connectors returns all connectors Dex is configured with.
+	Connectors() []map[string]interface{}
 	RedirectURIs() []string
 	// RequiredVolumeMounts returns volume mounts that the KeyValidatorConfig implementation requires.
 	RequiredVolumeMounts() []corev1.VolumeMount
@@ -100,8 +110,12 @@ func NewDexConfig(
 	authentication *oprv1.Authentication,
 	dexSecret *corev1.Secret,
 	idpSecret *corev1.Secret,
+	connectorSecrets map[string]*corev1.Secret,
 	clusterDomain string) DexConfig {
-	return &dexConfig{baseCfg(certificateManagement, authentication, dexSecret, idpSecret, clusterDomain)}
+	return &dexConfig{
+		dexBaseCfg:       baseCfg(certificateManagement, authentication, dexSecret, idpSecret, clusterDomain),
+		connectorSecrets: connectorSecrets,
+	}
 }
 
 type DexKeyValidatorConfig struct {
@@ -118,6 +132,9 @@ func (d *DexKeyValidatorConfig) RequiredVolumes() []corev1.Volume {
 
 type dexConfig struct {
 	*dexBaseCfg
+
+	// connectorSecrets holds the secret of each of the Authentication.Spec.Connectors, keyed by connector ID.
+	connectorSecrets map[string]*corev1.Secret
 }
 
 // Create a struct to hold the base configuration of dex.
@@ -236,12 +253,35 @@ func (d *dexBaseCfg) RequiredSecrets(namespace string) []*corev1.Secret {
 	return secrets
 }
 
+func (d *dexConfig) RequiredSecrets(namespace string) []*corev1.Secret {
+	secrets := d.dexBaseCfg.RequiredSecrets(namespace)
+	for _, connector := range d.authentication.Spec.Connectors {
+		if s := d.connectorSecrets[connector.ID]; s != nil {
+			secrets = append(secrets, secret.CopyToNamespace(namespace, s)...)
+		}
+	}
+	return secrets
+}
+
 // RequiredAnnotations returns the annotations that are relevant for a Dex deployment.
 func (d *dexConfig) RequiredAnnotations() map[string]string {
 	var annotations = map[string]string{
 		dexConfigMapAnnotation: rmeta.AnnotationHash(d.Connector()),
 	}
 
+	if len(d.authentication.Spec.Connectors) > 0 {
+		var connectors []map[string]interface{}
+		secretData := map[string]map[string][]byte{}
+		for _, connector := range d.authentication.Spec.Connectors {
+			connectors = append(connectors, d.additionalConnector(connector))
+			if s := d.connectorSecrets[connector.ID]; s != nil {
+				secretData[connector.ID] = s.Data
+			}
+		}
+		annotations[dexConnectorsAnnotation] = rmeta.AnnotationHash(connectors)
+		annotations[dexConnectorSecretAnnotation] = rmeta.AnnotationHash(secretData)
+	}
+
 	if d.tlsSecret != nil {
 		annotations[d.tlsSecret.HashAnnotationKey()] = d.tlsSecret.HashAnnotationValue()
 	}
@@ -297,6 +337,24 @@ func (d *dexConfig) RequiredEnv(string) []corev1.EnvVar {
 		addIfPresent(BindPWSecretField, bindPWEnv)
 	}
 
+	for _, connector := range d.authentication.Spec.Connectors {
+		s := d.connectorSecrets[connector.ID]
+		if s == nil {
+			continue
+		}
+		prefix := connectorEnvPrefix(connector.ID)
+		for _, field := range []struct{ fieldName, envName string }{
+			{ClientIDSecretField, clientIDEnv},
+			{ClientSecretSecretField, clientSecretEnv},
+			{BindDNSecretField, bindDNEnv},
+			{BindPWSecretField, bindPWEnv},
+		} {
+			if _, found := s.Data[field.fieldName]; found {
+				env = append(env, corev1.EnvVar{Name: prefix + field.envName, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{Key: field.fieldName, LocalObjectReference: corev1.LocalObjectReference{Name: s.Name}}}})
+			}
+		}
+	}
+
 	return env
 }
 
@@ -327,6 +385,18 @@ func (d *dexConfig) RequiredVolumes() []corev1.Volume {
 			},
 		)
 	}
+
+	for _, connector := range d.authentication.Spec.Connectors {
+		if !d.connectorHasRootCA(connector.ID) {
+			continue
+		}
+		volumes = append(volumes,
+			corev1.Volume{
+				Name:         connectorVolumeName(connector.ID),
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{DefaultMode: &defaultMode, SecretName: d.connectorSecrets[connector.ID].Name, Items: []corev1.KeyToPath{{Key: RootCASecretField, Path: "ca.pem"}}}},
+			},
+		)
+	}
 	return volumes
 }
 
@@ -339,20 +409,30 @@ func (d *dexConfig) RequiredVolumeMounts() []corev1.VolumeMount {
 			ReadOnly:  true,
 		},
 	}
-	if d.idpSecret.Data[serviceAccountSecretField] != nil {
+	if d.idpSecret != nil && d.idpSecret.Data[serviceAccountSecretField] != nil {
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
 			Name:      "secrets",
 			MountPath: "/etc/dex/secrets",
 			ReadOnly:  true,
 		})
 	}
-	if d.idpSecret.Data[RootCASecretField] != nil {
+	if d.idpSecret != nil && d.idpSecret.Data[RootCASecretField] != nil {
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
 			Name:      "secrets",
 			MountPath: "/etc/ssl/certs/",
 			ReadOnly:  true,
 		})
 	}
+	for _, connector := range d.authentication.Spec.Connectors {
+		if !d.connectorHasRootCA(connector.ID) {
+			continue
+		}
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      connectorVolumeName(connector.ID),
+			MountPath: fmt.Sprintf("%s/%s", connectorSecretsLocation, connector.ID),
+			ReadOnly:  true,
+		})
+	}
 	return volumeMounts
 }
 
@@ -363,46 +443,7 @@ func (d *dexConfig) Connector() map[string]interface{} {
 
 	switch connectorType {
 	case connectorTypeOIDC:
-		config = map[string]interface{}{
-			"issuer":       d.authentication.Spec.OIDC.IssuerURL,
-			"clientID":     fmt.Sprintf("$%s", clientIDEnv),
-			"clientSecret": fmt.Sprintf("$%s", clientSecretEnv),
-			"redirectURI":  fmt.Sprintf("%s/dex/callback", d.BaseURL()),
-			"scopes":       d.RequestedScopes(),
-			"userNameKey":  d.UsernameClaim(),
-			"userIDKey":    d.UsernameClaim(),
-			"insecureSkipEmailVerified": d.authentication.Spec.OIDC.EmailVerification != nil &&
-				*d.authentication.Spec.OIDC.EmailVerification == oprv1.EmailVerificationTypeSkip,
-			// Although the field is called insecure, it no longer is. It was first introduced without proper refreshing
-			// of the groups claim, leading to stale groups. This has been addressed in Dex v2.25, yet the field retains
-			// this name.
-			"insecureEnableGroups": true,
-		}
-		promptTypes := d.authentication.Spec.OIDC.PromptTypes
-		if promptTypes != nil {
-			length := len(promptTypes)
-			prompts := make([]string, length)
-			for i, v := range promptTypes {
-				switch v {
-				case oprv1.PromptTypeNone:
-					prompts[i] = "none"
-				case oprv1.PromptTypeSelectAccount:
-					prompts[i] = "select_account"
-				case oprv1.PromptTypeLogin:
-					prompts[i] = "login"
-				case oprv1.PromptTypeConsent:
-					prompts[i] = "consent"
-				}
-			}
-			// RFC specifies space delimited case sensitive list: https://openid.net/specs/openid-connect-core-1_0.html#AuthRequest
-			config["promptType"] = strings.Join(prompts, " ")
-		}
-		groupsClaim := d.authentication.Spec.OIDC.GroupsClaim
-		if groupsClaim != "" && groupsClaim != DefaultGroupsClaim {
-			config["claimMapping"] = map[string]string{
-				"groups": groupsClaim,
-			}
-		}
+		config = d.oidcConnectorConfig(d.authentication.Spec.OIDC, "", d.UsernameClaim(), d.RequestedScopes())
 
 	case connectorTypeGoogle:
 		config = map[string]interface{}{
@@ -426,45 +467,236 @@ func (d *dexConfig) Connector() map[string]interface{} {
 			RootCASecretField: rootCASecretLocation,
 		}
 	case connectorTypeLDAP:
+		config = ldapConnectorConfig(d.authentication.Spec.LDAP, "", rootCASecretLocation)
+	default:
+
+	}
+
+	return map[string]interface{}{
+		"id":     connectorType,
+		"type":   connectorType,
+		"name":   connectorType,
+		"config": config,
+	}
+}
+
+// Connectors returns the connector configured through OIDC, Openshift or LDAP, followed by the connectors of
+// Authentication.Spec.Connectors.
+func (d *dexConfig) Connectors() []map[string]interface{} {
+	var connectors []map[string]interface{}
+	if d.connectorType != "" {
+		connectors = append(connectors, d.Connector())
+	}
+	for _, connector := range d.authentication.Spec.Connectors {
+		connectors = append(connectors, d.additionalConnector(connector))
+	}
+	return connectors
+}
+
+// additionalConnector prepares the configuration of one of the connectors of Authentication.Spec.Connectors. Its
+// credentials are read from environment variables that are prefixed with the connector ID, and its root CA is
+// mounted in a directory named after the connector ID.
+func (d *dexConfig) additionalConnector(connector oprv1.AuthenticationConnector) map[string]interface{} {
+	envPrefix := connectorEnvPrefix(connector.ID)
+	var rootCA string
+	if d.connectorHasRootCA(connector.ID) {
+		rootCA = fmt.Sprintf("%s/%s/ca.pem", connectorSecretsLocation, connector.ID)
+	}
+	redirectURI := fmt.Sprintf("%s/dex/callback", d.BaseURL())
+
+	var connectorType string
+	var config map[string]interface{}
+	switch connector.Type {
+	case oprv1.ConnectorTypeOIDC:
+		connectorType = connectorTypeOIDC
+		usernameClaim := defaultUsernameClaim
+		if connector.OIDC.UsernameClaim != "" {
+			usernameClaim = connector.OIDC.UsernameClaim
+		}
+		scopes := []string{"openid", "email", "profile"}
+		if connector.OIDC.RequestedScopes != nil {
+			scopes = connector.OIDC.RequestedScopes
+		}
+		config = d.oidcConnectorConfig(connector.OIDC, envPrefix, usernameClaim, scopes)
+		if rootCA != "" {
+			config["rootCAs"] = []string{rootCA}
+		}
+
+	case oprv1.ConnectorTypeLDAP:
+		connectorType = connectorTypeLDAP
+		config = ldapConnectorConfig(connector.LDAP, envPrefix, rootCA)
+
+	case oprv1.ConnectorTypeGitHub:
+		connectorType = connectorTypeGitHub
 		config = map[string]interface{}{
-			"host":            d.authentication.Spec.LDAP.Host,
-			"bindDN":          fmt.Sprintf("$%s", bindDNEnv),
-			"bindPW":          fmt.Sprintf("$%s", bindPWEnv),
-			"startTLS":        d.authentication.Spec.LDAP.StartTLS != nil && *d.authentication.Spec.LDAP.StartTLS,
-			RootCASecretField: rootCASecretLocation,
-			"userSearch": map[string]string{
-				"baseDN":    d.authentication.Spec.LDAP.UserSearch.BaseDN,
-				"filter":    d.authentication.Spec.LDAP.UserSearch.Filter,
-				"emailAttr": d.authentication.Spec.LDAP.UserSearch.NameAttribute,
-				"idAttr":    d.authentication.Spec.LDAP.UserSearch.NameAttribute,
-				"username":  d.authentication.Spec.LDAP.UserSearch.NameAttribute,
-				"nameAttr":  d.authentication.Spec.LDAP.UserSearch.NameAttribute,
-			},
+			"clientID":     fmt.Sprintf("$%s%s", envPrefix, clientIDEnv),
+			"clientSecret": fmt.Sprintf("$%s%s", envPrefix, clientSecretEnv),
+			"redirectURI":  redirectURI,
+			// Without orgs to restrict logins to, the teams of all organizations of the user are used as groups.
+			"loadAllGroups": len(connector.GitHub.Orgs) == 0,
 		}
-		if d.authentication.Spec.LDAP.GroupSearch != nil {
-			matchers := make([]map[string]string, len(d.authentication.Spec.LDAP.GroupSearch.UserMatchers))
-			for i, match := range d.authentication.Spec.LDAP.GroupSearch.UserMatchers {
-				matchers[i] = map[string]string{
-					"userAttr":  match.UserAttribute,
-					"groupAttr": match.GroupAttribute,
+		if len(connector.GitHub.Orgs) > 0 {
+			orgs := make([]map[string]interface{}, len(connector.GitHub.Orgs))
+			for i, org := range connector.GitHub.Orgs {
+				orgs[i] = map[string]interface{}{"name": org.Name}
+				if len(org.Teams) > 0 {
+					orgs[i]["teams"] = org.Teams
 				}
 			}
+			config["orgs"] = orgs
+		}
+		if connector.GitHub.TeamNameField != "" {
+			config["teamNameField"] = connector.GitHub.TeamNameField
+		}
+		if connector.GitHub.HostName != "" {
+			config["hostName"] = connector.GitHub.HostName
+		}
+		if rootCA != "" {
+			config[RootCASecretField] = rootCA
+		}
 
-			config["groupSearch"] = map[string]interface{}{
-				"baseDN":       d.authentication.Spec.LDAP.GroupSearch.BaseDN,
-				"filter":       d.authentication.Spec.LDAP.GroupSearch.Filter,
-				"nameAttr":     d.authentication.Spec.LDAP.GroupSearch.NameAttribute,
-				"userMatchers": matchers,
-			}
+	case oprv1.ConnectorTypeGitLab:
+		connectorType = connectorTypeGitLab
+		config = map[string]interface{}{
+			"clientID":     fmt.Sprintf("$%s%s", envPrefix, clientIDEnv),
+			"clientSecret": fmt.Sprintf("$%s%s", envPrefix, clientSecretEnv),
+			"redirectURI":  redirectURI,
+		}
+		if connector.GitLab.BaseURL != "" {
+			config["baseURL"] = connector.GitLab.BaseURL
+		}
+		if len(connector.GitLab.Groups) > 0 {
+			config["groups"] = connector.GitLab.Groups
 		}
-	default:
 
+	case oprv1.ConnectorTypeSAML:
+		connectorType = connectorTypeSAML
+		config = map[string]interface{}{
+			"ssoURL":       connector.SAML.SSOURL,
+			"ca":           rootCA,
+			"redirectURI":  redirectURI,
+			"usernameAttr": connector.SAML.UsernameAttr,
+			"emailAttr":    connector.SAML.EmailAttr,
+		}
+		for key, value := range map[string]string{
+			"groupsAttr":         connector.SAML.GroupsAttr,
+			"entityIssuer":       connector.SAML.EntityIssuer,
+			"ssoIssuer":          connector.SAML.SSOIssuer,
+			"nameIDPolicyFormat": connector.SAML.NameIDPolicyFormat,
+		} {
+			if value != "" {
+				config[key] = value
+			}
+		}
 	}
 
+	name := connector.Name
+	if name == "" {
+		name = connector.ID
+	}
 	return map[string]interface{}{
-		"id":     connectorType,
+		"id":     connector.ID,
 		"type":   connectorType,
-		"name":   connectorType,
+		"name":   name,
 		"config": config,
 	}
 }
+
+// oidcConnectorConfig returns the configuration of an OIDC connector whose client credentials are read from the
+// environment variables with the given prefix.
+func (d *dexConfig) oidcConnectorConfig(oidc *oprv1.AuthenticationOIDC, envPrefix, usernameClaim string, scopes []string) map[string]interface{} {
+	config := map[string]interface{}{
+		"issuer":       oidc.IssuerURL,
+		"clientID":     fmt.Sprintf("$%s%s", envPrefix, clientIDEnv),
+		"clientSecret": fmt.Sprintf("$%s%s", envPrefix, clientSecretEnv),
+		"redirectURI":  fmt.Sprintf("%s/dex/callback", d.BaseURL()),
+		"scopes":       scopes,
+		"userNameKey":  usernameClaim,
+		"userIDKey":    usernameClaim,
+		"insecureSkipEmailVerified": oidc.EmailVerification != nil &&
+			*oidc.EmailVerification == oprv1.EmailVerificationTypeSkip,
+		// Although the field is called insecure, it no longer is. It was first introduced without proper refreshing
+		// of the groups claim, leading to stale groups. This has been addressed in Dex v2.25, yet the field retains
+		// this name.
+		"insecureEnableGroups": true,
+	}
+	promptTypes := oidc.PromptTypes
+	if promptTypes != nil {
+		length := len(promptTypes)
+		prompts := make([]string, length)
+		for i, v := range promptTypes {
+			switch v {
+			case oprv1.PromptTypeNone:
+				prompts[i] = "none"
+			case oprv1.PromptTypeSelectAccount:
+				prompts[i] = "select_account"
+			case oprv1.PromptTypeLogin:
+				prompts[i] = "login"
+			case oprv1.PromptTypeConsent:
+				prompts[i] = "consent"
+			}
+		}
+		// RFC specifies space delimited case sensitive list: https://openid.net/specs/openid-connect-core-1_0.html#AuthRequest
+		config["promptType"] = strings.Join(prompts, " ")
+	}
+	groupsClaim := oidc.GroupsClaim
+	if groupsClaim != "" && groupsClaim != DefaultGroupsClaim {
+		config["claimMapping"] = map[string]string{
+			"groups": groupsClaim,
+		}
+	}
+	return config
+}
+
+// ldapConnectorConfig returns the configuration of an LDAP connector whose bind credentials are read from the
+// environment variables with the given prefix. If rootCA is empty, the system CAs are used.
+func ldapConnectorConfig(ldap *oprv1.AuthenticationLDAP, envPrefix, rootCA string) map[string]interface{} {
+	config := map[string]interface{}{
+		"host":     ldap.Host,
+		"bindDN":   fmt.Sprintf("$%s%s", envPrefix, bindDNEnv),
+		"bindPW":   fmt.Sprintf("$%s%s", envPrefix, bindPWEnv),
+		"startTLS": ldap.StartTLS != nil && *ldap.StartTLS,
+		"userSearch": map[string]string{
+			"baseDN":    ldap.UserSearch.BaseDN,
+			"filter":    ldap.UserSearch.Filter,
+			"emailAttr": ldap.UserSearch.NameAttribute,
+			"idAttr":    ldap.UserSearch.NameAttribute,
+			"username":  ldap.UserSearch.NameAttribute,
+			"nameAttr":  ldap.UserSearch.NameAttribute,
+		},
+	}
+	if rootCA != "" {
+		config[RootCASecretField] = rootCA
+	}
+	if ldap.GroupSearch != nil {
+		matchers := make([]map[string]string, len(ldap.GroupSearch.UserMatchers))
+		for i, match := range ldap.GroupSearch.UserMatchers {
+			matchers[i] = map[string]string{
+				"userAttr":  match.UserAttribute,
+				"groupAttr": match.GroupAttribute,
+			}
+		}
+
+		config["groupSearch"] = map[string]interface{}{
+			"baseDN":       ldap.GroupSearch.BaseDN,
+			"filter":       ldap.GroupSearch.Filter,
+			"nameAttr":     ldap.GroupSearch.NameAttribute,
+			"userMatchers": matchers,
+		}
+	}
+	return config
+}
+
+func (d *dexConfig) connectorHasRootCA(id string) bool {
+	s := d.connectorSecrets[id]
+	return s != nil && len(s.Data[RootCASecretField]) > 0
+}
+
+// connectorEnvPrefix returns the prefix of the environment variables holding the credentials of a connector.
+func connectorEnvPrefix(id string) string {
+	return fmt.Sprintf("CONNECTOR_%s_", strings.ToUpper(strings.ReplaceAll(id, "-", "_")))
+}
+
+func connectorVolumeName(id string) string {
+	return fmt.Sprintf("connector-%s", id)
+}
@@ -75,7 +75,7 @@ var _ = Describe("dex config tests", func() {
 
 	Context("OIDC connector config options", func() {
 		It("should configure insecureSkipEmailVerified ", func() {
-			connector := render.NewDexConfig(nil, authentication, dexSecret, idpSecret, dns.DefaultClusterDomain).Connector()
+			connector := render.NewDexConfig(nil, authentication, dexSecret, idpSecret, nil, dns.DefaultClusterDomain).Connector()
 			cfg := connector["config"].(map[string]interface{})
 			Expect(cfg["insecureSkipEmailVerified"]).To(Equal(true))
 		})
@@ -83,9 +83,9 @@ var _ = Describe("dex config tests", func() {
 
 	Context("Hashes should be consistent and not be affected by fields with pointers", func() {
 		It("should produce consistent hashes for dex config", func() {
-			hashes1 := render.NewDexConfig(nil, authentication, dexSecret, idpSecret, dns.DefaultClusterDomain).RequiredAnnotations()
-			hashes2 := render.NewDexConfig(nil, authentication.DeepCopy(), dexSecret, idpSecret, dns.DefaultClusterDomain).RequiredAnnotations()
-			hashes3 := render.NewDexConfig(nil, authenticationDiff, dexSecret, idpSecret, dns.DefaultClusterDomain).RequiredAnnotations()
+			hashes1 := render.NewDexConfig(nil, authentication, dexSecret, idpSecret, nil, dns.DefaultClusterDomain).RequiredAnnotations()
+			hashes2 := render.NewDexConfig(nil, authentication.DeepCopy(), dexSecret, idpSecret, nil, dns.DefaultClusterDomain).RequiredAnnotations()
+			hashes3 := render.NewDexConfig(nil, authenticationDiff, dexSecret, idpSecret, nil, dns.DefaultClusterDomain).RequiredAnnotations()
 			Expect(hashes1).To(HaveLen(3))
 			Expect(hashes2).To(HaveLen(3))
 			Expect(hashes3).To(HaveLen(3))
@@ -127,7 +127,7 @@ var _ = Describe("dex config tests", func() {
 	)
 
 	DescribeTable("Test DexConfig methods for various connectors ", func(auth *operatorv1.Authentication, expectedConnector map[string]interface{}, expectedVolumes []corev1.Volume, expectedEnv []corev1.EnvVar, secret *corev1.Secret) {
-		dexConfig := render.NewDexConfig(nil, auth, dexSecret, secret, dns.DefaultClusterDomain)
+		dexConfig := render.NewDexConfig(nil, auth, dexSecret, secret, nil, dns.DefaultClusterDomain)
 		Expect(dexConfig.Connector()).To(BeEquivalentTo(expectedConnector))
 		annotations := dexConfig.RequiredAnnotations()
 
@@ -280,7 +280,7 @@ var _ = Describe("dex config tests", func() {
 			TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
 			Data:     secretData,
 		}
-		dexConfig := render.NewDexConfig(nil, google, dexSecret, secret, dns.DefaultClusterDomain)
+		dexConfig := render.NewDexConfig(nil, google, dexSecret, secret, nil, dns.DefaultClusterDomain)
 		connector := dexConfig.Connector()["config"].(map[string]interface{})
 
 		email, emailFound := connector["adminEmail"]
@@ -319,7 +319,7 @@ var _ = Describe("dex config tests", func() {
 	DescribeTable("Test values for promptTypes ", func(in []operatorv1.PromptType, result string) {
 		auth := oidc.DeepCopy()
 		auth.Spec.OIDC.PromptTypes = in
-		dexConfig := render.NewDexConfig(nil, auth, dexSecret, idpSecret, dns.DefaultClusterDomain)
+		dexConfig := render.NewDexConfig(nil, auth, dexSecret, idpSecret, nil, dns.DefaultClusterDomain)
 		config, ok := dexConfig.Connector()["config"].(map[string]interface{})
 		Expect(ok).To(BeTrue())
 		if result == "" {
@@ -338,4 +338,122 @@ var _ = Describe("dex config tests", func() {
 		Entry("Compare actual and expected promptType", []operatorv1.PromptType{operatorv1.PromptTypeConsent, operatorv1.PromptTypeSelectAccount}, "consent select_account"),
 		Entry("Compare actual and expected promptType", []operatorv1.PromptType{operatorv1.PromptTypeConsent, operatorv1.PromptTypeSelectAccount, operatorv1.PromptTypeLogin}, "consent select_account login"),
 	)
+
+	Context("additional connectors", func() {
+		var (
+			auth             *operatorv1.Authentication
+			connectorSecrets map[string]*corev1.Secret
+		)
+
+		BeforeEach(func() {
+			auth = oidc.DeepCopy()
+			auth.Spec.Connectors = []operatorv1.AuthenticationConnector{
+				{
+					ID:         "break-glass",
+					Name:       "Break glass",
+					Type:       operatorv1.ConnectorTypeLDAP,
+					SecretName: "break-glass",
+					LDAP:       ldap.Spec.LDAP.DeepCopy(),
+				},
+				{
+					ID:         "github",
+					Type:       operatorv1.ConnectorTypeGitHub,
+					SecretName: "github",
+					GitHub: &operatorv1.AuthenticationGitHub{
+						Orgs: []operatorv1.GitHubOrg{{Name: "tigera", Teams: []string{"admins"}}},
+					},
+				},
+				{
+					ID:         "saml",
+					Type:       operatorv1.ConnectorTypeSAML,
+					SecretName: "saml",
+					SAML: &operatorv1.AuthenticationSAML{
+						SSOURL:       "https://saml.example.com/sso",
+						UsernameAttr: "name",
+						EmailAttr:    "email",
+						GroupsAttr:   "groups",
+					},
+				},
+			}
+			connectorSecrets = map[string]*corev1.Secret{
+				"break-glass": {
+					ObjectMeta: metav1.ObjectMeta{Name: "break-glass", Namespace: common.OperatorNamespace()},
+					Data:       map[string][]byte{"bindDN": []byte(validDN), "bindPW": []byte("my-secret")},
+				},
+				"github": {
+					ObjectMeta: metav1.ObjectMeta{Name: "github", Namespace: common.OperatorNamespace()},
+					Data:       map[string][]byte{"clientID": []byte("id"), "clientSecret": []byte("my-secret")},
+				},
+				"saml": {
+					ObjectMeta: metav1.ObjectMeta{Name: "saml", Namespace: common.OperatorNamespace()},
+					Data:       map[string][]byte{"rootCA": []byte("ca")},
+				},
+			}
+		})
+
+		It("should render a connector for each identity provider", func() {
+			dexConfig := render.NewDexConfig(nil, auth, dexSecret, idpSecret, connectorSecrets, dns.DefaultClusterDomain)
+			connectors := dexConfig.Connectors()
+			Expect(connectors).To(HaveLen(4))
+			Expect(connectors[0]["id"]).To(Equal("oidc"))
+
+			Expect(connectors[1]["id"]).To(Equal("break-glass"))
+			Expect(connectors[1]["type"]).To(Equal("ldap"))
+			Expect(connectors[1]["name"]).To(Equal("Break glass"))
+			ldapConfig := connectors[1]["config"].(map[string]interface{})
+			Expect(ldapConfig["bindDN"]).To(Equal("$CONNECTOR_BREAK_GLASS_BIND_DN"))
+			Expect(ldapConfig["bindPW"]).To(Equal("$CONNECTOR_BREAK_GLASS_BIND_PW"))
+			Expect(ldapConfig).NotTo(HaveKey("rootCA"))
+
+			Expect(connectors[2]["id"]).To(Equal("github"))
+			Expect(connectors[2]["name"]).To(Equal("github"))
+			Expect(connectors[2]["config"]).To(Equal(map[string]interface{}{
+				"clientID":      "$CONNECTOR_GITHUB_CLIENT_ID",
+				"clientSecret":  "$CONNECTOR_GITHUB_CLIENT_SECRET",
+				"redirectURI":   "https://example.com/dex/callback",
+				"loadAllGroups": false,
+				"orgs":          []map[string]interface{}{{"name": "tigera", "teams": []string{"admins"}}},
+			}))
+
+			Expect(connectors[3]["config"]).To(Equal(map[string]interface{}{
+				"ssoURL":       "https://saml.example.com/sso",
+				"ca":           "/etc/dex/connectors/saml/ca.pem",
+				"redirectURI":  "https://example.com/dex/callback",
+				"usernameAttr": "name",
+				"emailAttr":    "email",
+				"groupsAttr":   "groups",
+			}))
+		})
+
+		It("should provide the credentials of each connector", func() {
+			dexConfig := render.NewDexConfig(nil, auth, dexSecret, idpSecret, connectorSecrets, dns.DefaultClusterDomain)
+
+			var envNames []string
+			for _, env := range dexConfig.RequiredEnv("") {
+				envNames = append(envNames, env.Name)
+			}
+			Expect(envNames).To(ContainElements(
+				"CONNECTOR_BREAK_GLASS_BIND_DN", "CONNECTOR_BREAK_GLASS_BIND_PW",
+				"CONNECTOR_GITHUB_CLIENT_ID", "CONNECTOR_GITHUB_CLIENT_SECRET",
+			))
+
+			Expect(dexConfig.RequiredVolumes()).To(ContainElement(corev1.Volume{
+				Name:         "connector-saml",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{DefaultMode: &defaultMode, SecretName: "saml", Items: []corev1.KeyToPath{{Key: "rootCA", Path: "ca.pem"}}}},
+			}))
+			Expect(dexConfig.RequiredVolumeMounts()).To(ContainElement(corev1.VolumeMount{
+				Name: "connector-saml", MountPath: "/etc/dex/connectors/saml", ReadOnly: true,
+			}))
+			Expect(dexConfig.RequiredSecrets(render.DexNamespace)).To(HaveLen(5))
+			Expect(dexConfig.RequiredAnnotations()).To(HaveKey("hash.operator.tigera.io/tigera-dex-connectors"))
+			Expect(dexConfig.RequiredAnnotations()).To(HaveKey("hash.operator.tigera.io/tigera-dex-connector-secrets"))
+		})
+
+		It("should only render the additional connectors when no other identity provider is configured", func() {
+			auth.Spec.OIDC = nil
+			dexConfig := render.NewDexConfig(nil, auth, dexSecret, nil, connectorSecrets, dns.DefaultClusterDomain)
+			Expect(dexConfig.Connectors()).To(HaveLen(3))
+			Expect(dexConfig.RequiredVolumeMounts()).To(HaveLen(2))
+		})
+	})
 })
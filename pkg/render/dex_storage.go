@@ -0,0 +1,240 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"net"
+	"strconv"
+
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+)
+
+const (
+	DexStorageUsernameSecretField = "username"
+	DexStoragePasswordSecretField = "password"
+
+	dexSettingsAnnotation      = "hash.operator.tigera.io/tigera-dex-settings"
+	dexStorageSecretAnnotation = "hash.operator.tigera.io/tigera-dex-storage-secret"
+
+	// Dex expands environment variables in its storage configuration, so the database credentials never end up in
+	// the config map.
+	dexStorageUsernameEnv = "DEX_STORAGE_USERNAME"
+	dexStoragePasswordEnv = "DEX_STORAGE_PASSWORD"
+
+	defaultDexIDTokenExpiry = "15m"
+	defaultPostgresPort     = 5432
+	defaultMySQLPort        = 3306
+)
+
+// replicas returns the number of Dex replicas, preferring Authentication.Spec.DexReplicas over the control plane
+// replicas of the Installation.
+func (c *dexComponent) replicas() *int32 {
+	if c.cfg.Authentication != nil && c.cfg.Authentication.Spec.DexReplicas != nil {
+		return c.cfg.Authentication.Spec.DexReplicas
+	}
+	return c.cfg.Installation.ControlPlaneReplicas
+}
+
+func (c *dexComponent) highlyAvailable() bool {
+	replicas := c.replicas()
+	return replicas != nil && *replicas > 1
+}
+
+// deploymentStrategy recreates a single Dex replica, but rolls multiple replicas so that logins keep working while
+// Dex is updated.
+func (c *dexComponent) deploymentStrategy() appsv1.DeploymentStrategy {
+	if c.highlyAvailable() {
+		return appsv1.DeploymentStrategy{Type: appsv1.RollingUpdateDeploymentStrategyType}
+	}
+	return appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType}
+}
+
+func (c *dexComponent) podDisruptionBudget() *policyv1.PodDisruptionBudget {
+	maxUnavailable := intstr.FromInt(1)
+	return &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{Kind: "PodDisruptionBudget", APIVersion: "policy/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DexObjectName,
+			Namespace: DexNamespace,
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: &maxUnavailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"k8s-app": DexObjectName},
+			},
+		},
+	}
+}
+
+func (c *dexComponent) storage() *operatorv1.DexStorage {
+	if c.cfg.Authentication == nil {
+		return nil
+	}
+	return c.cfg.Authentication.Spec.DexStorage
+}
+
+// sqlStorage returns the database configuration, or nil if Dex stores its state in the cluster.
+func (c *dexComponent) sqlStorage() *operatorv1.DexSQLStorage {
+	storage := c.storage()
+	if storage.GetType() == operatorv1.DexStorageTypeKubernetes {
+		return nil
+	}
+	return storage.SQL
+}
+
+func (c *dexComponent) sqlPort() int32 {
+	sql := c.sqlStorage()
+	if sql.Port != nil {
+		return *sql.Port
+	}
+	if c.storage().GetType() == operatorv1.DexStorageTypeMySQL {
+		return defaultMySQLPort
+	}
+	return defaultPostgresPort
+}
+
+// storageConfig returns the storage section of the Dex configuration.
+func (c *dexComponent) storageConfig() map[string]interface{} {
+	sql := c.sqlStorage()
+	if sql == nil {
+		return map[string]interface{}{
+			"type": "kubernetes",
+			"config": map[string]bool{
+				"inCluster": true,
+			},
+		}
+	}
+
+	config := map[string]interface{}{
+		"host":     sql.Host,
+		"port":     c.sqlPort(),
+		"database": sql.Database,
+		"user":     "$" + dexStorageUsernameEnv,
+		"password": "$" + dexStoragePasswordEnv,
+	}
+
+	verify := sql.GetSSLMode() == operatorv1.DexSQLSSLModeVerifyCA || sql.GetSSLMode() == operatorv1.DexSQLSSLModeVerifyFull
+	if c.storage().GetType() == operatorv1.DexStorageTypeMySQL {
+		// MySQL only distinguishes between no TLS, TLS without verification and TLS verified against a CA file.
+		switch {
+		case verify:
+			config["ssl"] = map[string]string{"caFile": c.cfg.TrustedBundle.MountPath()}
+		case sql.GetSSLMode() == operatorv1.DexSQLSSLModeRequire:
+			config["ssl"] = map[string]string{"mode": "skip-verify"}
+		default:
+			config["ssl"] = map[string]string{"mode": "false"}
+		}
+		return map[string]interface{}{"type": "mysql", "config": config}
+	}
+
+	ssl := map[string]string{}
+	switch sql.GetSSLMode() {
+	case operatorv1.DexSQLSSLModeDisable:
+		ssl["mode"] = "disable"
+	case operatorv1.DexSQLSSLModeRequire:
+		ssl["mode"] = "require"
+	case operatorv1.DexSQLSSLModeVerifyCA:
+		ssl["mode"] = "verify-ca"
+	default:
+		ssl["mode"] = "verify-full"
+	}
+	if verify {
+		ssl["caFile"] = c.cfg.TrustedBundle.MountPath()
+	}
+	config["ssl"] = ssl
+	return map[string]interface{}{"type": "postgres", "config": config}
+}
+
+// storageEnv returns the environment variables with the database credentials referenced by the storage config.
+func (c *dexComponent) storageEnv() []corev1.EnvVar {
+	sql := c.sqlStorage()
+	if sql == nil {
+		return nil
+	}
+	return []corev1.EnvVar{
+		{Name: dexStorageUsernameEnv, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{Key: DexStorageUsernameSecretField, LocalObjectReference: corev1.LocalObjectReference{Name: sql.SecretName}}}},
+		{Name: dexStoragePasswordEnv, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{Key: DexStoragePasswordSecretField, LocalObjectReference: corev1.LocalObjectReference{Name: sql.SecretName}}}},
+	}
+}
+
+// storageEgressRule allows Dex to connect to its database, or returns nil if Dex stores its state in the cluster.
+func (c *dexComponent) storageEgressRule() *v3.Rule {
+	sql := c.sqlStorage()
+	if sql == nil {
+		return nil
+	}
+	rule, err := resolveEgressRuleForDestination(net.JoinHostPort(sql.Host, strconv.Itoa(int(c.sqlPort()))))
+	if err != nil {
+		log.Error(err, "failed to resolve egress rule for the Dex database, skipping for policy rendering")
+		return nil
+	}
+	return &rule
+}
+
+// expiryConfig returns the expiry section of the Dex configuration.
+func (c *dexComponent) expiryConfig() map[string]interface{} {
+	expiry := map[string]interface{}{
+		// Default duration is 24h. This is too high for most organizations. Setting it to 15m.
+		"idTokens": defaultDexIDTokenExpiry,
+	}
+	if c.cfg.Authentication == nil {
+		return expiry
+	}
+
+	if e := c.cfg.Authentication.Spec.DexExpiry; e != nil {
+		if e.IDTokens != nil {
+			expiry["idTokens"] = e.IDTokens.Duration.String()
+		}
+		if e.SigningKeys != nil {
+			expiry["signingKeys"] = e.SigningKeys.Duration.String()
+		}
+		if e.AuthRequests != nil {
+			expiry["authRequests"] = e.AuthRequests.Duration.String()
+		}
+	}
+
+	if s := c.cfg.Authentication.Spec.DexSession; s != nil {
+		refreshTokens := map[string]string{}
+		if s.IdleTimeout != nil {
+			refreshTokens["validIfNotUsedFor"] = s.IdleTimeout.Duration.String()
+		}
+		if s.AbsoluteLifetime != nil {
+			refreshTokens["absoluteLifetime"] = s.AbsoluteLifetime.Duration.String()
+		}
+		if len(refreshTokens) > 0 {
+			expiry["refreshTokens"] = refreshTokens
+		}
+	}
+	return expiry
+}
+
+// settingsAnnotations restart Dex when its storage or expiry settings, or the database credentials, change.
+func (c *dexComponent) settingsAnnotations() map[string]string {
+	annotations := map[string]string{
+		dexSettingsAnnotation: rmeta.AnnotationHash([]interface{}{c.storageConfig(), c.expiryConfig()}),
+	}
+	if c.cfg.StorageSecret != nil {
+		annotations[dexStorageSecretAnnotation] = rmeta.AnnotationHash(c.cfg.StorageSecret.Data)
+	}
+	return annotations
+}
@@ -16,6 +16,7 @@ package render_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -25,6 +26,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -188,7 +190,7 @@ var _ = Describe("dex rendering tests", func() {
 
 			replicas = 2
 
-			dexCfg := render.NewDexConfig(installation.CertificateManagement, authentication, dexSecret, idpSecret, clusterName)
+			dexCfg := render.NewDexConfig(installation.CertificateManagement, authentication, dexSecret, idpSecret, nil, clusterName)
 			trustedCaBundle, err := certificateManager.CreateTrustedBundleWithSystemRootCertificates()
 			Expect(err).NotTo(HaveOccurred())
 
@@ -222,6 +224,7 @@ var _ = Describe("dex rendering tests", func() {
 				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: render.OIDCSecretName, Namespace: render.DexNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}},
 				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: pullSecretName, Namespace: render.DexNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}},
 				&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: render.TigeraOperatorSecrets, Namespace: render.DexNamespace}},
+				&policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: render.DexObjectName, Namespace: render.DexNamespace}, TypeMeta: metav1.TypeMeta{Kind: "PodDisruptionBudget", APIVersion: "policy/v1"}},
 			}
 
 			rtest.ExpectResources(resources, expectedResources)
@@ -266,6 +269,38 @@ var _ = Describe("dex rendering tests", func() {
 				"https://example.com/login/oidc/silent-callback"))
 		})
 
+		It("should register Grafana as a client and admit it when its address is set", func() {
+			cfg.GrafanaURL = "https://grafana.example.com/"
+			component := render.Dex(cfg)
+			resources, _ := component.Objects()
+
+			cm, ok := rtest.GetResource(resources, "tigera-dex", "tigera-dex", "", "v1", "ConfigMap").(*corev1.ConfigMap)
+			Expect(ok).To(BeTrue())
+			var config ConfigYAML
+			Expect(yaml.Unmarshal([]byte(cm.Data["config.yaml"]), &config)).To(Succeed())
+			Expect(config.StaticClients).To(HaveLen(2))
+			Expect(config.StaticClients[1]).To(Equal(StaticClients{
+				ID:           render.DexGrafanaClientID,
+				RedirectURIs: []string{"https://grafana.example.com/login/generic_oauth"},
+				SecretEnv:    "DEX_SECRET",
+			}))
+
+			d := rtest.GetResource(resources, "tigera-dex", "tigera-dex", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/tigera-dex-clients"))
+
+			policy := testutils.GetAllowTigeraPolicyFromResources(types.NamespacedName{Name: render.DexPolicyName, Namespace: render.DexNamespace}, resources)
+			Expect(policy).NotTo(BeNil())
+			Expect(policy.Spec.Ingress).To(ContainElement(v3.Rule{
+				Action:   v3.Allow,
+				Protocol: &networkpolicy.TCPProtocol,
+				Source: v3.EntityRule{
+					Selector:          "k8s-app == 'tigera-grafana'",
+					NamespaceSelector: "projectcalico.org/name == 'tigera-prometheus'",
+				},
+				Destination: v3.EntityRule{Ports: networkpolicy.Ports(render.DexPort)},
+			}))
+		})
+
 		It("should render config Map with the HSTS headers", func() {
 			component := render.Dex(cfg)
 			resources, _ := component.Objects()
@@ -333,7 +368,7 @@ var _ = Describe("dex rendering tests", func() {
 
 		It("should render all resources for a certificate management", func() {
 			cfg.Installation.CertificateManagement = &operatorv1.CertificateManagement{}
-			cfg.DexConfig = render.NewDexConfig(cfg.Installation.CertificateManagement, authentication, dexSecret, idpSecret, clusterName)
+			cfg.DexConfig = render.NewDexConfig(cfg.Installation.CertificateManagement, authentication, dexSecret, idpSecret, nil, clusterName)
 
 			component := render.Dex(cfg)
 			resources, _ := component.Objects()
@@ -355,6 +390,7 @@ var _ = Describe("dex rendering tests", func() {
 				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: pullSecretName, Namespace: render.DexNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}},
 				&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tigera-dex:csr-creator"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 				&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: render.TigeraOperatorSecrets, Namespace: render.DexNamespace}},
+				&policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: render.DexObjectName, Namespace: render.DexNamespace}, TypeMeta: metav1.TypeMeta{Kind: "PodDisruptionBudget", APIVersion: "policy/v1"}},
 			}
 
 			rtest.ExpectResources(resources, expectedResources)
@@ -399,6 +435,103 @@ var _ = Describe("dex rendering tests", func() {
 			Expect(deploy.Spec.Template.Spec.Affinity).To(Equal(podaffinity.NewPodAntiAffinity("tigera-dex", "tigera-dex")))
 		})
 
+		It("should prefer DexReplicas and only render a PodDisruptionBudget for multiple replicas", func() {
+			cfg.Authentication = authentication
+			authentication.Spec.DexReplicas = ptr.Int32ToPtr(1)
+
+			resources, toDelete := render.Dex(cfg).Objects()
+			deploy := rtest.GetResource(resources, render.DexObjectName, render.DexNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(*deploy.Spec.Replicas).To(BeEquivalentTo(1))
+			Expect(deploy.Spec.Strategy.Type).To(Equal(appsv1.RecreateDeploymentStrategyType))
+			Expect(rtest.GetResource(resources, render.DexObjectName, render.DexNamespace, "policy", "v1", "PodDisruptionBudget")).To(BeNil())
+			Expect(rtest.GetResource(toDelete, render.DexObjectName, render.DexNamespace, "policy", "v1", "PodDisruptionBudget")).NotTo(BeNil())
+
+			authentication.Spec.DexReplicas = ptr.Int32ToPtr(3)
+			resources, _ = render.Dex(cfg).Objects()
+			deploy = rtest.GetResource(resources, render.DexObjectName, render.DexNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(*deploy.Spec.Replicas).To(BeEquivalentTo(3))
+			Expect(deploy.Spec.Strategy.Type).To(Equal(appsv1.RollingUpdateDeploymentStrategyType))
+			pdb := rtest.GetResource(resources, render.DexObjectName, render.DexNamespace, "policy", "v1", "PodDisruptionBudget").(*policyv1.PodDisruptionBudget)
+			Expect(pdb.Spec.MaxUnavailable.IntValue()).To(Equal(1))
+			Expect(pdb.Spec.Selector.MatchLabels).To(Equal(map[string]string{"k8s-app": render.DexObjectName}))
+		})
+
+		It("should render the expiry and session settings", func() {
+			cfg.Authentication = authentication
+			authentication.Spec.DexExpiry = &operatorv1.DexExpiry{
+				IDTokens:    &metav1.Duration{Duration: 5 * time.Minute},
+				SigningKeys: &metav1.Duration{Duration: 12 * time.Hour},
+			}
+			authentication.Spec.DexSession = &operatorv1.DexSession{
+				IdleTimeout:      &metav1.Duration{Duration: 8 * time.Hour},
+				AbsoluteLifetime: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+			}
+
+			resources, _ := render.Dex(cfg).Objects()
+			cm := rtest.GetResource(resources, render.DexObjectName, render.DexNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+			var config ConfigYAML
+			Expect(yaml.Unmarshal([]byte(cm.Data["config.yaml"]), &config)).To(Succeed())
+			Expect(config.Expiry).To(Equal(Expiry{
+				IDTokens:    "5m0s",
+				SigningKeys: "12h0m0s",
+				RefreshTokens: RefreshTokens{
+					ValidIfNotUsedFor: "8h0m0s",
+					AbsoluteLifetime:  "168h0m0s",
+				},
+			}))
+			Expect(config.Storage.Type).To(Equal("kubernetes"))
+		})
+
+		It("should render SQL storage with the credentials from the storage secret", func() {
+			cfg.Authentication = authentication
+			authentication.Spec.DexStorage = &operatorv1.DexStorage{
+				Type: operatorv1.DexStorageTypePostgres,
+				SQL: &operatorv1.DexSQLStorage{
+					Host:       "10.0.0.5",
+					Database:   "dex",
+					SecretName: "dex-db",
+				},
+			}
+			cfg.StorageSecret = &corev1.Secret{
+				TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "dex-db", Namespace: common.OperatorNamespace()},
+				Data:       map[string][]byte{"username": []byte("dex"), "password": []byte("pw")},
+			}
+
+			resources, _ := render.Dex(cfg).Objects()
+			Expect(rtest.GetResource(resources, "dex-db", render.DexNamespace, "", "v1", "Secret")).NotTo(BeNil())
+
+			cm := rtest.GetResource(resources, render.DexObjectName, render.DexNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+			var config ConfigYAML
+			Expect(yaml.Unmarshal([]byte(cm.Data["config.yaml"]), &config)).To(Succeed())
+			Expect(config.Storage.Type).To(Equal("postgres"))
+			Expect(config.Storage.Config).To(Equal(map[string]interface{}{
+				"host":     "10.0.0.5",
+				"port":     5432,
+				"database": "dex",
+				"user":     "$DEX_STORAGE_USERNAME",
+				"password": "$DEX_STORAGE_PASSWORD",
+				"ssl": map[interface{}]interface{}{
+					"mode":   "verify-full",
+					"caFile": certificatemanagement.TrustedCertBundleMountPath,
+				},
+			}))
+
+			deploy := rtest.GetResource(resources, render.DexObjectName, render.DexNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(deploy.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+				corev1.EnvVar{Name: "DEX_STORAGE_USERNAME", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{Key: "username", LocalObjectReference: corev1.LocalObjectReference{Name: "dex-db"}}}},
+				corev1.EnvVar{Name: "DEX_STORAGE_PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{Key: "password", LocalObjectReference: corev1.LocalObjectReference{Name: "dex-db"}}}},
+			))
+			Expect(deploy.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/tigera-dex-storage-secret"))
+
+			policy := rtest.GetResource(resources, render.DexPolicyName, render.DexNamespace, "projectcalico.org", "v3", "NetworkPolicy").(*v3.NetworkPolicy)
+			Expect(policy.Spec.Egress).To(ContainElement(v3.Rule{
+				Action:      v3.Allow,
+				Protocol:    &networkpolicy.TCPProtocol,
+				Destination: v3.EntityRule{Nets: []string{"10.0.0.5/32"}, Ports: networkpolicy.Ports(5432)},
+			}))
+		})
+
 		It("should render configuration with resource requests and limits", func() {
 			ca, _ := tls.MakeCA(rmeta.DefaultOperatorCASignerName())
 			cert, _, _ := ca.Config.GetPEMBytes() // create a valid pem block
@@ -548,6 +681,12 @@ type ConfigYAML struct {
 	Web           Web             `yaml:"web"`
 	StaticClients []StaticClients `yaml:"staticClients"`
 	Expiry        Expiry          `yaml:"expiry"`
+	Storage       Storage         `yaml:"storage"`
+}
+
+type Storage struct {
+	Type   string                 `yaml:"type"`
+	Config map[string]interface{} `yaml:"config"`
 }
 
 type Web struct {
@@ -555,11 +694,20 @@ type Web struct {
 }
 
 type StaticClients struct {
+	ID           string   `yaml:"id"`
 	RedirectURIs []string `yaml:"redirectURIs"`
+	SecretEnv    string   `yaml:"secretEnv"`
 }
 
 type Expiry struct {
-	IDTokens string `yaml:"idTokens"`
+	IDTokens      string        `yaml:"idTokens"`
+	SigningKeys   string        `yaml:"signingKeys"`
+	RefreshTokens RefreshTokens `yaml:"refreshTokens"`
+}
+
+type RefreshTokens struct {
+	ValidIfNotUsedFor string `yaml:"validIfNotUsedFor"`
+	AbsoluteLifetime  string `yaml:"absoluteLifetime"`
 }
 
 type Headers struct {
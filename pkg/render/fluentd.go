@@ -17,6 +17,8 @@ package render
 import (
 	"crypto/x509"
 	"fmt"
+	"regexp"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +34,7 @@ import (
 	rcomponents "github.com/tigera/operator/pkg/render/common/components"
 	relasticsearch "github.com/tigera/operator/pkg/render/common/elasticsearch"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/metrics"
 	"github.com/tigera/operator/pkg/render/common/networkpolicy"
 	"github.com/tigera/operator/pkg/render/common/resourcequota"
 	"github.com/tigera/operator/pkg/render/common/secret"
@@ -61,6 +64,9 @@ const (
 	FluentdMetricsPort                       = 9081
 	FluentdPolicyName                        = networkpolicy.TigeraComponentPolicyPrefix + "allow-fluentd-node"
 	filterHashAnnotation                     = "hash.operator.tigera.io/fluentd-filters"
+	storeFilterHashAnnotation                = "hash.operator.tigera.io/fluentd-store-filters"
+	FluentdStoreFilterConfigMapName          = "fluentd-store-filters"
+	storeFilterMountPath                     = "/etc/fluentd/store-filters"
 	s3CredentialHashAnnotation               = "hash.operator.tigera.io/s3-credentials"
 	splunkCredentialHashAnnotation           = "hash.operator.tigera.io/splunk-credentials"
 	eksCloudwatchLogCredentialHashAnnotation = "hash.operator.tigera.io/eks-cloudwatch-log-credentials"
@@ -76,6 +82,33 @@ const (
 	SysLogPublicCertKey                      = "ca-bundle.crt"
 	SysLogPublicCAPath                       = SysLogPublicCADir + SysLogPublicCertKey
 	SyslogCAConfigMapName                    = "syslog-ca"
+	KafkaFluentdCredentialsSecretName        = "logcollector-kafka-credentials"
+	KafkaFluentdSecretUsernameKey            = "username"
+	KafkaFluentdSecretPasswordKey            = "password"
+	KafkaCAConfigMapName                     = "kafka-ca"
+	HTTPFluentdCredentialsSecretName         = "logcollector-http-credentials"
+	OTLPFluentdCredentialsSecretName         = "logcollector-otlp-credentials"
+	FluentdSecretAuthorizationKey            = "authorization"
+	LokiFluentdCredentialsSecretName         = "logcollector-loki-credentials"
+	LokiFluentdSecretUsernameKey             = "username"
+	LokiFluentdSecretPasswordKey             = "password"
+	kafkaCredentialHashAnnotation            = "hash.operator.tigera.io/kafka-credentials"
+	httpCredentialHashAnnotation             = "hash.operator.tigera.io/http-credentials"
+	otlpCredentialHashAnnotation             = "hash.operator.tigera.io/otlp-credentials"
+	lokiCredentialHashAnnotation             = "hash.operator.tigera.io/loki-credentials"
+
+	// Default Kafka topics for each log type, used when KafkaStoreSpec.Topics does not set one.
+	DefaultKafkaFlowsTopic     = "tigera_secure_ee_flows"
+	DefaultKafkaDNSTopic       = "tigera_secure_ee_dns"
+	DefaultKafkaAuditTopic     = "tigera_secure_ee_audit"
+	DefaultKafkaIDSEventsTopic = "tigera_secure_ee_events"
+
+	// Constants for authenticating with S3 using an IAM role.
+	EKSRoleARNAnnotation         = "eks.amazonaws.com/role-arn"
+	S3WebIdentityTokenVolumeName = "aws-web-identity-token"
+	S3WebIdentityTokenMountPath  = "/var/run/secrets/tigera.io/aws"
+	S3WebIdentityTokenPath       = "token"
+	DefaultS3WebIdentityAudience = "sts.amazonaws.com"
 
 	// Constants for Linseed token volume mounting in managed clusters.
 	LinseedTokenVolumeName = "linseed-token"
@@ -89,7 +122,7 @@ const (
 	fluentdWindowsName = "tigera-fluentd-windows"
 
 	FluentdNodeName        = "fluentd-node"
-	fluentdNodeWindowsName = "fluentd-node-windows"
+	FluentdNodeWindowsName = "fluentd-node-windows"
 
 	EKSLogForwarderName          = "eks-log-forwarder"
 	EKSLogForwarderTLSSecretName = "tigera-eks-log-forwarder-tls"
@@ -100,7 +133,7 @@ const (
 
 var FluentdSourceEntityRule = v3.EntityRule{
 	NamespaceSelector: fmt.Sprintf("name == '%s'", LogCollectorNamespace),
-	Selector:          networkpolicy.KubernetesAppSelector(FluentdNodeName, fluentdNodeWindowsName),
+	Selector:          networkpolicy.KubernetesAppSelector(FluentdNodeName, FluentdNodeWindowsName),
 }
 
 var EKSLogForwarderEntityRule = networkpolicy.CreateSourceEntityRule(LogCollectorNamespace, EKSLogForwarderName)
@@ -125,6 +158,21 @@ type SplunkCredential struct {
 	Token []byte
 }
 
+type KafkaCredential struct {
+	Username []byte
+	Password []byte
+}
+
+// HTTPCredential holds the value of the Authorization header sent to HTTP based log stores.
+type HTTPCredential struct {
+	Authorization []byte
+}
+
+type LokiCredential struct {
+	Username []byte
+	Password []byte
+}
+
 func Fluentd(cfg *FluentdConfiguration) Component {
 	return &fluentdComponent{
 		cfg:          cfg,
@@ -144,10 +192,14 @@ type EksCloudwatchLogConfig struct {
 
 // FluentdConfiguration contains all the config information needed to render the component.
 type FluentdConfiguration struct {
-	LogCollector   *operatorv1.LogCollector
-	S3Credential   *S3Credential
-	SplkCredential *SplunkCredential
-	Filters        *FluentdFilters
+	LogCollector    *operatorv1.LogCollector
+	S3Credential    *S3Credential
+	SplkCredential  *SplunkCredential
+	KafkaCredential *KafkaCredential
+	HTTPCredential  *HTTPCredential
+	OTLPCredential  *HTTPCredential
+	LokiCredential  *LokiCredential
+	Filters         *FluentdFilters
 	// ESClusterConfig is only populated for when EKSConfig
 	// is also defined
 	ESClusterConfig *relasticsearch.ClusterConfig
@@ -213,7 +265,7 @@ func (c *fluentdComponent) fluentdName() string {
 
 func (c *fluentdComponent) fluentdNodeName() string {
 	if c.cfg.OSType == rmeta.OSTypeWindows {
-		return fluentdNodeWindowsName
+		return FluentdNodeWindowsName
 	}
 	return FluentdNodeName
 }
@@ -288,9 +340,24 @@ func (c *fluentdComponent) Objects() ([]client.Object, []client.Object) {
 	if c.cfg.SplkCredential != nil {
 		objs = append(objs, secret.ToRuntimeObjects(secret.CopyToNamespace(LogCollectorNamespace, c.splunkCredentialSecret()...)...)...)
 	}
+	if c.cfg.KafkaCredential != nil {
+		objs = append(objs, c.kafkaCredentialSecret())
+	}
+	if c.cfg.HTTPCredential != nil {
+		objs = append(objs, authorizationCredentialSecret(HTTPFluentdCredentialsSecretName, c.cfg.HTTPCredential))
+	}
+	if c.cfg.OTLPCredential != nil {
+		objs = append(objs, authorizationCredentialSecret(OTLPFluentdCredentialsSecretName, c.cfg.OTLPCredential))
+	}
+	if c.cfg.LokiCredential != nil {
+		objs = append(objs, c.lokiCredentialSecret())
+	}
 	if c.cfg.Filters != nil {
 		objs = append(objs, c.filtersConfigMap())
 	}
+	if storeFilters := c.storeFiltersConfigMap(); storeFilters != nil {
+		objs = append(objs, storeFilters)
+	}
 	if c.cfg.EKSConfig != nil && c.cfg.OSType == rmeta.OSTypeLinux {
 		objs = append(objs,
 			c.eksLogForwarderClusterRole(),
@@ -389,6 +456,158 @@ func (c *fluentdComponent) filtersConfigMap() *corev1.ConfigMap {
 	}
 }
 
+// additionalStore describes an additional log store that is configured on the LogCollector.
+type additionalStore struct {
+	// name identifies the store within the store filters ConfigMap.
+	name string
+	// envPrefix is the prefix of the environment variables that configure the store in fluentd.
+	envPrefix string
+	filters   *operatorv1.AdditionalStoreFilters
+}
+
+// additionalStores returns the configured additional log stores in a stable order.
+func (c *fluentdComponent) additionalStores() []additionalStore {
+	stores := c.cfg.LogCollector.Spec.AdditionalStores
+	if stores == nil {
+		return nil
+	}
+	var result []additionalStore
+	if stores.S3 != nil {
+		result = append(result, additionalStore{"s3", "S3", stores.S3.Filters})
+	}
+	if stores.Syslog != nil {
+		result = append(result, additionalStore{"syslog", "SYSLOG", stores.Syslog.Filters})
+	}
+	if stores.Splunk != nil {
+		result = append(result, additionalStore{"splunk", "SPLUNK", stores.Splunk.Filters})
+	}
+	if stores.Kafka != nil {
+		result = append(result, additionalStore{"kafka", "KAFKA", stores.Kafka.Filters})
+	}
+	if stores.HTTP != nil {
+		result = append(result, additionalStore{"http", "HTTP", stores.HTTP.Filters})
+	}
+	if stores.OpenTelemetry != nil {
+		result = append(result, additionalStore{"otlp", "OTLP", stores.OpenTelemetry.Filters})
+	}
+	if stores.Loki != nil {
+		result = append(result, additionalStore{"loki", "LOKI", stores.Loki.Filters})
+	}
+	return result
+}
+
+// storeFlowFilters returns the fluentd flow log filter configuration of each additional store that has filters,
+// keyed by the file name it is mounted as.
+func (c *fluentdComponent) storeFlowFilters() map[string]string {
+	filters := map[string]string{}
+	for _, store := range c.additionalStores() {
+		if conf := flowLogFilterConfig(store.filters); conf != "" {
+			filters[store.flowFilterFileName()] = conf
+		}
+	}
+	return filters
+}
+
+func (s additionalStore) flowFilterFileName() string {
+	return s.name + "-flow-filters.conf"
+}
+
+func (c *fluentdComponent) storeFiltersConfigMap() *corev1.ConfigMap {
+	filters := c.storeFlowFilters()
+	if len(filters) == 0 {
+		return nil
+	}
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FluentdStoreFilterConfigMapName,
+			Namespace: LogCollectorNamespace,
+		},
+		Data: filters,
+	}
+}
+
+// flowLogFilterConfig renders the fluentd grep filters that implement the given store filters. Each Include
+// criterion is rendered as its own filter so that a flow log must match all of them, while the Exclude criteria
+// share a single filter so that a flow log is dropped if it matches any of them.
+func flowLogFilterConfig(filters *operatorv1.AdditionalStoreFilters) string {
+	if filters == nil {
+		return ""
+	}
+	var b strings.Builder
+	if include := filters.Include; include != nil {
+		if len(include.Namespaces) > 0 {
+			pattern := anchoredPattern(include.Namespaces)
+			b.WriteString("<filter **>\n  @type grep\n  <or>\n")
+			b.WriteString(grepRule("regexp", "source_namespace", pattern, "    "))
+			b.WriteString(grepRule("regexp", "dest_namespace", pattern, "    "))
+			b.WriteString("  </or>\n</filter>\n")
+		}
+		if len(include.Actions) > 0 {
+			b.WriteString("<filter **>\n  @type grep\n")
+			b.WriteString(grepRule("regexp", "action", actionPattern(include.Actions), "  "))
+			b.WriteString("</filter>\n")
+		}
+		if len(include.PolicyTiers) > 0 {
+			b.WriteString("<filter **>\n  @type grep\n")
+			b.WriteString(grepRule("regexp", "$.policies.all_policies", tierPattern(include.PolicyTiers), "  "))
+			b.WriteString("</filter>\n")
+		}
+	}
+	if exclude := filters.Exclude; exclude != nil {
+		var rules strings.Builder
+		if len(exclude.Namespaces) > 0 {
+			pattern := anchoredPattern(exclude.Namespaces)
+			rules.WriteString(grepRule("exclude", "source_namespace", pattern, "  "))
+			rules.WriteString(grepRule("exclude", "dest_namespace", pattern, "  "))
+		}
+		if len(exclude.Actions) > 0 {
+			rules.WriteString(grepRule("exclude", "action", actionPattern(exclude.Actions), "  "))
+		}
+		if len(exclude.PolicyTiers) > 0 {
+			rules.WriteString(grepRule("exclude", "$.policies.all_policies", tierPattern(exclude.PolicyTiers), "  "))
+		}
+		if rules.Len() > 0 {
+			b.WriteString("<filter **>\n  @type grep\n")
+			b.WriteString(rules.String())
+			b.WriteString("</filter>\n")
+		}
+	}
+	return b.String()
+}
+
+func grepRule(directive, key, pattern, indent string) string {
+	return fmt.Sprintf("%[1]s<%[2]s>\n%[1]s  key %[3]s\n%[1]s  pattern /%[4]s/\n%[1]s</%[2]s>\n", indent, directive, key, pattern)
+}
+
+// anchoredPattern returns a regular expression matching exactly one of the given values.
+func anchoredPattern(values []string) string {
+	return "^(" + quotedAlternatives(values) + ")$"
+}
+
+// actionPattern returns a regular expression matching the flow log action field, which is recorded in lower case.
+func actionPattern(actions []operatorv1.FlowLogAction) string {
+	var values []string
+	for _, a := range actions {
+		values = append(values, strings.ToLower(string(a)))
+	}
+	return anchoredPattern(values)
+}
+
+// tierPattern returns a regular expression matching an entry of the flow log policies field for a policy in one of
+// the given tiers. Entries have the form <index>|<tier>|<policy>|<action>|<rule>.
+func tierPattern(tiers []string) string {
+	return `\|(` + quotedAlternatives(tiers) + `)\|`
+}
+
+func quotedAlternatives(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = regexp.QuoteMeta(v)
+	}
+	return strings.Join(quoted, "|")
+}
+
 func (c *fluentdComponent) splunkCredentialSecret() []*corev1.Secret {
 	if c.cfg.SplkCredential == nil {
 		return nil
@@ -407,11 +626,56 @@ func (c *fluentdComponent) splunkCredentialSecret() []*corev1.Secret {
 	}
 }
 
+func (c *fluentdComponent) kafkaCredentialSecret() *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      KafkaFluentdCredentialsSecretName,
+			Namespace: LogCollectorNamespace,
+		},
+		Data: map[string][]byte{
+			KafkaFluentdSecretUsernameKey: c.cfg.KafkaCredential.Username,
+			KafkaFluentdSecretPasswordKey: c.cfg.KafkaCredential.Password,
+		},
+	}
+}
+
+func (c *fluentdComponent) lokiCredentialSecret() *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LokiFluentdCredentialsSecretName,
+			Namespace: LogCollectorNamespace,
+		},
+		Data: map[string][]byte{
+			LokiFluentdSecretUsernameKey: c.cfg.LokiCredential.Username,
+			LokiFluentdSecretPasswordKey: c.cfg.LokiCredential.Password,
+		},
+	}
+}
+
+func authorizationCredentialSecret(name string, cred *HTTPCredential) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: LogCollectorNamespace,
+		},
+		Data: map[string][]byte{
+			FluentdSecretAuthorizationKey: cred.Authorization,
+		},
+	}
+}
+
 func (c *fluentdComponent) fluentdServiceAccount() *corev1.ServiceAccount {
-	return &corev1.ServiceAccount{
+	sa := &corev1.ServiceAccount{
 		TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{Name: c.fluentdNodeName(), Namespace: LogCollectorNamespace},
 	}
+	if c.s3Credentials() == operatorv1.S3CredentialsServiceAccountRole {
+		sa.Annotations = map[string]string{EKSRoleARNAnnotation: c.cfg.LogCollector.Spec.AdditionalStores.S3.RoleARN}
+	}
+	return sa
 }
 
 // packetCaptureApiRole creates a role in the tigera-fluentd namespace to allow pod/exec
@@ -482,9 +746,24 @@ func (c *fluentdComponent) daemonset() *appsv1.DaemonSet {
 	if c.cfg.SplkCredential != nil {
 		annots[splunkCredentialHashAnnotation] = rmeta.AnnotationHash(c.cfg.SplkCredential)
 	}
+	if c.cfg.KafkaCredential != nil {
+		annots[kafkaCredentialHashAnnotation] = rmeta.AnnotationHash(c.cfg.KafkaCredential)
+	}
+	if c.cfg.HTTPCredential != nil {
+		annots[httpCredentialHashAnnotation] = rmeta.AnnotationHash(c.cfg.HTTPCredential)
+	}
+	if c.cfg.OTLPCredential != nil {
+		annots[otlpCredentialHashAnnotation] = rmeta.AnnotationHash(c.cfg.OTLPCredential)
+	}
+	if c.cfg.LokiCredential != nil {
+		annots[lokiCredentialHashAnnotation] = rmeta.AnnotationHash(c.cfg.LokiCredential)
+	}
 	if c.cfg.Filters != nil {
 		annots[filterHashAnnotation] = rmeta.AnnotationHash(c.cfg.Filters)
 	}
+	if storeFilters := c.storeFlowFilters(); len(storeFilters) > 0 {
+		annots[storeFilterHashAnnotation] = rmeta.AnnotationHash(storeFilters)
+	}
 	var initContainers []corev1.Container
 	if c.cfg.FluentdKeyPair != nil && c.cfg.FluentdKeyPair.UseCertificateManagement() {
 		initContainers = append(initContainers, c.cfg.FluentdKeyPair.InitContainer(LogCollectorNamespace))
@@ -521,15 +800,25 @@ func (c *fluentdComponent) daemonset() *appsv1.DaemonSet {
 			},
 		},
 	}
-	if c.cfg.LogCollector != nil {
-		if overrides := c.cfg.LogCollector.Spec.FluentdDaemonSet; overrides != nil {
-			rcomponents.ApplyDaemonSetOverrides(ds, overrides)
-		}
+	if overrides := c.daemonSetOverrides(); overrides != nil {
+		rcomponents.ApplyDaemonSetOverrides(ds, overrides)
 	}
 	setNodeCriticalPod(&(ds.Spec.Template))
 	return ds
 }
 
+// daemonSetOverrides returns the overrides for the DaemonSet of this OS. The Windows DaemonSet uses the Linux
+// overrides unless Windows specific overrides are set.
+func (c *fluentdComponent) daemonSetOverrides() *operatorv1.FluentdDaemonSet {
+	if c.cfg.LogCollector == nil {
+		return nil
+	}
+	if c.cfg.OSType == rmeta.OSTypeWindows && c.cfg.LogCollector.Spec.FluentdWindowsDaemonSet != nil {
+		return c.cfg.LogCollector.Spec.FluentdWindowsDaemonSet
+	}
+	return c.cfg.LogCollector.Spec.FluentdDaemonSet
+}
+
 // container creates the fluentd container.
 func (c *fluentdComponent) container() corev1.Container {
 	// Determine environment to pass to the CNI init container.
@@ -557,12 +846,29 @@ func (c *fluentdComponent) container() corev1.Container {
 		}
 	}
 
+	if len(c.storeFlowFilters()) > 0 {
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{
+				Name:      FluentdStoreFilterConfigMapName,
+				MountPath: c.path(storeFilterMountPath),
+			})
+	}
+
 	volumeMounts = append(volumeMounts, c.cfg.TrustedBundle.VolumeMounts(c.SupportedOSType())...)
 
 	if c.cfg.FluentdKeyPair != nil {
 		volumeMounts = append(volumeMounts, c.cfg.FluentdKeyPair.VolumeMount(c.SupportedOSType()))
 	}
 
+	if c.s3Credentials() == operatorv1.S3CredentialsWebIdentity {
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{
+				Name:      S3WebIdentityTokenVolumeName,
+				MountPath: c.path(S3WebIdentityTokenMountPath),
+				ReadOnly:  true,
+			})
+	}
+
 	if c.cfg.ManagedCluster {
 		volumeMounts = append(volumeMounts,
 			corev1.VolumeMount{
@@ -616,6 +922,23 @@ func (c *fluentdComponent) metricsService() *corev1.Service {
 	}
 }
 
+// FluentdMetricsTarget declares the metrics endpoint of fluentd that is exposed by the fluentd metrics services.
+func FluentdMetricsTarget() metrics.Target {
+	return metrics.Target{
+		Name:      FluentdMetricsService,
+		Namespace: LogCollectorNamespace,
+		Selector:  metrics.AppSelector(FluentdNodeName, FluentdNodeWindowsName),
+		Endpoints: []metrics.Endpoint{
+			{
+				Port:       FluentdMetricsPortName,
+				Scheme:     metrics.SchemeHTTPS,
+				ServerName: FluentdPrometheusTLSSecretName,
+				ClientAuth: true,
+			},
+		},
+	}
+}
+
 func (c *fluentdComponent) envvars() []corev1.EnvVar {
 	envs := []corev1.EnvVar{
 		{Name: "LINSEED_ENABLED", Value: "true"},
@@ -638,37 +961,8 @@ func (c *fluentdComponent) envvars() []corev1.EnvVar {
 	}
 
 	if c.cfg.LogCollector.Spec.AdditionalStores != nil {
-		s3 := c.cfg.LogCollector.Spec.AdditionalStores.S3
-		if s3 != nil {
-			envs = append(envs,
-				corev1.EnvVar{
-					Name: "AWS_KEY_ID",
-					ValueFrom: &corev1.EnvVarSource{
-						SecretKeyRef: &corev1.SecretKeySelector{
-							LocalObjectReference: corev1.LocalObjectReference{
-								Name: S3FluentdSecretName,
-							},
-							Key: S3KeyIdName,
-						},
-					},
-				},
-				corev1.EnvVar{
-					Name: "AWS_SECRET_KEY",
-					ValueFrom: &corev1.EnvVarSource{
-						SecretKeyRef: &corev1.SecretKeySelector{
-							LocalObjectReference: corev1.LocalObjectReference{
-								Name: S3FluentdSecretName,
-							},
-							Key: S3KeySecretName,
-						},
-					},
-				},
-				corev1.EnvVar{Name: "S3_STORAGE", Value: "true"},
-				corev1.EnvVar{Name: "S3_BUCKET_NAME", Value: s3.BucketName},
-				corev1.EnvVar{Name: "AWS_REGION", Value: s3.Region},
-				corev1.EnvVar{Name: "S3_BUCKET_PATH", Value: s3.BucketPath},
-				corev1.EnvVar{Name: "S3_FLUSH_INTERVAL", Value: fluentdDefaultFlush},
-			)
+		if s3 := c.cfg.LogCollector.Spec.AdditionalStores.S3; s3 != nil {
+			envs = append(envs, c.s3EnvVars(s3)...)
 		}
 		syslog := c.cfg.LogCollector.Spec.AdditionalStores.Syslog
 		if syslog != nil {
@@ -732,11 +1026,11 @@ func (c *fluentdComponent) envvars() []corev1.EnvVar {
 				)
 				if c.cfg.UseSyslogCertificate {
 					envs = append(envs,
-						corev1.EnvVar{Name: "SYSLOG_CA_FILE", Value: c.cfg.TrustedBundle.MountPath()},
+						corev1.EnvVar{Name: "SYSLOG_CA_FILE", Value: c.trustedBundlePath()},
 					)
 				} else {
 					envs = append(envs,
-						corev1.EnvVar{Name: "SYSLOG_CA_FILE", Value: SysLogPublicCAPath},
+						corev1.EnvVar{Name: "SYSLOG_CA_FILE", Value: c.path(SysLogPublicCAPath)},
 					)
 				}
 			}
@@ -756,14 +1050,69 @@ func (c *fluentdComponent) envvars() []corev1.EnvVar {
 						},
 					},
 				},
-				corev1.EnvVar{Name: "SPLUNK_FLOW_LOG", Value: "true"},
-				corev1.EnvVar{Name: "SPLUNK_AUDIT_LOG", Value: "true"},
-				corev1.EnvVar{Name: "SPLUNK_DNS_LOG", Value: "true"},
 				corev1.EnvVar{Name: "SPLUNK_HEC_HOST", Value: host},
 				corev1.EnvVar{Name: "SPLUNK_HEC_PORT", Value: port},
 				corev1.EnvVar{Name: "SPLUNK_PROTOCOL", Value: proto},
 				corev1.EnvVar{Name: "SPLUNK_FLUSH_INTERVAL", Value: fluentdDefaultFlush},
 			)
+			envs = append(envs, logTypeEnvVars("SPLUNK", logTypesOrDefault(splunk.LogTypes))...)
+		}
+		if kafka := c.cfg.LogCollector.Spec.AdditionalStores.Kafka; kafka != nil {
+			envs = append(envs, c.kafkaEnvVars(kafka)...)
+		}
+		if httpStore := c.cfg.LogCollector.Spec.AdditionalStores.HTTP; httpStore != nil {
+			envs = append(envs,
+				corev1.EnvVar{Name: "HTTP_ENDPOINT", Value: httpStore.Endpoint},
+				corev1.EnvVar{Name: "HTTP_CA_FILE", Value: c.trustedBundlePath()},
+				corev1.EnvVar{Name: "HTTP_FLUSH_INTERVAL", Value: fluentdDefaultFlush},
+			)
+			envs = append(envs, logTypeEnvVars("HTTP", httpStore.LogTypes)...)
+			if c.cfg.HTTPCredential != nil {
+				envs = append(envs, secretEnvVar("HTTP_AUTHORIZATION", HTTPFluentdCredentialsSecretName, FluentdSecretAuthorizationKey))
+			}
+		}
+		if otlp := c.cfg.LogCollector.Spec.AdditionalStores.OpenTelemetry; otlp != nil {
+			protocol := "http"
+			if otlp.Protocol == operatorv1.OTLPProtocolGRPC {
+				protocol = "grpc"
+			}
+			envs = append(envs,
+				corev1.EnvVar{Name: "OTLP_ENDPOINT", Value: otlp.Endpoint},
+				corev1.EnvVar{Name: "OTLP_PROTOCOL", Value: protocol},
+				corev1.EnvVar{Name: "OTLP_CA_FILE", Value: c.trustedBundlePath()},
+				corev1.EnvVar{Name: "OTLP_FLUSH_INTERVAL", Value: fluentdDefaultFlush},
+			)
+			envs = append(envs, logTypeEnvVars("OTLP", otlp.LogTypes)...)
+			if c.cfg.OTLPCredential != nil {
+				envs = append(envs, secretEnvVar("OTLP_AUTHORIZATION", OTLPFluentdCredentialsSecretName, FluentdSecretAuthorizationKey))
+			}
+		}
+		if loki := c.cfg.LogCollector.Spec.AdditionalStores.Loki; loki != nil {
+			envs = append(envs,
+				corev1.EnvVar{Name: "LOKI_ENDPOINT", Value: loki.Endpoint},
+				corev1.EnvVar{Name: "LOKI_CA_FILE", Value: c.trustedBundlePath()},
+				corev1.EnvVar{Name: "LOKI_FLUSH_INTERVAL", Value: fluentdDefaultFlush},
+			)
+			if loki.TenantID != "" {
+				envs = append(envs, corev1.EnvVar{Name: "LOKI_TENANT_ID", Value: loki.TenantID})
+			}
+			envs = append(envs, logTypeEnvVars("LOKI", loki.LogTypes)...)
+			if c.cfg.LokiCredential != nil {
+				envs = append(envs,
+					secretEnvVar("LOKI_USERNAME", LokiFluentdCredentialsSecretName, LokiFluentdSecretUsernameKey),
+					secretEnvVar("LOKI_PASSWORD", LokiFluentdCredentialsSecretName, LokiFluentdSecretPasswordKey),
+				)
+			}
+		}
+	}
+
+	storeFilters := c.storeFlowFilters()
+	for _, store := range c.additionalStores() {
+		if _, ok := storeFilters[store.flowFilterFileName()]; ok {
+			envs = append(envs, corev1.EnvVar{
+				Name:  store.envPrefix + "_FLOW_FILTERS_FILE",
+				Value: c.path(storeFilterMountPath + "/" + store.flowFilterFileName()),
+			})
 		}
 	}
 
@@ -783,6 +1132,156 @@ func (c *fluentdComponent) envvars() []corev1.EnvVar {
 	return envs
 }
 
+func (c *fluentdComponent) s3EnvVars(s3 *operatorv1.S3StoreSpec) []corev1.EnvVar {
+	var envs []corev1.EnvVar
+	switch s3.Credentials {
+	case operatorv1.S3CredentialsServiceAccountRole:
+		// The EKS pod identity webhook injects AWS_ROLE_ARN and AWS_WEB_IDENTITY_TOKEN_FILE based on the
+		// annotation on the fluentd service account.
+	case operatorv1.S3CredentialsWebIdentity:
+		envs = append(envs,
+			corev1.EnvVar{Name: "AWS_ROLE_ARN", Value: s3.RoleARN},
+			corev1.EnvVar{Name: "AWS_WEB_IDENTITY_TOKEN_FILE", Value: c.path(S3WebIdentityTokenMountPath + "/" + S3WebIdentityTokenPath)},
+		)
+	default:
+		envs = append(envs,
+			secretEnvVar("AWS_KEY_ID", S3FluentdSecretName, S3KeyIdName),
+			secretEnvVar("AWS_SECRET_KEY", S3FluentdSecretName, S3KeySecretName),
+		)
+	}
+	envs = append(envs,
+		corev1.EnvVar{Name: "S3_STORAGE", Value: "true"},
+		corev1.EnvVar{Name: "S3_BUCKET_NAME", Value: s3.BucketName},
+		corev1.EnvVar{Name: "AWS_REGION", Value: s3.Region},
+		corev1.EnvVar{Name: "S3_BUCKET_PATH", Value: s3.BucketPath},
+		corev1.EnvVar{Name: "S3_FLUSH_INTERVAL", Value: fluentdDefaultFlush},
+	)
+	if s3.Endpoint != "" {
+		envs = append(envs,
+			corev1.EnvVar{Name: "S3_ENDPOINT", Value: s3.Endpoint},
+			corev1.EnvVar{Name: "S3_CA_FILE", Value: c.trustedBundlePath()},
+		)
+	}
+	if s3.AddressingStyle == operatorv1.S3AddressingStylePath {
+		envs = append(envs, corev1.EnvVar{Name: "S3_FORCE_PATH_STYLE", Value: "true"})
+	}
+	switch s3.ServerSideEncryption {
+	case operatorv1.S3ServerSideEncryptionAES256:
+		envs = append(envs, corev1.EnvVar{Name: "S3_SSE", Value: "AES256"})
+	case operatorv1.S3ServerSideEncryptionKMS:
+		envs = append(envs,
+			corev1.EnvVar{Name: "S3_SSE", Value: "aws:kms"},
+			corev1.EnvVar{Name: "S3_SSE_KMS_KEY_ID", Value: s3.KMSKeyID},
+		)
+	}
+	if s3.ObjectKeyFormat != "" {
+		envs = append(envs, corev1.EnvVar{Name: "S3_OBJECT_KEY_FORMAT", Value: s3.ObjectKeyFormat})
+	}
+	switch s3.Compression {
+	case operatorv1.S3CompressionNone:
+		envs = append(envs, corev1.EnvVar{Name: "S3_STORE_AS", Value: "text"})
+	case operatorv1.S3CompressionGzip:
+		envs = append(envs, corev1.EnvVar{Name: "S3_STORE_AS", Value: "gzip"})
+	}
+	return append(envs, logTypeEnvVars("S3", logTypesOrDefault(s3.LogTypes))...)
+}
+
+// s3Credentials returns the S3 credentials type configured on the LogCollector, or an empty string if S3 is not
+// configured.
+func (c *fluentdComponent) s3Credentials() operatorv1.S3CredentialsType {
+	if c.cfg.LogCollector.Spec.AdditionalStores == nil || c.cfg.LogCollector.Spec.AdditionalStores.S3 == nil {
+		return ""
+	}
+	return c.cfg.LogCollector.Spec.AdditionalStores.S3.Credentials
+}
+
+func (c *fluentdComponent) kafkaEnvVars(kafka *operatorv1.KafkaStoreSpec) []corev1.EnvVar {
+	topics := operatorv1.KafkaTopics{}
+	if kafka.Topics != nil {
+		topics = *kafka.Topics
+	}
+	envs := []corev1.EnvVar{
+		{Name: "KAFKA_BROKERS", Value: strings.Join(kafka.Brokers, ",")},
+		{Name: "KAFKA_FLUSH_INTERVAL", Value: fluentdDefaultFlush},
+		{Name: "KAFKA_FLOW_TOPIC", Value: stringOrDefault(topics.Flows, DefaultKafkaFlowsTopic)},
+		{Name: "KAFKA_DNS_TOPIC", Value: stringOrDefault(topics.DNS, DefaultKafkaDNSTopic)},
+		{Name: "KAFKA_AUDIT_TOPIC", Value: stringOrDefault(topics.Audit, DefaultKafkaAuditTopic)},
+		{Name: "KAFKA_IDS_EVENT_TOPIC", Value: stringOrDefault(topics.IDSEvents, DefaultKafkaIDSEventsTopic)},
+	}
+	envs = append(envs, logTypeEnvVars("KAFKA", kafka.LogTypes)...)
+
+	if kafka.SASLMechanism != "" && kafka.SASLMechanism != operatorv1.KafkaSASLMechanismNone {
+		envs = append(envs,
+			corev1.EnvVar{Name: "KAFKA_SASL_MECHANISM", Value: strings.ToLower(string(kafka.SASLMechanism))},
+			secretEnvVar("KAFKA_USERNAME", KafkaFluentdCredentialsSecretName, KafkaFluentdSecretUsernameKey),
+			secretEnvVar("KAFKA_PASSWORD", KafkaFluentdCredentialsSecretName, KafkaFluentdSecretPasswordKey),
+		)
+	}
+	if kafka.Encryption == operatorv1.EncryptionTLS {
+		envs = append(envs,
+			corev1.EnvVar{Name: "KAFKA_TLS", Value: "true"},
+			corev1.EnvVar{Name: "KAFKA_CA_FILE", Value: c.trustedBundlePath()},
+		)
+	}
+	return envs
+}
+
+// DefaultAdditionalStoreLogTypes returns the log types exported to an additional store that does not list any.
+func DefaultAdditionalStoreLogTypes() []operatorv1.SyslogLogType {
+	return []operatorv1.SyslogLogType{
+		operatorv1.SyslogLogAudit,
+		operatorv1.SyslogLogDNS,
+		operatorv1.SyslogLogFlows,
+	}
+}
+
+func logTypesOrDefault(logTypes []operatorv1.SyslogLogType) []operatorv1.SyslogLogType {
+	if len(logTypes) == 0 {
+		return DefaultAdditionalStoreLogTypes()
+	}
+	return logTypes
+}
+
+// logTypeEnvVars returns the <prefix>_<TYPE>_LOG environment variables that enable forwarding of each of the given
+// log types to the store identified by prefix.
+func logTypeEnvVars(prefix string, logTypes []operatorv1.SyslogLogType) []corev1.EnvVar {
+	var envs []corev1.EnvVar
+	for _, t := range logTypes {
+		switch t {
+		case operatorv1.SyslogLogAudit:
+			envs = append(envs, corev1.EnvVar{Name: prefix + "_AUDIT_LOG", Value: "true"})
+		case operatorv1.SyslogLogDNS:
+			envs = append(envs, corev1.EnvVar{Name: prefix + "_DNS_LOG", Value: "true"})
+		case operatorv1.SyslogLogFlows:
+			envs = append(envs, corev1.EnvVar{Name: prefix + "_FLOW_LOG", Value: "true"})
+		case operatorv1.SyslogLogIDSEvents:
+			envs = append(envs, corev1.EnvVar{Name: prefix + "_IDS_EVENT_LOG", Value: "true"})
+		}
+	}
+	return envs
+}
+
+func secretEnvVar(name, secretName, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: secretName,
+				},
+				Key: key,
+			},
+		},
+	}
+}
+
+func stringOrDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
 func (c *fluentdComponent) trustedBundlePath() string {
 	if c.cfg.OSType == rmeta.OSTypeWindows {
 		return certificatemanagement.TrustedCertBundleMountPathWindows
@@ -872,9 +1371,15 @@ func (c *fluentdComponent) volumes() []corev1.Volume {
 				},
 			})
 	}
+	if len(c.storeFlowFilters()) > 0 {
+		volumes = append(volumes, storeFiltersVolume())
+	}
 	if c.cfg.FluentdKeyPair != nil {
 		volumes = append(volumes, c.cfg.FluentdKeyPair.Volume())
 	}
+	if c.s3Credentials() == operatorv1.S3CredentialsWebIdentity {
+		volumes = append(volumes, c.s3WebIdentityTokenVolume())
+	}
 	if c.cfg.ManagedCluster {
 		volumes = append(volumes,
 			corev1.Volume{
@@ -1086,6 +1591,44 @@ func trustedBundleVolume(bundle certificatemanagement.TrustedBundle) corev1.Volu
 	return volume
 }
 
+// storeFiltersVolume returns the volume holding the flow log filters of the additional stores.
+func storeFiltersVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: FluentdStoreFilterConfigMapName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: FluentdStoreFilterConfigMapName,
+				},
+			},
+		},
+	}
+}
+
+// s3WebIdentityTokenVolume returns the volume holding the projected service account token that is exchanged for
+// AWS credentials when the S3 store uses web identity credentials.
+func (c *fluentdComponent) s3WebIdentityTokenVolume() corev1.Volume {
+	audience := c.cfg.LogCollector.Spec.AdditionalStores.S3.WebIdentityAudience
+	if audience == "" {
+		audience = DefaultS3WebIdentityAudience
+	}
+	expiration := int64(86400)
+	return corev1.Volume{
+		Name: S3WebIdentityTokenVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{{
+					ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+						Audience:          audience,
+						ExpirationSeconds: &expiration,
+						Path:              S3WebIdentityTokenPath,
+					},
+				}},
+			},
+		},
+	}
+}
+
 func (c *fluentdComponent) eksLogForwarderVolumeMounts() []corev1.VolumeMount {
 
 	volumeMounts := []corev1.VolumeMount{
@@ -1098,11 +1641,28 @@ func (c *fluentdComponent) eksLogForwarderVolumeMounts() []corev1.VolumeMount {
 			MountPath: c.path("/etc/fluentd/elastic/"),
 		},
 	}
+	if len(c.storeFlowFilters()) > 0 {
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{
+				Name:      FluentdStoreFilterConfigMapName,
+				MountPath: c.path(storeFilterMountPath),
+			})
+	}
+
 	volumeMounts = append(volumeMounts, c.cfg.TrustedBundle.VolumeMounts(c.SupportedOSType())...)
 	if c.cfg.EKSLogForwarderKeyPair != nil {
 		volumeMounts = append(volumeMounts, c.cfg.EKSLogForwarderKeyPair.VolumeMount(c.SupportedOSType()))
 	}
 
+	if c.s3Credentials() == operatorv1.S3CredentialsWebIdentity {
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{
+				Name:      S3WebIdentityTokenVolumeName,
+				MountPath: c.path(S3WebIdentityTokenMountPath),
+				ReadOnly:  true,
+			})
+	}
+
 	if c.cfg.ManagedCluster {
 		volumeMounts = append(volumeMounts,
 			corev1.VolumeMount{
@@ -1127,6 +1687,12 @@ func (c *fluentdComponent) eksLogForwarderVolumes() []corev1.Volume {
 	if c.cfg.EKSLogForwarderKeyPair != nil {
 		volumes = append(volumes, c.cfg.EKSLogForwarderKeyPair.Volume())
 	}
+	if len(c.storeFlowFilters()) > 0 {
+		volumes = append(volumes, storeFiltersVolume())
+	}
+	if c.s3Credentials() == operatorv1.S3CredentialsWebIdentity {
+		volumes = append(volumes, c.s3WebIdentityTokenVolume())
+	}
 
 	if c.cfg.ManagedCluster {
 		volumes = append(volumes,
@@ -1242,7 +1808,7 @@ func (c *fluentdComponent) allowTigeraPolicy() *v3.NetworkPolicy {
 		Spec: v3.NetworkPolicySpec{
 			Order:                  &networkpolicy.HighPrecedenceOrder,
 			Tier:                   networkpolicy.TigeraComponentTierName,
-			Selector:               networkpolicy.KubernetesAppSelector(FluentdNodeName, fluentdNodeWindowsName),
+			Selector:               networkpolicy.KubernetesAppSelector(FluentdNodeName, FluentdNodeWindowsName),
 			ServiceAccountSelector: "",
 			Types:                  []v3.PolicyType{v3.PolicyTypeIngress, v3.PolicyTypeEgress},
 			Ingress: []v3.Rule{
@@ -1254,6 +1820,16 @@ func (c *fluentdComponent) allowTigeraPolicy() *v3.NetworkPolicy {
 						Ports: networkpolicy.Ports(FluentdMetricsPort),
 					},
 				},
+				{
+					Action:   v3.Allow,
+					Protocol: &networkpolicy.TCPProtocol,
+					Destination: v3.EntityRule{
+						Ports: networkpolicy.Ports(FluentdMetricsPort),
+					},
+					// The operator reads the output plugin metrics to report the delivery status of the additional
+					// stores, however, since the operator is on the hostnetwork it's hard to create specific network
+					// policies for it. Allow all sources, as node CIDRs are not known.
+				},
 			},
 			Egress: egressRules,
 		},
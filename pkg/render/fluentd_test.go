@@ -614,6 +614,112 @@ var _ = Describe("Tigera Secure Fluentd rendering tests", func() {
 		Expect(container.SecurityContext).To(BeNil())
 	})
 
+	It("should render the same log stores and filters for Windows nodes as for Linux nodes", func() {
+		cfg.S3Credential = &render.S3Credential{KeyId: []byte("IdForTheKey"), KeySecret: []byte("SecretForTheKey")}
+		cfg.SplkCredential = &render.SplunkCredential{Token: []byte("TokenForHEC")}
+		cfg.UseSyslogCertificate = true
+		cfg.Filters = &render.FluentdFilters{Flow: "flow-filter", DNS: "dns-filter"}
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			S3: &operatorv1.S3StoreSpec{Region: "anyplace", BucketName: "thebucket", BucketPath: "bucketpath"},
+			Syslog: &operatorv1.SyslogStoreSpec{
+				Endpoint:   "tcp://1.2.3.4:80",
+				Encryption: operatorv1.EncryptionTLS,
+				LogTypes:   []operatorv1.SyslogLogType{operatorv1.SyslogLogFlows},
+			},
+			Splunk: &operatorv1.SplunkStoreSpec{
+				Endpoint: "https://1.2.3.4:8088",
+				Filters: &operatorv1.AdditionalStoreFilters{
+					Exclude: &operatorv1.FlowLogFilter{Namespaces: []string{"kube-system"}},
+				},
+			},
+		}
+
+		linuxResources, _ := render.Fluentd(cfg).Objects()
+		linuxDS := rtest.GetResource(linuxResources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		cfg.OSType = rmeta.OSTypeWindows
+		windowsResources, _ := render.Fluentd(cfg).Objects()
+		windowsDS := rtest.GetResource(windowsResources, "fluentd-node-windows", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+
+		By("rendering the same credential secrets and filter ConfigMaps")
+		for _, obj := range []struct{ name, kind string }{
+			{render.S3FluentdSecretName, "Secret"},
+			{render.SplunkFluentdTokenSecretName, "Secret"},
+			{render.FluentdFilterConfigMapName, "ConfigMap"},
+			{render.FluentdStoreFilterConfigMapName, "ConfigMap"},
+		} {
+			linux := rtest.GetResource(linuxResources, obj.name, render.LogCollectorNamespace, "", "v1", obj.kind)
+			Expect(linux).NotTo(BeNil())
+			Expect(rtest.GetResource(windowsResources, obj.name, render.LogCollectorNamespace, "", "v1", obj.kind)).To(Equal(linux))
+		}
+
+		By("configuring the same stores with Windows paths")
+		envNames := func(envs []corev1.EnvVar) []string {
+			var names []string
+			for _, env := range envs {
+				names = append(names, env.Name)
+			}
+			return names
+		}
+		Expect(envNames(windowsDS.Spec.Template.Spec.Containers[0].Env)).To(ConsistOf(envNames(linuxDS.Spec.Template.Spec.Containers[0].Env)))
+		Expect(windowsDS.Spec.Template.Spec.Containers[0].Env).To(ContainElements([]corev1.EnvVar{
+			{Name: "SYSLOG_CA_FILE", Value: certificatemanagement.TrustedCertBundleMountPathWindows},
+			{Name: "SPLUNK_FLOW_FILTERS_FILE", Value: "c:/etc/fluentd/store-filters/splunk-flow-filters.conf"},
+			{Name: "S3_FLOW_LOG", Value: "true"},
+		}))
+		Expect(windowsDS.Spec.Template.Annotations).To(Equal(linuxDS.Spec.Template.Annotations))
+
+		var linuxVolumes, windowsVolumes []string
+		for _, v := range linuxDS.Spec.Template.Spec.Volumes {
+			linuxVolumes = append(linuxVolumes, v.Name)
+		}
+		for _, v := range windowsDS.Spec.Template.Spec.Volumes {
+			windowsVolumes = append(windowsVolumes, v.Name)
+		}
+		Expect(windowsVolumes).To(ConsistOf(linuxVolumes))
+		Expect(windowsDS.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElements(
+			corev1.VolumeMount{Name: "fluentd-filters", MountPath: "c:/etc/fluentd/flow-filters.conf", SubPath: render.FluentdFilterFlowName},
+			corev1.VolumeMount{Name: render.FluentdStoreFilterConfigMapName, MountPath: "c:/etc/fluentd/store-filters"},
+		))
+	})
+
+	It("should render the Windows DaemonSet overrides for Windows nodes only", func() {
+		linuxResources := corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{"memory": resource.MustParse("150Mi")},
+		}
+		windowsResources := corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{"memory": resource.MustParse("500Mi")},
+		}
+		overrides := func(resources corev1.ResourceRequirements) *operatorv1.FluentdDaemonSet {
+			return &operatorv1.FluentdDaemonSet{
+				Spec: &operatorv1.FluentdDaemonSetSpec{
+					Template: &operatorv1.FluentdDaemonSetPodTemplateSpec{
+						Spec: &operatorv1.FluentdDaemonSetPodSpec{
+							Containers: []operatorv1.FluentdDaemonSetContainer{{Name: "fluentd", Resources: &resources}},
+						},
+					},
+				},
+			}
+		}
+		cfg.LogCollector.Spec.FluentdDaemonSet = overrides(linuxResources)
+
+		By("using the FluentdDaemonSet overrides when no Windows overrides are set")
+		cfg.OSType = rmeta.OSTypeWindows
+		resources, _ := render.Fluentd(cfg).Objects()
+		ds := rtest.GetResource(resources, "fluentd-node-windows", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Resources).To(Equal(linuxResources))
+
+		By("using the FluentdWindowsDaemonSet overrides on Windows nodes")
+		cfg.LogCollector.Spec.FluentdWindowsDaemonSet = overrides(windowsResources)
+		resources, _ = render.Fluentd(cfg).Objects()
+		ds = rtest.GetResource(resources, "fluentd-node-windows", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Resources).To(Equal(windowsResources))
+
+		cfg.OSType = rmeta.OSTypeLinux
+		resources, _ = render.Fluentd(cfg).Objects()
+		ds = rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Resources).To(Equal(linuxResources))
+	})
+
 	It("should render with S3 configuration", func() {
 		cfg.S3Credential = &render.S3Credential{
 			KeyId:     []byte("IdForTheKey"),
@@ -680,6 +786,83 @@ var _ = Describe("Tigera Secure Fluentd rendering tests", func() {
 		}
 	})
 
+	It("should render S3 with a custom endpoint and web identity credentials", func() {
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			S3: &operatorv1.S3StoreSpec{
+				Region:               "anyplace",
+				BucketName:           "thebucket",
+				BucketPath:           "bucketpath",
+				Endpoint:             "https://minio.example.com:9000",
+				AddressingStyle:      operatorv1.S3AddressingStylePath,
+				Credentials:          operatorv1.S3CredentialsWebIdentity,
+				RoleARN:              "arn:aws:iam::123456789012:role/fluentd",
+				ServerSideEncryption: operatorv1.S3ServerSideEncryptionKMS,
+				KMSKeyID:             "my-key",
+				ObjectKeyFormat:      "%{path}%{time_slice}_%{index}.%{file_extension}",
+				Compression:          operatorv1.S3CompressionGzip,
+			},
+		}
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+		Expect(rtest.GetResource(resources, render.S3FluentdSecretName, render.LogCollectorNamespace, "", "v1", "Secret")).To(BeNil())
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Annotations).NotTo(HaveKey("hash.operator.tigera.io/s3-credentials"))
+		var volume *corev1.Volume
+		for i := range ds.Spec.Template.Spec.Volumes {
+			if ds.Spec.Template.Spec.Volumes[i].Name == render.S3WebIdentityTokenVolumeName {
+				volume = &ds.Spec.Template.Spec.Volumes[i]
+			}
+		}
+		Expect(volume).NotTo(BeNil())
+		Expect(volume.Projected.Sources).To(HaveLen(1))
+		Expect(volume.Projected.Sources[0].ServiceAccountToken.Audience).To(Equal("sts.amazonaws.com"))
+		Expect(volume.Projected.Sources[0].ServiceAccountToken.Path).To(Equal("token"))
+
+		container := ds.Spec.Template.Spec.Containers[0]
+		Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+			Name:      render.S3WebIdentityTokenVolumeName,
+			MountPath: "/var/run/secrets/tigera.io/aws",
+			ReadOnly:  true,
+		}))
+		Expect(container.Env).To(ContainElements([]corev1.EnvVar{
+			{Name: "AWS_ROLE_ARN", Value: "arn:aws:iam::123456789012:role/fluentd"},
+			{Name: "AWS_WEB_IDENTITY_TOKEN_FILE", Value: "/var/run/secrets/tigera.io/aws/token"},
+			{Name: "S3_ENDPOINT", Value: "https://minio.example.com:9000"},
+			{Name: "S3_FORCE_PATH_STYLE", Value: "true"},
+			{Name: "S3_SSE", Value: "aws:kms"},
+			{Name: "S3_SSE_KMS_KEY_ID", Value: "my-key"},
+			{Name: "S3_OBJECT_KEY_FORMAT", Value: "%{path}%{time_slice}_%{index}.%{file_extension}"},
+			{Name: "S3_STORE_AS", Value: "gzip"},
+		}))
+		for _, env := range container.Env {
+			Expect(env.Name).NotTo(BeElementOf("AWS_KEY_ID", "AWS_SECRET_KEY"))
+		}
+	})
+
+	It("should annotate the fluentd service account for S3 service account role credentials", func() {
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			S3: &operatorv1.S3StoreSpec{
+				Region:      "anyplace",
+				BucketName:  "thebucket",
+				BucketPath:  "bucketpath",
+				Credentials: operatorv1.S3CredentialsServiceAccountRole,
+				RoleARN:     "arn:aws:iam::123456789012:role/fluentd",
+			},
+		}
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+		sa := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "", "v1", "ServiceAccount").(*corev1.ServiceAccount)
+		Expect(sa.Annotations).To(Equal(map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/fluentd"}))
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		for _, env := range ds.Spec.Template.Spec.Containers[0].Env {
+			Expect(env.Name).NotTo(BeElementOf("AWS_KEY_ID", "AWS_SECRET_KEY", "AWS_ROLE_ARN"))
+		}
+	})
+
 	It("should render with Syslog configuration", func() {
 
 		expectedResources := []client.Object{
@@ -903,6 +1086,186 @@ var _ = Describe("Tigera Secure Fluentd rendering tests", func() {
 		}
 	})
 
+	It("should render splunk with per-store log types and filters", func() {
+		cfg.SplkCredential = &render.SplunkCredential{
+			Token: []byte("TokenForHEC"),
+		}
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			Splunk: &operatorv1.SplunkStoreSpec{
+				Endpoint: "https://1.2.3.4:8088",
+				LogTypes: []operatorv1.SyslogLogType{operatorv1.SyslogLogFlows},
+				Filters: &operatorv1.AdditionalStoreFilters{
+					Include: &operatorv1.FlowLogFilter{
+						Actions:     []operatorv1.FlowLogAction{operatorv1.FlowLogActionDeny},
+						PolicyTiers: []string{"security", "platform"},
+					},
+					Exclude: &operatorv1.FlowLogFilter{
+						Namespaces: []string{"kube-system"},
+					},
+				},
+			},
+			S3: &operatorv1.S3StoreSpec{
+				Region:     "anyplace",
+				BucketName: "thebucket",
+				BucketPath: "bucketpath",
+			},
+		}
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		cm := rtest.GetResource(resources, render.FluentdStoreFilterConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+		Expect(cm.Data).To(HaveLen(1))
+		Expect(cm.Data["splunk-flow-filters.conf"]).To(Equal(`<filter **>
+  @type grep
+  <regexp>
+    key action
+    pattern /^(deny)$/
+  </regexp>
+</filter>
+<filter **>
+  @type grep
+  <regexp>
+    key $.policies.all_policies
+    pattern /\|(security|platform)\|/
+  </regexp>
+</filter>
+<filter **>
+  @type grep
+  <exclude>
+    key source_namespace
+    pattern /^(kube-system)$/
+  </exclude>
+  <exclude>
+    key dest_namespace
+    pattern /^(kube-system)$/
+  </exclude>
+</filter>
+`))
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/fluentd-store-filters"))
+		Expect(ds.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", render.FluentdStoreFilterConfigMapName)))
+		container := ds.Spec.Template.Spec.Containers[0]
+		Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: render.FluentdStoreFilterConfigMapName, MountPath: "/etc/fluentd/store-filters"}))
+
+		envs := container.Env
+		Expect(envs).To(ContainElements([]corev1.EnvVar{
+			{Name: "SPLUNK_FLOW_LOG", Value: "true"},
+			{Name: "SPLUNK_FLOW_FILTERS_FILE", Value: "/etc/fluentd/store-filters/splunk-flow-filters.conf"},
+			{Name: "S3_AUDIT_LOG", Value: "true"},
+			{Name: "S3_DNS_LOG", Value: "true"},
+			{Name: "S3_FLOW_LOG", Value: "true"},
+		}))
+		Expect(envs).NotTo(ContainElement(corev1.EnvVar{Name: "SPLUNK_AUDIT_LOG", Value: "true"}))
+		Expect(envs).NotTo(ContainElement(corev1.EnvVar{Name: "SPLUNK_DNS_LOG", Value: "true"}))
+		for _, env := range envs {
+			Expect(env.Name).NotTo(Equal("S3_FLOW_FILTERS_FILE"))
+		}
+	})
+
+	It("should render with kafka configuration", func() {
+		cfg.KafkaCredential = &render.KafkaCredential{
+			Username: []byte("user"),
+			Password: []byte("pass"),
+		}
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			Kafka: &operatorv1.KafkaStoreSpec{
+				Brokers:       []string{"1.2.3.4:9093", "1.2.3.5:9093"},
+				Topics:        &operatorv1.KafkaTopics{Flows: "siem-flows"},
+				LogTypes:      []operatorv1.SyslogLogType{operatorv1.SyslogLogFlows, operatorv1.SyslogLogDNS},
+				SASLMechanism: operatorv1.KafkaSASLMechanismPlain,
+				Encryption:    operatorv1.EncryptionTLS,
+			},
+		}
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		secret := rtest.GetResource(resources, render.KafkaFluentdCredentialsSecretName, render.LogCollectorNamespace, "", "v1", "Secret").(*corev1.Secret)
+		Expect(secret.Data).To(Equal(map[string][]byte{"username": []byte("user"), "password": []byte("pass")}))
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/kafka-credentials"))
+		envs := ds.Spec.Template.Spec.Containers[0].Env
+		Expect(envs).To(ContainElements([]corev1.EnvVar{
+			{Name: "KAFKA_BROKERS", Value: "1.2.3.4:9093,1.2.3.5:9093"},
+			{Name: "KAFKA_FLUSH_INTERVAL", Value: "5s"},
+			{Name: "KAFKA_FLOW_TOPIC", Value: "siem-flows"},
+			{Name: "KAFKA_DNS_TOPIC", Value: "tigera_secure_ee_dns"},
+			{Name: "KAFKA_FLOW_LOG", Value: "true"},
+			{Name: "KAFKA_DNS_LOG", Value: "true"},
+			{Name: "KAFKA_SASL_MECHANISM", Value: "plain"},
+			{Name: "KAFKA_TLS", Value: "true"},
+			{Name: "KAFKA_CA_FILE", Value: cfg.TrustedBundle.MountPath()},
+			{
+				Name: "KAFKA_USERNAME",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: render.KafkaFluentdCredentialsSecretName},
+						Key:                  "username",
+					},
+				},
+			},
+		}))
+		Expect(envs).NotTo(ContainElement(corev1.EnvVar{Name: "KAFKA_AUDIT_LOG", Value: "true"}))
+	})
+
+	It("should render with HTTP, OpenTelemetry and Loki configuration", func() {
+		cfg.HTTPCredential = &render.HTTPCredential{Authorization: []byte("Bearer abc")}
+		cfg.LokiCredential = &render.LokiCredential{Username: []byte("user"), Password: []byte("pass")}
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			HTTP: &operatorv1.HTTPStoreSpec{
+				Endpoint: "https://1.2.3.4:8080/ingest",
+				LogTypes: []operatorv1.SyslogLogType{operatorv1.SyslogLogAudit},
+			},
+			OpenTelemetry: &operatorv1.OpenTelemetryStoreSpec{
+				Endpoint: "https://otel-collector:4317",
+				Protocol: operatorv1.OTLPProtocolGRPC,
+				LogTypes: []operatorv1.SyslogLogType{operatorv1.SyslogLogFlows},
+			},
+			Loki: &operatorv1.LokiStoreSpec{
+				Endpoint: "https://loki:3100",
+				TenantID: "calico",
+				LogTypes: []operatorv1.SyslogLogType{operatorv1.SyslogLogDNS},
+			},
+		}
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		Expect(rtest.GetResource(resources, render.HTTPFluentdCredentialsSecretName, render.LogCollectorNamespace, "", "v1", "Secret")).NotTo(BeNil())
+		Expect(rtest.GetResource(resources, render.LokiFluentdCredentialsSecretName, render.LogCollectorNamespace, "", "v1", "Secret")).NotTo(BeNil())
+		Expect(rtest.GetResource(resources, render.OTLPFluentdCredentialsSecretName, render.LogCollectorNamespace, "", "v1", "Secret")).To(BeNil())
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/http-credentials"))
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/loki-credentials"))
+		envs := ds.Spec.Template.Spec.Containers[0].Env
+		Expect(envs).To(ContainElements([]corev1.EnvVar{
+			{Name: "HTTP_ENDPOINT", Value: "https://1.2.3.4:8080/ingest"},
+			{Name: "HTTP_AUDIT_LOG", Value: "true"},
+			{Name: "OTLP_ENDPOINT", Value: "https://otel-collector:4317"},
+			{Name: "OTLP_PROTOCOL", Value: "grpc"},
+			{Name: "OTLP_FLOW_LOG", Value: "true"},
+			{Name: "LOKI_ENDPOINT", Value: "https://loki:3100"},
+			{Name: "LOKI_TENANT_ID", Value: "calico"},
+			{Name: "LOKI_DNS_LOG", Value: "true"},
+			{
+				Name: "HTTP_AUTHORIZATION",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: render.HTTPFluentdCredentialsSecretName},
+						Key:                  "authorization",
+					},
+				},
+			},
+		}))
+		for _, env := range envs {
+			Expect(env.Name).NotTo(Equal("OTLP_AUTHORIZATION"))
+		}
+	})
+
 	It("should render with filter", func() {
 		cfg.Filters = &render.FluentdFilters{
 			Flow: "flow-filter",
@@ -1053,6 +1416,42 @@ var _ = Describe("Tigera Secure Fluentd rendering tests", func() {
 		}))
 	})
 
+	It("should render a volume for every EKS Cloudwatch Log volume mount", func() {
+		cfg.EKSConfig = setupEKSCloudwatchLogConfig()
+		cfg.ESClusterConfig = relasticsearch.NewClusterConfig("clusterTestName", 1, 1, 1)
+		cfg.Installation.KubernetesProvider = operatorv1.ProviderEKS
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			S3: &operatorv1.S3StoreSpec{
+				Region:      "anyplace",
+				BucketName:  "thebucket",
+				BucketPath:  "bucketpath",
+				Credentials: operatorv1.S3CredentialsWebIdentity,
+				RoleARN:     "arn:aws:iam::123456789012:role/fluentd",
+				Filters: &operatorv1.AdditionalStoreFilters{
+					Include: &operatorv1.FlowLogFilter{Actions: []operatorv1.FlowLogAction{operatorv1.FlowLogActionDeny}},
+				},
+			},
+		}
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+		deploy := rtest.GetResource(resources, "eks-log-forwarder", "tigera-fluentd", "apps", "v1", "Deployment").(*appsv1.Deployment)
+
+		volumes := map[string]bool{}
+		for _, v := range deploy.Spec.Template.Spec.Volumes {
+			volumes[v.Name] = true
+		}
+		containers := append(deploy.Spec.Template.Spec.InitContainers, deploy.Spec.Template.Spec.Containers...)
+		Expect(containers).To(HaveLen(2))
+		for _, container := range containers {
+			for _, mount := range container.VolumeMounts {
+				Expect(volumes).To(HaveKey(mount.Name), "container %s mounts missing volume %s", container.Name, mount.Name)
+			}
+		}
+		Expect(volumes).To(HaveKey(render.FluentdStoreFilterConfigMapName))
+		Expect(volumes).To(HaveKey(render.S3WebIdentityTokenVolumeName))
+	})
+
 	It("should render with EKS Cloudwatch Log with resources", func() {
 
 		cfg.EKSConfig = setupEKSCloudwatchLogConfig()
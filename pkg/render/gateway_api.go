@@ -26,6 +26,7 @@ import (
 	"github.com/tigera/operator/pkg/components"
 	rcomp "github.com/tigera/operator/pkg/render/common/components"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/metrics"
 	"github.com/tigera/operator/pkg/render/common/secret"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -78,6 +79,10 @@ const (
 	EnvoyGatewayConfigKey               = "envoy-gateway.yaml"
 	EnvoyGatewayDeploymentContainerName = "envoy-gateway"
 	EnvoyGatewayJobContainerName        = "envoy-gateway-certgen"
+	EnvoyGatewayMetricsPort             = 19001
+
+	// GatewayClassName is the name of the GatewayClass provisioned for the Envoy Gateway implementation.
+	GatewayClassName = "tigera-gateway-class"
 )
 
 func GatewayAPIResourcesGetter() func() *gatewayAPIResources {
@@ -496,6 +501,24 @@ func (pr *gatewayAPIImplementationComponent) Objects() ([]client.Object, []clien
 	return objs, nil
 }
 
+// GatewayAPIMetricsTarget declares the metrics endpoint of the Envoy Gateway controller that is exposed by its
+// service.
+func GatewayAPIMetricsTarget() metrics.Target {
+	service := GatewayAPIResources().controllerService
+	return metrics.Target{
+		Name:      service.Name,
+		Namespace: service.Namespace,
+		Selector:  metav1.LabelSelector{MatchLabels: map[string]string{"control-plane": service.Labels["control-plane"]}},
+		Endpoints: []metrics.Endpoint{
+			{
+				Port:       "metrics",
+				PortNumber: EnvoyGatewayMetricsPort,
+				Scheme:     metrics.SchemeHTTP,
+			},
+		},
+	}
+}
+
 func (pr *gatewayAPIImplementationComponent) envoyProxyConfig() *envoyapi.EnvoyProxy {
 	envoyProxy := &envoyapi.EnvoyProxy{
 		TypeMeta: metav1.TypeMeta{Kind: "EnvoyProxy", APIVersion: "gateway.envoyproxy.io/v1alpha1"},
@@ -529,7 +552,7 @@ func (pr *gatewayAPIImplementationComponent) gatewayClass(controllerName string,
 	return &gapi.GatewayClass{
 		TypeMeta: metav1.TypeMeta{Kind: "GatewayClass", APIVersion: "gateway.networking.k8s.io/v1"},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "tigera-gateway-class",
+			Name:      GatewayClassName,
 			Namespace: "tigera-gateway",
 		},
 		Spec: gapi.GatewayClassSpec{
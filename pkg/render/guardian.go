@@ -57,7 +57,11 @@ const (
 	GuardianVolumeName             = "tigera-guardian-certs"
 	GuardianSecretName             = "tigera-managed-cluster-connection"
 	GuardianTargetPort             = 8080
+	GuardianHealthPort             = 9080
 	GuardianPolicyName             = networkpolicy.TigeraComponentPolicyPrefix + "guardian-access"
+
+	// GuardianTunnelStatusPath is served on the health port and reports the state of the tunnel as JSON.
+	GuardianTunnelStatusPath = "/tunnel"
 )
 
 var (
@@ -99,6 +103,10 @@ type GuardianConfiguration struct {
 	// If this slice is empty, then resolution has not yet occurred. Pods with no proxy
 	// configured are represented with a nil value.
 	PodProxies []*httpproxy.Config
+
+	// HealthProbeSourceNets are the addresses of the nodes. The operator is host networked, so its polls of the tunnel
+	// status are sourced from one of them. If empty, the health endpoint is not reachable from other nodes.
+	HealthProbeSourceNets []string
 }
 
 type GuardianComponent struct {
@@ -336,7 +344,7 @@ func (c *GuardianComponent) container() []corev1.Container {
 				ProbeHandler: corev1.ProbeHandler{
 					HTTPGet: &corev1.HTTPGetAction{
 						Path: "/health",
-						Port: intstr.FromInt(9080),
+						Port: intstr.FromInt(GuardianHealthPort),
 					},
 				},
 				InitialDelaySeconds: 90,
@@ -345,7 +353,7 @@ func (c *GuardianComponent) container() []corev1.Container {
 				ProbeHandler: corev1.ProbeHandler{
 					HTTPGet: &corev1.HTTPGetAction{
 						Path: "/health",
-						Port: intstr.FromInt(9080),
+						Port: intstr.FromInt(GuardianHealthPort),
 					},
 				},
 				InitialDelaySeconds: 10,
@@ -395,46 +403,13 @@ func guardianAllowTigeraPolicy(cfg *GuardianConfiguration) (*v3.NetworkPolicy, e
 		},
 	}...)
 
-	// The loop below creates an egress rule for each unique destination that the Guardian pods connect to. If there are
-	// multiple guardian pods and their proxy  settings differ, then there are multiple destinations that must have egress allowed.
-	allowedDestinations := map[string]bool{}
-	processedPodProxies := ProcessPodProxies(cfg.PodProxies)
-	for _, podProxyConfig := range processedPodProxies {
-		var proxyURL *url.URL
-		var err error
-		if podProxyConfig != nil && podProxyConfig.HTTPSProxy != "" {
-			targetURL := &url.URL{
-				// The scheme should be HTTPS, as we are establishing an mTLS session with the target.
-				Scheme: "https",
-
-				// We expect `target` to be of the form host:port.
-				Host: cfg.URL,
-			}
-
-			proxyURL, err = podProxyConfig.ProxyFunc()(targetURL)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		var tunnelDestinationHostPort string
-		if proxyURL != nil {
-			proxyHostPort, err := operatorurl.ParseHostPortFromHTTPProxyURL(proxyURL)
-			if err != nil {
-				return nil, err
-			}
-
-			tunnelDestinationHostPort = proxyHostPort
-		} else {
-			// cfg.URL has host:port form
-			tunnelDestinationHostPort = cfg.URL
-		}
-
-		// Check if we've already created an egress rule for this destination.
-		if allowedDestinations[tunnelDestinationHostPort] {
-			continue
-		}
-
+	// Create an egress rule for each unique destination that the Guardian pods connect to. If there are multiple
+	// guardian pods and their proxy settings differ, then there are multiple destinations that must have egress allowed.
+	destinations, err := GuardianTunnelDestinations(cfg.URL, cfg.PodProxies)
+	if err != nil {
+		return nil, err
+	}
+	for _, tunnelDestinationHostPort := range destinations {
 		host, port, err := net.SplitHostPort(tunnelDestinationHostPort)
 		if err != nil {
 			return nil, err
@@ -454,8 +429,6 @@ func guardianAllowTigeraPolicy(cfg *GuardianConfiguration) (*v3.NetworkPolicy, e
 					Ports:   []numorstring.Port{parsedPort},
 				},
 			})
-			allowedDestinations[tunnelDestinationHostPort] = true
-
 		} else {
 			var netSuffix string
 			if parsedIp.To4() != nil {
@@ -472,7 +445,6 @@ func guardianAllowTigeraPolicy(cfg *GuardianConfiguration) (*v3.NetworkPolicy, e
 					Ports: []numorstring.Port{parsedPort},
 				},
 			})
-			allowedDestinations[tunnelDestinationHostPort] = true
 		}
 	}
 
@@ -529,6 +501,15 @@ func guardianAllowTigeraPolicy(cfg *GuardianConfiguration) (*v3.NetworkPolicy, e
 			Destination: guardianIngressDestinationEntityRule,
 		},
 	}
+	if len(cfg.HealthProbeSourceNets) > 0 {
+		// The operator polls the tunnel status from the health endpoint.
+		ingressRules = append(ingressRules, v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Source:      v3.EntityRule{Nets: cfg.HealthProbeSourceNets},
+			Destination: v3.EntityRule{Ports: networkpolicy.Ports(GuardianHealthPort)},
+		})
+	}
 
 	policy := &v3.NetworkPolicy{
 		TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"},
@@ -549,6 +530,46 @@ func guardianAllowTigeraPolicy(cfg *GuardianConfiguration) (*v3.NetworkPolicy, e
 	return policy, nil
 }
 
+// GuardianTunnelDestinations returns the unique host:port addresses that the Guardian pods dial to establish the
+// tunnel to the management cluster at managementClusterAddr. Each address is either the management cluster itself
+// or the proxy configured for a pod.
+func GuardianTunnelDestinations(managementClusterAddr string, podProxies []*httpproxy.Config) ([]string, error) {
+	var destinations []string
+	seen := map[string]bool{}
+	for _, podProxyConfig := range ProcessPodProxies(podProxies) {
+		var proxyURL *url.URL
+		var err error
+		if podProxyConfig != nil && podProxyConfig.HTTPSProxy != "" {
+			targetURL := &url.URL{
+				// The scheme should be HTTPS, as we are establishing an mTLS session with the target.
+				Scheme: "https",
+
+				// We expect `target` to be of the form host:port.
+				Host: managementClusterAddr,
+			}
+
+			proxyURL, err = podProxyConfig.ProxyFunc()(targetURL)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		tunnelDestinationHostPort := managementClusterAddr
+		if proxyURL != nil {
+			tunnelDestinationHostPort, err = operatorurl.ParseHostPortFromHTTPProxyURL(proxyURL)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if !seen[tunnelDestinationHostPort] {
+			seen[tunnelDestinationHostPort] = true
+			destinations = append(destinations, tunnelDestinationHostPort)
+		}
+	}
+	return destinations, nil
+}
+
 func ProcessPodProxies(podProxies []*httpproxy.Config) []*httpproxy.Config {
 	// If pod proxies are empty, then pod proxy resolution has not yet occurred.
 	// Assume that a single Guardian pod is running without a proxy.
@@ -78,10 +78,11 @@ var _ = Describe("Rendering tests", func() {
 					Namespace: common.OperatorNamespace(),
 				},
 			}},
-			Installation:      &i,
-			TunnelSecret:      secret,
-			TrustedCertBundle: bundle,
-			OpenShift:         openshift,
+			Installation:          &i,
+			TunnelSecret:          secret,
+			TrustedCertBundle:     bundle,
+			OpenShift:             openshift,
+			HealthProbeSourceNets: []string{"10.0.0.1/32", "10.0.0.2/32"},
 		}
 	}
 
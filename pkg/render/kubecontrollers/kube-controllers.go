@@ -38,6 +38,7 @@ import (
 	rcomp "github.com/tigera/operator/pkg/render/common/components"
 	relasticsearch "github.com/tigera/operator/pkg/render/common/elasticsearch"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/metrics"
 	"github.com/tigera/operator/pkg/render/common/networkpolicy"
 	"github.com/tigera/operator/pkg/render/common/secret"
 	"github.com/tigera/operator/pkg/render/common/securitycontext"
@@ -535,6 +536,14 @@ func (c *kubeControllersComponent) controllersDeployment() *appsv1.Deployment {
 
 	env = append(env, c.cfg.K8sServiceEp.EnvVars(false, c.cfg.Installation.KubernetesProvider)...)
 
+	if c.kubeControllerName == KubeController && c.cfg.Installation.HostProtection != nil && c.cfg.Installation.HostProtection.AutoHostEndpoints != nil {
+		autoHostEndpoints := "disabled"
+		if c.cfg.Installation.HostProtection.AutoHostEndpointsEnabled() {
+			autoHostEndpoints = "enabled"
+		}
+		env = append(env, corev1.EnvVar{Name: "AUTO_HOST_ENDPOINTS", Value: autoHostEndpoints})
+	}
+
 	if c.cfg.Installation.Variant == operatorv1.TigeraSecureEnterprise {
 		if c.cfg.Tenant != nil {
 			env = append(env, corev1.EnvVar{Name: "TENANT_ID", Value: c.cfg.Tenant.Spec.ID})
@@ -740,6 +749,24 @@ func (c *kubeControllersComponent) prometheusService() *corev1.Service {
 	}
 }
 
+// MetricsTarget declares the metrics endpoint of calico-kube-controllers that is exposed by its metrics service.
+func MetricsTarget(port int) metrics.Target {
+	return metrics.Target{
+		Name:      monitor.KubeControllerMetrics,
+		Namespace: common.CalicoNamespace,
+		Selector:  metrics.AppSelector(KubeController),
+		Endpoints: []metrics.Endpoint{
+			{
+				Port:       "metrics-port",
+				PortNumber: uint16(port),
+				Scheme:     metrics.SchemeHTTPS,
+				ServerName: monitor.KubeControllerMetrics,
+				ClientAuth: true,
+			},
+		},
+	}
+}
+
 // kubeControllerResources creates the kube-controller's resource requirements.
 func (c *kubeControllersComponent) kubeControllersResources() corev1.ResourceRequirements {
 	return rmeta.GetResourceRequirements(c.cfg.Installation, operatorv1.ComponentNameKubeControllers)
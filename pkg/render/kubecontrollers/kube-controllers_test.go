@@ -672,6 +672,22 @@ var _ = Describe("kube-controllers rendering tests", func() {
 		}
 	})
 
+	It("should configure automatic host endpoints", func() {
+		enabled := operatorv1.AutoHostEndpointsEnabled
+		cfg.Installation.HostProtection = &operatorv1.HostProtection{AutoHostEndpoints: &enabled}
+		component := kubecontrollers.NewCalicoKubeControllers(&cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+		deployment := rtest.GetResource(resources, kubecontrollers.KubeController, common.CalicoNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(deployment.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "AUTO_HOST_ENDPOINTS", Value: "enabled"}))
+
+		disabled := operatorv1.AutoHostEndpointsDisabled
+		cfg.Installation.HostProtection.AutoHostEndpoints = &disabled
+		resources, _ = kubecontrollers.NewCalicoKubeControllers(&cfg).Objects()
+		deployment = rtest.GetResource(resources, kubecontrollers.KubeController, common.CalicoNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(deployment.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "AUTO_HOST_ENDPOINTS", Value: "disabled"}))
+	})
+
 	It("should add the OIDC prefix env variables", func() {
 		instance.Variant = operatorv1.TigeraSecureEnterprise
 		cfg.LogStorageExists = true
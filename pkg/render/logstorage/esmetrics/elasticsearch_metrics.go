@@ -33,6 +33,7 @@ import (
 	rcomponents "github.com/tigera/operator/pkg/render/common/components"
 	relasticsearch "github.com/tigera/operator/pkg/render/common/elasticsearch"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/metrics"
 	"github.com/tigera/operator/pkg/render/common/networkpolicy"
 	"github.com/tigera/operator/pkg/render/common/secret"
 	"github.com/tigera/operator/pkg/render/common/securitycontext"
@@ -48,6 +49,7 @@ const (
 	ElasticsearchMetricsRoleName        = "tigera-elasticsearch-metrics"
 	ElasticsearchMetricsPolicyName      = networkpolicy.TigeraComponentPolicyPrefix + "elasticsearch-metrics"
 	ElasticsearchMetricsPort            = 9081
+	ElasticsearchMetricsMonitorName     = "elasticsearch-metrics"
 )
 
 var ESMetricsSourceEntityRule = networkpolicy.CreateSourceEntityRule(render.ElasticsearchNamespace, ElasticsearchMetricsName)
@@ -192,6 +194,24 @@ func (e *elasticsearchMetrics) metricsService() *corev1.Service {
 	}
 }
 
+// MetricsTarget declares the metrics endpoint of the Elasticsearch metrics exporter.
+func MetricsTarget() metrics.Target {
+	return metrics.Target{
+		Name:      ElasticsearchMetricsMonitorName,
+		Namespace: render.ElasticsearchNamespace,
+		Selector:  metrics.AppSelector(ElasticsearchMetricsName),
+		Endpoints: []metrics.Endpoint{
+			{
+				Port:       "metrics-port",
+				PortNumber: ElasticsearchMetricsPort,
+				Scheme:     metrics.SchemeHTTPS,
+				ServerName: ElasticsearchMetricsName,
+				ClientAuth: true,
+			},
+		},
+	}
+}
+
 func (e *elasticsearchMetrics) metricsDeployment() *appsv1.Deployment {
 	var initContainers []corev1.Container
 	annotations := e.cfg.TrustedBundle.HashAnnotations()
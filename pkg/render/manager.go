@@ -245,6 +245,8 @@ func (c *managerComponent) Objects() ([]client.Object, []client.Object) {
 	)
 	objs = append(objs, c.getTLSObjects()...)
 	objs = append(objs, c.managerService())
+	exposureObjs, exposureObjsToDelete := c.exposureObjects()
+	objs = append(objs, exposureObjs...)
 
 	if c.cfg.VoltronRouteConfig != nil {
 		objs = append(objs, c.cfg.VoltronRouteConfig.RoutesConfigMap(c.cfg.Namespace))
@@ -265,7 +267,7 @@ func (c *managerComponent) Objects() ([]client.Object, []client.Object) {
 		}
 	}
 
-	return objs, nil
+	return objs, exposureObjsToDelete
 }
 
 func (c *managerComponent) Ready() bool {
@@ -426,17 +428,44 @@ func (c *managerComponent) managerEnvVars() []corev1.EnvVar {
 		{Name: "CNX_ELASTICSEARCH_API_URL", Value: "/tigera-elasticsearch"},
 		{Name: "CNX_ELASTICSEARCH_KIBANA_URL", Value: fmt.Sprintf("/%s", KibanaBasePath)},
 		{Name: "CNX_ENABLE_ERROR_TRACKING", Value: "false"},
-		{Name: "CNX_ALP_SUPPORT", Value: "true"},
-		{Name: "CNX_CLUSTER_NAME", Value: "cluster"},
-		{Name: "CNX_POLICY_RECOMMENDATION_SUPPORT", Value: "true"},
+		{Name: "CNX_ALP_SUPPORT", Value: strconv.FormatBool(c.featureEnabled(c.features().ApplicationLayerPolicy, true))},
+		{Name: "CNX_CLUSTER_NAME", Value: c.clusterName()},
+		{Name: "CNX_POLICY_RECOMMENDATION_SUPPORT", Value: strconv.FormatBool(c.featureEnabled(c.features().PolicyRecommendation, true))},
 		{Name: "ENABLE_MULTI_CLUSTER_MANAGEMENT", Value: strconv.FormatBool(c.cfg.ManagementCluster != nil)},
-		{Name: "ENABLE_KIBANA", Value: strconv.FormatBool(!c.cfg.Tenant.MultiTenant())},
+		{Name: "ENABLE_KIBANA", Value: strconv.FormatBool(c.kibanaEnabled())},
 	}
 
 	envs = append(envs, c.managerOAuth2EnvVars()...)
 	return envs
 }
 
+// clusterName returns the name the manager UI displays for this cluster.
+func (c *managerComponent) clusterName() string {
+	if c.cfg.Manager != nil && c.cfg.Manager.Spec.Branding != nil && c.cfg.Manager.Spec.Branding.ClusterName != "" {
+		return c.cfg.Manager.Spec.Branding.ClusterName
+	}
+	return "cluster"
+}
+
+func (c *managerComponent) features() operatorv1.ManagerFeatures {
+	if c.cfg.Manager == nil || c.cfg.Manager.Spec.Features == nil {
+		return operatorv1.ManagerFeatures{}
+	}
+	return *c.cfg.Manager.Spec.Features
+}
+
+func (c *managerComponent) featureEnabled(state *operatorv1.ManagerFeatureState, def bool) bool {
+	if state == nil {
+		return def
+	}
+	return *state == operatorv1.ManagerFeatureEnabled
+}
+
+// kibanaEnabled returns whether the manager links to Kibana. Kibana is never available to multi-tenant managers.
+func (c *managerComponent) kibanaEnabled() bool {
+	return !c.cfg.Tenant.MultiTenant() && c.featureEnabled(c.features().Kibana, true)
+}
+
 // managerContainer returns the manager container.
 func (c *managerComponent) managerContainer() corev1.Container {
 	return corev1.Container{
@@ -598,7 +627,7 @@ func (c *managerComponent) managerUIAPIsContainer() corev1.Container {
 		{Name: "ELASTIC_KIBANA_ENDPOINT", Value: rkibana.HTTPSEndpoint(c.SupportedOSType(), c.cfg.ClusterDomain)},
 		{Name: "LINSEED_CLIENT_CERT", Value: certPath},
 		{Name: "LINSEED_CLIENT_KEY", Value: keyPath},
-		{Name: "ELASTIC_KIBANA_DISABLED", Value: strconv.FormatBool(c.cfg.Tenant.MultiTenant())},
+		{Name: "ELASTIC_KIBANA_DISABLED", Value: strconv.FormatBool(!c.kibanaEnabled())},
 		{Name: "VOLTRON_URL", Value: fmt.Sprintf("https://tigera-manager.%s.svc:9443", c.cfg.Namespace)},
 	}
 
@@ -652,7 +681,7 @@ func (c *managerComponent) managerTolerations() []corev1.Toleration {
 
 // managerService returns the service exposing the Tigera Secure web app.
 func (c *managerComponent) managerService() *corev1.Service {
-	return &corev1.Service{
+	svc := &corev1.Service{
 		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      ManagerServiceName,
@@ -671,6 +700,8 @@ func (c *managerComponent) managerService() *corev1.Service {
 			},
 		},
 	}
+	c.applyLoadBalancerExposure(svc)
+	return svc
 }
 
 // managerServiceAccount creates the serviceaccount used by the Tigera Secure web app.
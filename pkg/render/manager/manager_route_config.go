@@ -16,7 +16,10 @@ package manager
 
 import (
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"net/url"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -187,9 +190,14 @@ func (builder *voltronRouteConfigBuilder) Build() (*VoltronRouteConfig, error) {
 	var tunnelTLSTerminatedRoutes tlsTerminatedRouteList
 	var tunnelTLSPassThroughRoutes tlsPassThroughRouteList
 
+	// Routes are validated individually, and a route that fails validation is left out of the configuration rather than
+	// failing the whole build, so that one broken route doesn't take down the others.
+	rejected := map[RouteKey]*RouteError{}
+
 	for _, route := range builder.tlsTerminatedRoutes {
-		if route.Spec.CABundle == nil {
-			return nil, fmt.Errorf("CABundle is required")
+		if err := builder.validateTLSTerminatedRoute(route); err != nil {
+			rejected[RouteKey{Kind: TLSTerminatedRouteKind, Name: route.Name}] = err
+			continue
 		}
 
 		r := &tlsTerminatedRoute{
@@ -204,25 +212,15 @@ func (builder *voltronRouteConfigBuilder) Build() (*VoltronRouteConfig, error) {
 			if route.Spec.Unauthenticated != nil {
 				r.Unauthenticated = *route.Spec.Unauthenticated
 			}
-		} else if route.Spec.Target == operatorv1.TargetTypeUpstreamTunnel {
-			tunnelTLSTerminatedRoutes = append(tunnelTLSTerminatedRoutes, r)
 		} else {
-			return nil, fmt.Errorf("unknown Target value %s", route.Spec.Target)
-		}
-
-		if route.Spec.CABundle != nil {
-			path, err := builder.mountConfigMapReference(route.Spec.CABundle.Name, route.Spec.CABundle.Key)
-			if err != nil {
-				return nil, err
-			}
-
-			r.CABundlePath = path
+			tunnelTLSTerminatedRoutes = append(tunnelTLSTerminatedRoutes, r)
 		}
 
-		// Require that either both MTLSCert and MTLSKey are set or neither are.
-		if (route.Spec.ForwardingMTLSCert != nil && route.Spec.ForwardingMTLSKey == nil) || (route.Spec.ForwardingMTLSKey != nil && route.Spec.ForwardingMTLSCert == nil) {
-			return nil, fmt.Errorf("must set both MTLSCert and MTLSKey, or neither for TLS terminated route %s", route.Name)
+		path, err := builder.mountConfigMapReference(route.Spec.CABundle.Name, route.Spec.CABundle.Key)
+		if err != nil {
+			return nil, err
 		}
+		r.CABundlePath = path
 
 		if route.Spec.ForwardingMTLSCert != nil {
 			path, err := builder.mountSecretReference(route.Spec.ForwardingMTLSCert.Name, route.Spec.ForwardingMTLSCert.Key)
@@ -231,8 +229,7 @@ func (builder *voltronRouteConfigBuilder) Build() (*VoltronRouteConfig, error) {
 			}
 			r.ClientCertPath = path
 
-			// At this point, if MTLSCert is set then MTLSKey will be set otherwise this if statement wouldn't be executed
-			// or an error would have already been returned.
+			// Validation guarantees that MTLSKey is set whenever MTLSCert is.
 			path, err = builder.mountSecretReference(route.Spec.ForwardingMTLSKey.Name, route.Spec.ForwardingMTLSKey.Key)
 			if err != nil {
 				return nil, err
@@ -242,6 +239,11 @@ func (builder *voltronRouteConfigBuilder) Build() (*VoltronRouteConfig, error) {
 	}
 
 	for _, route := range builder.tlsPassThroughRoutes {
+		if err := validateTLSPassThroughRoute(route); err != nil {
+			rejected[RouteKey{Kind: TLSPassThroughRouteKind, Name: route.Name}] = err
+			continue
+		}
+
 		r := &tlsPassThroughRoute{
 			Destination: route.Spec.Destination,
 			ServerName:  route.Spec.SNIMatch.ServerName,
@@ -298,9 +300,153 @@ func (builder *voltronRouteConfigBuilder) Build() (*VoltronRouteConfig, error) {
 		volumeMounts: builder.volumeMounts,
 		volumes:      builder.volumes,
 		annotations:  builder.generateAnnotations(),
+		rejected:     rejected,
 	}, nil
 }
 
+// validateTLSTerminatedRoute checks that the route spec is well-formed and that the ConfigMaps and Secrets it
+// references were added to the builder and contain PEM data under the referenced keys.
+func (builder *voltronRouteConfigBuilder) validateTLSTerminatedRoute(route operatorv1.TLSTerminatedRoute) *RouteError {
+	if route.Spec.Target != operatorv1.TargetTypeUI && route.Spec.Target != operatorv1.TargetTypeUpstreamTunnel {
+		return invalidSpec("unknown target %q", route.Spec.Target)
+	}
+	if route.Spec.PathMatch == nil || route.Spec.PathMatch.Path == "" {
+		return invalidSpec("pathMatch.path is required")
+	}
+	if route.Spec.PathMatch.PathRegexp != nil {
+		if _, err := regexp.Compile(*route.Spec.PathMatch.PathRegexp); err != nil {
+			return invalidSpec("pathMatch.pathRegexp %q does not compile: %v", *route.Spec.PathMatch.PathRegexp, err)
+		}
+	}
+	if err := validateDestinationURL(route.Spec.Destination); err != nil {
+		return err
+	}
+
+	if route.Spec.CABundle == nil {
+		return invalidSpec("caBundle is required")
+	}
+	// Require that either both MTLSCert and MTLSKey are set or neither are.
+	if (route.Spec.ForwardingMTLSCert != nil) != (route.Spec.ForwardingMTLSKey != nil) {
+		return invalidSpec("must set both mtlsCert and mtlsKey, or neither")
+	}
+
+	configMap := builder.configMaps[route.Spec.CABundle.Name]
+	if configMap == nil {
+		return refNotFound("ConfigMap %s containing the CA bundle does not exist", route.Spec.CABundle.Name)
+	}
+	caBundle, ok := configMap.Data[route.Spec.CABundle.Key]
+	if !ok {
+		return refNotFound("ConfigMap %s has no key %s", route.Spec.CABundle.Name, route.Spec.CABundle.Key)
+	}
+	if !containsPEMBlock([]byte(caBundle), isCertificateBlock) {
+		return invalidRef("key %s of ConfigMap %s does not contain a PEM encoded certificate", route.Spec.CABundle.Key, route.Spec.CABundle.Name)
+	}
+
+	if route.Spec.ForwardingMTLSCert != nil {
+		if err := builder.validateSecretKey(route.Spec.ForwardingMTLSCert, "certificate", isCertificateBlock); err != nil {
+			return err
+		}
+		if err := builder.validateSecretKey(route.Spec.ForwardingMTLSKey, "private key", isPrivateKeyBlock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (builder *voltronRouteConfigBuilder) validateSecretKey(ref *corev1.SecretKeySelector, contents string, isExpectedBlock func(*pem.Block) bool) *RouteError {
+	secret := builder.secrets[ref.Name]
+	if secret == nil {
+		return refNotFound("Secret %s containing the mTLS %s does not exist", ref.Name, contents)
+	}
+	data, ok := secret.Data[ref.Key]
+	if !ok {
+		return refNotFound("Secret %s has no key %s", ref.Name, ref.Key)
+	}
+	if !containsPEMBlock(data, isExpectedBlock) {
+		return invalidRef("key %s of Secret %s does not contain a PEM encoded %s", ref.Key, ref.Name, contents)
+	}
+	return nil
+}
+
+func validateTLSPassThroughRoute(route operatorv1.TLSPassThroughRoute) *RouteError {
+	if route.Spec.Target != operatorv1.TargetTypeUpstreamTunnel {
+		return invalidSpec("unknown target %q", route.Spec.Target)
+	}
+	if route.Spec.SNIMatch == nil || route.Spec.SNIMatch.ServerName == "" {
+		return invalidSpec("sniMatch.serverName is required")
+	}
+	if route.Spec.Destination == "" {
+		return invalidSpec("destination is required")
+	}
+	return nil
+}
+
+func validateDestinationURL(destination string) *RouteError {
+	u, err := url.Parse(destination)
+	if err != nil {
+		return invalidSpec("destination %q is not a valid URL: %v", destination, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return invalidSpec("destination %q must be an absolute URL with a scheme and host", destination)
+	}
+	return nil
+}
+
+// containsPEMBlock returns true if data contains at least one PEM block accepted by isExpectedBlock.
+func containsPEMBlock(data []byte, isExpectedBlock func(*pem.Block) bool) bool {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return false
+		}
+		if isExpectedBlock(block) {
+			return true
+		}
+	}
+}
+
+func isCertificateBlock(block *pem.Block) bool {
+	return block.Type == "CERTIFICATE"
+}
+
+func isPrivateKeyBlock(block *pem.Block) bool {
+	return strings.HasSuffix(block.Type, "PRIVATE KEY")
+}
+
+const (
+	TLSTerminatedRouteKind  = "TLSTerminatedRoute"
+	TLSPassThroughRouteKind = "TLSPassThroughRoute"
+)
+
+// RouteKey identifies a route CR within the manager namespace.
+type RouteKey struct {
+	Kind string
+	Name string
+}
+
+// RouteError describes why a route was rejected. Reason is one of the TLSRouteReason values of the operator API.
+type RouteError struct {
+	Reason  string
+	Message string
+}
+
+func (e *RouteError) Error() string {
+	return e.Message
+}
+
+func invalidSpec(format string, args ...any) *RouteError {
+	return &RouteError{Reason: operatorv1.TLSRouteReasonInvalidSpec, Message: fmt.Sprintf(format, args...)}
+}
+
+func refNotFound(format string, args ...any) *RouteError {
+	return &RouteError{Reason: operatorv1.TLSRouteReasonRefNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+func invalidRef(format string, args ...any) *RouteError {
+	return &RouteError{Reason: operatorv1.TLSRouteReasonInvalidRef, Message: fmt.Sprintf(format, args...)}
+}
+
 func marshalRouteList[R sort.Interface](list R) ([]byte, error) {
 	sort.Sort(list)
 
@@ -460,6 +606,7 @@ type VoltronRouteConfig struct {
 	volumeMounts []corev1.VolumeMount
 	volumes      []corev1.Volume
 	annotations  map[string]string
+	rejected     map[RouteKey]*RouteError
 }
 
 type ByVolumeMountName []corev1.VolumeMount
@@ -519,3 +666,8 @@ func (cfg *VoltronRouteConfig) EnvVars() []corev1.EnvVar {
 func (cfg *VoltronRouteConfig) Annotations() map[string]string {
 	return cfg.annotations
 }
+
+// RouteError returns the reason the given route was left out of the configuration, or nil if it was accepted.
+func (cfg *VoltronRouteConfig) RouteError(kind, name string) *RouteError {
+	return cfg.rejected[RouteKey{Kind: kind, Name: name}]
+}
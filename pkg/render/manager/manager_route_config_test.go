@@ -17,6 +17,7 @@ package manager_test
 import (
 	"bytes"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 
 	. "github.com/onsi/ginkgo"
@@ -31,6 +32,11 @@ import (
 	"github.com/tigera/operator/pkg/render/manager"
 )
 
+var (
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("certbytes")})
+	keyPEM  = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: []byte("keybytes")})
+)
+
 var _ = Describe("VoltronRouteConfigBuilder", func() {
 	var (
 		builder manager.VoltronRouteConfigBuilder
@@ -65,6 +71,7 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 					PathRegexp:  ptr.ToPtr("^/foobar$"),
 					PathReplace: ptr.ToPtr("/"),
 				},
+				Destination: "https://foobar",
 			},
 		}
 
@@ -92,7 +99,7 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 				Name: "ca-bundle",
 			},
 			Data: map[string]string{
-				"bundle": "bundle",
+				"ca.bundle": string(certPEM),
 			},
 		}
 
@@ -113,7 +120,7 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 				Namespace: "tigera-manager",
 			},
 			Data: map[string][]byte{
-				"cert.pem": []byte("certbytes"),
+				"cert.pem": certPEM,
 			},
 		}
 
@@ -134,7 +141,7 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 				Namespace: "tigera-manager",
 			},
 			Data: map[string][]byte{
-				"key.pem": []byte("keybytes"),
+				"key.pem": keyPEM,
 			},
 		}
 
@@ -153,16 +160,23 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 
 	Context("TLSTerminatedRoutes", func() {
 		When("the CABundle is not set", func() {
-			It("returns an error", func() {
+			It("rejects the route", func() {
+				route.Spec.Target = operatorv1.TargetTypeUI
 				builder.AddTLSTerminatedRoute(route)
 
-				_, err := builder.Build()
-				Expect(err).Should(HaveOccurred())
+				config, err := builder.Build()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(config.RouteError(manager.TLSTerminatedRouteKind, route.Name)).Should(Equal(&manager.RouteError{
+					Reason:  operatorv1.TLSRouteReasonInvalidSpec,
+					Message: "caBundle is required",
+				}))
+				Expect(config.RoutesConfigMap("tigera-manager").Data).Should(BeEmpty())
 			})
 		})
 
 		When("the CABundle is set but the config map was not added to the builder", func() {
-			It("returns an error", func() {
+			It("rejects the route", func() {
+				route.Spec.Target = operatorv1.TargetTypeUI
 				route.Spec.CABundle = &corev1.ConfigMapKeySelector{
 					LocalObjectReference: corev1.LocalObjectReference{
 						Name: "ca-bundle",
@@ -171,8 +185,109 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 				}
 				builder.AddTLSTerminatedRoute(route)
 
-				_, err := builder.Build()
-				Expect(err).Should(HaveOccurred())
+				config, err := builder.Build()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(config.RouteError(manager.TLSTerminatedRouteKind, route.Name).Reason).Should(Equal(operatorv1.TLSRouteReasonRefNotFound))
+				Expect(config.VolumeMounts()).Should(Equal([]corev1.VolumeMount{routesConfigMapVolumeMount}))
+			})
+		})
+
+		DescribeTable("rejects invalid routes", func(mutate func(*operatorv1.TLSTerminatedRoute), reason string) {
+			route.Spec.Target = operatorv1.TargetTypeUI
+			route.Spec.CABundle = &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: "ca-bundle",
+				},
+				Key: "ca.bundle",
+			}
+			mutate(&route)
+
+			builder.AddTLSTerminatedRoute(route)
+			builder.AddConfigMap(caBundle)
+			builder.AddSecret(mtlsCert)
+			builder.AddSecret(mtlsKey)
+
+			config, err := builder.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			routeErr := config.RouteError(manager.TLSTerminatedRouteKind, route.Name)
+			Expect(routeErr).ShouldNot(BeNil())
+			Expect(routeErr.Reason).Should(Equal(reason))
+		},
+			Entry("path regexp does not compile", func(r *operatorv1.TLSTerminatedRoute) {
+				r.Spec.PathMatch.PathRegexp = ptr.ToPtr("^/foo(bar$")
+			}, operatorv1.TLSRouteReasonInvalidSpec),
+			Entry("destination is not a URL", func(r *operatorv1.TLSTerminatedRoute) {
+				r.Spec.Destination = "://foobar"
+			}, operatorv1.TLSRouteReasonInvalidSpec),
+			Entry("destination has no scheme", func(r *operatorv1.TLSTerminatedRoute) {
+				r.Spec.Destination = "foobar"
+			}, operatorv1.TLSRouteReasonInvalidSpec),
+			Entry("CA bundle key does not exist", func(r *operatorv1.TLSTerminatedRoute) {
+				r.Spec.CABundle.Key = "missing"
+			}, operatorv1.TLSRouteReasonRefNotFound),
+			Entry("CA bundle is not PEM", func(r *operatorv1.TLSTerminatedRoute) {
+				caBundle.Data["ca.bundle"] = "bundle"
+			}, operatorv1.TLSRouteReasonInvalidRef),
+			Entry("mTLS key is not specified", func(r *operatorv1.TLSTerminatedRoute) {
+				r.Spec.ForwardingMTLSCert = &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: mtlsCert.Name},
+					Key:                  "cert.pem",
+				}
+			}, operatorv1.TLSRouteReasonInvalidSpec),
+			Entry("mTLS secret does not exist", func(r *operatorv1.TLSTerminatedRoute) {
+				r.Spec.ForwardingMTLSCert = &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "missing"},
+					Key:                  "cert.pem",
+				}
+				r.Spec.ForwardingMTLSKey = &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: mtlsKey.Name},
+					Key:                  "key.pem",
+				}
+			}, operatorv1.TLSRouteReasonRefNotFound),
+			Entry("mTLS key is a certificate", func(r *operatorv1.TLSTerminatedRoute) {
+				r.Spec.ForwardingMTLSCert = &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: mtlsCert.Name},
+					Key:                  "cert.pem",
+				}
+				r.Spec.ForwardingMTLSKey = &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: mtlsCert.Name},
+					Key:                  "cert.pem",
+				}
+			}, operatorv1.TLSRouteReasonInvalidRef),
+		)
+
+		When("one of several routes is invalid", func() {
+			It("applies the valid routes", func() {
+				route.Spec.Target = operatorv1.TargetTypeUI
+				route.Spec.CABundle = &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: "ca-bundle",
+					},
+					Key: "ca.bundle",
+				}
+				broken := *route.DeepCopy()
+				broken.Name = "broken-route"
+				broken.Spec.CABundle = &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: "missing",
+					},
+					Key: "ca.bundle",
+				}
+
+				builder.AddTLSTerminatedRoute(broken)
+				builder.AddTLSTerminatedRoute(route)
+				builder.AddConfigMap(caBundle)
+
+				config, err := builder.Build()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(config.RouteError(manager.TLSTerminatedRouteKind, route.Name)).Should(BeNil())
+				Expect(config.RouteError(manager.TLSTerminatedRouteKind, broken.Name).Reason).Should(Equal(operatorv1.TLSRouteReasonRefNotFound))
+
+				cm := config.RoutesConfigMap("tigera-manager")
+				Expect(compactJSONString(cm.Data["uiTLSTermRoutes.json"])).Should(Equal(
+					`[{"destination":"https://foobar","path":"/foobar","caBundlePath":"/config_maps/ca-bundle/ca.bundle","pathRegexp":"^/foobar$","pathReplace":"/"}]`,
+				))
+				Expect(config.VolumeMounts()).Should(Equal([]corev1.VolumeMount{caBundleVolumeMount, routesConfigMapVolumeMount}))
 			})
 		})
 
@@ -190,8 +305,8 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 				// so we can test that we don't go over the 63 char limit when the number of digits increase for the suffix.
 				num := 6
 				for i := 0; i < num; i++ {
-					mtlsCert.Data[fmt.Sprintf("%s%d", "cert.pem", i)] = []byte("bytes")
-					mtlsCert.Data[fmt.Sprintf("%s%d", "key.pem", i)] = []byte("bytes")
+					mtlsCert.Data[fmt.Sprintf("%s%d", "cert.pem", i)] = certPEM
+					mtlsCert.Data[fmt.Sprintf("%s%d", "key.pem", i)] = keyPEM
 					route := operatorv1.TLSTerminatedRoute{
 						ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%d-test-route", i), Namespace: "tigera-manager"},
 						Spec: operatorv1.TLSTerminatedRouteSpec{
@@ -200,6 +315,7 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 								PathRegexp:  ptr.ToPtr("^/foobar$"),
 								PathReplace: ptr.ToPtr("/"),
 							},
+							Destination: "https://foobar",
 						},
 					}
 
@@ -235,20 +351,20 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 				Expect(err).ShouldNot(HaveOccurred())
 
 				Expect(config.Annotations()).Should(Equal(map[string]string{
-					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflict": "b64f683d0e588b7b03b62f62460efd553df9491e",
-					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic1": "b64f683d0e588b7b03b62f62460efd553df9491e",
-					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic2": "b64f683d0e588b7b03b62f62460efd553df9491e",
-					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic3": "b64f683d0e588b7b03b62f62460efd553df9491e",
-					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic4": "b64f683d0e588b7b03b62f62460efd553df9491e",
-					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic5": "b64f683d0e588b7b03b62f62460efd553df9491e",
-					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic6": "b64f683d0e588b7b03b62f62460efd553df9491e",
-					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic7": "b64f683d0e588b7b03b62f62460efd553df9491e",
-					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic8": "b64f683d0e588b7b03b62f62460efd553df9491e",
-					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic9": "b64f683d0e588b7b03b62f62460efd553df9491e",
-					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconfli10": "b64f683d0e588b7b03b62f62460efd553df9491e",
-					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconfli11": "b64f683d0e588b7b03b62f62460efd553df9491e",
-					"hash.operator.tigera.io/routeconf-cm-ca-bundle-bundle":           "ed2e97c745074a9d7ed51a99ea4dfb8b337a3109",
-					"hash.operator.tigera.io/routeconf-cm-voltron-routes-uitlstermro": "05f3ffd328b6f86f89a9fb6814b6d2a8d8b12299",
+					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflict": "4dee9dacdd95956c17678b4b9fa6e1c34f0df113",
+					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic1": "4dee9dacdd95956c17678b4b9fa6e1c34f0df113",
+					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic2": "4dee9dacdd95956c17678b4b9fa6e1c34f0df113",
+					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic3": "4dee9dacdd95956c17678b4b9fa6e1c34f0df113",
+					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic4": "4dee9dacdd95956c17678b4b9fa6e1c34f0df113",
+					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic5": "4dee9dacdd95956c17678b4b9fa6e1c34f0df113",
+					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic6": "d9a6bf89dc2481c6d9fcc8b8e6b6a21f9a032ffa",
+					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic7": "d9a6bf89dc2481c6d9fcc8b8e6b6a21f9a032ffa",
+					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic8": "d9a6bf89dc2481c6d9fcc8b8e6b6a21f9a032ffa",
+					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconflic9": "d9a6bf89dc2481c6d9fcc8b8e6b6a21f9a032ffa",
+					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconfli10": "d9a6bf89dc2481c6d9fcc8b8e6b6a21f9a032ffa",
+					"hash.operator.tigera.io/routeconf-s-verylongnametoforceconfli11": "d9a6bf89dc2481c6d9fcc8b8e6b6a21f9a032ffa",
+					"hash.operator.tigera.io/routeconf-cm-ca-bundle-ca.bundle":        "4dee9dacdd95956c17678b4b9fa6e1c34f0df113",
+					"hash.operator.tigera.io/routeconf-cm-voltron-routes-uitlstermro": "574dbd78a9daf27dfb2a4969b85120403d9b8c76",
 				}))
 			})
 		})
@@ -271,8 +387,8 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 				Expect(err).ShouldNot(HaveOccurred())
 
 				Expect(config.Annotations()).Should(Equal(map[string]string{
-					"hash.operator.tigera.io/routeconf-cm-ca-bundle-bundle": "ed2e97c745074a9d7ed51a99ea4dfb8b337a3109",
-					routeCMKey: "ca2304ee9ca1739c7efdb1b2fc30a348041576c7",
+					"hash.operator.tigera.io/routeconf-cm-ca-bundle-ca.bundle": "4dee9dacdd95956c17678b4b9fa6e1c34f0df113",
+					routeCMKey: "af1d5887c5e7ee7e2574234cb8238b3b4a3876d6",
 				}))
 				Expect(config.VolumeMounts()).Should(Equal([]corev1.VolumeMount{caBundleVolumeMount, routesConfigMapVolumeMount}))
 
@@ -281,7 +397,7 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 				cm := config.RoutesConfigMap("tigera-manager")
 				cm.Data[fileName] = compactJSONString(cm.Data[fileName])
 
-				routesConfigMap.Data[fileName] = `[{"destination":"","path":"/foobar","caBundlePath":"/config_maps/ca-bundle/ca.bundle","pathRegexp":"^/foobar$","pathReplace":"/"}]`
+				routesConfigMap.Data[fileName] = `[{"destination":"https://foobar","path":"/foobar","caBundlePath":"/config_maps/ca-bundle/ca.bundle","pathRegexp":"^/foobar$","pathReplace":"/"}]`
 				Expect(cm).Should(Equal(routesConfigMap))
 			},
 				Entry("UI target", operatorv1.TargetTypeUI, "uiTLSTermRoutes.json", "hash.operator.tigera.io/routeconf-cm-voltron-routes-uitlstermro"),
@@ -299,23 +415,6 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 				}
 			})
 
-			It("returns an error if the MTLS key is not specified", func() {
-				route.Spec.Target = operatorv1.TargetTypeUI
-				route.Spec.ForwardingMTLSCert = &corev1.SecretKeySelector{
-					LocalObjectReference: corev1.LocalObjectReference{
-						Name: mtlsCert.Name,
-					},
-					Key: "cert.pem",
-				}
-
-				builder.AddTLSTerminatedRoute(route)
-				builder.AddConfigMap(caBundle)
-				builder.AddSecret(mtlsCert)
-
-				_, err := builder.Build()
-				Expect(err).Should(HaveOccurred())
-			})
-
 			DescribeTable("succeeds if the MTLS key is specified", func(target operatorv1.TargetType, fileName string, routeCMKey string) {
 				route.Spec.Target = target
 				route.Spec.ForwardingMTLSCert = &corev1.SecretKeySelector{
@@ -340,10 +439,10 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 				Expect(err).ShouldNot(HaveOccurred())
 
 				Expect(config.Annotations()).Should(Equal(map[string]string{
-					"hash.operator.tigera.io/routeconf-cm-ca-bundle-bundle":  "ed2e97c745074a9d7ed51a99ea4dfb8b337a3109",
-					"hash.operator.tigera.io/routeconf-s-mtls-cert-cert.pem": "e50bc7ce05be499174194858aaf077b556de4d4a",
-					"hash.operator.tigera.io/routeconf-s-mtls-key-key.pem":   "6b519c7eea53167b5fe03c86b7650ada4e7a4784",
-					routeCMKey: "89372dff23323c2dc393016ffa370df893ec0dd7",
+					"hash.operator.tigera.io/routeconf-cm-ca-bundle-ca.bundle": "4dee9dacdd95956c17678b4b9fa6e1c34f0df113",
+					"hash.operator.tigera.io/routeconf-s-mtls-cert-cert.pem":   "4dee9dacdd95956c17678b4b9fa6e1c34f0df113",
+					"hash.operator.tigera.io/routeconf-s-mtls-key-key.pem":     "d9a6bf89dc2481c6d9fcc8b8e6b6a21f9a032ffa",
+					routeCMKey: "52e6e579dd3c1515a59f866cf8dd2d4eeffd8e15",
 				}))
 				Expect(config.VolumeMounts()).Should(Equal([]corev1.VolumeMount{caBundleVolumeMount, routesConfigMapVolumeMount, mtlsCertVolumeMount, mtlsKeyVolumeMount}))
 
@@ -352,7 +451,7 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 				cm := config.RoutesConfigMap("tigera-manager")
 				cm.Data[fileName] = compactJSONString(cm.Data[fileName])
 
-				routesConfigMap.Data[fileName] = `[{"destination":"","path":"/foobar","caBundlePath":"/config_maps/ca-bundle/ca.bundle","pathRegexp":"^/foobar$","pathReplace":"/","clientCertPath":"/secrets/mtls-cert/cert.pem","clientKeyPath":"/secrets/mtls-key/key.pem"}]`
+				routesConfigMap.Data[fileName] = `[{"destination":"https://foobar","path":"/foobar","caBundlePath":"/config_maps/ca-bundle/ca.bundle","pathRegexp":"^/foobar$","pathReplace":"/","clientCertPath":"/secrets/mtls-cert/cert.pem","clientKeyPath":"/secrets/mtls-key/key.pem"}]`
 				Expect(cm).Should(Equal(routesConfigMap))
 			},
 				Entry("UI target", operatorv1.TargetTypeUI, "uiTLSTermRoutes.json", "hash.operator.tigera.io/routeconf-cm-voltron-routes-uitlstermro"),
@@ -379,7 +478,7 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 								PathRegexp:  ptr.ToPtr("^/bar/?"),
 								PathReplace: ptr.ToPtr("/"),
 							},
-							Destination: "bar",
+							Destination: "https://bar",
 						},
 					},
 					{
@@ -398,7 +497,7 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 								PathRegexp:  ptr.ToPtr("^/foo/?"),
 								PathReplace: ptr.ToPtr("/"),
 							},
-							Destination: "foo",
+							Destination: "https://foo",
 						},
 					},
 					{
@@ -417,7 +516,7 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 								PathRegexp:  ptr.ToPtr("^/goo/?"),
 								PathReplace: ptr.ToPtr("/"),
 							},
-							Destination: "goo",
+							Destination: "https://goo",
 						},
 					},
 				}
@@ -430,7 +529,7 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 						Name: "public-cert",
 					},
 					Data: map[string]string{
-						"tls.crt": "bundle",
+						"tls.crt": string(certPEM),
 					},
 				})
 				builder.AddConfigMap(&corev1.ConfigMap{
@@ -438,7 +537,7 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 						Name: "ca-bundle",
 					},
 					Data: map[string]string{
-						"ca-bundle.crt": "bundle",
+						"ca-bundle.crt": string(certPEM),
 					},
 				})
 				config, err := builder.Build()
@@ -465,6 +564,38 @@ var _ = Describe("VoltronRouteConfigBuilder", func() {
 			})
 		})
 	})
+
+	Context("TLSPassThroughRoutes", func() {
+		It("rejects a route without a server name", func() {
+			builder.AddTLSPassThroughRoute(operatorv1.TLSPassThroughRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "broken-route", Namespace: "tigera-manager"},
+				Spec: operatorv1.TLSPassThroughRouteSpec{
+					Target:      operatorv1.TargetTypeUpstreamTunnel,
+					SNIMatch:    &operatorv1.SNIMatch{},
+					Destination: "foobar:443",
+				},
+			})
+			builder.AddTLSPassThroughRoute(operatorv1.TLSPassThroughRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-route", Namespace: "tigera-manager"},
+				Spec: operatorv1.TLSPassThroughRouteSpec{
+					Target:      operatorv1.TargetTypeUpstreamTunnel,
+					SNIMatch:    &operatorv1.SNIMatch{ServerName: "foobar"},
+					Destination: "foobar:443",
+				},
+			})
+
+			config, err := builder.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(config.RouteError(manager.TLSPassThroughRouteKind, "broken-route")).Should(Equal(&manager.RouteError{
+				Reason:  operatorv1.TLSRouteReasonInvalidSpec,
+				Message: "sniMatch.serverName is required",
+			}))
+			Expect(config.RouteError(manager.TLSPassThroughRouteKind, "test-route")).Should(BeNil())
+
+			cm := config.RoutesConfigMap("tigera-manager")
+			Expect(compactJSONString(cm.Data["upTunTLSPTRoutes.json"])).Should(Equal(`[{"destination":"foobar:443","serverName":"foobar"}]`))
+		})
+	})
 })
 
 func compactJSONString(jsonStr string) string {
@@ -0,0 +1,268 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gapi "sigs.k8s.io/gateway-api/apis/v1"
+	gapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gapiv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/ptr"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
+)
+
+const (
+	// ManagerExposureName is the name of the Ingress, Gateway and routes that expose the manager outside of the cluster.
+	ManagerExposureName = "tigera-manager"
+
+	// ManagerIngressBackendProtocolAnnotation tells ingress-nginx to speak HTTPS to the manager.
+	ManagerIngressBackendProtocolAnnotation = "nginx.ingress.kubernetes.io/backend-protocol"
+
+	managerGatewayListenerName = "manager"
+	managerGatewayPort         = 443
+)
+
+// ManagerExposureHostname returns the hostname under which the manager is exposed, or an empty string if the
+// manager is not exposed outside of the cluster.
+func ManagerExposureHostname(m *operatorv1.Manager) string {
+	if m == nil || m.Spec.Exposure == nil {
+		return ""
+	}
+	return m.Spec.Exposure.Hostname
+}
+
+// exposureObjects returns the objects that expose the manager outside of the cluster, as well as the objects
+// belonging to exposure types that are not in use.
+func (c *managerComponent) exposureObjects() ([]client.Object, []client.Object) {
+	var exposure *operatorv1.ManagerExposure
+	if c.cfg.Manager != nil {
+		exposure = c.cfg.Manager.Spec.Exposure
+	}
+
+	ingress := c.managerIngress(exposure)
+	gateway := c.managerGateway(exposure)
+	tlsRoute := c.managerTLSRoute(exposure)
+	httpRoute := c.managerHTTPRoute(exposure)
+	backendTLSPolicy := c.managerBackendTLSPolicy(exposure)
+
+	if exposure == nil {
+		return nil, []client.Object{ingress, gateway, tlsRoute, httpRoute, backendTLSPolicy}
+	}
+
+	switch exposure.Type {
+	case operatorv1.ManagerExposureTypeIngress:
+		return []client.Object{ingress}, []client.Object{gateway, tlsRoute, httpRoute, backendTLSPolicy}
+	case operatorv1.ManagerExposureTypeGatewayAPI:
+		if exposure.GatewayAPI.GetRouteType() == operatorv1.ManagerGatewayRouteTypeHTTPRoute {
+			return []client.Object{gateway, httpRoute, backendTLSPolicy}, []client.Object{ingress, tlsRoute}
+		}
+		return []client.Object{gateway, tlsRoute}, []client.Object{ingress, httpRoute, backendTLSPolicy}
+	default:
+		// LoadBalancer exposure is handled on the manager Service itself.
+		return nil, []client.Object{ingress, gateway, tlsRoute, httpRoute, backendTLSPolicy}
+	}
+}
+
+// managerIngress returns an Ingress that routes the exposure hostname to the manager Service. TLS is terminated
+// by the ingress controller using the manager certificate and re-encrypted to Voltron.
+func (c *managerComponent) managerIngress(exposure *operatorv1.ManagerExposure) *netv1.Ingress {
+	ingress := &netv1.Ingress{
+		TypeMeta:   metav1.TypeMeta{Kind: "Ingress", APIVersion: "networking.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: ManagerExposureName, Namespace: c.cfg.Namespace},
+	}
+	if exposure == nil {
+		return ingress
+	}
+
+	ingress.Annotations = map[string]string{ManagerIngressBackendProtocolAnnotation: "HTTPS"}
+	if exposure.Ingress != nil {
+		ingress.Spec.IngressClassName = exposure.Ingress.IngressClassName
+		for k, v := range exposure.Ingress.Annotations {
+			ingress.Annotations[k] = v
+		}
+	}
+
+	pathType := netv1.PathTypePrefix
+	ingress.Spec.TLS = []netv1.IngressTLS{
+		{
+			Hosts:      []string{exposure.Hostname},
+			SecretName: c.cfg.TLSKeyPair.GetName(),
+		},
+	}
+	ingress.Spec.Rules = []netv1.IngressRule{
+		{
+			Host: exposure.Hostname,
+			IngressRuleValue: netv1.IngressRuleValue{
+				HTTP: &netv1.HTTPIngressRuleValue{
+					Paths: []netv1.HTTPIngressPath{
+						{
+							Path:     "/",
+							PathType: &pathType,
+							Backend: netv1.IngressBackend{
+								Service: &netv1.IngressServiceBackend{
+									Name: ManagerServiceName,
+									Port: netv1.ServiceBackendPort{Number: managerPort},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return ingress
+}
+
+// managerGateway returns a Gateway of the class provisioned by the GatewayAPI controller, with a single listener
+// for the exposure hostname. For TLSRoutes the listener passes TLS through to Voltron, for HTTPRoutes it
+// terminates TLS with the manager certificate.
+func (c *managerComponent) managerGateway(exposure *operatorv1.ManagerExposure) *gapi.Gateway {
+	gateway := &gapi.Gateway{
+		TypeMeta:   metav1.TypeMeta{Kind: "Gateway", APIVersion: "gateway.networking.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: ManagerExposureName, Namespace: c.cfg.Namespace},
+	}
+	if exposure == nil {
+		return gateway
+	}
+
+	hostname := gapi.Hostname(exposure.Hostname)
+	listener := gapi.Listener{
+		Name:     managerGatewayListenerName,
+		Hostname: &hostname,
+		Port:     managerGatewayPort,
+		Protocol: gapi.TLSProtocolType,
+		TLS:      &gapi.GatewayTLSConfig{Mode: ptr.ToPtr(gapi.TLSModePassthrough)},
+	}
+	if exposure.GatewayAPI.GetRouteType() == operatorv1.ManagerGatewayRouteTypeHTTPRoute {
+		listener.Protocol = gapi.HTTPSProtocolType
+		listener.TLS = &gapi.GatewayTLSConfig{
+			Mode: ptr.ToPtr(gapi.TLSModeTerminate),
+			CertificateRefs: []gapi.SecretObjectReference{
+				{Name: gapi.ObjectName(c.cfg.TLSKeyPair.GetName())},
+			},
+		}
+	}
+
+	gateway.Spec = gapi.GatewaySpec{
+		GatewayClassName: GatewayClassName,
+		Listeners:        []gapi.Listener{listener},
+	}
+	return gateway
+}
+
+// managerTLSRoute returns a TLSRoute that passes connections for the exposure hostname through to Voltron.
+func (c *managerComponent) managerTLSRoute(exposure *operatorv1.ManagerExposure) *gapiv1alpha2.TLSRoute {
+	route := &gapiv1alpha2.TLSRoute{
+		TypeMeta:   metav1.TypeMeta{Kind: "TLSRoute", APIVersion: "gateway.networking.k8s.io/v1alpha2"},
+		ObjectMeta: metav1.ObjectMeta{Name: ManagerExposureName, Namespace: c.cfg.Namespace},
+	}
+	if exposure == nil {
+		return route
+	}
+
+	route.Spec = gapiv1alpha2.TLSRouteSpec{
+		CommonRouteSpec: gapi.CommonRouteSpec{ParentRefs: []gapi.ParentReference{c.managerGatewayParentRef()}},
+		Hostnames:       []gapi.Hostname{gapi.Hostname(exposure.Hostname)},
+		Rules: []gapiv1alpha2.TLSRouteRule{
+			{BackendRefs: []gapi.BackendRef{managerServiceBackendRef()}},
+		},
+	}
+	return route
+}
+
+// managerHTTPRoute returns an HTTPRoute that forwards requests for the exposure hostname to Voltron.
+func (c *managerComponent) managerHTTPRoute(exposure *operatorv1.ManagerExposure) *gapi.HTTPRoute {
+	route := &gapi.HTTPRoute{
+		TypeMeta:   metav1.TypeMeta{Kind: "HTTPRoute", APIVersion: "gateway.networking.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: ManagerExposureName, Namespace: c.cfg.Namespace},
+	}
+	if exposure == nil {
+		return route
+	}
+
+	route.Spec = gapi.HTTPRouteSpec{
+		CommonRouteSpec: gapi.CommonRouteSpec{ParentRefs: []gapi.ParentReference{c.managerGatewayParentRef()}},
+		Hostnames:       []gapi.Hostname{gapi.Hostname(exposure.Hostname)},
+		Rules: []gapi.HTTPRouteRule{
+			{BackendRefs: []gapi.HTTPBackendRef{{BackendRef: managerServiceBackendRef()}}},
+		},
+	}
+	return route
+}
+
+// managerBackendTLSPolicy makes the gateway re-encrypt HTTPRoute traffic to Voltron, verifying the manager
+// certificate against the tigera CA bundle.
+func (c *managerComponent) managerBackendTLSPolicy(exposure *operatorv1.ManagerExposure) *gapiv1alpha3.BackendTLSPolicy {
+	policy := &gapiv1alpha3.BackendTLSPolicy{
+		TypeMeta:   metav1.TypeMeta{Kind: "BackendTLSPolicy", APIVersion: "gateway.networking.k8s.io/v1alpha3"},
+		ObjectMeta: metav1.ObjectMeta{Name: ManagerExposureName, Namespace: c.cfg.Namespace},
+	}
+	if exposure == nil {
+		return policy
+	}
+
+	policy.Spec = gapiv1alpha3.BackendTLSPolicySpec{
+		TargetRefs: []gapiv1alpha2.LocalPolicyTargetReferenceWithSectionName{
+			{
+				LocalPolicyTargetReference: gapiv1alpha2.LocalPolicyTargetReference{
+					Kind: "Service",
+					Name: ManagerServiceName,
+				},
+			},
+		},
+		Validation: gapiv1alpha3.BackendTLSPolicyValidation{
+			CACertificateRefs: []gapi.LocalObjectReference{
+				{Kind: "ConfigMap", Name: certificatemanagement.TrustedCertConfigMapName},
+			},
+			Hostname: gapi.PreciseHostname(exposure.Hostname),
+		},
+	}
+	return policy
+}
+
+func (c *managerComponent) managerGatewayParentRef() gapi.ParentReference {
+	return gapi.ParentReference{
+		Name:        ManagerExposureName,
+		SectionName: ptr.ToPtr(gapi.SectionName(managerGatewayListenerName)),
+	}
+}
+
+func managerServiceBackendRef() gapi.BackendRef {
+	return gapi.BackendRef{
+		BackendObjectReference: gapi.BackendObjectReference{
+			Name: ManagerServiceName,
+			Port: ptr.ToPtr(gapi.PortNumber(managerPort)),
+		},
+	}
+}
+
+// applyLoadBalancerExposure turns the manager Service into a LoadBalancer when the manager is exposed that way.
+func (c *managerComponent) applyLoadBalancerExposure(svc *corev1.Service) {
+	if c.cfg.Manager == nil || c.cfg.Manager.Spec.Exposure == nil || c.cfg.Manager.Spec.Exposure.Type != operatorv1.ManagerExposureTypeLoadBalancer {
+		return
+	}
+
+	svc.Spec.Type = corev1.ServiceTypeLoadBalancer
+	if lb := c.cfg.Manager.Spec.Exposure.LoadBalancer; lb != nil {
+		svc.Annotations = lb.Annotations
+		svc.Spec.LoadBalancerClass = lb.LoadBalancerClass
+		svc.Spec.LoadBalancerSourceRanges = lb.LoadBalancerSourceRanges
+	}
+}
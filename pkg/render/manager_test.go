@@ -25,12 +25,16 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gapi "sigs.k8s.io/gateway-api/apis/v1"
+	gapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gapiv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 	operatorv1 "github.com/tigera/operator/api/v1"
@@ -951,6 +955,164 @@ var _ = Describe("Tigera Secure Manager rendering tests", func() {
 		Expect(initContainer.Resources).To(Equal(managerResources))
 	})
 
+	Context("manager exposure", func() {
+		exposedManager := func(exposure *operatorv1.ManagerExposure) *operatorv1.Manager {
+			return &operatorv1.Manager{Spec: operatorv1.ManagerSpec{Exposure: exposure}}
+		}
+
+		renderExposure := func(manager *operatorv1.Manager) []client.Object {
+			return renderObjects(renderConfig{
+				installation: &operatorv1.InstallationSpec{ControlPlaneReplicas: &replicas},
+				compliance:   compliance,
+				ns:           render.ManagerNamespace,
+				manager:      manager,
+			})
+		}
+
+		It("should not render any exposure objects by default", func() {
+			resources := renderExposure(nil)
+			Expect(rtest.GetResource(resources, render.ManagerExposureName, render.ManagerNamespace, "networking.k8s.io", "v1", "Ingress")).To(BeNil())
+			Expect(rtest.GetResource(resources, render.ManagerExposureName, render.ManagerNamespace, "gateway.networking.k8s.io", "v1", "Gateway")).To(BeNil())
+
+			svc := rtest.GetResource(resources, render.ManagerServiceName, render.ManagerNamespace, "", "v1", "Service").(*corev1.Service)
+			Expect(svc.Spec.Type).To(BeEmpty())
+		})
+
+		It("should render an Ingress for the exposure hostname", func() {
+			className := "nginx"
+			resources := renderExposure(exposedManager(&operatorv1.ManagerExposure{
+				Type:     operatorv1.ManagerExposureTypeIngress,
+				Hostname: "manager.example.com",
+				Ingress: &operatorv1.ManagerIngress{
+					IngressClassName: &className,
+					Annotations:      map[string]string{"foo": "bar"},
+				},
+			}))
+
+			ingress := rtest.GetResource(resources, render.ManagerExposureName, render.ManagerNamespace, "networking.k8s.io", "v1", "Ingress").(*netv1.Ingress)
+			Expect(ingress.Spec.IngressClassName).To(Equal(&className))
+			Expect(ingress.Annotations).To(Equal(map[string]string{
+				render.ManagerIngressBackendProtocolAnnotation: "HTTPS",
+				"foo": "bar",
+			}))
+			Expect(ingress.Spec.TLS).To(ConsistOf(netv1.IngressTLS{Hosts: []string{"manager.example.com"}, SecretName: render.ManagerTLSSecretName}))
+			Expect(ingress.Spec.Rules).To(HaveLen(1))
+			Expect(ingress.Spec.Rules[0].Host).To(Equal("manager.example.com"))
+			backend := ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service
+			Expect(backend.Name).To(Equal(render.ManagerServiceName))
+			Expect(backend.Port.Number).To(BeEquivalentTo(9443))
+		})
+
+		It("should render a passthrough Gateway and TLSRoute by default for Gateway API exposure", func() {
+			resources := renderExposure(exposedManager(&operatorv1.ManagerExposure{
+				Type:     operatorv1.ManagerExposureTypeGatewayAPI,
+				Hostname: "manager.example.com",
+			}))
+
+			gateway := rtest.GetResource(resources, render.ManagerExposureName, render.ManagerNamespace, "gateway.networking.k8s.io", "v1", "Gateway").(*gapi.Gateway)
+			Expect(gateway.Spec.GatewayClassName).To(BeEquivalentTo(render.GatewayClassName))
+			Expect(gateway.Spec.Listeners).To(HaveLen(1))
+			Expect(gateway.Spec.Listeners[0].Protocol).To(Equal(gapi.TLSProtocolType))
+			Expect(*gateway.Spec.Listeners[0].TLS.Mode).To(Equal(gapi.TLSModePassthrough))
+			Expect(*gateway.Spec.Listeners[0].Hostname).To(BeEquivalentTo("manager.example.com"))
+
+			route := rtest.GetResource(resources, render.ManagerExposureName, render.ManagerNamespace, "gateway.networking.k8s.io", "v1alpha2", "TLSRoute").(*gapiv1alpha2.TLSRoute)
+			Expect(route.Spec.Hostnames).To(ConsistOf(gapi.Hostname("manager.example.com")))
+			Expect(route.Spec.ParentRefs[0].Name).To(BeEquivalentTo(render.ManagerExposureName))
+			Expect(route.Spec.Rules[0].BackendRefs[0].Name).To(BeEquivalentTo(render.ManagerServiceName))
+
+			Expect(rtest.GetResource(resources, render.ManagerExposureName, render.ManagerNamespace, "gateway.networking.k8s.io", "v1", "HTTPRoute")).To(BeNil())
+		})
+
+		It("should render a terminating Gateway, HTTPRoute and BackendTLSPolicy for HTTPRoute exposure", func() {
+			routeType := operatorv1.ManagerGatewayRouteTypeHTTPRoute
+			resources := renderExposure(exposedManager(&operatorv1.ManagerExposure{
+				Type:       operatorv1.ManagerExposureTypeGatewayAPI,
+				Hostname:   "manager.example.com",
+				GatewayAPI: &operatorv1.ManagerGatewayAPI{RouteType: &routeType},
+			}))
+
+			gateway := rtest.GetResource(resources, render.ManagerExposureName, render.ManagerNamespace, "gateway.networking.k8s.io", "v1", "Gateway").(*gapi.Gateway)
+			Expect(gateway.Spec.Listeners[0].Protocol).To(Equal(gapi.HTTPSProtocolType))
+			Expect(*gateway.Spec.Listeners[0].TLS.Mode).To(Equal(gapi.TLSModeTerminate))
+			Expect(gateway.Spec.Listeners[0].TLS.CertificateRefs[0].Name).To(BeEquivalentTo(render.ManagerTLSSecretName))
+
+			route := rtest.GetResource(resources, render.ManagerExposureName, render.ManagerNamespace, "gateway.networking.k8s.io", "v1", "HTTPRoute").(*gapi.HTTPRoute)
+			Expect(route.Spec.Hostnames).To(ConsistOf(gapi.Hostname("manager.example.com")))
+
+			policy := rtest.GetResource(resources, render.ManagerExposureName, render.ManagerNamespace, "gateway.networking.k8s.io", "v1alpha3", "BackendTLSPolicy").(*gapiv1alpha3.BackendTLSPolicy)
+			Expect(policy.Spec.TargetRefs[0].Name).To(BeEquivalentTo(render.ManagerServiceName))
+			Expect(policy.Spec.Validation.Hostname).To(BeEquivalentTo("manager.example.com"))
+			Expect(policy.Spec.Validation.CACertificateRefs[0].Name).To(BeEquivalentTo(certificatemanagement.TrustedCertConfigMapName))
+
+			Expect(rtest.GetResource(resources, render.ManagerExposureName, render.ManagerNamespace, "gateway.networking.k8s.io", "v1alpha2", "TLSRoute")).To(BeNil())
+		})
+
+		It("should render the manager Service as a LoadBalancer", func() {
+			lbClass := "service.k8s.aws/nlb"
+			resources := renderExposure(exposedManager(&operatorv1.ManagerExposure{
+				Type:     operatorv1.ManagerExposureTypeLoadBalancer,
+				Hostname: "manager.example.com",
+				LoadBalancer: &operatorv1.ManagerLoadBalancer{
+					Annotations:              map[string]string{"foo": "bar"},
+					LoadBalancerClass:        &lbClass,
+					LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+				},
+			}))
+
+			svc := rtest.GetResource(resources, render.ManagerServiceName, render.ManagerNamespace, "", "v1", "Service").(*corev1.Service)
+			Expect(svc.Spec.Type).To(Equal(corev1.ServiceTypeLoadBalancer))
+			Expect(svc.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(svc.Spec.LoadBalancerClass).To(Equal(&lbClass))
+			Expect(svc.Spec.LoadBalancerSourceRanges).To(ConsistOf("10.0.0.0/8"))
+			Expect(rtest.GetResource(resources, render.ManagerExposureName, render.ManagerNamespace, "networking.k8s.io", "v1", "Ingress")).To(BeNil())
+		})
+	})
+
+	Context("branding and features", func() {
+		managerEnv := func(manager *operatorv1.Manager, tenant *operatorv1.Tenant) []corev1.EnvVar {
+			resources := renderObjects(renderConfig{
+				installation: &operatorv1.InstallationSpec{ControlPlaneReplicas: &replicas},
+				compliance:   compliance,
+				ns:           render.ManagerNamespace,
+				manager:      manager,
+				tenant:       tenant,
+			})
+			d := rtest.GetResource(resources, "tigera-manager", render.ManagerNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			return test.GetContainer(d.Spec.Template.Spec.Containers, "tigera-manager").Env
+		}
+
+		It("should render default branding and features", func() {
+			env := managerEnv(nil, nil)
+			Expect(env).To(ContainElements(
+				corev1.EnvVar{Name: "CNX_CLUSTER_NAME", Value: "cluster"},
+				corev1.EnvVar{Name: "CNX_ALP_SUPPORT", Value: "true"},
+				corev1.EnvVar{Name: "CNX_POLICY_RECOMMENDATION_SUPPORT", Value: "true"},
+				corev1.EnvVar{Name: "ENABLE_KIBANA", Value: "true"},
+			))
+		})
+
+		It("should render the configured branding and features", func() {
+			disabled := operatorv1.ManagerFeatureDisabled
+			env := managerEnv(&operatorv1.Manager{Spec: operatorv1.ManagerSpec{
+				Branding: &operatorv1.ManagerBranding{
+					ClusterName: "eu-west-prod-1",
+				},
+				Features: &operatorv1.ManagerFeatures{
+					PolicyRecommendation:   &disabled,
+					ApplicationLayerPolicy: &disabled,
+					Kibana:                 &disabled,
+				},
+			}}, nil)
+			Expect(env).To(ContainElements(
+				corev1.EnvVar{Name: "CNX_CLUSTER_NAME", Value: "eu-west-prod-1"},
+				corev1.EnvVar{Name: "CNX_ALP_SUPPORT", Value: "false"},
+				corev1.EnvVar{Name: "CNX_POLICY_RECOMMENDATION_SUPPORT", Value: "false"},
+				corev1.EnvVar{Name: "ENABLE_KIBANA", Value: "false"},
+			))
+		})
+	})
+
 	Context("allow-tigera rendering", func() {
 		policyName := types.NamespacedName{Name: "allow-tigera.manager-access", Namespace: "tigera-manager"}
 
@@ -0,0 +1,176 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"fmt"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/render"
+)
+
+// AlertRulesVersion is the version of the curated alerting rules. It is bumped whenever the rules change, so that
+// users can tell which set of rules is deployed.
+const AlertRulesVersion = "1"
+
+// alertDefinition is a curated alerting rule. Expr is a format string that receives the threshold when the alert has
+// one.
+type alertDefinition struct {
+	name             string
+	expr             string
+	defaultThreshold int32
+	defaultFor       monitoringv1.Duration
+	defaultSeverity  operatorv1.AlertSeverity
+	summary          string
+	description      string
+	rule             func(*operatorv1.MonitorAlerts) *operatorv1.AlertRule
+
+	// metricsTarget is the name of the metrics target that provides the metric of the alert, if the target is
+	// optional. The alert is omitted when the target is not configured, since it could never fire.
+	metricsTarget string
+}
+
+func (d alertDefinition) hasThreshold() bool {
+	return d.defaultThreshold != 0
+}
+
+var alertDefinitions = []alertDefinition{
+	{
+		name:             "DeniedPacketsRate",
+		expr:             "rate(calico_denied_packets[10s]) > %d",
+		defaultThreshold: 50,
+		defaultSeverity:  operatorv1.AlertSeverityCritical,
+		summary:          "Instance {{$labels.instance}} - Large rate of packets denied",
+		description:      "{{$labels.instance}} with calico-node pod {{$labels.pod}} has been denying packets at a fast rate {{$labels.sourceIp}} by policy {{$labels.policy}}.",
+		rule:             func(a *operatorv1.MonitorAlerts) *operatorv1.AlertRule { return a.DeniedPacketsRate },
+	},
+	{
+		name:            "FelixDown",
+		expr:            fmt.Sprintf(`up{job="%s"} == 0`, render.CalicoNodeMetricsService),
+		defaultFor:      "5m",
+		defaultSeverity: operatorv1.AlertSeverityCritical,
+		summary:         "Instance {{$labels.instance}} - Felix is down",
+		description:     "Felix metrics of calico-node pod {{$labels.pod}} cannot be scraped.",
+		rule:            func(a *operatorv1.MonitorAlerts) *operatorv1.AlertRule { return a.FelixDown },
+	},
+	{
+		name:            "TyphaDown",
+		expr:            fmt.Sprintf(`up{job="%s"} == 0`, render.TyphaMetricsName),
+		defaultFor:      "5m",
+		defaultSeverity: operatorv1.AlertSeverityCritical,
+		summary:         "Instance {{$labels.instance}} - Typha is down",
+		description:     "Metrics of Typha pod {{$labels.pod}} cannot be scraped.",
+		rule:            func(a *operatorv1.MonitorAlerts) *operatorv1.AlertRule { return a.TyphaDown },
+	},
+	{
+		name:            "BGPSessionDown",
+		expr:            `sum by (instance, pod) (bgp_peers{status!="Established"}) > 0`,
+		defaultFor:      "5m",
+		defaultSeverity: operatorv1.AlertSeverityWarning,
+		summary:         "Instance {{$labels.instance}} - BGP session down",
+		description:     "calico-node pod {{$labels.pod}} has {{$value}} BGP peers that are not established.",
+		rule:            func(a *operatorv1.MonitorAlerts) *operatorv1.AlertRule { return a.BGPSessionDown },
+	},
+	{
+		name:             "IPAMExhaustion",
+		expr:             "sum by (ippool) (ipam_allocations_in_use) / max by (ippool) (ipam_ippool_size) * 100 > %d",
+		defaultThreshold: 90,
+		defaultFor:       "15m",
+		defaultSeverity:  operatorv1.AlertSeverityWarning,
+		summary:          "IP pool {{$labels.ippool}} is nearly exhausted",
+		description:      "{{$value | humanize}}% of the addresses of IP pool {{$labels.ippool}} are allocated.",
+		rule:             func(a *operatorv1.MonitorAlerts) *operatorv1.AlertRule { return a.IPAMExhaustion },
+	},
+	{
+		name:            "ElasticsearchClusterHealth",
+		expr:            `elasticsearch_cluster_health_status{color="red"} == 1`,
+		defaultFor:      "5m",
+		defaultSeverity: operatorv1.AlertSeverityCritical,
+		summary:         "Elasticsearch cluster health is red",
+		description:     "The Elasticsearch cluster has unassigned primary shards, so some logs cannot be stored or queried.",
+		rule:            func(a *operatorv1.MonitorAlerts) *operatorv1.AlertRule { return a.ElasticsearchClusterHealth },
+	},
+	{
+		name:            "GuardianTunnelDown",
+		expr:            "tigera_operator_guardian_tunnel_connected == 0",
+		defaultFor:      "5m",
+		defaultSeverity: operatorv1.AlertSeverityCritical,
+		summary:         "Guardian tunnel is down",
+		description:     "Guardian does not have an established tunnel to the management cluster.",
+		rule:            func(a *operatorv1.MonitorAlerts) *operatorv1.AlertRule { return a.GuardianTunnelDown },
+		metricsTarget:   OperatorMetricsName,
+	},
+	{
+		name:             "CertificateExpiry",
+		expr:             "(tigera_operator_certificate_expiry_timestamp_seconds - time()) / 86400 < %d",
+		defaultThreshold: 21,
+		defaultSeverity:  operatorv1.AlertSeverityWarning,
+		summary:          "Certificate {{$labels.namespace}}/{{$labels.name}} expires soon",
+		description:      "The certificate in secret {{$labels.namespace}}/{{$labels.name}} expires in {{$value | humanize}} days.",
+		rule:             func(a *operatorv1.MonitorAlerts) *operatorv1.AlertRule { return a.CertificateExpiry },
+		metricsTarget:    OperatorMetricsName,
+	},
+}
+
+// alertRules returns the enabled curated alerting rules, with the overrides of the Monitor CR applied.
+func (mc *monitorComponent) alertRules() []monitoringv1.Rule {
+	alerts := mc.cfg.Monitor.Alerts
+	if alerts == nil {
+		alerts = &operatorv1.MonitorAlerts{}
+	}
+
+	var rules []monitoringv1.Rule
+	for _, d := range alertDefinitions {
+		override := d.rule(alerts)
+		if !override.IsEnabled() || (d.metricsTarget != "" && !mc.hasMetricsTarget(d.metricsTarget)) {
+			continue
+		}
+
+		expr := d.expr
+		if d.hasThreshold() {
+			threshold := d.defaultThreshold
+			if override != nil && override.Threshold != nil {
+				threshold = *override.Threshold
+			}
+			expr = fmt.Sprintf(d.expr, threshold)
+		}
+
+		severity := d.defaultSeverity
+		if override != nil && override.Severity != nil {
+			severity = *override.Severity
+		}
+
+		rule := monitoringv1.Rule{
+			Alert:  d.name,
+			Expr:   intstr.FromString(expr),
+			Labels: map[string]string{"severity": string(severity)},
+			Annotations: map[string]string{
+				"summary":     d.summary,
+				"description": d.description,
+			},
+		}
+		if override != nil && override.For != nil {
+			rule.For = override.For
+		} else if d.defaultFor != "" {
+			forDuration := d.defaultFor
+			rule.For = &forDuration
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
@@ -0,0 +1,690 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	calicrypto "github.com/tigera/operator/pkg/crypto"
+	"github.com/tigera/operator/pkg/ptr"
+	"github.com/tigera/operator/pkg/render"
+	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/networkpolicy"
+	"github.com/tigera/operator/pkg/render/common/secret"
+	"github.com/tigera/operator/pkg/render/common/securitycontext"
+	"github.com/tigera/operator/pkg/render/common/securitycontextconstraints"
+)
+
+const (
+	GrafanaName            = "tigera-grafana"
+	GrafanaPolicyName      = networkpolicy.TigeraComponentPolicyPrefix + GrafanaName
+	GrafanaTLSSecretName   = "tigera-grafana-tls"
+	GrafanaAdminSecretName = "tigera-grafana-admin"
+	GrafanaPort            = 3000
+
+	// GrafanaDashboardConfigMapPrefix is the prefix of the names of the ConfigMaps that contain the bundled dashboards.
+	GrafanaDashboardConfigMapPrefix = "tigera-grafana-dashboard-"
+
+	// GrafanaDashboardLabel marks the dashboard ConfigMaps managed by the operator, so that they can be found and removed
+	// from any namespace.
+	GrafanaDashboardLabel = "operator.tigera.io/grafana-dashboard"
+
+	GrafanaAdminUsernameKey = "username"
+	GrafanaAdminPasswordKey = "password"
+
+	grafanaConfigPath           = "/etc/grafana/config"
+	grafanaProvisioningPath     = "/etc/grafana/provisioning"
+	grafanaDashboardsPath       = "/etc/grafana/dashboards"
+	grafanaDataPath             = "/var/lib/grafana"
+	grafanaHealthEndpoint       = "/api/health"
+	grafanaFolderAnnotation     = "grafana_folder"
+	grafanaConfigHashAnnotation = "hash.operator.tigera.io/grafana-config"
+	grafanaAdminHashAnnotation  = "hash.operator.tigera.io/grafana-admin"
+	grafanaOAuthHashAnnotation  = "hash.operator.tigera.io/grafana-oauth"
+)
+
+// defaultGrafanaDashboardLabels are the labels that the Grafana dashboard sidecar selects by default.
+var defaultGrafanaDashboardLabels = map[string]string{"grafana_dashboard": "1"}
+
+//go:embed dashboards/*.json
+var grafanaDashboards embed.FS
+
+// CreateGrafanaAdminSecret returns a new secret with the credentials of the Grafana admin user.
+func CreateGrafanaAdminSecret() *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GrafanaAdminSecretName,
+			Namespace: common.OperatorNamespace(),
+		},
+		Data: map[string][]byte{
+			GrafanaAdminUsernameKey: []byte("admin"),
+			GrafanaAdminPasswordKey: []byte(calicrypto.GeneratePassword(24)),
+		},
+	}
+}
+
+// GrafanaDeployed returns true if the Monitor spec requests the operator to deploy Grafana.
+func GrafanaDeployed(spec operatorv1.MonitorSpec) bool {
+	return spec.Grafana != nil && spec.Grafana.GetMode() == operatorv1.GrafanaModeDeployment
+}
+
+// GrafanaURL returns the address that users open the deployed Grafana on, or an empty string if Grafana is not
+// deployed or its address is not set. Users can only sign in to Grafana through Dex if its address is known.
+func GrafanaURL(spec operatorv1.MonitorSpec) string {
+	if !GrafanaDeployed(spec) {
+		return ""
+	}
+	return strings.TrimSuffix(spec.Grafana.URL, "/")
+}
+
+// GrafanaDashboardsNamespace returns the namespace of the ConfigMaps that contain the bundled dashboards.
+func GrafanaDashboardsNamespace(spec operatorv1.MonitorSpec) string {
+	if spec.Grafana != nil && spec.Grafana.Dashboards != nil && spec.Grafana.Dashboards.Namespace != "" {
+		return spec.Grafana.Dashboards.Namespace
+	}
+	return common.TigeraPrometheusNamespace
+}
+
+// grafanaDashboardNames returns the names of the bundled dashboards, sorted.
+func grafanaDashboardNames() []string {
+	entries, err := grafanaDashboards.ReadDir("dashboards")
+	if err != nil {
+		// Panic since this would be a developer error, as the dashboards are embedded.
+		panic(err)
+	}
+	var names []string
+	for _, e := range entries {
+		names = append(names, strings.TrimSuffix(e.Name(), ".json"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// grafanaObjects returns the objects to create and delete for the Grafana configuration of the Monitor spec.
+func (mc *monitorComponent) grafanaObjects() ([]client.Object, []client.Object) {
+	if mc.cfg.Monitor.Grafana == nil {
+		return nil, append(mc.staleGrafanaDashboards(nil), grafanaDeploymentObjectsToDelete()...)
+	}
+
+	toCreate := mc.grafanaDashboardConfigMaps()
+	toDelete := mc.staleGrafanaDashboards(toCreate)
+	if !GrafanaDeployed(mc.cfg.Monitor) {
+		return toCreate, append(toDelete, grafanaDeploymentObjectsToDelete()...)
+	}
+
+	toCreate = append(toCreate, secret.ToRuntimeObjects(secret.CopyToNamespace(common.TigeraPrometheusNamespace, mc.cfg.GrafanaAdminSecret)...)...)
+	if mc.grafanaOAuthEnabled() {
+		toCreate = append(toCreate, secret.ToRuntimeObjects(secret.CopyToNamespace(common.TigeraPrometheusNamespace, mc.cfg.DexClientSecret)...)...)
+	}
+	toCreate = append(toCreate,
+		mc.grafanaServiceAccount(),
+		mc.grafanaClusterRole(),
+		mc.grafanaClusterRoleBinding(),
+		mc.grafanaTokenSecret(),
+		mc.grafanaConfigMap(),
+		mc.grafanaDeployment(),
+		mc.grafanaService(),
+	)
+	return toCreate, toDelete
+}
+
+// staleGrafanaDashboards returns the existing dashboard ConfigMaps that are not in dashboards, such as the ones left in
+// the previous namespace after the dashboards namespace changed.
+func (mc *monitorComponent) staleGrafanaDashboards(dashboards []client.Object) []client.Object {
+	rendered := map[client.ObjectKey]bool{}
+	for _, obj := range dashboards {
+		rendered[client.ObjectKeyFromObject(obj)] = true
+	}
+
+	var toDelete []client.Object
+	for _, key := range mc.cfg.GrafanaDashboards {
+		if !rendered[key] {
+			toDelete = append(toDelete, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}})
+		}
+	}
+	return toDelete
+}
+
+// grafanaDeploymentObjectsToDelete returns the objects of the Grafana deployment, for when Grafana is not deployed.
+func grafanaDeploymentObjectsToDelete() []client.Object {
+	meta := metav1.ObjectMeta{Name: GrafanaName, Namespace: common.TigeraPrometheusNamespace}
+	return []client.Object{
+		&corev1.ServiceAccount{ObjectMeta: meta},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: GrafanaName}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: GrafanaName}},
+		&corev1.Secret{ObjectMeta: meta},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: GrafanaAdminSecretName, Namespace: common.TigeraPrometheusNamespace}},
+		&corev1.ConfigMap{ObjectMeta: meta},
+		&appsv1.Deployment{ObjectMeta: meta},
+		&corev1.Service{ObjectMeta: meta},
+	}
+}
+
+// grafanaDashboardConfigMaps returns a ConfigMap for each of the bundled dashboards, labeled so that the Grafana
+// dashboard sidecar picks them up.
+func (mc *monitorComponent) grafanaDashboardConfigMaps() []client.Object {
+	sidecarLabels := defaultGrafanaDashboardLabels
+	var annotations map[string]string
+	if g := mc.cfg.Monitor.Grafana; g != nil && g.Dashboards != nil {
+		if len(g.Dashboards.Labels) > 0 {
+			sidecarLabels = g.Dashboards.Labels
+		}
+		if g.Dashboards.Folder != "" {
+			annotations = map[string]string{grafanaFolderAnnotation: g.Dashboards.Folder}
+		}
+	}
+
+	labels := map[string]string{GrafanaDashboardLabel: "true"}
+	for k, v := range sidecarLabels {
+		labels[k] = v
+	}
+
+	var objs []client.Object
+	for _, name := range grafanaDashboardNames() {
+		data, err := grafanaDashboards.ReadFile(path.Join("dashboards", name+".json"))
+		if err != nil {
+			panic(err)
+		}
+		objs = append(objs, &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        GrafanaDashboardConfigMapPrefix + name,
+				Namespace:   GrafanaDashboardsNamespace(mc.cfg.Monitor),
+				Labels:      labels,
+				Annotations: annotations,
+			},
+			Data: map[string]string{name + ".json": string(data)},
+		})
+	}
+	return objs
+}
+
+func (mc *monitorComponent) grafanaServiceAccount() *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: GrafanaName, Namespace: common.TigeraPrometheusNamespace},
+	}
+}
+
+// grafanaClusterRole allows Grafana to query Prometheus through the authn-proxy.
+func (mc *monitorComponent) grafanaClusterRole() *rbacv1.ClusterRole {
+	rules := []rbacv1.PolicyRule{
+		{
+			// The authn-proxy authorizes access to the Prometheus API using the services/proxy resources.
+			APIGroups:     []string{""},
+			Resources:     []string{"services/proxy"},
+			ResourceNames: []string{"https:tigera-api:8080", "calico-node-prometheus:9090"},
+			Verbs:         []string{"get", "create"},
+		},
+	}
+	if mc.cfg.OpenShift {
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups:     []string{"security.openshift.io"},
+			Resources:     []string{"securitycontextconstraints"},
+			Verbs:         []string{"use"},
+			ResourceNames: []string{securitycontextconstraints.NonRootV2},
+		})
+	}
+	return &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: GrafanaName},
+		Rules:      rules,
+	}
+}
+
+func (mc *monitorComponent) grafanaClusterRoleBinding() *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		TypeMeta:   metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: GrafanaName},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     GrafanaName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      GrafanaName,
+				Namespace: common.TigeraPrometheusNamespace,
+			},
+		},
+	}
+}
+
+// grafanaTokenSecret creates the bearer token that Grafana uses to query Prometheus.
+func (mc *monitorComponent) grafanaTokenSecret() *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GrafanaName,
+			Namespace: common.TigeraPrometheusNamespace,
+			// The annotation below will result in the auto-creation of spec.data.token.
+			Annotations: map[string]string{
+				"kubernetes.io/service-account.name": GrafanaName,
+			},
+		},
+		Type: corev1.SecretTypeServiceAccountToken,
+	}
+}
+
+// grafanaConfigMap contains the Grafana configuration file and the provisioning of the Prometheus data source and the
+// bundled dashboards.
+func (mc *monitorComponent) grafanaConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: GrafanaName, Namespace: common.TigeraPrometheusNamespace},
+		Data: map[string]string{
+			"grafana.ini":      mc.grafanaIni(),
+			"datasources.yaml": mc.grafanaDatasources(),
+			"dashboards.yaml":  grafanaDashboardProviders(),
+		},
+	}
+}
+
+// grafanaIni returns the Grafana configuration. Grafana serves HTTPS with a certificate issued by the operator. When
+// Grafana is registered as a Dex client, users sign in with the same identity providers as the manager, and their
+// Grafana role is derived from their groups.
+func (mc *monitorComponent) grafanaIni() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `[server]
+protocol = https
+http_port = %d
+cert_file = %s
+cert_key = %s
+`,
+		GrafanaPort,
+		mc.cfg.GrafanaTLSSecret.VolumeMountCertificateFilePath(),
+		mc.cfg.GrafanaTLSSecret.VolumeMountKeyFilePath(),
+	)
+	if url := GrafanaURL(mc.cfg.Monitor); url != "" {
+		fmt.Fprintf(&sb, "root_url = %s\n", url)
+	}
+	fmt.Fprintf(&sb, `
+[paths]
+data = %s
+provisioning = %s
+
+[analytics]
+reporting_enabled = false
+check_for_updates = false
+
+[security]
+cookie_secure = true
+disable_gravatar = true
+
+[users]
+allow_sign_up = false
+auto_assign_org_role = Viewer
+
+[auth.anonymous]
+enabled = false
+`,
+		grafanaDataPath,
+		grafanaProvisioningPath,
+	)
+
+	if mc.grafanaOAuthEnabled() {
+		// The browser is redirected to the public issuer to sign in, while Grafana exchanges the code and reads the
+		// user info through the Dex service. The client secret is set through the environment.
+		env := mc.cfg.KeyValidatorConfig.RequiredEnv("")
+		dexURL := strings.TrimSuffix(envValue(env, "DEX_URL"), "/")
+		fmt.Fprintf(&sb, `
+[auth.generic_oauth]
+enabled = true
+name = Calico
+client_id = %s
+scopes = openid email profile groups offline_access
+auth_url = %s/auth
+token_url = %s/dex/token
+api_url = %s/dex/userinfo
+tls_client_ca = %s
+use_pkce = true
+allow_sign_up = true
+login_attribute_path = %s
+email_attribute_path = email
+groups_attribute_path = groups
+role_attribute_path = %s
+`,
+			render.DexGrafanaClientID,
+			mc.cfg.KeyValidatorConfig.Issuer(),
+			dexURL,
+			dexURL,
+			mc.cfg.TrustedCertBundle.MountPath(),
+			envValue(env, "OIDC_AUTH_USERNAME_CLAIM"),
+			grafanaRoleAttributePath(mc.cfg.Monitor.Grafana.RoleMappings),
+		)
+	}
+	return sb.String()
+}
+
+// grafanaOAuthEnabled returns true if users sign in to Grafana through Dex. This requires the address of Grafana, to
+// which Dex redirects users, and the Dex client secret.
+func (mc *monitorComponent) grafanaOAuthEnabled() bool {
+	if _, ok := mc.cfg.KeyValidatorConfig.(*render.DexKeyValidatorConfig); !ok {
+		return false
+	}
+	return GrafanaURL(mc.cfg.Monitor) != "" && mc.cfg.DexClientSecret != nil
+}
+
+// grafanaRoleAttributePath returns the JMESPath expression that Grafana evaluates against the user info to determine
+// the role of a user. The first mapping with a group of the user applies, and users without a mapped group are
+// Viewers.
+func grafanaRoleAttributePath(mappings []operatorv1.GrafanaRoleMapping) string {
+	var terms []string
+	for _, m := range mappings {
+		for _, group := range m.Groups {
+			terms = append(terms, fmt.Sprintf("contains(groups[*], '%s') && '%s'", jmesPathLiteral(group), m.Role))
+		}
+	}
+	terms = append(terms, fmt.Sprintf("'%s'", operatorv1.GrafanaRoleViewer))
+	return strings.Join(terms, " || ")
+}
+
+// jmesPathLiteral escapes the value for use in a JMESPath raw string literal.
+func jmesPathLiteral(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+}
+
+// grafanaDatasources provisions the Calico Prometheus as the default data source. Grafana authenticates with the token
+// of its service account and verifies the Prometheus certificate with the trusted bundle.
+func (mc *monitorComponent) grafanaDatasources() string {
+	return fmt.Sprintf(`apiVersion: 1
+datasources:
+- name: Calico Prometheus
+  uid: calico-prometheus
+  type: prometheus
+  access: proxy
+  isDefault: true
+  editable: false
+  url: https://%s.%s.svc:%d
+  jsonData:
+    tlsAuthWithCACert: true
+    httpHeaderName1: Authorization
+  secureJsonData:
+    tlsCACert: $__file{%s}
+    httpHeaderValue1: Bearer ${PROMETHEUS_TOKEN}
+`,
+		PrometheusServiceServiceName,
+		common.TigeraPrometheusNamespace,
+		PrometheusDefaultPort,
+		mc.cfg.TrustedCertBundle.MountPath(),
+	)
+}
+
+func grafanaDashboardProviders() string {
+	return fmt.Sprintf(`apiVersion: 1
+providers:
+- name: calico
+  folder: Calico
+  type: file
+  disableDeletion: true
+  allowUiUpdates: false
+  options:
+    path: %s
+`, grafanaDashboardsPath)
+}
+
+func (mc *monitorComponent) grafanaDeployment() *appsv1.Deployment {
+	var initContainers []corev1.Container
+	if mc.cfg.GrafanaTLSSecret.UseCertificateManagement() {
+		initContainers = append(initContainers, mc.cfg.GrafanaTLSSecret.InitContainer(common.TigeraPrometheusNamespace))
+	}
+
+	annotations := mc.cfg.TrustedCertBundle.HashAnnotations()
+	annotations[mc.cfg.GrafanaTLSSecret.HashAnnotationKey()] = mc.cfg.GrafanaTLSSecret.HashAnnotationValue()
+	annotations[grafanaConfigHashAnnotation] = rmeta.AnnotationHash(mc.grafanaConfigMap().Data)
+	if mc.cfg.GrafanaAdminSecret != nil {
+		annotations[grafanaAdminHashAnnotation] = rmeta.AnnotationHash(mc.cfg.GrafanaAdminSecret.Data)
+	}
+	if mc.grafanaOAuthEnabled() {
+		annotations[grafanaOAuthHashAnnotation] = rmeta.AnnotationHash(mc.cfg.DexClientSecret.Data)
+	}
+	if mc.cfg.KeyValidatorConfig != nil {
+		for k, v := range mc.cfg.KeyValidatorConfig.RequiredAnnotations() {
+			annotations[k] = v
+		}
+	}
+
+	var dashboardSources []corev1.VolumeProjection
+	for _, name := range grafanaDashboardNames() {
+		dashboardSources = append(dashboardSources, corev1.VolumeProjection{
+			ConfigMap: &corev1.ConfigMapProjection{
+				LocalObjectReference: corev1.LocalObjectReference{Name: GrafanaDashboardConfigMapPrefix + name},
+			},
+		})
+	}
+
+	volumes := []corev1.Volume{
+		{
+			Name: "config",
+			VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: GrafanaName},
+				Items:                []corev1.KeyToPath{{Key: "grafana.ini", Path: "grafana.ini"}},
+			}},
+		},
+		{
+			Name: "provisioning",
+			VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: GrafanaName},
+				Items: []corev1.KeyToPath{
+					{Key: "datasources.yaml", Path: "datasources/datasources.yaml"},
+					{Key: "dashboards.yaml", Path: "dashboards/dashboards.yaml"},
+				},
+			}},
+		},
+		{
+			Name:         "dashboards",
+			VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: dashboardSources}},
+		},
+		{
+			Name:         "data",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		},
+		mc.cfg.GrafanaTLSSecret.Volume(),
+		mc.cfg.TrustedCertBundle.Volume(),
+	}
+	volumeMounts := append(
+		[]corev1.VolumeMount{
+			{Name: "config", MountPath: grafanaConfigPath, ReadOnly: true},
+			{Name: "provisioning", MountPath: grafanaProvisioningPath, ReadOnly: true},
+			{Name: "dashboards", MountPath: grafanaDashboardsPath, ReadOnly: true},
+			{Name: "data", MountPath: grafanaDataPath},
+			mc.cfg.GrafanaTLSSecret.VolumeMount(mc.SupportedOSType()),
+		},
+		mc.cfg.TrustedCertBundle.VolumeMounts(mc.SupportedOSType())...,
+	)
+
+	env := []corev1.EnvVar{
+		{Name: "GF_PATHS_CONFIG", Value: path.Join(grafanaConfigPath, "grafana.ini")},
+		{
+			Name: "GF_SECURITY_ADMIN_USER",
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: GrafanaAdminSecretName},
+				Key:                  GrafanaAdminUsernameKey,
+			}},
+		},
+		{
+			Name: "GF_SECURITY_ADMIN_PASSWORD",
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: GrafanaAdminSecretName},
+				Key:                  GrafanaAdminPasswordKey,
+			}},
+		},
+		{
+			Name: "PROMETHEUS_TOKEN",
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: GrafanaName},
+				Key:                  corev1.ServiceAccountTokenKey,
+			}},
+		},
+	}
+	if mc.grafanaOAuthEnabled() {
+		env = append(env, corev1.EnvVar{
+			Name: "GF_AUTH_GENERIC_OAUTH_CLIENT_SECRET",
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: mc.cfg.DexClientSecret.Name},
+				Key:                  render.ClientSecretSecretField,
+			}},
+		})
+	}
+
+	probe := func() *corev1.Probe {
+		return &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   grafanaHealthEndpoint,
+					Port:   intstr.FromInt(GrafanaPort),
+					Scheme: corev1.URISchemeHTTPS,
+				},
+			},
+		}
+	}
+
+	tolerations := mc.cfg.Installation.ControlPlaneTolerations
+	if mc.cfg.Installation.KubernetesProvider.IsGKE() {
+		tolerations = append(tolerations, rmeta.TolerateGKEARM64NoSchedule)
+	}
+
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GrafanaName,
+			Namespace: common.TigeraPrometheusNamespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.Int32ToPtr(1),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					Tolerations:        tolerations,
+					NodeSelector:       mc.cfg.Installation.ControlPlaneNodeSelector,
+					ImagePullSecrets:   secret.GetReferenceList(mc.cfg.PullSecrets),
+					ServiceAccountName: GrafanaName,
+					SecurityContext:    securitycontext.NewNonRootPodContext(),
+					InitContainers:     initContainers,
+					Containers: []corev1.Container{
+						{
+							Name:            GrafanaName,
+							Image:           mc.grafanaImage,
+							ImagePullPolicy: render.ImagePullPolicy(),
+							Env:             env,
+							Ports: []corev1.ContainerPort{
+								{Name: "https", ContainerPort: GrafanaPort},
+							},
+							VolumeMounts:    volumeMounts,
+							ReadinessProbe:  probe(),
+							LivenessProbe:   probe(),
+							SecurityContext: securitycontext.NewNonRootContext(),
+						},
+					},
+					Volumes: volumes,
+				},
+			},
+		},
+	}
+}
+
+func (mc *monitorComponent) grafanaService() *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GrafanaName,
+			Namespace: common.TigeraPrometheusNamespace,
+			Labels:    map[string]string{render.AppLabelName: GrafanaName},
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "https",
+					Port:       GrafanaPort,
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromInt(GrafanaPort),
+				},
+			},
+			Selector: map[string]string{render.AppLabelName: GrafanaName},
+		},
+	}
+}
+
+// allowTigeraGrafanaPolicy allows users to reach Grafana, and Grafana to reach Prometheus and Dex.
+func allowTigeraGrafanaPolicy(cfg *Config) *v3.NetworkPolicy {
+	egressRules := []v3.Rule{}
+	egressRules = networkpolicy.AppendDNSEgressRules(egressRules, cfg.OpenShift)
+	egressRules = append(egressRules,
+		v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Destination: networkpolicy.PrometheusEntityRule,
+		},
+		v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Destination: render.DexEntityRule,
+		},
+	)
+
+	return &v3.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GrafanaPolicyName,
+			Namespace: common.TigeraPrometheusNamespace,
+		},
+		Spec: v3.NetworkPolicySpec{
+			Order:    &networkpolicy.HighPrecedenceOrder,
+			Tier:     networkpolicy.TigeraComponentTierName,
+			Selector: networkpolicy.KubernetesAppSelector(GrafanaName),
+			Types:    []v3.PolicyType{v3.PolicyTypeIngress, v3.PolicyTypeEgress},
+			Ingress: []v3.Rule{
+				{
+					Action:   v3.Allow,
+					Protocol: &networkpolicy.TCPProtocol,
+					Destination: v3.EntityRule{
+						Ports: networkpolicy.Ports(GrafanaPort),
+					},
+				},
+			},
+			Egress: egressRules,
+		},
+	}
+}
+
+// envValue returns the value of the environment variable with the given name, or an empty string if it is not set.
+func envValue(env []corev1.EnvVar, name string) string {
+	for _, e := range env {
+		if e.Name == name {
+			return e.Value
+		}
+	}
+	return ""
+}
@@ -18,6 +18,8 @@ import (
 	"crypto/x509"
 	_ "embed"
 	"fmt"
+	"net"
+	"net/url"
 	"strings"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -31,6 +33,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	"github.com/tigera/api/pkg/lib/numorstring"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
@@ -40,7 +43,9 @@ import (
 	rcomponents "github.com/tigera/operator/pkg/render/common/components"
 	"github.com/tigera/operator/pkg/render/common/configmap"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/metrics"
 	"github.com/tigera/operator/pkg/render/common/networkpolicy"
+	"github.com/tigera/operator/pkg/render/common/podaffinity"
 	"github.com/tigera/operator/pkg/render/common/secret"
 	"github.com/tigera/operator/pkg/render/common/securitycontext"
 	"github.com/tigera/operator/pkg/render/common/securitycontextconstraints"
@@ -52,7 +57,7 @@ import (
 const (
 	MonitoringAPIVersion   = "monitoring.coreos.com/v1"
 	CalicoNodeAlertmanager = "calico-node-alertmanager"
-	CalicoNodeMonitor      = "calico-node-monitor"
+	CalicoNodeMonitor      = render.CalicoNodeMonitorName
 	CalicoNodePrometheus   = "calico-node-prometheus"
 
 	CalicoPrometheusOperator       = "calico-prometheus-operator"
@@ -82,8 +87,12 @@ const (
 	AlertmanagerPort           = 9093
 	MeshAlertManagerPolicyName = AlertManagerPolicyName + "-mesh"
 
-	ElasticsearchMetrics = "elasticsearch-metrics"
-	FluentdMetrics       = "fluentd-metrics"
+	// RemoteWriteSecretLabel is set on the copies of the remote write Secrets in the tigera-prometheus namespace, so
+	// that the copies can be removed once no remote write endpoint references them.
+	RemoteWriteSecretLabel = "operator.tigera.io/prometheus-remote-write"
+
+	ElasticsearchMetrics = esmetrics.ElasticsearchMetricsMonitorName
+	FluentdMetrics       = render.FluentdMetricsService
 
 	calicoNodePrometheusServiceName       = "calico-node-prometheus"
 	tigeraPrometheusServiceHealthEndpoint = "/health"
@@ -109,30 +118,70 @@ func Monitor(cfg *Config) render.Component {
 }
 
 func MonitorPolicy(cfg *Config) render.Component {
+	var grafanaPolicy client.Object
+	if GrafanaDeployed(cfg.Monitor) {
+		grafanaPolicy = allowTigeraGrafanaPolicy(cfg)
+	}
 	return render.NewPassthrough(
 		allowTigeraAlertManagerPolicy(cfg),
 		allowTigeraAlertManagerMeshPolicy(cfg),
 		allowTigeraPrometheusPolicy(cfg),
 		allowTigeraPrometheusAPIPolicy(cfg),
 		allowTigeraPrometheusOperatorPolicy(cfg),
+		grafanaPolicy,
 		networkpolicy.AllowTigeraDefaultDeny(common.TigeraPrometheusNamespace),
 	)
 }
 
+// MonitorPolicyToDelete returns the policies of optional components that are not configured.
+func MonitorPolicyToDelete(cfg *Config) render.Component {
+	var objs []client.Object
+	if !GrafanaDeployed(cfg.Monitor) {
+		objs = append(objs, &v3.NetworkPolicy{
+			TypeMeta:   metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"},
+			ObjectMeta: metav1.ObjectMeta{Name: GrafanaPolicyName, Namespace: common.TigeraPrometheusNamespace},
+		})
+	}
+	return render.NewDeletionPassthrough(objs...)
+}
+
 // Config contains all the config information needed to render the Monitor component.
 type Config struct {
-	Monitor                       operatorv1.MonitorSpec
-	Installation                  *operatorv1.InstallationSpec
-	PullSecrets                   []*corev1.Secret
-	AlertmanagerConfigSecret      *corev1.Secret
-	KeyValidatorConfig            authentication.KeyValidatorConfig
-	ServerTLSSecret               certificatemanagement.KeyPairInterface
-	ClientTLSSecret               certificatemanagement.KeyPairInterface
-	ClusterDomain                 string
-	TrustedCertBundle             certificatemanagement.TrustedBundle
-	OpenShift                     bool
-	KubeControllerPort            int
-	FelixPrometheusMetricsEnabled bool
+	Monitor                  operatorv1.MonitorSpec
+	Installation             *operatorv1.InstallationSpec
+	PullSecrets              []*corev1.Secret
+	AlertmanagerConfigSecret *corev1.Secret
+	KeyValidatorConfig       authentication.KeyValidatorConfig
+	ServerTLSSecret          certificatemanagement.KeyPairInterface
+	ClientTLSSecret          certificatemanagement.KeyPairInterface
+	ClusterDomain            string
+	TrustedCertBundle        certificatemanagement.TrustedBundle
+	OpenShift                bool
+	KubeControllerPort       int
+
+	// MetricsTargets are the metrics endpoints declared by the components. A ServiceMonitor, or a PodMonitor for
+	// components without a service, is rendered for each of them.
+	MetricsTargets []metrics.Target
+
+	// RemoteWriteSecrets are the Secrets referenced by the Prometheus remote write endpoints. They are copied to the
+	// tigera-prometheus namespace.
+	RemoteWriteSecrets []*corev1.Secret
+
+	// CopiedRemoteWriteSecrets are the names of the remote write Secrets that currently exist in the tigera-prometheus
+	// namespace. The ones that are no longer referenced are removed.
+	CopiedRemoteWriteSecrets []string
+
+	// GrafanaDashboards are the dashboard ConfigMaps that currently exist in any namespace. The ones that are no longer
+	// rendered are removed.
+	GrafanaDashboards []client.ObjectKey
+
+	// GrafanaTLSSecret and GrafanaAdminSecret are only required when Grafana is deployed.
+	GrafanaTLSSecret   certificatemanagement.KeyPairInterface
+	GrafanaAdminSecret *corev1.Secret
+
+	// DexClientSecret holds the secret that Grafana authenticates to Dex with. Users can only sign in to Grafana
+	// through Dex if it is set, Dex is the identity provider of the KeyValidatorConfig and the Grafana URL is set.
+	DexClientSecret *corev1.Secret
 }
 
 type monitorComponent struct {
@@ -140,6 +189,7 @@ type monitorComponent struct {
 	alertmanagerImage      string
 	prometheusImage        string
 	prometheusServiceImage string
+	grafanaImage           string
 }
 
 func (mc *monitorComponent) ResolveImages(is *operatorv1.ImageSet) error {
@@ -165,6 +215,13 @@ func (mc *monitorComponent) ResolveImages(is *operatorv1.ImageSet) error {
 		errMsgs = append(errMsgs, err.Error())
 	}
 
+	if GrafanaDeployed(mc.cfg.Monitor) {
+		mc.grafanaImage, err = components.GetReference(components.ComponentGrafana, reg, path, prefix, is)
+		if err != nil {
+			errMsgs = append(errMsgs, err.Error())
+		}
+	}
+
 	if len(errMsgs) != 0 {
 		return fmt.Errorf("%s", strings.Join(errMsgs, ","))
 	}
@@ -203,6 +260,7 @@ func (mc *monitorComponent) Objects() ([]client.Object, []client.Object) {
 
 	toCreate = append(toCreate, secret.ToRuntimeObjects(secret.CopyToNamespace(common.TigeraPrometheusNamespace, mc.cfg.PullSecrets...)...)...)
 	toCreate = append(toCreate, secret.ToRuntimeObjects(secret.CopyToNamespace(common.TigeraPrometheusNamespace, mc.cfg.AlertmanagerConfigSecret)...)...)
+	toCreate = append(toCreate, secret.ToRuntimeObjects(mc.remoteWriteSecrets()...)...)
 
 	toCreate = append(toCreate,
 		mc.prometheusOperatorServiceAccount(),
@@ -218,12 +276,8 @@ func (mc *monitorComponent) Objects() ([]client.Object, []client.Object) {
 		mc.prometheusServiceClusterRole(),
 		mc.prometheusServiceClusterRoleBinding(),
 		mc.prometheusRule(),
-		mc.serviceMonitorCalicoNode(),
-		mc.serviceMonitorElasticsearch(),
-		mc.serviceMonitorFluentd(),
-		mc.serviceMonitorQueryServer(),
-		mc.serviceMonitorCalicoKubeControllers(),
 	)
+	toCreate = append(toCreate, mc.metricsMonitors()...)
 
 	if mc.cfg.KeyValidatorConfig != nil {
 		toCreate = append(toCreate, secret.ToRuntimeObjects(mc.cfg.KeyValidatorConfig.RequiredSecrets(common.TigeraPrometheusNamespace)...)...)
@@ -242,13 +296,21 @@ func (mc *monitorComponent) Objects() ([]client.Object, []client.Object) {
 		}
 	}
 
+	// Remove the monitors of optional targets that are no longer configured.
 	var toDelete []client.Object
-	if mc.cfg.Installation.TyphaMetricsPort != nil {
-		toCreate = append(toCreate, mc.typhaServiceMonitor())
-	} else {
-		toDelete = append(toDelete, mc.typhaServiceMonitor())
+	if !mc.hasMetricsTarget(render.TyphaMetricsName) {
+		toDelete = append(toDelete, &monitoringv1.ServiceMonitor{ObjectMeta: metav1.ObjectMeta{Name: render.TyphaMetricsName, Namespace: common.TigeraPrometheusNamespace}})
+	}
+	if !mc.hasMetricsTarget(OperatorMetricsName) {
+		toDelete = append(toDelete, &monitoringv1.PodMonitor{ObjectMeta: metav1.ObjectMeta{Name: OperatorMetricsName, Namespace: common.TigeraPrometheusNamespace}})
 	}
 
+	grafanaToCreate, grafanaToDelete := mc.grafanaObjects()
+	toCreate = append(toCreate, grafanaToCreate...)
+	toDelete = append(toDelete, grafanaToDelete...)
+
+	toDelete = append(toDelete, mc.staleRemoteWriteSecrets(toCreate)...)
+
 	toDelete = append(toDelete,
 		// Remove the pod monitor that existed prior to v1.25.
 		&monitoringv1.PodMonitor{ObjectMeta: metav1.ObjectMeta{Name: FluentdMetrics, Namespace: common.TigeraPrometheusNamespace}},
@@ -259,6 +321,33 @@ func (mc *monitorComponent) Objects() ([]client.Object, []client.Object) {
 	return toCreate, toDelete
 }
 
+// remoteWriteSecrets returns the copies of the remote write Secrets in the tigera-prometheus namespace.
+func (mc *monitorComponent) remoteWriteSecrets() []*corev1.Secret {
+	secrets := secret.CopyToNamespace(common.TigeraPrometheusNamespace, mc.cfg.RemoteWriteSecrets...)
+	for _, s := range secrets {
+		s.Labels = map[string]string{RemoteWriteSecretLabel: "true"}
+	}
+	return secrets
+}
+
+// staleRemoteWriteSecrets returns the copied remote write Secrets that are not created by this component anymore.
+func (mc *monitorComponent) staleRemoteWriteSecrets(toCreate []client.Object) []client.Object {
+	created := map[string]bool{}
+	for _, obj := range toCreate {
+		if s, ok := obj.(*corev1.Secret); ok && s.Namespace == common.TigeraPrometheusNamespace {
+			created[s.Name] = true
+		}
+	}
+
+	var toDelete []client.Object
+	for _, name := range mc.cfg.CopiedRemoteWriteSecrets {
+		if !created[name] {
+			toDelete = append(toDelete, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: common.TigeraPrometheusNamespace}})
+		}
+	}
+	return toDelete
+}
+
 func (mc *monitorComponent) Ready() bool {
 	return true
 }
@@ -614,6 +703,37 @@ func (mc *monitorComponent) prometheus() *monitoringv1.Prometheus {
 		},
 	}
 
+	if spec := mc.prometheusSpec(); spec != nil {
+		if spec.Retention != "" {
+			prometheus.Spec.Retention = monitoringv1.Duration(spec.Retention)
+		}
+		if spec.RetentionSize != "" {
+			prometheus.Spec.RetentionSize = monitoringv1.ByteSize(spec.RetentionSize)
+		}
+		if spec.Replicas != nil {
+			prometheus.Spec.Replicas = spec.Replicas
+			if *spec.Replicas > 1 {
+				prometheus.Spec.Affinity = podaffinity.NewPodAntiAffinity(TigeraPrometheusObjectName, common.TigeraPrometheusNamespace)
+			}
+		}
+		if spec.Storage != nil {
+			prometheus.Spec.Storage = &monitoringv1.StorageSpec{
+				VolumeClaimTemplate: monitoringv1.EmbeddedPersistentVolumeClaim{
+					Spec: corev1.PersistentVolumeClaimSpec{
+						StorageClassName: spec.Storage.StorageClassName,
+						AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceStorage: spec.Storage.Size},
+						},
+					},
+				},
+			}
+		}
+		for _, rw := range spec.RemoteWrite {
+			prometheus.Spec.RemoteWrite = append(prometheus.Spec.RemoteWrite, remoteWriteSpec(rw))
+		}
+	}
+
 	if overrides := mc.cfg.Monitor.Prometheus; overrides != nil {
 		rcomponents.ApplyPrometheusOverrides(prometheus, overrides)
 	}
@@ -621,6 +741,52 @@ func (mc *monitorComponent) prometheus() *monitoringv1.Prometheus {
 	return prometheus
 }
 
+func (mc *monitorComponent) prometheusSpec() *operatorv1.PrometheusSpec {
+	if mc.cfg.Monitor.Prometheus == nil {
+		return nil
+	}
+	return mc.cfg.Monitor.Prometheus.PrometheusSpec
+}
+
+// remoteWriteSpec converts a remote write endpoint of the Monitor CR to the prometheus-operator representation. The
+// Secrets it references are copied to the tigera-prometheus namespace under the same names.
+func remoteWriteSpec(rw operatorv1.PrometheusRemoteWrite) monitoringv1.RemoteWriteSpec {
+	spec := monitoringv1.RemoteWriteSpec{
+		Name:                rw.Name,
+		URL:                 rw.URL,
+		WriteRelabelConfigs: rw.WriteRelabelConfigs,
+	}
+	if rw.BasicAuth != nil {
+		spec.BasicAuth = &monitoringv1.BasicAuth{
+			Username: rw.BasicAuth.Username,
+			Password: rw.BasicAuth.Password,
+		}
+	}
+	if rw.BearerTokenSecret != nil {
+		spec.Authorization = &monitoringv1.Authorization{
+			SafeAuthorization: monitoringv1.SafeAuthorization{
+				Type:        "Bearer",
+				Credentials: rw.BearerTokenSecret,
+			},
+		}
+	}
+	if rw.TLS != nil {
+		tlsConfig := monitoringv1.SafeTLSConfig{
+			CA:        monitoringv1.SecretOrConfigMap{Secret: rw.TLS.CA},
+			Cert:      monitoringv1.SecretOrConfigMap{Secret: rw.TLS.Cert},
+			KeySecret: rw.TLS.Key,
+		}
+		if rw.TLS.ServerName != "" {
+			tlsConfig.ServerName = &rw.TLS.ServerName
+		}
+		if rw.TLS.InsecureSkipVerify {
+			tlsConfig.InsecureSkipVerify = &rw.TLS.InsecureSkipVerify
+		}
+		spec.TLSConfig = &monitoringv1.TLSConfig{SafeTLSConfig: tlsConfig}
+	}
+	return spec
+}
+
 func (mc *monitorComponent) prometheusServiceAccount() *corev1.ServiceAccount {
 	return &corev1.ServiceAccount{
 		TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
@@ -789,6 +955,9 @@ func (mc *monitorComponent) prometheusRule() *monitoringv1.PrometheusRule {
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      TigeraPrometheusDPRate,
 			Namespace: common.TigeraPrometheusNamespace,
+			Annotations: map[string]string{
+				"operator.tigera.io/alert-rules-version": AlertRulesVersion,
+			},
 			Labels: map[string]string{
 				"prometheus": CalicoNodePrometheus,
 				"role":       "tigera-prometheus-rules",
@@ -797,77 +966,14 @@ func (mc *monitorComponent) prometheusRule() *monitoringv1.PrometheusRule {
 		Spec: monitoringv1.PrometheusRuleSpec{
 			Groups: []monitoringv1.RuleGroup{
 				{
-					Name: "calico.rules",
-					Rules: []monitoringv1.Rule{
-						{
-							Alert:  "DeniedPacketsRate",
-							Expr:   intstr.FromString("rate(calico_denied_packets[10s]) > 50"),
-							Labels: map[string]string{"severity": "critical"},
-							Annotations: map[string]string{
-								"summary":     "Instance {{$labels.instance}} - Large rate of packets denied",
-								"description": "{{$labels.instance}} with calico-node pod {{$labels.pod}} has been denying packets at a fast rate {{$labels.sourceIp}} by policy {{$labels.policy}}.",
-							},
-						},
-					},
+					Name:  "calico.rules",
+					Rules: mc.alertRules(),
 				},
 			},
 		},
 	}
 }
 
-func (mc *monitorComponent) serviceMonitorCalicoNode() *monitoringv1.ServiceMonitor {
-	endpoints := []monitoringv1.Endpoint{
-		{
-			HonorLabels:   true,
-			Interval:      "5s",
-			Port:          "calico-metrics-port",
-			ScrapeTimeout: "5s",
-			Scheme:        "https",
-			TLSConfig:     mc.tlsConfig(render.CalicoNodeMetricsService),
-		},
-		{
-			HonorLabels:   true,
-			Interval:      "5s",
-			Port:          "calico-bgp-metrics-port",
-			ScrapeTimeout: "5s",
-			Scheme:        "https",
-			TLSConfig:     mc.tlsConfig(render.CalicoNodeMetricsService),
-		},
-	}
-
-	if mc.cfg.FelixPrometheusMetricsEnabled {
-		endpoints = append(endpoints, monitoringv1.Endpoint{
-			HonorLabels:   true,
-			Interval:      "5s",
-			Port:          "felix-metrics-port",
-			ScrapeTimeout: "5s",
-			Scheme:        "http",
-		})
-	}
-
-	return &monitoringv1.ServiceMonitor{
-		TypeMeta: metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: MonitoringAPIVersion},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      CalicoNodeMonitor,
-			Namespace: common.TigeraPrometheusNamespace,
-			Labels:    map[string]string{"team": "network-operators"},
-		},
-		Spec: monitoringv1.ServiceMonitorSpec{
-			Selector: metav1.LabelSelector{
-				MatchExpressions: []metav1.LabelSelectorRequirement{
-					{
-						Key:      "k8s-app",
-						Operator: metav1.LabelSelectorOpIn,
-						Values:   []string{"calico-node", "calico-node-windows"},
-					},
-				},
-			},
-			NamespaceSelector: monitoringv1.NamespaceSelector{MatchNames: []string{"calico-system"}},
-			Endpoints:         endpoints,
-		},
-	}
-}
-
 func (mc *monitorComponent) tlsConfig(serverName string) *monitoringv1.TLSConfig {
 	return &monitoringv1.TLSConfig{
 		KeyFile:  mc.cfg.ClientTLSSecret.VolumeMountKeyFilePath(),
@@ -879,99 +985,6 @@ func (mc *monitorComponent) tlsConfig(serverName string) *monitoringv1.TLSConfig
 	}
 }
 
-func (mc *monitorComponent) serviceMonitorElasticsearch() *monitoringv1.ServiceMonitor {
-	return &monitoringv1.ServiceMonitor{
-		TypeMeta: metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: MonitoringAPIVersion},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      ElasticsearchMetrics,
-			Namespace: common.TigeraPrometheusNamespace,
-			Labels:    map[string]string{"team": "network-operators"},
-		},
-		Spec: monitoringv1.ServiceMonitorSpec{
-			Selector:          metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": "tigera-elasticsearch-metrics"}},
-			NamespaceSelector: monitoringv1.NamespaceSelector{MatchNames: []string{"tigera-elasticsearch"}},
-			Endpoints: []monitoringv1.Endpoint{
-				{
-					HonorLabels:   true,
-					Interval:      "5s",
-					Port:          "metrics-port",
-					ScrapeTimeout: "5s",
-					Scheme:        "https",
-					TLSConfig:     mc.tlsConfig(esmetrics.ElasticsearchMetricsName),
-				},
-			},
-		},
-	}
-}
-
-// serviceMonitorFluentd creates a service monitor to make Prometheus watch Fluentd. Previously, a pod monitor was used.
-// However, the pod monitor does not have all the tls configuration options that we need, namely reading them from the
-// file system, as opposed to getting them from watching kubernetes secrets.
-func (mc *monitorComponent) serviceMonitorFluentd() *monitoringv1.ServiceMonitor {
-	return &monitoringv1.ServiceMonitor{
-		TypeMeta: metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: MonitoringAPIVersion},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      render.FluentdMetricsService,
-			Namespace: common.TigeraPrometheusNamespace,
-			Labels:    map[string]string{"team": "network-operators"},
-		},
-		Spec: monitoringv1.ServiceMonitorSpec{
-			Selector: metav1.LabelSelector{
-				MatchExpressions: []metav1.LabelSelectorRequirement{
-					{
-						Key:      "k8s-app",
-						Operator: metav1.LabelSelectorOpIn,
-						Values:   []string{"fluentd-node", "fluentd-node-windows"},
-					},
-				},
-			},
-			NamespaceSelector: monitoringv1.NamespaceSelector{MatchNames: []string{render.LogCollectorNamespace}},
-			Endpoints: []monitoringv1.Endpoint{
-				{
-					HonorLabels:   true,
-					Interval:      "5s",
-					Port:          render.FluentdMetricsPortName,
-					ScrapeTimeout: "5s",
-					Scheme:        "https",
-					TLSConfig:     mc.tlsConfig(render.FluentdPrometheusTLSSecretName),
-				},
-			},
-		},
-	}
-}
-
-func (mc *monitorComponent) serviceMonitorQueryServer() *monitoringv1.ServiceMonitor {
-	serverName := render.ProjectCalicoAPIServerServiceName(mc.cfg.Installation.Variant)
-	return &monitoringv1.ServiceMonitor{
-		TypeMeta: metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: MonitoringAPIVersion},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      render.QueryserverServiceName,
-			Namespace: common.TigeraPrometheusNamespace,
-			Labels:    map[string]string{"team": "network-operators"},
-		},
-		Spec: monitoringv1.ServiceMonitorSpec{
-			Selector:          metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": render.QueryserverServiceName}},
-			NamespaceSelector: monitoringv1.NamespaceSelector{MatchNames: []string{render.QueryserverNamespace}},
-			Endpoints: []monitoringv1.Endpoint{
-				{
-					HonorLabels:     true,
-					Interval:        "5s",
-					Port:            "queryserver",
-					ScrapeTimeout:   "5s",
-					Scheme:          "https",
-					BearerTokenFile: bearerTokenFile,
-					TLSConfig: &monitoringv1.TLSConfig{
-						CAFile: mc.cfg.TrustedCertBundle.MountPath(),
-						SafeTLSConfig: monitoringv1.SafeTLSConfig{
-							ServerName: &serverName,
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
 func (mc *monitorComponent) operatorRoles() []*rbacv1.Role {
 
 	return []*rbacv1.Role{
@@ -1220,6 +1233,10 @@ func allowTigeraPrometheusPolicy(cfg *Config) *v3.NetworkPolicy {
 		})
 	}
 
+	egressRules = append(egressRules, metricsEgressRules(cfg)...)
+
+	egressRules = append(egressRules, remoteWriteEgressRules(cfg)...)
+
 	typhaMetricsPort := cfg.Installation.TyphaMetricsPort
 	if typhaMetricsPort != nil {
 		egressRules = append(egressRules, v3.Rule{
@@ -1258,6 +1275,62 @@ func allowTigeraPrometheusPolicy(cfg *Config) *v3.NetworkPolicy {
 	}
 }
 
+// remoteWriteEgressRules returns the egress rules that allow Prometheus to reach its remote write endpoints.
+func remoteWriteEgressRules(cfg *Config) []v3.Rule {
+	if cfg.Monitor.Prometheus == nil || cfg.Monitor.Prometheus.PrometheusSpec == nil {
+		return nil
+	}
+
+	var rules []v3.Rule
+	allowedDestinations := map[string]bool{}
+	for _, rw := range cfg.Monitor.Prometheus.PrometheusSpec.RemoteWrite {
+		destination, hostPort, ok := urlDestination(rw.URL)
+		if !ok || allowedDestinations[hostPort] {
+			// The controller validates the URLs before rendering.
+			continue
+		}
+		allowedDestinations[hostPort] = true
+
+		rules = append(rules, v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Destination: destination,
+		})
+	}
+	return rules
+}
+
+// urlDestination returns the entity rule that matches the host and port of the URL, along with the host and port
+// joined. It returns false if the URL cannot be parsed.
+func urlDestination(rawURL string) (v3.EntityRule, string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return v3.EntityRule{}, "", false
+	}
+	port := u.Port()
+	if port == "" {
+		port = "443"
+		if u.Scheme == "http" {
+			port = "80"
+		}
+	}
+	parsedPort, err := numorstring.PortFromString(port)
+	if err != nil {
+		return v3.EntityRule{}, "", false
+	}
+
+	host := u.Hostname()
+	destination := v3.EntityRule{Ports: []numorstring.Port{parsedPort}}
+	if ip := net.ParseIP(host); ip == nil {
+		destination.Domains = []string{host}
+	} else if ip.To4() != nil {
+		destination.Nets = []string{host + "/32"}
+	} else {
+		destination.Nets = []string{host + "/128"}
+	}
+	return destination, net.JoinHostPort(host, port), true
+}
+
 // Creates a network policy to allow traffic to access through tigera-prometheus-api
 func allowTigeraPrometheusAPIPolicy(cfg *Config) *v3.NetworkPolicy {
 	egressRules := []v3.Rule{}
@@ -1319,31 +1392,6 @@ func allowTigeraPrometheusOperatorPolicy(cfg *Config) *v3.NetworkPolicy {
 	}
 }
 
-func (mc *monitorComponent) serviceMonitorCalicoKubeControllers() *monitoringv1.ServiceMonitor {
-	return &monitoringv1.ServiceMonitor{
-		TypeMeta: metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: MonitoringAPIVersion},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      KubeControllerMetrics,
-			Namespace: common.TigeraPrometheusNamespace,
-			Labels:    map[string]string{"team": "network-operators"},
-		},
-		Spec: monitoringv1.ServiceMonitorSpec{
-			Selector:          metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": "calico-kube-controllers"}},
-			NamespaceSelector: monitoringv1.NamespaceSelector{MatchNames: []string{"calico-system"}},
-			Endpoints: []monitoringv1.Endpoint{
-				{
-					HonorLabels:   true,
-					Interval:      "5s",
-					Port:          "metrics-port",
-					ScrapeTimeout: "5s",
-					Scheme:        "https",
-					TLSConfig:     mc.tlsConfig(KubeControllerMetrics),
-				},
-			},
-		},
-	}
-}
-
 // externalPrometheusRole creates the permissions for the external prometheus server to scrape ours.
 func (mc *monitorComponent) externalPrometheusRole() client.Object {
 	return &rbacv1.ClusterRole{
@@ -1496,33 +1544,3 @@ func (mc *monitorComponent) externalServiceMonitor() (client.Object, bool) {
 		},
 	}, needsRBAC
 }
-
-func (mc *monitorComponent) typhaServiceMonitor() client.Object {
-	return &monitoringv1.ServiceMonitor{
-		TypeMeta: metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: MonitoringAPIVersion},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      render.TyphaMetricsName,
-			Namespace: TigeraPrometheusObjectName,
-			Labels:    map[string]string{"team": "network-operators"},
-		},
-		Spec: monitoringv1.ServiceMonitorSpec{
-			Endpoints: []monitoringv1.Endpoint{
-				{
-					HonorLabels:   true,
-					Interval:      "5s",
-					Port:          render.TyphaMetricsName,
-					Scheme:        "http",
-					ScrapeTimeout: "5s",
-				},
-			},
-			NamespaceSelector: monitoringv1.NamespaceSelector{
-				MatchNames: []string{common.CalicoNamespace},
-			},
-			Selector: metav1.LabelSelector{
-				MatchLabels: map[string]string{
-					render.AppLabelName: render.TyphaMetricsName,
-				},
-			},
-		},
-	}
-}
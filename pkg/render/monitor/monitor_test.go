@@ -15,6 +15,7 @@
 package monitor_test
 
 import (
+	"encoding/json"
 	"fmt"
 
 	. "github.com/onsi/ginkgo"
@@ -23,6 +24,7 @@ import (
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8sresource "k8s.io/apimachinery/pkg/api/resource"
@@ -44,7 +46,12 @@ import (
 	"github.com/tigera/operator/pkg/ptr"
 	"github.com/tigera/operator/pkg/render"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/metrics"
+	"github.com/tigera/operator/pkg/render/common/networkpolicy"
+	"github.com/tigera/operator/pkg/render/common/podaffinity"
 	rtest "github.com/tigera/operator/pkg/render/common/test"
+	"github.com/tigera/operator/pkg/render/kubecontrollers"
+	"github.com/tigera/operator/pkg/render/logstorage/esmetrics"
 	"github.com/tigera/operator/pkg/render/monitor"
 	"github.com/tigera/operator/pkg/render/testutils"
 	"github.com/tigera/operator/pkg/tls/certificatemanagement"
@@ -102,6 +109,7 @@ var _ = Describe("monitor rendering tests", func() {
 			AlertmanagerConfigSecret: defaultAlertmanagerConfigSecret,
 			ClusterDomain:            "example.org",
 			TrustedCertBundle:        bundle,
+			MetricsTargets:           baseMetricsTargets(false),
 		}
 	})
 
@@ -114,7 +122,7 @@ var _ = Describe("monitor rendering tests", func() {
 		expectedResources := expectedBaseResources()
 		rtest.ExpectResources(toCreate, expectedResources)
 
-		Expect(toDelete).To(HaveLen(3))
+		Expect(toDelete).To(HaveLen(12))
 
 		// Check the namespace.
 		namespace := rtest.GetResource(toCreate, "tigera-prometheus", "", "", "v1", "Namespace").(*corev1.Namespace)
@@ -170,7 +178,7 @@ var _ = Describe("monitor rendering tests", func() {
 		component := monitor.Monitor(cfg)
 		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
 		toCreate, toDelete := component.Objects()
-		Expect(toDelete).To(HaveLen(3))
+		Expect(toDelete).To(HaveLen(12))
 
 		// Prometheus
 		prometheusObj, ok := rtest.GetResource(toCreate, monitor.CalicoNodePrometheus, common.TigeraPrometheusNamespace, "monitoring.coreos.com", "v1", monitoringv1.PrometheusesKind).(*monitoringv1.Prometheus)
@@ -189,6 +197,108 @@ var _ = Describe("monitor rendering tests", func() {
 
 	})
 
+	It("Should render Prometheus retention, storage, replicas and remote write", func() {
+		storageClass := "fast"
+		cfg.Monitor.Prometheus = &operatorv1.Prometheus{
+			PrometheusSpec: &operatorv1.PrometheusSpec{
+				Retention:     "15d",
+				RetentionSize: "10GB",
+				Replicas:      ptr.Int32ToPtr(2),
+				Storage: &operatorv1.PrometheusStorage{
+					StorageClassName: &storageClass,
+					Size:             k8sresource.MustParse("50Gi"),
+				},
+				RemoteWrite: []operatorv1.PrometheusRemoteWrite{
+					{
+						Name: "thanos",
+						URL:  "https://thanos.example.com/api/v1/receive",
+						BasicAuth: &operatorv1.PrometheusRemoteWriteBasicAuth{
+							Username: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "thanos-auth"}, Key: "username"},
+							Password: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "thanos-auth"}, Key: "password"},
+						},
+						TLS: &operatorv1.PrometheusRemoteWriteTLS{
+							CA:         &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "thanos-ca"}, Key: "ca.crt"},
+							ServerName: "thanos",
+						},
+					},
+					{
+						URL:               "http://10.0.0.1:9009/api/v1/push",
+						BearerTokenSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "mimir-token"}, Key: "token"},
+					},
+				},
+			},
+		}
+		cfg.RemoteWriteSecrets = []*corev1.Secret{
+			{TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}, ObjectMeta: metav1.ObjectMeta{Name: "thanos-auth", Namespace: common.OperatorNamespace()}},
+			{TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}, ObjectMeta: metav1.ObjectMeta{Name: "thanos-ca", Namespace: common.OperatorNamespace()}},
+			{TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}, ObjectMeta: metav1.ObjectMeta{Name: "mimir-token", Namespace: common.OperatorNamespace()}},
+		}
+		cfg.CopiedRemoteWriteSecrets = []string{"thanos-auth", "old-token"}
+
+		component := monitor.Monitor(cfg)
+		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+		toCreate, toDelete := component.Objects()
+
+		for _, name := range []string{"thanos-auth", "thanos-ca", "mimir-token"} {
+			rtest.ExpectResourceInList(toCreate, name, common.TigeraPrometheusNamespace, "", "v1", "Secret")
+			s := rtest.GetResource(toCreate, name, common.TigeraPrometheusNamespace, "", "v1", "Secret").(*corev1.Secret)
+			Expect(s.Labels).To(HaveKeyWithValue(monitor.RemoteWriteSecretLabel, "true"))
+		}
+
+		// Only the copies that are no longer referenced are removed.
+		_, err := rtest.GetResourceOfType[*corev1.Secret](toDelete, "old-token", common.TigeraPrometheusNamespace)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = rtest.GetResourceOfType[*corev1.Secret](toDelete, "thanos-auth", common.TigeraPrometheusNamespace)
+		Expect(err).To(HaveOccurred())
+
+		prometheusObj, ok := rtest.GetResource(toCreate, monitor.CalicoNodePrometheus, common.TigeraPrometheusNamespace, "monitoring.coreos.com", "v1", monitoringv1.PrometheusesKind).(*monitoringv1.Prometheus)
+		Expect(ok).To(BeTrue())
+		Expect(prometheusObj.Spec.Retention).To(Equal(monitoringv1.Duration("15d")))
+		Expect(prometheusObj.Spec.RetentionSize).To(Equal(monitoringv1.ByteSize("10GB")))
+		Expect(*prometheusObj.Spec.Replicas).To(Equal(int32(2)))
+		Expect(prometheusObj.Spec.Affinity).To(Equal(podaffinity.NewPodAntiAffinity("tigera-prometheus", common.TigeraPrometheusNamespace)))
+
+		Expect(prometheusObj.Spec.Storage).NotTo(BeNil())
+		pvcSpec := prometheusObj.Spec.Storage.VolumeClaimTemplate.Spec
+		Expect(*pvcSpec.StorageClassName).To(Equal("fast"))
+		Expect(pvcSpec.AccessModes).To(ConsistOf(corev1.ReadWriteOnce))
+		Expect(pvcSpec.Resources.Requests[corev1.ResourceStorage]).To(Equal(k8sresource.MustParse("50Gi")))
+
+		Expect(prometheusObj.Spec.RemoteWrite).To(HaveLen(2))
+		thanos := prometheusObj.Spec.RemoteWrite[0]
+		Expect(thanos.Name).To(Equal("thanos"))
+		Expect(thanos.URL).To(Equal("https://thanos.example.com/api/v1/receive"))
+		Expect(thanos.BasicAuth.Username.Name).To(Equal("thanos-auth"))
+		Expect(thanos.BasicAuth.Password.Key).To(Equal("password"))
+		Expect(thanos.Authorization).To(BeNil())
+		Expect(thanos.TLSConfig.CA.Secret.Name).To(Equal("thanos-ca"))
+		Expect(*thanos.TLSConfig.ServerName).To(Equal("thanos"))
+		Expect(thanos.TLSConfig.InsecureSkipVerify).To(BeNil())
+
+		mimir := prometheusObj.Spec.RemoteWrite[1]
+		Expect(mimir.BasicAuth).To(BeNil())
+		Expect(mimir.Authorization.Type).To(Equal("Bearer"))
+		Expect(mimir.Authorization.Credentials.Name).To(Equal("mimir-token"))
+		Expect(mimir.TLSConfig).To(BeNil())
+	})
+
+	It("Should not render Prometheus anti-affinity with a single replica", func() {
+		cfg.Monitor.Prometheus = &operatorv1.Prometheus{
+			PrometheusSpec: &operatorv1.PrometheusSpec{Replicas: ptr.Int32ToPtr(1)},
+		}
+
+		component := monitor.Monitor(cfg)
+		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+		toCreate, _ := component.Objects()
+
+		prometheusObj, ok := rtest.GetResource(toCreate, monitor.CalicoNodePrometheus, common.TigeraPrometheusNamespace, "monitoring.coreos.com", "v1", monitoringv1.PrometheusesKind).(*monitoringv1.Prometheus)
+		Expect(ok).To(BeTrue())
+		Expect(prometheusObj.Spec.Retention).To(Equal(monitoringv1.Duration("24h")))
+		Expect(*prometheusObj.Spec.Replicas).To(Equal(int32(1)))
+		Expect(prometheusObj.Spec.Affinity).To(BeNil())
+		Expect(prometheusObj.Spec.Storage).To(BeNil())
+	})
+
 	It("Should render Prometheus resource Specs correctly", func() {
 		component := monitor.Monitor(cfg)
 		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
@@ -479,7 +589,8 @@ var _ = Describe("monitor rendering tests", func() {
 		Expect(prometheusruleObj.ObjectMeta.Labels["role"]).To(Equal("tigera-prometheus-rules"))
 		Expect(prometheusruleObj.Spec.Groups).To(HaveLen(1))
 		Expect(prometheusruleObj.Spec.Groups[0].Name).To(Equal("calico.rules"))
-		Expect(prometheusruleObj.Spec.Groups[0].Rules).To(HaveLen(1))
+		Expect(prometheusruleObj.ObjectMeta.Annotations["operator.tigera.io/alert-rules-version"]).To(Equal(monitor.AlertRulesVersion))
+		Expect(prometheusruleObj.Spec.Groups[0].Rules).To(HaveLen(6))
 		Expect(prometheusruleObj.Spec.Groups[0].Rules[0].Alert).To(Equal("DeniedPacketsRate"))
 		Expect(prometheusruleObj.Spec.Groups[0].Rules[0].Expr).To(Equal(intstr.FromString("rate(calico_denied_packets[10s]) > 50")))
 		Expect(prometheusruleObj.Spec.Groups[0].Rules[0].Labels["severity"]).To(Equal("critical"))
@@ -597,6 +708,67 @@ var _ = Describe("monitor rendering tests", func() {
 		Expect(rolebindingObj.Subjects[0].Namespace).To(Equal(common.OperatorNamespace()))
 	})
 
+	It("Should render the curated alerts with the overrides of the Monitor CR", func() {
+		disabled := operatorv1.AlertRuleDisabled
+		warning := operatorv1.AlertSeverityWarning
+		forDuration := monitoringv1.Duration("30m")
+		cfg.Monitor.Alerts = &operatorv1.MonitorAlerts{
+			DeniedPacketsRate: &operatorv1.AlertRule{State: &disabled},
+			IPAMExhaustion: &operatorv1.AlertRule{
+				Threshold: ptr.Int32ToPtr(75),
+				For:       &forDuration,
+				Severity:  &warning,
+			},
+			CertificateExpiry: &operatorv1.AlertRule{Threshold: ptr.Int32ToPtr(7)},
+		}
+		cfg.MetricsTargets = append(cfg.MetricsTargets, monitor.OperatorMetricsTarget(8484))
+
+		component := monitor.Monitor(cfg)
+		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+		toCreate, _ := component.Objects()
+
+		prometheusruleObj, ok := rtest.GetResource(toCreate, monitor.TigeraPrometheusDPRate, common.TigeraPrometheusNamespace, "monitoring.coreos.com", "v1", monitoringv1.PrometheusRuleKind).(*monitoringv1.PrometheusRule)
+		Expect(ok).To(BeTrue())
+
+		rules := map[string]monitoringv1.Rule{}
+		for _, rule := range prometheusruleObj.Spec.Groups[0].Rules {
+			rules[rule.Alert] = rule
+		}
+		Expect(rules).To(HaveLen(7))
+		Expect(rules).NotTo(HaveKey("DeniedPacketsRate"))
+		Expect(rules).To(HaveKey("FelixDown"))
+		Expect(rules).To(HaveKey("TyphaDown"))
+		Expect(rules).To(HaveKey("BGPSessionDown"))
+		Expect(rules).To(HaveKey("ElasticsearchClusterHealth"))
+		Expect(rules).To(HaveKey("GuardianTunnelDown"))
+
+		ipam := rules["IPAMExhaustion"]
+		Expect(ipam.Expr).To(Equal(intstr.FromString("sum by (ippool) (ipam_allocations_in_use) / max by (ippool) (ipam_ippool_size) * 100 > 75")))
+		Expect(*ipam.For).To(Equal(monitoringv1.Duration("30m")))
+		Expect(ipam.Labels["severity"]).To(Equal("warning"))
+
+		certs := rules["CertificateExpiry"]
+		Expect(certs.Expr).To(Equal(intstr.FromString("(tigera_operator_certificate_expiry_timestamp_seconds - time()) / 86400 < 7")))
+		Expect(certs.For).To(BeNil())
+
+		felix := rules["FelixDown"]
+		Expect(felix.Expr).To(Equal(intstr.FromString(`up{job="calico-node-metrics"} == 0`)))
+		Expect(*felix.For).To(Equal(monitoringv1.Duration("5m")))
+		Expect(felix.Labels["severity"]).To(Equal("critical"))
+	})
+
+	It("Should omit the alerts based on operator metrics when the operator does not serve metrics", func() {
+		component := monitor.Monitor(cfg)
+		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+		toCreate, _ := component.Objects()
+
+		prometheusruleObj, ok := rtest.GetResource(toCreate, monitor.TigeraPrometheusDPRate, common.TigeraPrometheusNamespace, "monitoring.coreos.com", "v1", monitoringv1.PrometheusRuleKind).(*monitoringv1.PrometheusRule)
+		Expect(ok).To(BeTrue())
+		for _, rule := range prometheusruleObj.Spec.Groups[0].Rules {
+			Expect(rule.Alert).NotTo(BeElementOf("CertificateExpiry", "GuardianTunnelDown"))
+		}
+	})
+
 	It("should render toleration on GKE", func() {
 		cfg.Installation.KubernetesProvider = operatorv1.ProviderGKE
 		component := monitor.Monitor(cfg)
@@ -676,7 +848,7 @@ var _ = Describe("monitor rendering tests", func() {
 		expectedResources := expectedBaseResources()
 		rtest.ExpectResources(toCreate, expectedResources)
 
-		Expect(toDelete).To(HaveLen(3))
+		Expect(toDelete).To(HaveLen(12))
 
 		// Prometheus
 		prometheusObj, ok := rtest.GetResource(toCreate, monitor.CalicoNodePrometheus, common.TigeraPrometheusNamespace, "monitoring.coreos.com", "v1", monitoringv1.PrometheusesKind).(*monitoringv1.Prometheus)
@@ -835,6 +1007,114 @@ var _ = Describe("monitor rendering tests", func() {
 
 			Expect(len(zeroedPolicy.Spec.Egress)).To(Equal(len(baselinePolicy.Spec.Egress) - 1))
 		})
+
+		It("prometheus policy should allow egress to the remote write endpoints", func() {
+			component := monitor.MonitorPolicy(cfg)
+			resourcesToCreate, _ := component.Objects()
+			baselinePolicy := testutils.GetAllowTigeraPolicyFromResources(types.NamespacedName{Name: "allow-tigera.prometheus", Namespace: "tigera-prometheus"}, resourcesToCreate)
+
+			cfg.Monitor.Prometheus = &operatorv1.Prometheus{
+				PrometheusSpec: &operatorv1.PrometheusSpec{
+					RemoteWrite: []operatorv1.PrometheusRemoteWrite{
+						{URL: "https://thanos.example.com/api/v1/receive"},
+						{URL: "http://10.0.0.1:9009/api/v1/push"},
+						{URL: "https://thanos.example.com/api/v1/other"},
+					},
+				},
+			}
+			component = monitor.MonitorPolicy(cfg)
+			resourcesToCreate, _ = component.Objects()
+			policy := testutils.GetAllowTigeraPolicyFromResources(types.NamespacedName{Name: "allow-tigera.prometheus", Namespace: "tigera-prometheus"}, resourcesToCreate)
+
+			Expect(policy.Spec.Egress).To(ContainElements(
+				v3.Rule{
+					Action:      v3.Allow,
+					Protocol:    &networkpolicy.TCPProtocol,
+					Destination: v3.EntityRule{Domains: []string{"thanos.example.com"}, Ports: networkpolicy.Ports(443)},
+				},
+				v3.Rule{
+					Action:      v3.Allow,
+					Protocol:    &networkpolicy.TCPProtocol,
+					Destination: v3.EntityRule{Nets: []string{"10.0.0.1/32"}, Ports: networkpolicy.Ports(9009)},
+				},
+			))
+			Expect(len(policy.Spec.Egress)).To(Equal(len(baselinePolicy.Spec.Egress) + 2))
+		})
+
+		It("prometheus policy should allow egress to the metrics ports of the components", func() {
+			cfg.MetricsTargets = append(cfg.MetricsTargets,
+				metrics.Target{
+					Name:      "example",
+					Namespace: "example-ns",
+					Selector:  metrics.AppSelector("example"),
+					Endpoints: []metrics.Endpoint{{Port: "metrics", PortNumber: 9999, Scheme: metrics.SchemeHTTP}},
+				},
+				monitor.OperatorMetricsTarget(8484),
+			)
+			component := monitor.MonitorPolicy(cfg)
+			resourcesToCreate, _ := component.Objects()
+			policy := testutils.GetAllowTigeraPolicyFromResources(types.NamespacedName{Name: "allow-tigera.prometheus", Namespace: "tigera-prometheus"}, resourcesToCreate)
+
+			// Egress is restricted to the namespace of the target, unless it runs on the host network.
+			Expect(policy.Spec.Egress).To(ContainElements(
+				v3.Rule{
+					Action:   v3.Allow,
+					Protocol: &networkpolicy.TCPProtocol,
+					Destination: v3.EntityRule{
+						NamespaceSelector: "projectcalico.org/name == 'example-ns'",
+						Ports:             networkpolicy.Ports(9999),
+					},
+				},
+				v3.Rule{
+					Action:      v3.Allow,
+					Protocol:    &networkpolicy.TCPProtocol,
+					Destination: v3.EntityRule{Ports: networkpolicy.Ports(8484)},
+				},
+			))
+		})
+	})
+
+	It("Should render a monitor for each of the declared metrics targets", func() {
+		cfg.MetricsTargets = append(cfg.MetricsTargets,
+			metrics.Target{
+				Name:      "example",
+				Namespace: "example-ns",
+				Selector:  metrics.AppSelector("example"),
+				Endpoints: []metrics.Endpoint{{Port: "metrics", PortNumber: 9999, Scheme: metrics.SchemeHTTP}},
+			},
+			monitor.OperatorMetricsTarget(8484),
+		)
+		component := monitor.Monitor(cfg)
+		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+		toCreate, toDelete := component.Objects()
+
+		sm := rtest.GetResource(toCreate, "example", common.TigeraPrometheusNamespace, "monitoring.coreos.com", "v1", monitoringv1.ServiceMonitorsKind).(*monitoringv1.ServiceMonitor)
+		Expect(sm.Spec.NamespaceSelector).To(Equal(monitoringv1.NamespaceSelector{MatchNames: []string{"example-ns"}}))
+		Expect(sm.Spec.Selector).To(Equal(metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": "example"}}))
+		Expect(sm.Spec.Endpoints).To(ConsistOf(monitoringv1.Endpoint{
+			HonorLabels:   true,
+			Interval:      "5s",
+			Port:          "metrics",
+			ScrapeTimeout: "5s",
+			Scheme:        "http",
+		}))
+
+		// The operator does not have a service, so its pod is scraped directly.
+		targetPort := intstr.FromInt(8484)
+		pm := rtest.GetResource(toCreate, monitor.OperatorMetricsName, common.TigeraPrometheusNamespace, "monitoring.coreos.com", "v1", monitoringv1.PodMonitorsKind).(*monitoringv1.PodMonitor)
+		Expect(pm.Labels).To(Equal(map[string]string{"team": "network-operators"}))
+		Expect(pm.Spec.NamespaceSelector).To(Equal(monitoringv1.NamespaceSelector{MatchNames: []string{common.OperatorNamespace()}}))
+		Expect(pm.Spec.Selector).To(Equal(metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": "tigera-operator"}}))
+		Expect(pm.Spec.PodMetricsEndpoints).To(ConsistOf(monitoringv1.PodMetricsEndpoint{
+			HonorLabels:   true,
+			Interval:      "5s",
+			TargetPort:    &targetPort,
+			ScrapeTimeout: "5s",
+			Scheme:        "http",
+		}))
+
+		// The operator pod monitor is no longer removed, only the typha service monitor is.
+		Expect(toDelete).To(HaveLen(11))
 	})
 
 	It("Should render external prometheus resources with service monitor", func() {
@@ -865,7 +1145,7 @@ var _ = Describe("monitor rendering tests", func() {
 		)
 
 		rtest.ExpectResources(toCreate, expectedResources)
-		Expect(toDelete).To(HaveLen(3))
+		Expect(toDelete).To(HaveLen(12))
 	})
 
 	It("Should render external prometheus resources with service monitor and custom token", func() {
@@ -891,7 +1171,7 @@ var _ = Describe("monitor rendering tests", func() {
 		)
 
 		rtest.ExpectResources(toCreate, expectedResources)
-		Expect(toDelete).To(HaveLen(3))
+		Expect(toDelete).To(HaveLen(12))
 	})
 
 	It("Should render external prometheus resources without service monitor", func() {
@@ -907,11 +1187,12 @@ var _ = Describe("monitor rendering tests", func() {
 		)
 
 		rtest.ExpectResources(toCreate, expectedResources)
-		Expect(toDelete).To(HaveLen(3))
+		Expect(toDelete).To(HaveLen(12))
 	})
 
 	It("Should render typha service monitor if typha metrics are enabled", func() {
 		cfg.Installation.TyphaMetricsPort = ptr.Int32ToPtr(9093)
+		cfg.MetricsTargets = append(cfg.MetricsTargets, render.TyphaMetricsTarget())
 		component := monitor.Monitor(cfg)
 		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
 		toCreate, toDelete := component.Objects()
@@ -921,7 +1202,7 @@ var _ = Describe("monitor rendering tests", func() {
 		)
 
 		rtest.ExpectResources(toCreate, expectedResources)
-		Expect(toDelete).To(HaveLen(2))
+		Expect(toDelete).To(HaveLen(11))
 		sm := rtest.GetResource(toCreate, "calico-typha-metrics", "tigera-prometheus", "monitoring.coreos.com", "v1", "ServiceMonitor").(*monitoringv1.ServiceMonitor)
 		Expect(sm).To(Equal(&monitoringv1.ServiceMonitor{
 			TypeMeta: metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: "monitoring.coreos.com/v1"},
@@ -953,7 +1234,7 @@ var _ = Describe("monitor rendering tests", func() {
 	})
 
 	It("Should render serviceMonitor with felix endpoint if FelixPrometheusMetricsEnabled", func() {
-		cfg.FelixPrometheusMetricsEnabled = true
+		cfg.MetricsTargets = baseMetricsTargets(true)
 		component := monitor.Monitor(cfg)
 		toCreate, _ := component.Objects()
 		servicemonitorObj, ok := rtest.GetResource(toCreate, monitor.CalicoNodeMonitor, common.TigeraPrometheusNamespace, "monitoring.coreos.com", "v1", monitoringv1.ServiceMonitorsKind).(*monitoringv1.ServiceMonitor)
@@ -977,8 +1258,235 @@ var _ = Describe("monitor rendering tests", func() {
 		Expect(servicemonitorObj.Spec.Endpoints[2].Scheme).To(Equal("http"))
 
 	})
+
+	Context("Grafana", func() {
+		dashboardNames := []string{"bgp", "felix", "ipam", "log-pipeline", "typha"}
+
+		var grafanaKeyPair certificatemanagement.KeyPairInterface
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(apis.AddToScheme(scheme)).NotTo(HaveOccurred())
+			cli := ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+			certificateManager, err := certificatemanager.Create(cli, nil, dns.DefaultClusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
+			Expect(err).NotTo(HaveOccurred())
+			grafanaKeyPair, err = certificateManager.GetOrCreateKeyPair(cli, monitor.GrafanaTLSSecretName, common.OperatorNamespace(), []string{monitor.GrafanaName})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should delete the dashboards and the Grafana deployment when Grafana is not configured", func() {
+			for _, name := range dashboardNames {
+				cfg.GrafanaDashboards = append(cfg.GrafanaDashboards, client.ObjectKey{Name: monitor.GrafanaDashboardConfigMapPrefix + name, Namespace: "monitoring"})
+			}
+			component := monitor.Monitor(cfg)
+			Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+			toCreate, toDelete := component.Objects()
+
+			Expect(rtest.GetResource(toCreate, monitor.GrafanaName, common.TigeraPrometheusNamespace, "apps", "v1", "Deployment")).To(BeNil())
+			for _, name := range dashboardNames {
+				Expect(toDelete).To(ContainElement(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: monitor.GrafanaDashboardConfigMapPrefix + name, Namespace: "monitoring"}}))
+			}
+			Expect(toDelete).To(ContainElement(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: monitor.GrafanaName, Namespace: common.TigeraPrometheusNamespace}}))
+
+			policies, _ := monitor.MonitorPolicy(cfg).Objects()
+			Expect(testutils.GetAllowTigeraPolicyFromResources(types.NamespacedName{Name: monitor.GrafanaPolicyName, Namespace: common.TigeraPrometheusNamespace}, policies)).To(BeNil())
+			_, policiesToDelete := monitor.MonitorPolicyToDelete(cfg).Objects()
+			Expect(policiesToDelete).To(HaveLen(1))
+		})
+
+		It("should only export the dashboards in DashboardsOnly mode", func() {
+			mode := operatorv1.GrafanaModeDashboardsOnly
+			cfg.Monitor.Grafana = &operatorv1.Grafana{
+				Mode: &mode,
+				Dashboards: &operatorv1.GrafanaDashboards{
+					Namespace: "monitoring",
+					Labels:    map[string]string{"dashboards": "calico"},
+					Folder:    "Calico",
+				},
+			}
+			// The dashboards were previously exported to the default namespace.
+			for _, name := range dashboardNames {
+				cfg.GrafanaDashboards = append(cfg.GrafanaDashboards,
+					client.ObjectKey{Name: monitor.GrafanaDashboardConfigMapPrefix + name, Namespace: common.TigeraPrometheusNamespace},
+					client.ObjectKey{Name: monitor.GrafanaDashboardConfigMapPrefix + name, Namespace: "monitoring"},
+				)
+			}
+			component := monitor.Monitor(cfg)
+			Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+			toCreate, toDelete := component.Objects()
+
+			for _, name := range dashboardNames {
+				cm, ok := rtest.GetResource(toCreate, monitor.GrafanaDashboardConfigMapPrefix+name, "monitoring", "", "v1", "ConfigMap").(*corev1.ConfigMap)
+				Expect(ok).To(BeTrue())
+				Expect(cm.Labels).To(Equal(map[string]string{"dashboards": "calico", monitor.GrafanaDashboardLabel: "true"}))
+				Expect(toDelete).To(ContainElement(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: monitor.GrafanaDashboardConfigMapPrefix + name, Namespace: common.TigeraPrometheusNamespace}}))
+				Expect(toDelete).NotTo(ContainElement(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: monitor.GrafanaDashboardConfigMapPrefix + name, Namespace: "monitoring"}}))
+				Expect(cm.Annotations).To(Equal(map[string]string{"grafana_folder": "Calico"}))
+				Expect(cm.Data).To(HaveKey(name + ".json"))
+
+				var dashboard map[string]interface{}
+				Expect(json.Unmarshal([]byte(cm.Data[name+".json"]), &dashboard)).NotTo(HaveOccurred())
+				Expect(dashboard["uid"]).To(HavePrefix("calico-"))
+			}
+			Expect(rtest.GetResource(toCreate, monitor.GrafanaName, common.TigeraPrometheusNamespace, "apps", "v1", "Deployment")).To(BeNil())
+			Expect(toDelete).To(ContainElement(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: monitor.GrafanaName, Namespace: common.TigeraPrometheusNamespace}}))
+		})
+
+		It("should deploy Grafana with the dashboards and Prometheus as its data source", func() {
+			cfg.Monitor.Grafana = &operatorv1.Grafana{}
+			cfg.GrafanaTLSSecret = grafanaKeyPair
+			cfg.GrafanaAdminSecret = monitor.CreateGrafanaAdminSecret()
+			component := monitor.Monitor(cfg)
+			Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+			toCreate, _ := component.Objects()
+
+			expectedResources := expectedBaseResources()
+			for _, name := range dashboardNames {
+				expectedResources = append(expectedResources, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: monitor.GrafanaDashboardConfigMapPrefix + name, Namespace: common.TigeraPrometheusNamespace}, TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"}})
+			}
+			expectedResources = append(expectedResources,
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: monitor.GrafanaAdminSecretName, Namespace: common.TigeraPrometheusNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}},
+				&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: monitor.GrafanaName, Namespace: common.TigeraPrometheusNamespace}, TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"}},
+				&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: monitor.GrafanaName}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+				&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: monitor.GrafanaName}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: monitor.GrafanaName, Namespace: common.TigeraPrometheusNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}},
+				&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: monitor.GrafanaName, Namespace: common.TigeraPrometheusNamespace}, TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"}},
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: monitor.GrafanaName, Namespace: common.TigeraPrometheusNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"}},
+				&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: monitor.GrafanaName, Namespace: common.TigeraPrometheusNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
+			)
+			rtest.ExpectResources(toCreate, expectedResources)
+
+			d := rtest.GetResource(toCreate, monitor.GrafanaName, common.TigeraPrometheusNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			grafanaCom := components.ComponentGrafana
+			Expect(d.Spec.Template.Spec.Containers).To(HaveLen(1))
+			container := d.Spec.Template.Spec.Containers[0]
+			Expect(container.Image).To(Equal(fmt.Sprintf("%s%s:%s", grafanaCom.Registry, grafanaCom.Image, grafanaCom.Version)))
+			Expect(container.ReadinessProbe.HTTPGet.Scheme).To(Equal(corev1.URISchemeHTTPS))
+			Expect(container.VolumeMounts).To(ContainElements(
+				corev1.VolumeMount{Name: "dashboards", MountPath: "/etc/grafana/dashboards", ReadOnly: true},
+				corev1.VolumeMount{Name: monitor.GrafanaTLSSecretName, MountPath: "/tigera-grafana-tls", ReadOnly: true},
+			))
+			Expect(d.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/grafana-config"))
+			Expect(d.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/grafana-admin"))
+
+			var dashboardsVolume *corev1.Volume
+			for i, v := range d.Spec.Template.Spec.Volumes {
+				if v.Name == "dashboards" {
+					dashboardsVolume = &d.Spec.Template.Spec.Volumes[i]
+				}
+			}
+			Expect(dashboardsVolume).NotTo(BeNil())
+			Expect(dashboardsVolume.Projected.Sources).To(HaveLen(len(dashboardNames)))
+
+			cm := rtest.GetResource(toCreate, monitor.GrafanaName, common.TigeraPrometheusNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+			Expect(cm.Data["grafana.ini"]).To(ContainSubstring("protocol = https"))
+			Expect(cm.Data["grafana.ini"]).To(ContainSubstring("cert_file = /tigera-grafana-tls/tls.crt"))
+			Expect(cm.Data["grafana.ini"]).NotTo(ContainSubstring("[auth.jwt]"))
+			Expect(cm.Data["datasources.yaml"]).To(ContainSubstring("url: https://prometheus-http-api.tigera-prometheus.svc:9090"))
+			Expect(cm.Data["datasources.yaml"]).To(ContainSubstring("tlsCACert: $__file{/etc/pki/tls/certs/tigera-ca-bundle.crt}"))
+
+			policies, _ := monitor.MonitorPolicy(cfg).Objects()
+			policy := testutils.GetAllowTigeraPolicyFromResources(types.NamespacedName{Name: monitor.GrafanaPolicyName, Namespace: common.TigeraPrometheusNamespace}, policies)
+			Expect(policy).NotTo(BeNil())
+			Expect(policy.Spec.Ingress).To(ConsistOf(v3.Rule{
+				Action:      v3.Allow,
+				Protocol:    &networkpolicy.TCPProtocol,
+				Destination: v3.EntityRule{Ports: networkpolicy.Ports(monitor.GrafanaPort)},
+			}))
+			Expect(policy.Spec.Egress).To(ContainElement(v3.Rule{
+				Action:      v3.Allow,
+				Protocol:    &networkpolicy.TCPProtocol,
+				Destination: networkpolicy.PrometheusEntityRule,
+			}))
+			_, policiesToDelete := monitor.MonitorPolicyToDelete(cfg).Objects()
+			Expect(policiesToDelete).To(BeEmpty())
+		})
+
+		It("should sign Grafana users in through Dex and derive their roles from their groups", func() {
+			authentication := &operatorv1.Authentication{
+				Spec: operatorv1.AuthenticationSpec{
+					ManagerDomain: "https://example.com",
+					OIDC:          &operatorv1.AuthenticationOIDC{IssuerURL: "https://accounts.google.com", UsernameClaim: "email"},
+				},
+			}
+			cfg.KeyValidatorConfig = render.NewDexKeyValidatorConfig(authentication, nil, dns.DefaultClusterDomain)
+			cfg.Monitor.Grafana = &operatorv1.Grafana{
+				URL: "https://grafana.example.com/",
+				RoleMappings: []operatorv1.GrafanaRoleMapping{
+					{Groups: []string{"admins"}, Role: operatorv1.GrafanaRoleAdmin},
+					{Groups: []string{"editors", "o'neills"}, Role: operatorv1.GrafanaRoleEditor},
+				},
+			}
+			cfg.GrafanaTLSSecret = grafanaKeyPair
+			cfg.GrafanaAdminSecret = monitor.CreateGrafanaAdminSecret()
+			cfg.DexClientSecret = render.CreateDexClientSecret()
+			component := monitor.Monitor(cfg)
+			Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+			toCreate, _ := component.Objects()
+
+			Expect(rtest.GetResource(toCreate, render.DexObjectName, common.TigeraPrometheusNamespace, "", "v1", "Secret")).NotTo(BeNil())
+
+			cm := rtest.GetResource(toCreate, monitor.GrafanaName, common.TigeraPrometheusNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+			ini := cm.Data["grafana.ini"]
+			Expect(ini).NotTo(ContainSubstring("[auth.jwt]"))
+			Expect(ini).To(ContainSubstring("root_url = https://grafana.example.com\n"))
+			Expect(ini).To(ContainSubstring("[auth.generic_oauth]"))
+			Expect(ini).To(ContainSubstring("client_id = tigera-grafana"))
+			Expect(ini).To(ContainSubstring("auth_url = https://example.com/dex/auth"))
+			Expect(ini).To(ContainSubstring("token_url = https://tigera-dex.tigera-dex.svc.cluster.local:5556/dex/token"))
+			Expect(ini).To(ContainSubstring("api_url = https://tigera-dex.tigera-dex.svc.cluster.local:5556/dex/userinfo"))
+			Expect(ini).To(ContainSubstring("login_attribute_path = email"))
+			Expect(ini).To(ContainSubstring(`role_attribute_path = contains(groups[*], 'admins') && 'Admin' || contains(groups[*], 'editors') && 'Editor' || contains(groups[*], 'o\'neills') && 'Editor' || 'Viewer'`))
+
+			d := rtest.GetResource(toCreate, monitor.GrafanaName, common.TigeraPrometheusNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/grafana-oauth"))
+			Expect(d.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{
+				Name: "GF_AUTH_GENERIC_OAUTH_CLIENT_SECRET",
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: render.DexObjectName},
+					Key:                  render.ClientSecretSecretField,
+				}},
+			}))
+
+			// Grafana only talks to Dex within the cluster, so no additional egress is needed.
+			policies, _ := monitor.MonitorPolicy(cfg).Objects()
+			policy := testutils.GetAllowTigeraPolicyFromResources(types.NamespacedName{Name: monitor.GrafanaPolicyName, Namespace: common.TigeraPrometheusNamespace}, policies)
+			Expect(policy.Spec.Egress).To(HaveLen(3))
+		})
+
+		It("should not enable the Dex sign-in without the address of Grafana", func() {
+			authentication := &operatorv1.Authentication{
+				Spec: operatorv1.AuthenticationSpec{
+					ManagerDomain: "https://example.com",
+					OIDC:          &operatorv1.AuthenticationOIDC{IssuerURL: "https://accounts.google.com", UsernameClaim: "email"},
+				},
+			}
+			cfg.KeyValidatorConfig = render.NewDexKeyValidatorConfig(authentication, nil, dns.DefaultClusterDomain)
+			cfg.Monitor.Grafana = &operatorv1.Grafana{}
+			cfg.GrafanaTLSSecret = grafanaKeyPair
+			cfg.GrafanaAdminSecret = monitor.CreateGrafanaAdminSecret()
+			cfg.DexClientSecret = render.CreateDexClientSecret()
+			component := monitor.Monitor(cfg)
+			Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+			toCreate, _ := component.Objects()
+
+			Expect(rtest.GetResource(toCreate, render.DexObjectName, common.TigeraPrometheusNamespace, "", "v1", "Secret")).To(BeNil())
+			cm := rtest.GetResource(toCreate, monitor.GrafanaName, common.TigeraPrometheusNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+			Expect(cm.Data["grafana.ini"]).NotTo(ContainSubstring("[auth.generic_oauth]"))
+		})
+	})
 })
 
+// baseMetricsTargets returns the metrics targets of the components that are scraped in the most basic setup.
+func baseMetricsTargets(felixPrometheusMetricsEnabled bool) []metrics.Target {
+	return []metrics.Target{
+		render.CalicoNodeMetricsTarget(felixPrometheusMetricsEnabled),
+		esmetrics.MetricsTarget(),
+		render.FluentdMetricsTarget(),
+		render.QueryServerMetricsTarget(operatorv1.TigeraSecureEnterprise),
+		kubecontrollers.MetricsTarget(0),
+	}
+}
+
 // expectedBaseResources These are the expected resources in the most basic setup.
 func expectedBaseResources() []client.Object {
 	return []client.Object{
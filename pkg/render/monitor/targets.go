@@ -0,0 +1,201 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"fmt"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/render/common/metrics"
+	"github.com/tigera/operator/pkg/render/common/networkpolicy"
+)
+
+// OperatorMetricsName is the name of the PodMonitor that scrapes the metrics of the operator.
+const OperatorMetricsName = "tigera-operator-metrics"
+
+// OperatorMetricsTarget declares the metrics endpoint of the operator itself. The operator does not have a service, so
+// its pod is scraped directly.
+func OperatorMetricsTarget(port int32) metrics.Target {
+	return metrics.Target{
+		Name:        OperatorMetricsName,
+		Namespace:   common.OperatorNamespace(),
+		Selector:    metrics.AppSelector("tigera-operator"),
+		Pods:        true,
+		HostNetwork: true,
+		Endpoints: []metrics.Endpoint{
+			{
+				PortNumber: uint16(port),
+				Scheme:     metrics.SchemeHTTP,
+			},
+		},
+	}
+}
+
+// metricsMonitors returns a ServiceMonitor, or a PodMonitor for pod targets, for each of the metrics targets.
+func (mc *monitorComponent) metricsMonitors() []client.Object {
+	var objs []client.Object
+	for _, t := range mc.cfg.MetricsTargets {
+		if t.Pods {
+			objs = append(objs, podMonitor(t))
+		} else {
+			objs = append(objs, mc.serviceMonitor(t))
+		}
+	}
+	return objs
+}
+
+// hasMetricsTarget returns true if a metrics target with the given name is configured.
+func (mc *monitorComponent) hasMetricsTarget(name string) bool {
+	for _, t := range mc.cfg.MetricsTargets {
+		if t.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (mc *monitorComponent) serviceMonitor(t metrics.Target) *monitoringv1.ServiceMonitor {
+	var endpoints []monitoringv1.Endpoint
+	for _, ep := range t.Endpoints {
+		endpoint := monitoringv1.Endpoint{
+			HonorLabels:   true,
+			Interval:      "5s",
+			Port:          ep.Port,
+			Path:          ep.Path,
+			ScrapeTimeout: "5s",
+			Scheme:        ep.Scheme,
+		}
+		if ep.ServerName != "" {
+			if ep.ClientAuth {
+				endpoint.TLSConfig = mc.tlsConfig(ep.ServerName)
+			} else {
+				serverName := ep.ServerName
+				endpoint.TLSConfig = &monitoringv1.TLSConfig{
+					CAFile: mc.cfg.TrustedCertBundle.MountPath(),
+					SafeTLSConfig: monitoringv1.SafeTLSConfig{
+						ServerName: &serverName,
+					},
+				}
+			}
+		}
+		if ep.BearerToken {
+			endpoint.BearerTokenFile = bearerTokenFile
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	return &monitoringv1.ServiceMonitor{
+		TypeMeta: metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: MonitoringAPIVersion},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      t.Name,
+			Namespace: common.TigeraPrometheusNamespace,
+			Labels:    map[string]string{"team": "network-operators"},
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Selector:          t.Selector,
+			NamespaceSelector: namespaceSelector(t),
+			Endpoints:         endpoints,
+		},
+	}
+}
+
+// podMonitor creates a PodMonitor for a target without a service. Pod monitors cannot read TLS configuration from the
+// file system, so pod targets are scraped over plain HTTP.
+func podMonitor(t metrics.Target) *monitoringv1.PodMonitor {
+	var endpoints []monitoringv1.PodMetricsEndpoint
+	for _, ep := range t.Endpoints {
+		endpoint := monitoringv1.PodMetricsEndpoint{
+			HonorLabels:   true,
+			Interval:      "5s",
+			Port:          ep.Port,
+			Path:          ep.Path,
+			ScrapeTimeout: "5s",
+			Scheme:        ep.Scheme,
+		}
+		if ep.Port == "" {
+			targetPort := intstr.FromInt(int(ep.PortNumber))
+			endpoint.TargetPort = &targetPort
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	return &monitoringv1.PodMonitor{
+		TypeMeta: metav1.TypeMeta{Kind: monitoringv1.PodMonitorsKind, APIVersion: MonitoringAPIVersion},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      t.Name,
+			Namespace: common.TigeraPrometheusNamespace,
+			Labels:    map[string]string{"team": "network-operators"},
+		},
+		Spec: monitoringv1.PodMonitorSpec{
+			Selector:            t.Selector,
+			NamespaceSelector:   namespaceSelector(t),
+			PodMetricsEndpoints: endpoints,
+		},
+	}
+}
+
+func namespaceSelector(t metrics.Target) monitoringv1.NamespaceSelector {
+	if t.Namespace == "" {
+		return monitoringv1.NamespaceSelector{Any: true}
+	}
+	return monitoringv1.NamespaceSelector{MatchNames: []string{t.Namespace}}
+}
+
+// metricsEgressRules returns the egress rules that allow Prometheus to reach the metrics ports of the targets that are
+// not covered by the static rules of the Prometheus policy. Each rule is scoped to the namespace of its target.
+func metricsEgressRules(cfg *Config) []v3.Rule {
+	allowed := map[uint16]bool{9081: true, 9091: true, 9900: true, 8080: true}
+	if cfg.KubeControllerPort != 0 {
+		allowed[uint16(cfg.KubeControllerPort)] = true
+	}
+	if cfg.Installation.TyphaMetricsPort != nil {
+		allowed[uint16(*cfg.Installation.TyphaMetricsPort)] = true
+	}
+
+	var rules []v3.Rule
+	for _, t := range cfg.MetricsTargets {
+		var ports []uint16
+		for _, port := range t.Ports() {
+			if !allowed[port] {
+				ports = append(ports, port)
+			}
+		}
+		if len(ports) == 0 {
+			continue
+		}
+
+		destination := v3.EntityRule{Ports: networkpolicy.Ports(ports...)}
+		// Egress to host networked pods can only be restricted by port.
+		if !t.HostNetwork {
+			destination.NamespaceSelector = "all()"
+			if t.Namespace != "" {
+				destination.NamespaceSelector = fmt.Sprintf("projectcalico.org/name == '%s'", t.Namespace)
+			}
+		}
+		rules = append(rules, v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Destination: destination,
+		})
+	}
+	return rules
+}
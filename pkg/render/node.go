@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -31,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
+	nadv1 "github.com/tigera/operator/pkg/apis/k8s.cni.cncf.io/v1"
 	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/components"
 	"github.com/tigera/operator/pkg/controller/k8sapi"
@@ -39,6 +41,7 @@ import (
 	rcomp "github.com/tigera/operator/pkg/render/common/components"
 	"github.com/tigera/operator/pkg/render/common/configmap"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/metrics"
 	"github.com/tigera/operator/pkg/render/common/securitycontext"
 	"github.com/tigera/operator/pkg/render/common/securitycontextconstraints"
 	"github.com/tigera/operator/pkg/tls/certificatemanagement"
@@ -62,6 +65,7 @@ const (
 	CNIFinalizer                      = "tigera.io/cni-protector"
 
 	CalicoNodeMetricsService      = "calico-node-metrics"
+	CalicoNodeMonitorName         = "calico-node-monitor"
 	NodePrometheusTLSServerSecret = "calico-node-prometheus-server-tls"
 	CalicoNodeObjectName          = "calico-node"
 	CalicoCNIPluginObjectName     = "calico-cni-plugin"
@@ -129,6 +133,19 @@ type NodeConfiguration struct {
 	FelixPrometheusMetricsEnabled bool
 
 	FelixPrometheusMetricsPort int
+
+	// BPFMigration is set while nodes are still running the eBPF dataplane even though the Installation no longer
+	// selects it, as happens during a staged dataplane migration away from BPF. The BPF volumes are kept on
+	// every node until the migration completes.
+	BPFMigration bool
+
+	// NetworkAttachmentDefinitions are the NetworkAttachmentDefinitions in the cluster that were rendered for
+	// secondary networks. Any that are no longer needed are deleted.
+	NetworkAttachmentDefinitions []nadv1.NetworkAttachmentDefinition
+
+	// MissingSecondaryNetworkNamespaces are the namespaces of secondary networks that do not exist. No
+	// NetworkAttachmentDefinitions are rendered in them.
+	MissingSecondaryNetworkNamespaces map[string]bool
 }
 
 // Node creates the node daemonset and other resources for the daemonset to operate normally.
@@ -146,6 +163,11 @@ type nodeComponent struct {
 	nodeImage    string
 }
 
+// bpfVolumesRequired returns true if calico-node needs the BPF filesystem mounted.
+func (c *nodeComponent) bpfVolumesRequired() bool {
+	return c.cfg.Installation.BPFEnabled() || c.cfg.BPFMigration
+}
+
 func (c *nodeComponent) ResolveImages(is *operatorv1.ImageSet) error {
 	reg := c.cfg.Installation.Registry
 	path := c.cfg.Installation.ImagePath
@@ -218,6 +240,10 @@ func (c *nodeComponent) Objects() ([]client.Object, []client.Object) {
 		objs = append(objs, cniConfig)
 	}
 
+	nads, staleNADs := c.networkAttachmentDefinitions()
+	objs = append(objs, nads...)
+	objsToDelete = append(objsToDelete, staleNADs...)
+
 	if btcm := c.birdTemplateConfigMap(); btcm != nil {
 		objs = append(objs, btcm)
 	}
@@ -739,6 +765,87 @@ func (c *nodeComponent) createTuningPlugin() map[string]interface{} {
 	return tuningPlugin
 }
 
+// bandwidthPluginEnabled returns true if the bandwidth plugin is chained in the CNI network config.
+func bandwidthPluginEnabled(installation *operatorv1.InstallationSpec) bool {
+	cp := installation.CNI.ChainedPlugins
+	return cp == nil || cp.Bandwidth == nil || *cp.Bandwidth == operatorv1.BandwidthPluginEnabled
+}
+
+// portmapPluginEnabled returns true if the portmap plugin is chained in the CNI network config.
+func portmapPluginEnabled(installation *operatorv1.InstallationSpec) bool {
+	cn := installation.CalicoNetwork
+	return cn != nil && cn.HostPorts != nil && *cn.HostPorts == operatorv1.HostPortsEnabled
+}
+
+// tuningPluginEnabled returns true if the tuning plugin is chained in the CNI network config.
+func tuningPluginEnabled(installation *operatorv1.InstallationSpec) bool {
+	return installation.CalicoNetwork != nil && installation.CalicoNetwork.Sysctl != nil
+}
+
+// cniPluginTypeRegexp matches valid CNI plugin types, which are the names of the plugin binaries.
+var cniPluginTypeRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// cniPluginChain inserts the Installation's additional chained plugins into the given plugins. Each is chained
+// directly after the last plugin of the type it names in After, or last if After isn't set. An error is returned if
+// a plugin's type or configuration is invalid, its type is already in the chain, or it names a plugin in After that
+// isn't in the chain before it.
+func cniPluginChain(installation *operatorv1.InstallationSpec, plugins []map[string]interface{}) ([]map[string]interface{}, error) {
+	if installation.CNI.ChainedPlugins == nil {
+		return plugins, nil
+	}
+	chain := append([]map[string]interface{}{}, plugins...)
+	for _, p := range installation.CNI.ChainedPlugins.Additional {
+		if !cniPluginTypeRegexp.MatchString(p.Type) {
+			return nil, fmt.Errorf("plugin type %q is invalid, it must be a plugin binary name", p.Type)
+		}
+		plugin := map[string]interface{}{}
+		if p.Config != "" {
+			if err := json.Unmarshal([]byte(p.Config), &plugin); err != nil {
+				return nil, fmt.Errorf("config of plugin %s is not a JSON object: %w", p.Type, err)
+			}
+			if _, ok := plugin["type"]; ok {
+				return nil, fmt.Errorf("config of plugin %s must not set the type", p.Type)
+			}
+		}
+		plugin["type"] = p.Type
+
+		pos, after := len(chain), -1
+		for i, existing := range chain {
+			if existing["type"] == p.Type {
+				return nil, fmt.Errorf("plugin %s is already in the chain", p.Type)
+			}
+			if existing["type"] == p.After {
+				after = i
+			}
+		}
+		if p.After != "" {
+			if after < 0 {
+				return nil, fmt.Errorf("plugin %s is chained after %s, which is not in the chain", p.Type, p.After)
+			}
+			pos = after + 1
+		}
+		chain = append(chain[:pos], append([]map[string]interface{}{plugin}, chain[pos:]...)...)
+	}
+	return chain, nil
+}
+
+// ValidateCNIPluginChain checks that the Installation's additional chained plugins can be added to the CNI network
+// config.
+func ValidateCNIPluginChain(installation *operatorv1.InstallationSpec) error {
+	plugins := []map[string]interface{}{{"type": "calico"}}
+	if bandwidthPluginEnabled(installation) {
+		plugins = append(plugins, map[string]interface{}{"type": "bandwidth"})
+	}
+	if portmapPluginEnabled(installation) {
+		plugins = append(plugins, map[string]interface{}{"type": "portmap"})
+	}
+	if tuningPluginEnabled(installation) {
+		plugins = append(plugins, map[string]interface{}{"type": "tuning"})
+	}
+	_, err := cniPluginChain(installation, plugins)
+	return err
+}
+
 // nodeCNIConfigMap returns a config map containing the CNI network config to be installed on each node.
 // Returns nil if no configmap is needed.
 func (c *nodeComponent) nodeCNIConfigMap() *corev1.ConfigMap {
@@ -747,21 +854,29 @@ func (c *nodeComponent) nodeCNIConfigMap() *corev1.ConfigMap {
 		return nil
 	}
 
-	plugins := make([]interface{}, 0)
-	plugins = append(plugins, c.createCalicoPluginConfig())
-	plugins = append(plugins, c.createBandwidthPlugin())
+	plugins := []map[string]interface{}{c.createCalicoPluginConfig()}
+
+	// optional bandwidth plugin
+	if bandwidthPluginEnabled(c.cfg.Installation) {
+		plugins = append(plugins, c.createBandwidthPlugin())
+	}
 
 	// optional portmap plugin
-	if c.cfg.Installation.CalicoNetwork.HostPorts != nil &&
-		*c.cfg.Installation.CalicoNetwork.HostPorts == operatorv1.HostPortsEnabled {
+	if portmapPluginEnabled(c.cfg.Installation) {
 		plugins = append(plugins, c.createPortmapPlugin())
 	}
 
 	// optional tuning plugin
-	if c.cfg.Installation.CalicoNetwork.Sysctl != nil {
+	if tuningPluginEnabled(c.cfg.Installation) {
 		plugins = append(plugins, c.createTuningPlugin())
 	}
 
+	// Chain any additional plugins. These are validated before rendering, so only the operator's plugins are
+	// configured if they can't be chained.
+	if chain, err := cniPluginChain(c.cfg.Installation, plugins); err == nil {
+		plugins = chain
+	}
+
 	pluginsArray, _ := json.Marshal(plugins)
 
 	config := fmt.Sprintf(`{
@@ -922,7 +1037,7 @@ func (c *nodeComponent) nodeDaemonset(cniCfgMap *corev1.ConfigMap) *appsv1.Daemo
 		initContainers = append(initContainers, c.flexVolumeContainer())
 	}
 
-	if c.cfg.Installation.BPFEnabled() {
+	if c.bpfVolumesRequired() {
 		initContainers = append(initContainers, c.bpffsInitContainer())
 	}
 
@@ -1062,7 +1177,7 @@ func (c *nodeComponent) nodeVolumes() []corev1.Volume {
 		)
 	}
 
-	if c.cfg.Installation.BPFEnabled() {
+	if c.bpfVolumesRequired() {
 		volumes = append(volumes,
 			// Volume for the containing directory so that the init container can mount the child bpf directory if needed.
 			corev1.Volume{Name: "sys-fs", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/sys/fs", Type: &dirOrCreate}}},
@@ -1334,7 +1449,7 @@ func (c *nodeComponent) nodeVolumeMounts() []corev1.VolumeMount {
 			corev1.VolumeMount{MountPath: "/var/lib/calico", Name: "var-lib-calico"},
 		)
 	}
-	if c.cfg.Installation.BPFEnabled() {
+	if c.bpfVolumesRequired() {
 		nodeVolumeMounts = append(nodeVolumeMounts, corev1.VolumeMount{MountPath: "/sys/fs/bpf", Name: BPFVolumeName})
 	}
 	if c.vppDataplaneEnabled() {
@@ -1450,10 +1565,6 @@ func (c *nodeComponent) nodeEnvVars() []corev1.EnvVar {
 			corev1.EnvVar{
 				Name:  "FELIX_FLOWLOGSGOLDMANESERVER",
 				Value: "goldmane.calico-system.svc.cluster.local:7443",
-			},
-			corev1.EnvVar{
-				Name:  "FELIX_FLOWLOGSFLUSHINTERVAL",
-				Value: "15",
 			})
 	}
 
@@ -1778,6 +1889,37 @@ func (c *nodeComponent) nodeMetricsService() *corev1.Service {
 	}
 }
 
+// CalicoNodeMetricsTarget declares the metrics endpoints of calico-node that are exposed by the calico-node-metrics
+// service. The Felix endpoint is only served when Prometheus metrics are enabled in the FelixConfiguration.
+func CalicoNodeMetricsTarget(felixPrometheusMetricsEnabled bool) metrics.Target {
+	endpoints := []metrics.Endpoint{
+		{
+			Port:       "calico-metrics-port",
+			Scheme:     metrics.SchemeHTTPS,
+			ServerName: CalicoNodeMetricsService,
+			ClientAuth: true,
+		},
+		{
+			Port:       "calico-bgp-metrics-port",
+			Scheme:     metrics.SchemeHTTPS,
+			ServerName: CalicoNodeMetricsService,
+			ClientAuth: true,
+		},
+	}
+	if felixPrometheusMetricsEnabled {
+		endpoints = append(endpoints, metrics.Endpoint{
+			Port:   "felix-metrics-port",
+			Scheme: metrics.SchemeHTTP,
+		})
+	}
+	return metrics.Target{
+		Name:      CalicoNodeMonitorName,
+		Namespace: common.CalicoNamespace,
+		Selector:  metrics.AppSelector(CalicoNodeObjectName, WindowsNodeObjectName),
+		Endpoints: endpoints,
+	}
+}
+
 // hostPathInitContainer creates an init container that changes the permissions on hostPath volumes
 // so that they can be written to by a non-root container.
 func (c *nodeComponent) hostPathInitContainer() corev1.Container {
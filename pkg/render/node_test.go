@@ -15,6 +15,7 @@
 package render_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -27,12 +28,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/apis"
+	nadv1 "github.com/tigera/operator/pkg/apis/k8s.cni.cncf.io/v1"
 	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/components"
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
@@ -2672,6 +2675,116 @@ var _ = Describe("Node rendering tests", func() {
   }`, enableIPv4, enableIPv6)))
 			})
 
+			It("should render additional chained plugins in the cni config", func() {
+				disabled := operatorv1.BandwidthPluginDisabled
+				defaultInstance.CNI.ChainedPlugins = &operatorv1.CNIChainedPlugins{
+					Bandwidth: &disabled,
+					Additional: []operatorv1.CNIChainedPlugin{
+						{Type: "firewall", Config: `{"backend": "iptables"}`},
+						{Type: "sbr", After: "calico"},
+						{Type: "custom-meta", After: "sbr", Config: `{"table": 100, "rules": ["a", "b"]}`},
+					},
+				}
+				component := render.Node(&cfg)
+				Expect(component.ResolveImages(nil)).To(BeNil())
+				resources, _ := component.Objects()
+
+				cniCm := rtest.GetResource(resources, "cni-config", "calico-system", "", "v1", "ConfigMap").(*corev1.ConfigMap)
+				var config struct {
+					Plugins []map[string]interface{} `json:"plugins"`
+				}
+				Expect(json.Unmarshal([]byte(cniCm.Data["config"]), &config)).To(Succeed())
+				var types []interface{}
+				for _, p := range config.Plugins {
+					types = append(types, p["type"])
+				}
+				Expect(types).To(Equal([]interface{}{"calico", "sbr", "custom-meta", "portmap", "firewall"}))
+				Expect(config.Plugins[2]).To(Equal(map[string]interface{}{"type": "custom-meta", "table": float64(100), "rules": []interface{}{"a", "b"}}))
+				Expect(config.Plugins[4]).To(Equal(map[string]interface{}{"type": "firewall", "backend": "iptables"}))
+			})
+
+			It("should render NetworkAttachmentDefinitions for secondary networks", func() {
+				mm := operatorv1.MultiInterfaceModeMultus
+				defaultInstance.CalicoNetwork.MultiInterfaceMode = &mm
+				defaultInstance.CalicoNetwork.SecondaryNetworks = []operatorv1.SecondaryNetwork{
+					{Name: "net-a", IPPool: operatorv1.IPPool{CIDR: "10.10.0.0/16"}, InterfacePrefix: "cna", Namespaces: []string{"ns1", "ns2"}},
+					{Name: "net-b", IPPool: operatorv1.IPPool{CIDR: "fd00:10::/112"}, InterfacePrefix: "cnb", Namespaces: []string{"ns1"}},
+				}
+				cfg.NetworkAttachmentDefinitions = []nadv1.NetworkAttachmentDefinition{
+					{ObjectMeta: metav1.ObjectMeta{Name: "net-a", Namespace: "ns1"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "net-a", Namespace: "ns3"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "net-c", Namespace: "ns1"}},
+				}
+				component := render.Node(&cfg)
+				Expect(component.ResolveImages(nil)).To(BeNil())
+				resources, toDelete := component.Objects()
+				Expect(len(resources)).To(Equal(defaultNumExpectedResources + 3))
+
+				for _, ns := range []string{"ns1", "ns2"} {
+					nad := rtest.GetResource(resources, "net-a", ns, "k8s.cni.cncf.io", "v1", "NetworkAttachmentDefinition").(*nadv1.NetworkAttachmentDefinition)
+					Expect(nad.Labels).To(HaveKeyWithValue(render.SecondaryNetworkLabel, "net-a"))
+					Expect(nad.Spec.Config).To(MatchJSON(`{
+  "name": "net-a",
+  "cniVersion": "0.3.1",
+  "plugins": [
+    {
+      "container_settings": {
+        "allow_ip_forwarding": false
+      },
+      "datastore_type": "kubernetes",
+      "ipam": {
+        "assign_ipv4": "true",
+        "assign_ipv6": "false",
+        "ipv4_pools": ["10.10.0.0/16"],
+        "type": "calico-ipam"
+      },
+      "kubernetes": {
+        "kubeconfig": "/etc/cni/net.d/calico-kubeconfig"
+      },
+      "log_file_max_age": 5,
+      "log_file_max_count": 5,
+      "log_file_max_size": 1,
+      "log_file_path": "/var/log/calico/cni/cni.log",
+      "log_level": "Debug",
+      "mtu": 0,
+      "nodename_file_optional": false,
+      "policy": {
+        "type": "k8s"
+      },
+      "policy_setup_timeout_seconds": 0,
+      "endpoint_status_dir": "/var/run/calico/endpoint-status",
+      "interface_prefix": "cna",
+      "type": "calico"
+    }
+  ]
+}`))
+				}
+				nad := rtest.GetResource(resources, "net-b", "ns1", "k8s.cni.cncf.io", "v1", "NetworkAttachmentDefinition").(*nadv1.NetworkAttachmentDefinition)
+				Expect(nad.Spec.Config).To(ContainSubstring(`"ipam":{"assign_ipv4":"false","assign_ipv6":"true","ipv6_pools":["fd00:10::/112"],"type":"calico-ipam"}`))
+
+				// NetworkAttachmentDefinitions for removed networks and namespaces are deleted.
+				Expect(rtest.GetResource(toDelete, "net-a", "ns1", "k8s.cni.cncf.io", "v1", "NetworkAttachmentDefinition")).To(BeNil())
+				Expect(rtest.GetResource(toDelete, "net-a", "ns3", "k8s.cni.cncf.io", "v1", "NetworkAttachmentDefinition")).NotTo(BeNil())
+				Expect(rtest.GetResource(toDelete, "net-c", "ns1", "k8s.cni.cncf.io", "v1", "NetworkAttachmentDefinition")).NotTo(BeNil())
+			})
+
+			It("should not render NetworkAttachmentDefinitions in missing namespaces", func() {
+				mm := operatorv1.MultiInterfaceModeMultus
+				defaultInstance.CalicoNetwork.MultiInterfaceMode = &mm
+				defaultInstance.CalicoNetwork.SecondaryNetworks = []operatorv1.SecondaryNetwork{
+					{Name: "net-a", IPPool: operatorv1.IPPool{CIDR: "10.10.0.0/16"}, InterfacePrefix: "cna", Namespaces: []string{"ns1", "ns2"}},
+				}
+				cfg.MissingSecondaryNetworkNamespaces = map[string]bool{"ns2": true}
+				component := render.Node(&cfg)
+				Expect(component.ResolveImages(nil)).To(BeNil())
+				resources, _ := component.Objects()
+				Expect(len(resources)).To(Equal(defaultNumExpectedResources + 1))
+
+				nad := rtest.GetResource(resources, "net-a", "ns1", "k8s.cni.cncf.io", "v1", "NetworkAttachmentDefinition").(*nadv1.NetworkAttachmentDefinition)
+				Expect(nad.Spec.Config).To(ContainSubstring(`"interface_prefix":"cna"`))
+				Expect(rtest.GetResource(resources, "net-a", "ns2", "k8s.cni.cncf.io", "v1", "NetworkAttachmentDefinition")).To(BeNil())
+			})
+
 			It("should render a proper 'policy_setup_timeout_seconds' setting in the cni config", func() {
 				one := int32(1)
 				defaultInstance.CalicoNetwork.LinuxPolicySetupTimeoutSeconds = &one
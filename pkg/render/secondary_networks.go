@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"path/filepath"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	nadv1 "github.com/tigera/operator/pkg/apis/k8s.cni.cncf.io/v1"
+)
+
+// SecondaryNetworkLabel is set on the NetworkAttachmentDefinitions rendered for secondary networks, with the name of
+// the network as its value.
+const SecondaryNetworkLabel = "operator.tigera.io/secondary-network"
+
+// SecondaryNetworkPools returns the IP pools of the given Installation's secondary networks. The pools are only
+// assigned from on request, by the CNI configuration of their network.
+func SecondaryNetworkPools(installation *operatorv1.InstallationSpec) []operatorv1.IPPool {
+	if installation.CalicoNetwork == nil {
+		return nil
+	}
+	var pools []operatorv1.IPPool
+	for _, n := range installation.CalicoNetwork.SecondaryNetworks {
+		pool := n.IPPool.DeepCopy()
+		pool.AssignmentMode = crdv1.Manual
+		pools = append(pools, *pool)
+	}
+	return pools
+}
+
+// secondaryNetworkCNIConfig returns the CNI network configuration for a secondary network. It matches the Calico
+// plugin configuration installed on each node, except that addresses are assigned from the network's IP pool and
+// the host-side interfaces are named with the network's interface prefix.
+func (c *nodeComponent) secondaryNetworkCNIConfig(n operatorv1.SecondaryNetwork) string {
+	plugin := c.createCalicoPluginConfig()
+	plugin["interface_prefix"] = n.InterfacePrefix
+
+	ipam := map[string]interface{}{
+		"type":        "calico-ipam",
+		"assign_ipv4": "false",
+		"assign_ipv6": "false",
+	}
+	if addr, _, err := net.ParseCIDR(n.IPPool.CIDR); err == nil && addr.To4() != nil {
+		ipam["assign_ipv4"] = "true"
+		ipam["ipv4_pools"] = []string{n.IPPool.CIDR}
+	} else {
+		ipam["assign_ipv6"] = "true"
+		ipam["ipv6_pools"] = []string{n.IPPool.CIDR}
+	}
+	plugin["ipam"] = ipam
+
+	// Multus runs the plugin with this configuration directly, so the kubeconfig path must be the one the CNI plugin
+	// installer writes to, rather than the placeholder it substitutes in the node's configuration.
+	cniNetDir, _, _ := c.cniDirectories()
+	plugin["kubernetes"].(map[string]interface{})["kubeconfig"] = filepath.Join(cniNetDir, "calico-kubeconfig")
+
+	pluginsArray, _ := json.Marshal([]interface{}{plugin})
+	return fmt.Sprintf(`{
+			  "name": "%s",
+			  "cniVersion": "0.3.1",
+			  "plugins": %s
+			}`, n.Name, string(pluginsArray))
+}
+
+// networkAttachmentDefinitions returns the NetworkAttachmentDefinitions for the Installation's secondary networks,
+// one in each of the namespaces of each network that exists, and the operator created ones that are no longer needed.
+func (c *nodeComponent) networkAttachmentDefinitions() ([]client.Object, []client.Object) {
+	var objs []client.Object
+	desired := map[types.NamespacedName]bool{}
+	if c.cfg.Installation.CNI.Type == operatorv1.PluginCalico && c.cfg.Installation.CalicoNetwork != nil {
+		for _, n := range c.cfg.Installation.CalicoNetwork.SecondaryNetworks {
+			config := c.secondaryNetworkCNIConfig(n)
+			for _, ns := range n.Namespaces {
+				if c.cfg.MissingSecondaryNetworkNamespaces[ns] {
+					continue
+				}
+				desired[types.NamespacedName{Name: n.Name, Namespace: ns}] = true
+				objs = append(objs, &nadv1.NetworkAttachmentDefinition{
+					TypeMeta: metav1.TypeMeta{Kind: nadv1.KindNetworkAttachmentDefinition, APIVersion: "k8s.cni.cncf.io/v1"},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      n.Name,
+						Namespace: ns,
+						Labels:    map[string]string{SecondaryNetworkLabel: n.Name},
+					},
+					Spec: nadv1.NetworkAttachmentDefinitionSpec{Config: config},
+				})
+			}
+		}
+	}
+
+	var objsToDelete []client.Object
+	for _, nad := range c.cfg.NetworkAttachmentDefinitions {
+		if !desired[types.NamespacedName{Name: nad.Name, Namespace: nad.Namespace}] {
+			objsToDelete = append(objsToDelete, &nadv1.NetworkAttachmentDefinition{
+				TypeMeta:   metav1.TypeMeta{Kind: nadv1.KindNetworkAttachmentDefinition, APIVersion: "k8s.cni.cncf.io/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: nad.Name, Namespace: nad.Namespace},
+			})
+		}
+	}
+	return objs, objsToDelete
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tiers
+
+import (
+	"strconv"
+
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	"github.com/tigera/api/pkg/lib/numorstring"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/render"
+	"github.com/tigera/operator/pkg/render/common/networkpolicy"
+)
+
+const (
+	HostProtectionTierName          = "tigera-host-protection"
+	HostProtectionPolicyName        = HostProtectionTierName + ".allow-cluster-essentials"
+	HostProtectionDefaultPolicyName = "default." + HostProtectionTierName + "-default-deny"
+
+	// autoHostEndpointSelector selects the host endpoints that calico-kube-controllers creates for nodes.
+	autoHostEndpointSelector = "projectcalico.org/created-by == 'calico-kube-controllers'"
+
+	bgpPort       = 179
+	kubeletPort   = 10250
+	apiServerPort = 6443
+	vxlanPort     = 4789
+	ipipProtocol  = 4
+
+	sshPort             = 22
+	httpPort            = 80
+	httpsPort           = 443
+	kubeProxyHealthPort = 10256
+	nodePortMin         = 30000
+	nodePortMax         = 32767
+)
+
+var (
+	// hostProtectionTierOrder applies the host protection tier ahead of the allow-tigera tier and the tiers that
+	// users commonly create, so that they cannot deny the ports that the cluster needs.
+	hostProtectionTierOrder = 50.0
+
+	// hostProtectionDefaultPolicyOrder applies the default policy of the host protection after other ordered
+	// policies in the default tier.
+	hostProtectionDefaultPolicyOrder = 10000.0
+)
+
+// hostProtectionObjects returns the tier and policies of the baseline host policy, and those to delete if the
+// baseline host policy is disabled.
+func (t tiersComponent) hostProtectionObjects() ([]client.Object, []client.Object) {
+	objs := []client.Object{
+		t.hostProtectionTier(),
+		t.hostProtectionPolicy(),
+		t.hostProtectionDefaultPolicy(),
+	}
+	if t.cfg.HostProtection.BaselinePolicyMode() == operatorv1.HostBaselinePolicyDisabled {
+		// Delete the policies before the tier that holds them.
+		return nil, []client.Object{objs[1], objs[2], objs[0]}
+	}
+	return objs, nil
+}
+
+func (t tiersComponent) hostProtectionTier() *v3.Tier {
+	return &v3.Tier{
+		TypeMeta: metav1.TypeMeta{Kind: "Tier", APIVersion: "projectcalico.org/v3"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: HostProtectionTierName,
+			Labels: map[string]string{
+				"projectcalico.org/system-tier": "true",
+			},
+		},
+		Spec: v3.TierSpec{
+			Order: &hostProtectionTierOrder,
+		},
+	}
+}
+
+// hostProtectionPolicy creates a GlobalNetworkPolicy that allows ingress to the nodes on the ports needed by Calico
+// and the Kubernetes control plane. It defers other ingress to subsequent tiers using a Pass rule.
+func (t tiersComponent) hostProtectionPolicy() *v3.GlobalNetworkPolicy {
+	tcpPorts := []numorstring.Port{
+		numorstring.SinglePort(apiServerPort),
+		numorstring.SinglePort(kubeletPort),
+		numorstring.SinglePort(bgpPort),
+		numorstring.SinglePort(uint16(render.TyphaPort)),
+		{MinPort: 2379, MaxPort: 2380}, // etcd
+	}
+	if p, err := strconv.ParseUint(t.cfg.KubernetesServicePort, 10, 16); err == nil && p != apiServerPort {
+		tcpPorts = append(tcpPorts, numorstring.SinglePort(uint16(p)))
+	}
+	if t.cfg.NodeMetricsPort != nil {
+		tcpPorts = append(tcpPorts, numorstring.SinglePort(uint16(*t.cfg.NodeMetricsPort)))
+	}
+	if t.cfg.TyphaMetricsPort != nil {
+		tcpPorts = append(tcpPorts, numorstring.SinglePort(uint16(*t.cfg.TyphaMetricsPort)))
+	}
+
+	udpPorts := []numorstring.Port{
+		numorstring.SinglePort(vxlanPort),
+		{MinPort: 51820, MaxPort: 51821}, // WireGuard IPv4 and IPv6
+	}
+
+	ipip := numorstring.ProtocolFromInt(ipipProtocol)
+
+	return &v3.GlobalNetworkPolicy{
+		TypeMeta: metav1.TypeMeta{Kind: "GlobalNetworkPolicy", APIVersion: "projectcalico.org/v3"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: HostProtectionPolicyName,
+		},
+		Spec: v3.GlobalNetworkPolicySpec{
+			Order:    &networkpolicy.HighPrecedenceOrder,
+			Tier:     HostProtectionTierName,
+			Selector: autoHostEndpointSelector,
+			Ingress: []v3.Rule{
+				{
+					Action:      v3.Allow,
+					Protocol:    &networkpolicy.TCPProtocol,
+					Destination: v3.EntityRule{Ports: tcpPorts},
+				},
+				{
+					Action:      v3.Allow,
+					Protocol:    &networkpolicy.UDPProtocol,
+					Destination: v3.EntityRule{Ports: udpPorts},
+				},
+				{
+					Action:   v3.Allow,
+					Protocol: &ipip,
+				},
+				{
+					Action: v3.Pass,
+				},
+			},
+			Types: []v3.PolicyType{v3.PolicyTypeIngress},
+		},
+	}
+}
+
+// hostProtectionDefaultPolicy creates a GlobalNetworkPolicy in the default tier that allows ingress to the nodes
+// from other nodes and from pods, and from anywhere to the ports that are commonly exposed on nodes: SSH, HTTP and
+// HTTPS for host networked ingress controllers, the kube-proxy health check, the default NodePort range, which also
+// holds the health check node ports of services, and the configured extra ports. Ingress from elsewhere is denied,
+// or logged and allowed in audit mode.
+func (t tiersComponent) hostProtectionDefaultPolicy() *v3.GlobalNetworkPolicy {
+	tcpPorts := []numorstring.Port{
+		numorstring.SinglePort(sshPort),
+		numorstring.SinglePort(httpPort),
+		numorstring.SinglePort(httpsPort),
+		numorstring.SinglePort(kubeProxyHealthPort),
+		{MinPort: nodePortMin, MaxPort: nodePortMax},
+	}
+	udpPorts := []numorstring.Port{
+		{MinPort: nodePortMin, MaxPort: nodePortMax},
+	}
+	if t.cfg.HostProtection != nil {
+		for _, p := range t.cfg.HostProtection.AllowedIngressPorts {
+			port := numorstring.SinglePort(uint16(p.Port))
+			if p.EndPort != nil {
+				port.MaxPort = uint16(*p.EndPort)
+			}
+			if p.ProtocolOrDefault() == operatorv1.HostProtectionProtocolUDP {
+				udpPorts = append(udpPorts, port)
+			} else {
+				tcpPorts = append(tcpPorts, port)
+			}
+		}
+	}
+
+	ingress := []v3.Rule{
+		{
+			Action: v3.Allow,
+			Source: v3.EntityRule{Selector: autoHostEndpointSelector},
+		},
+		{
+			Action: v3.Allow,
+			Source: v3.EntityRule{NamespaceSelector: "all()"},
+		},
+		{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Destination: v3.EntityRule{Ports: tcpPorts},
+		},
+		{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.UDPProtocol,
+			Destination: v3.EntityRule{Ports: udpPorts},
+		},
+	}
+	if t.cfg.HostProtection.BaselinePolicyMode() == operatorv1.HostBaselinePolicyAudit {
+		ingress = append(ingress, v3.Rule{Action: v3.Log}, v3.Rule{Action: v3.Allow})
+	} else {
+		ingress = append(ingress, v3.Rule{Action: v3.Deny})
+	}
+
+	return &v3.GlobalNetworkPolicy{
+		TypeMeta: metav1.TypeMeta{Kind: "GlobalNetworkPolicy", APIVersion: "projectcalico.org/v3"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: HostProtectionDefaultPolicyName,
+		},
+		Spec: v3.GlobalNetworkPolicySpec{
+			Order:    &hostProtectionDefaultPolicyOrder,
+			Tier:     "default",
+			Selector: autoHostEndpointSelector,
+			Ingress:  ingress,
+			Types:    []v3.PolicyType{v3.PolicyTypeIngress},
+		},
+	}
+}
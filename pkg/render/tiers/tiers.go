@@ -45,6 +45,16 @@ type Config struct {
 	// populated dynamically by the controller in order to correctly capture the set of namespaces
 	// that require inclusion in policy generated by this component.
 	CalicoNamespaces []string
+
+	// HostProtection configures the baseline policy for the automatic host endpoints of the cluster's nodes.
+	HostProtection *operatorv1.HostProtection
+
+	// KubernetesServicePort is the port of the Kubernetes API server endpoint, if it has been configured.
+	KubernetesServicePort string
+
+	// NodeMetricsPort and TyphaMetricsPort are the metrics ports of calico-node and Typha, if they are enabled.
+	NodeMetricsPort  *int32
+	TyphaMetricsPort *int32
 }
 
 type DNSEgressCIDR struct {
@@ -74,6 +84,10 @@ func (t tiersComponent) Objects() ([]client.Object, []client.Object) {
 		objsToDelete = append(objsToDelete, t.allowTigeraNodeLocalDNSPolicy())
 	}
 
+	hostProtectionObjs, hostProtectionObjsToDelete := t.hostProtectionObjects()
+	objsToCreate = append(objsToCreate, hostProtectionObjs...)
+	objsToDelete = append(objsToDelete, hostProtectionObjsToDelete...)
+
 	return objsToCreate, objsToDelete
 }
 
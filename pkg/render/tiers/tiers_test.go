@@ -19,6 +19,7 @@ import (
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	"github.com/tigera/api/pkg/lib/numorstring"
 	v1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/render"
@@ -173,4 +174,91 @@ var _ = Describe("Tiers rendering tests", func() {
 			Entry("for when ipMode is not provided", nil),
 		)
 	})
+
+	Context("host protection rendering", func() {
+		It("should not render the host protection tier unless the baseline policy is enabled", func() {
+			component := tiers.Tiers(cfg)
+			resourcesToCreate, resourcesToDelete := component.Objects()
+
+			Expect(rtest.GetResource(resourcesToCreate, tiers.HostProtectionTierName, "", "projectcalico.org", "v3", "Tier")).To(BeNil())
+			Expect(rtest.GetResource(resourcesToDelete, tiers.HostProtectionTierName, "", "projectcalico.org", "v3", "Tier")).NotTo(BeNil())
+			Expect(rtest.GetResource(resourcesToDelete, tiers.HostProtectionPolicyName, "", "projectcalico.org", "v3", "GlobalNetworkPolicy")).NotTo(BeNil())
+			Expect(rtest.GetResource(resourcesToDelete, tiers.HostProtectionDefaultPolicyName, "", "projectcalico.org", "v3", "GlobalNetworkPolicy")).NotTo(BeNil())
+		})
+
+		DescribeTable("should render the baseline host policy",
+			func(mode v1.HostBaselinePolicyMode, lastRules []v3.Rule) {
+				autoHostEndpoints := v1.AutoHostEndpointsEnabled
+				cfg.HostProtection = &v1.HostProtection{AutoHostEndpoints: &autoHostEndpoints, BaselinePolicy: &mode}
+				cfg.KubernetesServicePort = "443"
+				nodeMetricsPort := int32(9081)
+				cfg.NodeMetricsPort = &nodeMetricsPort
+				component := tiers.Tiers(cfg)
+				resourcesToCreate, _ := component.Objects()
+
+				tier := rtest.GetResource(resourcesToCreate, tiers.HostProtectionTierName, "", "projectcalico.org", "v3", "Tier").(*v3.Tier)
+				Expect(*tier.Spec.Order).To(Equal(50.0))
+
+				policy := rtest.GetResource(resourcesToCreate, tiers.HostProtectionPolicyName, "", "projectcalico.org", "v3", "GlobalNetworkPolicy").(*v3.GlobalNetworkPolicy)
+				Expect(policy.Spec.Tier).To(Equal(tiers.HostProtectionTierName))
+				Expect(policy.Spec.Selector).To(Equal("projectcalico.org/created-by == 'calico-kube-controllers'"))
+				Expect(policy.Spec.Types).To(Equal([]v3.PolicyType{v3.PolicyTypeIngress}))
+				Expect(policy.Spec.Ingress).To(HaveLen(4))
+				Expect(policy.Spec.Ingress[0].Action).To(Equal(v3.Allow))
+				Expect(policy.Spec.Ingress[0].Destination.Ports).To(ConsistOf(
+					numorstring.SinglePort(6443),
+					numorstring.SinglePort(10250),
+					numorstring.SinglePort(179),
+					numorstring.SinglePort(5473),
+					numorstring.Port{MinPort: 2379, MaxPort: 2380},
+					numorstring.SinglePort(443),
+					numorstring.SinglePort(9081),
+				))
+				Expect(policy.Spec.Ingress[3]).To(Equal(v3.Rule{Action: v3.Pass}))
+
+				defaultPolicy := rtest.GetResource(resourcesToCreate, tiers.HostProtectionDefaultPolicyName, "", "projectcalico.org", "v3", "GlobalNetworkPolicy").(*v3.GlobalNetworkPolicy)
+				Expect(defaultPolicy.Spec.Tier).To(Equal("default"))
+				Expect(defaultPolicy.Spec.Ingress[4:]).To(Equal(lastRules))
+			},
+			Entry("in enforce mode", v1.HostBaselinePolicyEnforce, []v3.Rule{{Action: v3.Deny}}),
+			Entry("in audit mode", v1.HostBaselinePolicyAudit, []v3.Rule{{Action: v3.Log}, {Action: v3.Allow}}),
+		)
+
+		It("should allow the commonly exposed node ports and the configured extra ports before denying ingress", func() {
+			autoHostEndpoints := v1.AutoHostEndpointsEnabled
+			mode := v1.HostBaselinePolicyEnforce
+			udp := v1.HostProtectionProtocolUDP
+			endPort := int32(8090)
+			cfg.HostProtection = &v1.HostProtection{
+				AutoHostEndpoints: &autoHostEndpoints,
+				BaselinePolicy:    &mode,
+				AllowedIngressPorts: []v1.HostProtectionPort{
+					{Port: 8080, EndPort: &endPort},
+					{Port: 5353, Protocol: &udp},
+				},
+			}
+			component := tiers.Tiers(cfg)
+			resourcesToCreate, _ := component.Objects()
+
+			defaultPolicy := rtest.GetResource(resourcesToCreate, tiers.HostProtectionDefaultPolicyName, "", "projectcalico.org", "v3", "GlobalNetworkPolicy").(*v3.GlobalNetworkPolicy)
+			Expect(defaultPolicy.Spec.Ingress).To(HaveLen(5))
+			Expect(defaultPolicy.Spec.Ingress[2].Action).To(Equal(v3.Allow))
+			Expect(*defaultPolicy.Spec.Ingress[2].Protocol).To(Equal(numorstring.ProtocolFromString("TCP")))
+			Expect(defaultPolicy.Spec.Ingress[2].Destination.Ports).To(ConsistOf(
+				numorstring.SinglePort(22),
+				numorstring.SinglePort(80),
+				numorstring.SinglePort(443),
+				numorstring.SinglePort(10256),
+				numorstring.Port{MinPort: 30000, MaxPort: 32767},
+				numorstring.Port{MinPort: 8080, MaxPort: 8090},
+			))
+			Expect(defaultPolicy.Spec.Ingress[3].Action).To(Equal(v3.Allow))
+			Expect(*defaultPolicy.Spec.Ingress[3].Protocol).To(Equal(numorstring.ProtocolFromString("UDP")))
+			Expect(defaultPolicy.Spec.Ingress[3].Destination.Ports).To(ConsistOf(
+				numorstring.Port{MinPort: 30000, MaxPort: 32767},
+				numorstring.SinglePort(5353),
+			))
+			Expect(defaultPolicy.Spec.Ingress[4]).To(Equal(v3.Rule{Action: v3.Deny}))
+		})
+	})
 })
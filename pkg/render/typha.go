@@ -32,6 +32,7 @@ import (
 	"github.com/tigera/operator/pkg/controller/migration"
 	rcomp "github.com/tigera/operator/pkg/render/common/components"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/metrics"
 	"github.com/tigera/operator/pkg/render/common/securitycontext"
 	"github.com/tigera/operator/pkg/render/common/securitycontextconstraints"
 )
@@ -716,3 +717,19 @@ func (c *typhaComponent) typhaPrometheusService() *corev1.Service {
 		},
 	}
 }
+
+// TyphaMetricsTarget declares the metrics endpoint of Typha that is exposed by the calico-typha-metrics service. The
+// service only exists when Installation.TyphaMetricsPort is set.
+func TyphaMetricsTarget() metrics.Target {
+	return metrics.Target{
+		Name:      TyphaMetricsName,
+		Namespace: common.CalicoNamespace,
+		Selector:  metav1.LabelSelector{MatchLabels: map[string]string{AppLabelName: TyphaMetricsName}},
+		Endpoints: []metrics.Endpoint{
+			{
+				Port:   TyphaMetricsName,
+				Scheme: metrics.SchemeHTTP,
+			},
+		},
+	}
+}
@@ -437,7 +437,7 @@ func (c *windowsComponent) uninstallContainer() corev1.Container {
 		Image:           c.nodeImage,
 		Args:            []string{"$env:CONTAINER_SANDBOX_MOUNT_POINT/uninstall-calico.ps1"},
 		Env:             uninstallEnv,
-		SecurityContext: securitycontext.NewWindowsHostProcessContext(),
+		SecurityContext: c.hostProcessContext(),
 		VolumeMounts:    uninstallVolumeMounts,
 	}
 }
@@ -456,7 +456,7 @@ func (c *windowsComponent) cniContainer() corev1.Container {
 		Image:           c.cniImage,
 		Command:         []string{"$env:CONTAINER_SANDBOX_MOUNT_POINT/opt/cni/bin/install.exe"},
 		Env:             cniEnv,
-		SecurityContext: securitycontext.NewWindowsHostProcessContext(),
+		SecurityContext: c.hostProcessContext(),
 		VolumeMounts:    cniVolumeMounts,
 	}
 }
@@ -469,7 +469,7 @@ func (c *windowsComponent) nodeContainer() corev1.Container {
 		Args:            []string{"$env:CONTAINER_SANDBOX_MOUNT_POINT/CalicoWindows/node-service.ps1"},
 		WorkingDir:      "$env:CONTAINER_SANDBOX_MOUNT_POINT/CalicoWindows/",
 		Resources:       c.nodeWindowsResources(),
-		SecurityContext: securitycontext.NewWindowsHostProcessContext(),
+		SecurityContext: c.hostProcessContext(),
 		Env:             c.windowsEnvVars(),
 		VolumeMounts:    c.windowsVolumeMounts(),
 	}
@@ -486,7 +486,7 @@ func (c *windowsComponent) felixContainer() corev1.Container {
 		Args:            []string{"$env:CONTAINER_SANDBOX_MOUNT_POINT/CalicoWindows/felix-service.ps1"},
 		WorkingDir:      "$env:CONTAINER_SANDBOX_MOUNT_POINT/CalicoWindows/",
 		Resources:       c.felixWindowsResources(),
-		SecurityContext: securitycontext.NewWindowsHostProcessContext(),
+		SecurityContext: c.hostProcessContext(),
 		Env:             c.windowsEnvVars(),
 		VolumeMounts:    c.windowsVolumeMounts(),
 		LivenessProbe:   lp,
@@ -503,7 +503,7 @@ func (c *windowsComponent) confdContainer() corev1.Container {
 		Args:            []string{"$env:CONTAINER_SANDBOX_MOUNT_POINT/CalicoWindows/confd/confd-service.ps1"},
 		WorkingDir:      "$env:CONTAINER_SANDBOX_MOUNT_POINT/CalicoWindows/",
 		Resources:       c.confdWindowsResources(),
-		SecurityContext: securitycontext.NewWindowsHostProcessContext(),
+		SecurityContext: c.hostProcessContext(),
 		Env:             c.windowsEnvVars(),
 		VolumeMounts:    c.windowsVolumeMounts(),
 	}
@@ -527,7 +527,7 @@ func (c *windowsComponent) windowsEnvVars() []corev1.EnvVar {
 		clusterType = clusterType + ",aks"
 	}
 
-	if bgpEnabled(c.cfg.Installation) {
+	if windowsBGPEnabled(c.cfg.Installation) {
 		clusterType = clusterType + ",bgp"
 	}
 
@@ -639,7 +639,7 @@ func (c *windowsComponent) windowsEnvVars() []corev1.EnvVar {
 		windowsEnv = append(windowsEnv, corev1.EnvVar{Name: "FELIX_IPV6SUPPORT", Value: "true"})
 
 		// Set CALICO_ROUTER_ID to "hash" for IPv6-only with BGP enabled.
-		if v4Method == "" && bgpEnabled(c.cfg.Installation) {
+		if v4Method == "" && windowsBGPEnabled(c.cfg.Installation) {
 			windowsEnv = append(windowsEnv, corev1.EnvVar{Name: "CALICO_ROUTER_ID", Value: "hash"})
 		}
 
@@ -709,6 +709,10 @@ func (c *windowsComponent) windowsEnvVars() []corev1.EnvVar {
 		windowsEnv = append(windowsEnv, corev1.EnvVar{Name: "FELIX_ROUTESOURCE", Value: "WorkloadIPs"})
 	}
 
+	if wn := c.cfg.Installation.WindowsNodes; wn != nil && wn.FirewallRules != nil {
+		windowsEnv = append(windowsEnv, corev1.EnvVar{Name: "FELIX_WINDOWSMANAGEFIREWALLRULES", Value: string(*wn.FirewallRules)})
+	}
+
 	windowsEnv = append(windowsEnv, c.cfg.K8sServiceEp.EnvVars(true, c.cfg.Installation.KubernetesProvider)...)
 
 	return windowsEnv
@@ -871,7 +875,7 @@ func (c *windowsComponent) windowsDaemonset(cniCfgMap *corev1.ConfigMap) *appsv1
 	}
 
 	// Add confd container if BGP is enabled
-	if bgpEnabled(c.cfg.Installation) {
+	if windowsBGPEnabled(c.cfg.Installation) {
 		ds.Spec.Template.Spec.Containers = append(ds.Spec.Template.Spec.Containers, c.confdContainer())
 	}
 
@@ -889,8 +893,27 @@ func (c *windowsComponent) windowsDaemonset(cniCfgMap *corev1.ConfigMap) *appsv1
 	return &ds
 }
 
+// hostProcessContext returns the security context for the calico-node-windows host process containers.
+func (c *windowsComponent) hostProcessContext() *corev1.SecurityContext {
+	sc := securitycontext.NewWindowsHostProcessContext()
+	if c.cfg.Installation.WindowsNodes != nil && c.cfg.Installation.WindowsNodes.HostProcessUserName != "" {
+		user := c.cfg.Installation.WindowsNodes.HostProcessUserName
+		sc.WindowsOptions.RunAsUserName = &user
+	}
+	return sc
+}
+
+// windowsBGPEnabled returns true if BGP runs on Windows nodes. This follows the cluster-wide BGP setting unless it
+// is overridden for Windows nodes.
+func windowsBGPEnabled(installation *operatorv1.InstallationSpec) bool {
+	if installation.WindowsNodes != nil && installation.WindowsNodes.BGP != nil {
+		return *installation.WindowsNodes.BGP == operatorv1.BGPEnabled
+	}
+	return bgpEnabled(installation)
+}
+
 func getWindowsBackend(installation *operatorv1.InstallationSpec) string {
-	if !bgpEnabled(installation) {
+	if !windowsBGPEnabled(installation) {
 		if installation.CNI.Type == operatorv1.PluginCalico {
 			if installation.CNI.IPAM.Type == operatorv1.IPAMPluginHostLocal {
 				// If BGP is disabled and using HostLocal, then that means routing is done
@@ -2113,6 +2113,33 @@ var _ = Describe("Windows rendering tests", func() {
 		})
 	})
 
+	It("should render the Windows node options", func() {
+		disabled := operatorv1.BGPDisabled
+		firewallRules := operatorv1.WindowsFirewallRulesEnabled
+		defaultInstance.WindowsNodes.HostProcessUserName = "NT AUTHORITY\\Local service"
+		defaultInstance.WindowsNodes.BGP = &disabled
+		defaultInstance.WindowsNodes.FirewallRules = &firewallRules
+
+		component := render.Windows(&cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+		dsResource := rtest.GetResource(resources, "calico-node-windows", "calico-system", "apps", "v1", "DaemonSet")
+		Expect(dsResource).ToNot(BeNil())
+		ds := dsResource.(*appsv1.DaemonSet)
+
+		// BGP is disabled on Windows nodes even though it's enabled for the cluster, so there's no confd container.
+		Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(2))
+		for _, c := range append(ds.Spec.Template.Spec.InitContainers, ds.Spec.Template.Spec.Containers...) {
+			Expect(*c.SecurityContext.WindowsOptions.RunAsUserName).To(Equal("NT AUTHORITY\\Local service"))
+			Expect(*c.SecurityContext.WindowsOptions.HostProcess).To(BeTrue())
+		}
+
+		env := ds.Spec.Template.Spec.Containers[0].Env
+		Expect(env).To(ContainElement(corev1.EnvVar{Name: "CALICO_NETWORKING_BACKEND", Value: "vxlan"}))
+		Expect(env).To(ContainElement(corev1.EnvVar{Name: "CLUSTER_TYPE", Value: "k8s,operator,windows"}))
+		Expect(env).To(ContainElement(corev1.EnvVar{Name: "FELIX_WINDOWSMANAGEFIREWALLRULES", Value: "Enabled"}))
+	})
+
 	It("should not enable prometheus metrics if NodeMetricsPort is nil", func() {
 		defaultInstance.Variant = operatorv1.TigeraSecureEnterprise
 		defaultInstance.NodeMetricsPort = nil
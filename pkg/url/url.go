@@ -34,6 +34,21 @@ func ParseEndpoint(endpoint string) (string, string, string, error) {
 	return url.Scheme, splits[0], splits[1], nil
 }
 
+// ValidateHTTPEndpoint checks that the endpoint is an absolute http or https URL with a host.
+func ValidateHTTPEndpoint(endpoint string) error {
+	u, err := url.ParseRequestURI(endpoint)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unexpected scheme %q, expected http or https", u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("missing host in %q", endpoint)
+	}
+	return nil
+}
+
 func ParseHostPortFromHTTPProxyString(proxyURL string) (string, error) {
 	parsedProxyURL, err := url.ParseRequestURI(proxyURL)
 	if err != nil {